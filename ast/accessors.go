@@ -0,0 +1,123 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "github.com/abbychau/mysql-parser/opcode"
+
+// This file holds the read-only accessors behind the interfaces of package
+// ast/stable. Their signatures are part of the stable API, don't change them.
+
+// WhereExpr returns the WHERE condition, or nil if there is none.
+func (n *SelectStmt) WhereExpr() ExprNode {
+	return n.Where
+}
+
+// FromClause returns the FROM clause, or nil if there is none.
+func (n *SelectStmt) FromClause() *TableRefsClause {
+	return n.From
+}
+
+// FieldsCount returns the number of fields in the select list.
+func (n *SelectStmt) FieldsCount() int {
+	if n.Fields == nil {
+		return 0
+	}
+	return len(n.Fields.Fields)
+}
+
+// TargetTable returns the table to insert into.
+func (n *InsertStmt) TargetTable() *TableRefsClause {
+	return n.Table
+}
+
+// ColumnsCount returns the number of columns in the column list.
+func (n *InsertStmt) ColumnsCount() int {
+	return len(n.Columns)
+}
+
+// RowsCount returns the number of rows in the VALUES list.
+func (n *InsertStmt) RowsCount() int {
+	return len(n.Lists)
+}
+
+// TargetTable returns the tables to update.
+func (n *UpdateStmt) TargetTable() *TableRefsClause {
+	return n.TableRefs
+}
+
+// AssignmentsCount returns the number of assignments in the SET clause.
+func (n *UpdateStmt) AssignmentsCount() int {
+	return len(n.List)
+}
+
+// TargetTable returns the tables to delete from, and the joined ones for a
+// multiple-table DELETE.
+func (n *DeleteStmt) TargetTable() *TableRefsClause {
+	return n.TableRefs
+}
+
+// DBName returns the schema name in its original case, or "" if not specified.
+func (n *ColumnName) DBName() string {
+	return n.Schema.O
+}
+
+// TblName returns the table name in its original case, or "" if not specified.
+func (n *ColumnName) TblName() string {
+	return n.Table.O
+}
+
+// ColName returns the column name in its original case.
+func (n *ColumnName) ColName() string {
+	return n.Name.O
+}
+
+// DBName returns the schema name in its original case, or "" if not specified.
+func (n *TableName) DBName() string {
+	return n.Schema.O
+}
+
+// TblName returns the table name in its original case.
+func (n *TableName) TblName() string {
+	return n.Name.O
+}
+
+// FuncName returns the function name in lower case.
+func (n *FuncCallExpr) FuncName() string {
+	return n.FnName.L
+}
+
+// ArgsCount returns the number of arguments.
+func (n *FuncCallExpr) ArgsCount() int {
+	return len(n.Args)
+}
+
+// Arg returns the i-th argument.
+func (n *FuncCallExpr) Arg(i int) ExprNode {
+	return n.Args[i]
+}
+
+// Operator returns the operator.
+func (n *BinaryOperationExpr) Operator() opcode.Op {
+	return n.Op
+}
+
+// LeftExpr returns the left operand.
+func (n *BinaryOperationExpr) LeftExpr() ExprNode {
+	return n.L
+}
+
+// RightExpr returns the right operand.
+func (n *BinaryOperationExpr) RightExpr() ExprNode {
+	return n.R
+}
@@ -64,6 +64,28 @@ const (
 	FlagHasWindowFunc
 )
 
+// Annotation marks an expression node with a property of its text, which the
+// parser records on request.
+type Annotation uint8
+
+// Annotations of expression nodes.
+const (
+	// AnnotationZeroDate marks a zero-date literal, e.g. '0000-00-00'.
+	AnnotationZeroDate Annotation = 1 << iota
+	// AnnotationZeroInDate marks a date literal with a zero month or day, e.g. '2020-00-15'.
+	AnnotationZeroInDate
+)
+
+// AnnotatedNode is a node that can carry annotations. All the expression nodes
+// embedding TexprNode implement it.
+type AnnotatedNode interface {
+	Node
+	// Annotate adds the annotation a to the node.
+	Annotate(a Annotation)
+	// HasAnnotation reports whether the node has any of the annotations in a.
+	HasAnnotation(a Annotation) bool
+}
+
 // ExprNode is a node that can be evaluated.
 // Name of implementations should have 'Expr' suffix.
 type ExprNode interface {
@@ -105,6 +127,12 @@ type FuncNode interface {
 // Name of implementations should have 'Stmt' suffix.
 type StmtNode interface {
 	Node
+	// SetEndPosition sets the end offset of this statement in the origin text.
+	SetEndPosition(offset int)
+	// EndPosition gets the end offset of this statement in the origin text, the
+	// offset right after its terminating ';' if any. Together with
+	// OriginTextPosition it delimits the statement in a multi-statement text.
+	EndPosition() int
 	statement()
 }
 
@@ -149,6 +177,16 @@ type Visitor interface {
 	Leave(n Node) (node Node, ok bool)
 }
 
+// IsTransactionControlStmt checks whether the statement controls the transaction,
+// that is BEGIN, COMMIT, ROLLBACK [TO SAVEPOINT], SAVEPOINT and RELEASE SAVEPOINT.
+func IsTransactionControlStmt(stmtNode StmtNode) bool {
+	switch stmtNode.(type) {
+	case *BeginStmt, *CommitStmt, *RollbackStmt, *SavepointStmt, *ReleaseSavepointStmt:
+		return true
+	}
+	return false
+}
+
 // GetStmtLabel generates a label for a statement.
 func GetStmtLabel(stmtNode StmtNode) string {
 	switch x := stmtNode.(type) {
@@ -158,8 +196,14 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "AnalyzeTable"
 	case *BeginStmt:
 		return "Begin"
+	case *BinlogStmt:
+		return "Binlog"
+	case *CacheIndexStmt:
+		return "CacheIndex"
 	case *ChangeStmt:
 		return "Change"
+	case *ChecksumTableStmt:
+		return "ChecksumTable"
 	case *CommitStmt:
 		return "Commit"
 	case *CompactTableStmt:
@@ -172,8 +216,18 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "CreateTable"
 	case *CreateViewStmt:
 		return "CreateView"
+	case *CreateSequenceStmt:
+		return "CreateSequence"
+	case *AlterSequenceStmt:
+		return "AlterSequence"
+	case *DropSequenceStmt:
+		return "DropSequence"
 	case *CreateUserStmt:
 		return "CreateUser"
+	case *AlterUserStmt:
+		return "AlterUser"
+	case *AlterInstanceStmt:
+		return "AlterInstance"
 	case *DeleteStmt:
 		return "Delete"
 	case *DropDatabaseStmt:
@@ -202,6 +256,8 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "ImportInto"
 	case *LoadDataStmt:
 		return "LoadData"
+	case *LoadIndexIntoCacheStmt:
+		return "LoadIndexIntoCache"
 	case *RollbackStmt:
 		return "Rollback"
 	case *SelectStmt:
@@ -236,6 +292,8 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "Shutdown"
 	case *SavepointStmt:
 		return "Savepoint"
+	case *ReleaseSavepointStmt:
+		return "ReleaseSavepoint"
 	case *OptimizeTableStmt:
 		return "Optimize"
 	}
@@ -73,6 +73,17 @@ func (n *node) OriginalText() string {
 // Statement implementations should embed it in.
 type stmtNode struct {
 	node
+	endOffset int
+}
+
+// SetEndPosition implements StmtNode interface.
+func (sn *stmtNode) SetEndPosition(offset int) {
+	sn.endOffset = offset
+}
+
+// EndPosition implements StmtNode interface.
+func (sn *stmtNode) EndPosition() int {
+	return sn.endOffset
 }
 
 // statement implements StmtNode interface.
@@ -100,8 +111,9 @@ func (dn *dmlNode) dmlStatement() {}
 // Expression implementations should embed it in.
 type exprNode struct {
 	node
-	Type types.FieldType
-	flag uint64
+	Type        types.FieldType
+	flag        uint64
+	annotations Annotation
 }
 
 // TexprNode is exported for parser driver.
@@ -127,6 +139,16 @@ func (en *exprNode) GetFlag() uint64 {
 	return en.flag
 }
 
+// Annotate implements AnnotatedNode interface.
+func (en *exprNode) Annotate(a Annotation) {
+	en.annotations |= a
+}
+
+// HasAnnotation implements AnnotatedNode interface.
+func (en *exprNode) HasAnnotation(a Annotation) bool {
+	return en.annotations&a != 0
+}
+
 type funcNode struct {
 	exprNode
 }
@@ -0,0 +1,1046 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/types"
+	"github.com/pingcap/errors"
+)
+
+// EncodeBinary returns the compact binary form of the statements, e.g. to ship
+// parsed statements to another process. DecodeBinary decodes it into
+// statements Equal to stmts.
+//
+// A blob starts with the version of the encoding and the fingerprint of the
+// layout of the types of package ast, so that DecodeBinary rejects a blob
+// written by another version of the package with ErrBinaryVersion. The table
+// of the strings of the statements follows, each string written once, the
+// numbers of the values of each type, which DecodeBinary allocates together,
+// then the statements. The fields of a node, exported or not, are written in
+// their order after a bitmap of the ones which aren't zero, the integers as
+// varints and the strings as their index in the table.
+// The value of an interface, e.g. of an ExprNode field, is written after the
+// tag of its type, its index in binaryTypes, which genbinarytypes generates.
+//
+// A literal is written as the value, the type and the flag of its ValueExpr,
+// which DecodeBinary creates again with NewValueExpr, so that the literals
+// must hold the values the parser gives them: NULL, the numbers, the strings
+// and the bytes, the hex and bit literals and the decimals. A node reached by
+// two fields is decoded into two copies, and a pointer cycle is reported as an
+// error.
+func EncodeBinary(stmts []StmtNode) ([]byte, error) {
+	s := loadBinarySchema()
+	e := &binaryEncoder{
+		schema:  s,
+		strings: make(map[string]uint64),
+		counts:  make([]uint64, len(s.pools)),
+		onPath:  make(map[binaryVisit]struct{}),
+	}
+	e.uvarint(uint64(len(stmts)))
+	for i := range stmts {
+		if err := e.iface(reflect.ValueOf(&stmts[i]).Elem()); err != nil {
+			return nil, err
+		}
+	}
+
+	size := len(binaryMagic) + 2*binary.MaxVarintLen64 + 8 + len(e.buf)
+	for _, str := range e.table {
+		size += binary.MaxVarintLen64 + len(str)
+	}
+	pools := 0
+	for _, n := range e.counts {
+		if n > 0 {
+			pools++
+		}
+	}
+	size += (2*pools + 1) * binary.MaxVarintLen64
+	blob := make([]byte, 0, size)
+	blob = append(blob, binaryMagic...)
+	blob = binary.AppendUvarint(blob, binaryFormatVersion)
+	blob = binary.LittleEndian.AppendUint64(blob, s.fingerprint)
+	blob = binary.AppendUvarint(blob, uint64(len(e.table)))
+	for _, str := range e.table {
+		blob = binary.AppendUvarint(blob, uint64(len(str)))
+	}
+	for _, str := range e.table {
+		blob = append(blob, str...)
+	}
+	blob = binary.AppendUvarint(blob, uint64(pools))
+	for i, n := range e.counts {
+		if n > 0 {
+			blob = binary.AppendUvarint(blob, uint64(i))
+			blob = binary.AppendUvarint(blob, n)
+		}
+	}
+	return append(blob, e.buf...), nil
+}
+
+// DecodeBinary returns the statements of a blob of EncodeBinary. It returns
+// ErrBinaryVersion for a blob written by another version of package ast, and
+// ErrBinaryCorrupt for a blob which isn't well-formed. The literals are created
+// by the parser driver, which must be registered as for parsing.
+func DecodeBinary(blob []byte) (stmts []StmtNode, err error) {
+	s := loadBinarySchema()
+	d := &binaryDecoder{schema: s, data: blob}
+	if !bytes.HasPrefix(blob, []byte(binaryMagic)) {
+		return nil, ErrBinaryCorrupt
+	}
+	d.pos = len(binaryMagic)
+	if d.uvarint() != binaryFormatVersion || d.fixed64() != s.fingerprint {
+		if d.err != nil {
+			return nil, d.err
+		}
+		return nil, ErrBinaryVersion
+	}
+	d.readTable()
+	d.readPools()
+
+	defer func() {
+		// A blob which isn't well-formed may hold a value of a type which
+		// can't be assigned to its field.
+		if r := recover(); r != nil {
+			stmts, err = nil, ErrBinaryCorrupt
+		}
+	}()
+	if n := d.uvarint(); n > 0 && n <= uint64(len(d.data)-d.pos) {
+		stmts = make([]StmtNode, n)
+	} else if n > 0 {
+		d.fail()
+	}
+	codec := s.codecs[reflect.TypeOf((*StmtNode)(nil)).Elem()]
+	for i := range stmts {
+		codec.decode(d, unsafe.Pointer(&stmts[i]))
+		if d.err == nil && stmts[i] == nil {
+			d.fail()
+		}
+	}
+	if d.err == nil && d.pos != len(d.data) {
+		d.fail()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return stmts, nil
+}
+
+// binaryMagic starts a blob of EncodeBinary.
+const binaryMagic = "MYAST"
+
+// binaryFormatVersion is the version of the encoding of EncodeBinary. The
+// layouts of the types are checked by their fingerprint, so it's bumped only
+// when the encoding itself changes.
+const binaryFormatVersion = 1
+
+var (
+	// ErrBinaryVersion is returned by DecodeBinary for a blob written by
+	// another version of package ast.
+	ErrBinaryVersion = errors.New("ast: the binary tree was encoded by another version of package ast")
+	// ErrBinaryCorrupt is returned by DecodeBinary for a blob which isn't
+	// well-formed.
+	ErrBinaryCorrupt = errors.New("ast: the binary tree is corrupt")
+)
+
+// The tags of the values of the interfaces, besides the tags of the types of
+// binaryBuiltinTypes and binaryTypes, which start from binaryTagFirstType. A
+// tag is written shifted left by one, with the low bit set for a pointer.
+const (
+	binaryTagNil = iota
+	binaryTagValueExpr
+	binaryTagParamMarkerExpr
+	binaryTagFirstType
+)
+
+// binaryBuiltinTypes are the types of the values of the interfaces which
+// aren't declared by package ast, e.g. the uint64 of a TableOptimizerHint.
+var binaryBuiltinTypes = []reflect.Type{
+	reflect.TypeOf(false),
+	reflect.TypeOf(int(0)),
+	reflect.TypeOf(int8(0)),
+	reflect.TypeOf(int16(0)),
+	reflect.TypeOf(int32(0)),
+	reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint(0)),
+	reflect.TypeOf(uint8(0)),
+	reflect.TypeOf(uint16(0)),
+	reflect.TypeOf(uint32(0)),
+	reflect.TypeOf(uint64(0)),
+	reflect.TypeOf(float32(0)),
+	reflect.TypeOf(float64(0)),
+	reflect.TypeOf(""),
+}
+
+// The kinds of the values of the ValueExprs.
+const (
+	binaryValueNull = iota
+	binaryValueInt64
+	binaryValueUint64
+	binaryValueFloat32
+	binaryValueFloat64
+	binaryValueString
+	binaryValueBytes
+	// binaryValueBinaryLiteral is a hex or a bit literal, decoded by
+	// NewHexLiteral.
+	binaryValueBinaryLiteral
+	// binaryValueDecimal is a decimal, decoded by NewDecimal from its string.
+	binaryValueDecimal
+	// binaryValueExpr is a ValueExpr, which a driver may wrap in another.
+	binaryValueExpr
+)
+
+var (
+	nodeStructType    = reflect.TypeOf(node{})
+	fieldTypeType     = reflect.TypeOf(types.FieldType{})
+	emptyIfaceType    = reflect.TypeOf((*any)(nil)).Elem()
+	exprNodeType      = reflect.TypeOf((*ExprNode)(nil)).Elem()
+	stmtNodeType      = reflect.TypeOf((*StmtNode)(nil)).Elem()
+	resultSetNodeType = reflect.TypeOf((*ResultSetNode)(nil)).Elem()
+)
+
+// binaryCodec encodes and decodes the values of a type, at p. A value is
+// decoded into zeroed memory, so the zero fields of a struct are skipped.
+type binaryCodec struct {
+	encode func(e *binaryEncoder, p unsafe.Pointer) error
+	decode func(d *binaryDecoder, p unsafe.Pointer)
+}
+
+// binaryField is a field of a struct encoded by a binaryCodec.
+type binaryField struct {
+	offset, size uintptr
+	codec        *binaryCodec
+}
+
+// sliceHeader is the layout of a slice.
+type sliceHeader struct {
+	data     unsafe.Pointer
+	len, cap int
+}
+
+// binarySchema has the codecs of the types the trees are made of, which are
+// built once for the types reached from binaryTypes.
+type binarySchema struct {
+	codecs map[reflect.Type]*binaryCodec
+	// types are the types of the tags from binaryTagFirstType, typeCodecs
+	// their codecs and typePools the pools of their values pointed to.
+	types      []reflect.Type
+	typeCodecs []*binaryCodec
+	typePools  []int
+	// ptrTypes are the type words of the interface values of the pointers
+	// to the types, which are direct.
+	ptrTypes  []unsafe.Pointer
+	tags      map[reflect.Type]uint64
+	fieldType *binaryCodec
+	// pools are the slice types of the arrays the values pointed to and the
+	// elements of the slices are decoded into, by their element type in
+	// poolIDs.
+	pools   []reflect.Type
+	poolIDs map[reflect.Type]int
+	// oncePool is the pool of the sync.Onces of the nodes with a text.
+	oncePool int
+	// fingerprint is the hash of the layouts of the types.
+	fingerprint uint64
+	hash        hash.Hash64
+	// modulePath is the path the packages of the module start with.
+	modulePath string
+}
+
+var loadBinarySchema = sync.OnceValue(func() *binarySchema {
+	s := &binarySchema{
+		codecs:     make(map[reflect.Type]*binaryCodec),
+		tags:       make(map[reflect.Type]uint64),
+		poolIDs:    make(map[reflect.Type]int),
+		hash:       fnv.New64a(),
+		modulePath: fieldTypeType.PkgPath()[:strings.LastIndexByte(fieldTypeType.PkgPath(), '/')+1],
+	}
+	s.types = slices.Concat(binaryBuiltinTypes, binaryTypes)
+	for i, t := range s.types {
+		s.tags[t] = uint64(binaryTagFirstType + i)
+		s.typeCodecs = append(s.typeCodecs, s.codec(t))
+		s.codec(reflect.PointerTo(t))
+		s.typePools = append(s.typePools, s.pool(t))
+		ptr := reflect.Zero(reflect.PointerTo(t)).Interface()
+		s.ptrTypes = append(s.ptrTypes, (*[2]unsafe.Pointer)(unsafe.Pointer(&ptr))[0])
+	}
+	s.codec(stmtNodeType)
+	s.oncePool = s.pool(reflect.TypeOf(sync.Once{}))
+	s.fieldType = s.codec(fieldTypeType)
+	s.fingerprint = s.hash.Sum64()
+	s.hash = nil
+	return s
+})
+
+// pool returns the ID of the pool of the values of t.
+func (s *binarySchema) pool(t reflect.Type) int {
+	id, ok := s.poolIDs[t]
+	if !ok {
+		id = len(s.pools)
+		s.poolIDs[t] = id
+		s.pools = append(s.pools, reflect.SliceOf(t))
+	}
+	return id
+}
+
+// codec returns the codec of t, which is built and hashed into the
+// fingerprint the first time.
+func (s *binarySchema) codec(t reflect.Type) *binaryCodec {
+	if c, ok := s.codecs[t]; ok {
+		return c
+	}
+	c := &binaryCodec{}
+	s.codecs[t] = c
+	fmt.Fprintf(s.hash, "%s %s;", t, t.Kind())
+	switch t.Kind() {
+	case reflect.Bool:
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			e.buf = append(e.buf, *(*byte)(p))
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			*(*bool)(p) = d.byte() != 0
+		}
+	case reflect.Int:
+		*c = binaryInt[int]()
+	case reflect.Int8:
+		*c = binaryInt[int8]()
+	case reflect.Int16:
+		*c = binaryInt[int16]()
+	case reflect.Int32:
+		*c = binaryInt[int32]()
+	case reflect.Int64:
+		*c = binaryInt[int64]()
+	case reflect.Uint:
+		*c = binaryUint[uint]()
+	case reflect.Uint8:
+		*c = binaryUint[uint8]()
+	case reflect.Uint16:
+		*c = binaryUint[uint16]()
+	case reflect.Uint32:
+		*c = binaryUint[uint32]()
+	case reflect.Uint64:
+		*c = binaryUint[uint64]()
+	case reflect.Uintptr:
+		*c = binaryUint[uintptr]()
+	case reflect.Float32:
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			e.buf = binary.LittleEndian.AppendUint32(e.buf, math.Float32bits(*(*float32)(p)))
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			*(*float32)(p) = math.Float32frombits(uint32(d.fixed(4)))
+		}
+	case reflect.Float64:
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(*(*float64)(p)))
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			*(*float64)(p) = math.Float64frombits(d.fixed64())
+		}
+	case reflect.String:
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			e.string(*(*string)(p))
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			*(*string)(p) = d.string()
+		}
+	case reflect.Slice:
+		s.sliceCodec(c, t)
+	case reflect.Array:
+		elem, size := s.codec(t.Elem()), t.Elem().Size()
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			for i := range t.Len() {
+				if err := elem.encode(e, unsafe.Add(p, uintptr(i)*size)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			for i := range t.Len() {
+				elem.decode(d, unsafe.Add(p, uintptr(i)*size))
+			}
+		}
+	case reflect.Pointer:
+		elem, pool := s.codec(t.Elem()), s.pool(t.Elem())
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			q := *(*unsafe.Pointer)(p)
+			if q == nil {
+				e.buf = append(e.buf, 0)
+				return nil
+			}
+			key := binaryVisit{q, t}
+			if _, ok := e.onPath[key]; ok {
+				return errors.Errorf("cycle at %s in the tree", t)
+			}
+			e.onPath[key] = struct{}{}
+			e.counts[pool]++
+			e.buf = append(e.buf, 1)
+			err := elem.encode(e, q)
+			delete(e.onPath, key)
+			return err
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			if d.byte() == 0 {
+				*(*unsafe.Pointer)(p) = nil
+				return
+			}
+			q := d.alloc(pool, 1)
+			elem.decode(d, q)
+			*(*unsafe.Pointer)(p) = q
+		}
+	case reflect.Interface:
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			return e.iface(reflect.NewAt(t, p).Elem())
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			d.iface(t, p)
+		}
+	case reflect.Struct:
+		if t == nodeStructType {
+			c.encode, c.decode = encodeBinaryNode, decodeBinaryNode
+			break
+		}
+		if t.PkgPath() != nodeStructType.PkgPath() && !strings.HasPrefix(t.PkgPath(), s.modulePath) {
+			// The layouts of the other modules, e.g. of a compiled
+			// regexp.Regexp, aren't ours to encode.
+			s.unsupported(c, t)
+			break
+		}
+		fields := make([]binaryField, 0, t.NumField())
+		for i := range t.NumField() {
+			f := t.Field(i)
+			fmt.Fprintf(s.hash, "%s;", f.Name)
+			fields = append(fields, binaryField{offset: f.Offset, size: f.Type.Size(), codec: s.codec(f.Type)})
+		}
+		// The fields are written after a bitmap of the ones which aren't
+		// zero, as most of the fields of a node are.
+		maskSize := (len(fields) + 7) / 8
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			mask := len(e.buf)
+			for range maskSize {
+				e.buf = append(e.buf, 0)
+			}
+			for i, f := range fields {
+				q := unsafe.Add(p, f.offset)
+				if isZeroMemory(q, f.size) {
+					continue
+				}
+				e.buf[mask+i/8] |= 1 << (i % 8)
+				if err := f.codec.encode(e, q); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			if maskSize > len(d.data)-d.pos {
+				d.fail()
+				return
+			}
+			mask := d.data[d.pos : d.pos+maskSize]
+			d.pos += maskSize
+			for j, b := range mask {
+				for ; b != 0; b &= b - 1 {
+					i := j*8 + bits.TrailingZeros8(b)
+					if i >= len(fields) {
+						d.fail()
+						return
+					}
+					fields[i].codec.decode(d, unsafe.Add(p, fields[i].offset))
+				}
+			}
+		}
+	default:
+		// The maps, the functions and the channels aren't parts of a tree.
+		s.unsupported(c, t)
+	}
+	return c
+}
+
+func (s *binarySchema) sliceCodec(c *binaryCodec, t reflect.Type) {
+	if t.Elem().Kind() == reflect.Uint8 {
+		c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+			b := *(*[]byte)(p)
+			if b == nil {
+				e.buf = append(e.buf, 0)
+				return nil
+			}
+			e.uvarint(uint64(len(b)) + 1)
+			e.buf = append(e.buf, b...)
+			return nil
+		}
+		c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+			n := d.length()
+			if n < 0 {
+				*(*[]byte)(p) = nil
+				return
+			}
+			*(*[]byte)(p) = slices.Clone(d.data[d.pos : d.pos+n])
+			d.pos += n
+		}
+		return
+	}
+	elem, size, pool := s.codec(t.Elem()), t.Elem().Size(), s.pool(t.Elem())
+	c.encode = func(e *binaryEncoder, p unsafe.Pointer) error {
+		h := (*sliceHeader)(p)
+		if h.data == nil {
+			e.buf = append(e.buf, 0)
+			return nil
+		}
+		e.uvarint(uint64(h.len) + 1)
+		e.counts[pool] += uint64(h.len)
+		for i := range h.len {
+			if err := elem.encode(e, unsafe.Add(h.data, uintptr(i)*size)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	c.decode = func(d *binaryDecoder, p unsafe.Pointer) {
+		n := d.length()
+		if n < 0 {
+			*(*sliceHeader)(p) = sliceHeader{}
+			return
+		}
+		var data unsafe.Pointer
+		if n == 0 {
+			data = reflect.MakeSlice(t, 0, 0).UnsafePointer()
+		} else {
+			data = d.alloc(pool, n)
+		}
+		for i := range n {
+			elem.decode(d, unsafe.Add(data, uintptr(i)*size))
+		}
+		*(*sliceHeader)(p) = sliceHeader{data: data, len: n, cap: n}
+	}
+}
+
+// isZeroMemory reports whether the size bytes at p are zero, which they are for
+// a zero value. A value which isn't zero, e.g. -0.0, is encoded.
+func isZeroMemory(p unsafe.Pointer, size uintptr) bool {
+	for _, b := range unsafe.Slice((*byte)(p), size) {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// unsupported makes c the codec of a type which can only be encoded when it
+// holds its zero value, which it's decoded into.
+func (*binarySchema) unsupported(c *binaryCodec, t reflect.Type) {
+	c.encode = func(_ *binaryEncoder, p unsafe.Pointer) error {
+		if !reflect.NewAt(t, p).Elem().IsZero() {
+			return errors.Errorf("can't encode the %s in the tree", t)
+		}
+		return nil
+	}
+	c.decode = func(*binaryDecoder, unsafe.Pointer) {}
+}
+
+func binaryInt[T int | int8 | int16 | int32 | int64]() binaryCodec {
+	return binaryCodec{
+		encode: func(e *binaryEncoder, p unsafe.Pointer) error {
+			e.buf = binary.AppendVarint(e.buf, int64(*(*T)(p)))
+			return nil
+		},
+		decode: func(d *binaryDecoder, p unsafe.Pointer) {
+			*(*T)(p) = T(d.varint())
+		},
+	}
+}
+
+func binaryUint[T uint | uint8 | uint16 | uint32 | uint64 | uintptr]() binaryCodec {
+	return binaryCodec{
+		encode: func(e *binaryEncoder, p unsafe.Pointer) error {
+			e.uvarint(uint64(*(*T)(p)))
+			return nil
+		},
+		decode: func(d *binaryDecoder, p unsafe.Pointer) {
+			*(*T)(p) = T(d.uvarint())
+		},
+	}
+}
+
+// encodeBinaryNode encodes the node embedded in the nodes: its offset and its
+// text with its encoding. The text decoded into UTF-8 is cached by Text, so it
+// isn't encoded.
+func encodeBinaryNode(e *binaryEncoder, p unsafe.Pointer) error {
+	n := (*node)(p)
+	e.buf = binary.AppendVarint(e.buf, int64(n.offset))
+	if n.once == nil {
+		e.buf = append(e.buf, 0)
+		e.string(n.text)
+		return nil
+	}
+	e.buf = append(e.buf, 1)
+	e.counts[e.schema.oncePool]++
+	e.string(n.text)
+	if n.enc == nil {
+		e.string("")
+	} else {
+		e.string(n.enc.Name())
+	}
+	return nil
+}
+
+func decodeBinaryNode(d *binaryDecoder, p unsafe.Pointer) {
+	n := (*node)(p)
+	n.offset = int(d.varint())
+	if d.byte() == 0 {
+		n.text = d.string()
+		return
+	}
+	text := d.string()
+	var enc charset.Encoding
+	if name := d.string(); name != "" {
+		enc = charset.FindEncoding(name)
+	}
+	// It's SetText, with the sync.Once from a pool.
+	n.enc, n.text = enc, text
+	n.once = (*sync.Once)(d.alloc(d.schema.oncePool, 1))
+}
+
+// binaryVisit is a pointer followed by the binaryEncoder.
+type binaryVisit struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+}
+
+type binaryEncoder struct {
+	schema *binarySchema
+	buf    []byte
+	// strings maps the strings of table to their index.
+	strings map[string]uint64
+	table   []string
+	// counts are the numbers of values written of the pools.
+	counts []uint64
+	// onPath holds the pointers followed from the root to the value being
+	// encoded.
+	onPath map[binaryVisit]struct{}
+}
+
+func (e *binaryEncoder) uvarint(x uint64) {
+	e.buf = binary.AppendUvarint(e.buf, x)
+}
+
+func (e *binaryEncoder) string(s string) {
+	i, ok := e.strings[s]
+	if !ok {
+		i = uint64(len(e.table))
+		e.strings[s] = i
+		e.table = append(e.table, s)
+	}
+	e.uvarint(i)
+}
+
+// iface encodes v, a value of an interface type.
+func (e *binaryEncoder) iface(v reflect.Value) error {
+	if v.IsNil() {
+		e.buf = append(e.buf, binaryTagNil)
+		return nil
+	}
+	x := v.Elem()
+	switch n := x.Interface().(type) {
+	case ParamMarkerExpr:
+		e.uvarint(binaryTagParamMarkerExpr << 1)
+		return e.valueExpr(n)
+	case ValueExpr:
+		e.uvarint(binaryTagValueExpr << 1)
+		return e.valueExpr(n)
+	}
+	t, ptr := x.Type(), uint64(0)
+	if t.Kind() == reflect.Pointer {
+		t, ptr = t.Elem(), 1
+	}
+	tag, ok := e.schema.tags[t]
+	if !ok {
+		return errors.Errorf("can't encode the %s in the tree", x.Type())
+	}
+	e.uvarint(tag<<1 | ptr)
+	if ptr == 1 {
+		q := x.UnsafePointer()
+		return e.schema.codecs[x.Type()].encode(e, unsafe.Pointer(&q))
+	}
+	q := reflect.New(t)
+	q.Elem().Set(x)
+	return e.schema.codecs[t].encode(e, q.UnsafePointer())
+}
+
+// valueExpr encodes a ValueExpr of the parser driver by what its interface
+// tells.
+func (e *binaryEncoder) valueExpr(n ValueExpr) error {
+	if err := e.value(n.GetValue()); err != nil {
+		return err
+	}
+	if err := e.schema.fieldType.encode(e, unsafe.Pointer(n.GetType())); err != nil {
+		return err
+	}
+	e.uvarint(n.GetFlag())
+	e.buf = binary.AppendVarint(e.buf, int64(n.OriginTextPosition()))
+	e.string(n.OriginalText())
+	e.buf = binary.AppendVarint(e.buf, int64(n.GetProjectionOffset()))
+	return nil
+}
+
+// value encodes the value of a ValueExpr.
+func (e *binaryEncoder) value(val any) error {
+	switch x := val.(type) {
+	case nil:
+		e.buf = append(e.buf, binaryValueNull)
+	case int64:
+		e.buf = append(e.buf, binaryValueInt64)
+		e.buf = binary.AppendVarint(e.buf, x)
+	case uint64:
+		e.buf = append(e.buf, binaryValueUint64)
+		e.uvarint(x)
+	case float32:
+		e.buf = append(e.buf, binaryValueFloat32)
+		e.buf = binary.LittleEndian.AppendUint32(e.buf, math.Float32bits(x))
+	case float64:
+		e.buf = append(e.buf, binaryValueFloat64)
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(x))
+	case string:
+		e.buf = append(e.buf, binaryValueString)
+		e.string(x)
+	case []byte:
+		e.buf = append(e.buf, binaryValueBytes)
+		e.uvarint(uint64(len(x)))
+		e.buf = append(e.buf, x...)
+	case ValueExpr:
+		e.buf = append(e.buf, binaryValueExpr)
+		return e.valueExpr(x)
+	default:
+		v := reflect.ValueOf(val)
+		switch {
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			// The BinaryLiteral of a hex or a bit literal.
+			e.buf = append(e.buf, binaryValueBinaryLiteral)
+			e.uvarint(uint64(v.Len()))
+			e.buf = append(e.buf, v.Bytes()...)
+		case reflect.Indirect(v).Type().Name() == "MyDecimal":
+			e.buf = append(e.buf, binaryValueDecimal)
+			e.string(fmt.Sprint(val))
+		default:
+			return errors.Errorf("can't encode the %T value of a ValueExpr", val)
+		}
+	}
+	return nil
+}
+
+type binaryDecoder struct {
+	schema *binarySchema
+	data   []byte
+	pos    int
+	// table are the strings of the blob.
+	table []string
+	pools []binaryPool
+	err   error
+}
+
+// binaryPool is an array the values of a type are decoded into, so that they
+// aren't allocated one at a time.
+type binaryPool struct {
+	data      unsafe.Pointer
+	size      uintptr
+	used, len int
+}
+
+// fail records that the blob is corrupt, and makes the next reads fail.
+func (d *binaryDecoder) fail() {
+	if d.err == nil {
+		d.err = ErrBinaryCorrupt
+	}
+	d.pos = len(d.data)
+}
+
+func (d *binaryDecoder) byte() byte {
+	if d.pos >= len(d.data) {
+		d.fail()
+		return 0
+	}
+	d.pos++
+	return d.data[d.pos-1]
+}
+
+func (d *binaryDecoder) uvarint() uint64 {
+	x, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.pos += n
+	return x
+}
+
+func (d *binaryDecoder) varint() int64 {
+	x, n := binary.Varint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.pos += n
+	return x
+}
+
+// fixed reads a little endian integer of size bytes.
+func (d *binaryDecoder) fixed(size int) uint64 {
+	if len(d.data)-d.pos < size {
+		d.fail()
+		return 0
+	}
+	var x uint64
+	for i := size - 1; i >= 0; i-- {
+		x = x<<8 | uint64(d.data[d.pos+i])
+	}
+	d.pos += size
+	return x
+}
+
+func (d *binaryDecoder) fixed64() uint64 {
+	return d.fixed(8)
+}
+
+// length reads the length of a slice written plus one, -1 for a nil slice.
+// The length is checked against the bytes left, which each element takes one
+// of at least.
+func (d *binaryDecoder) length() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)-d.pos)+1 {
+		d.fail()
+		return -1
+	}
+	return int(n) - 1
+}
+
+func (d *binaryDecoder) string() string {
+	i := d.uvarint()
+	if i >= uint64(len(d.table)) {
+		d.fail()
+		return ""
+	}
+	return d.table[i]
+}
+
+// readPools reads the numbers of the values of the pools, which are allocated
+// when they are first used. As a value takes a byte at least, there are fewer
+// values than bytes left.
+func (d *binaryDecoder) readPools() {
+	d.pools = make([]binaryPool, len(d.schema.pools))
+	n := d.uvarint()
+	if n > uint64(len(d.pools)) {
+		d.fail()
+		return
+	}
+	total := uint64(0)
+	for range n {
+		id, count := d.uvarint(), d.uvarint()
+		total += count
+		if d.err != nil || id >= uint64(len(d.pools)) || count > uint64(len(d.data)) || total > uint64(len(d.data)-d.pos) {
+			d.fail()
+			return
+		}
+		d.pools[id].len = int(count)
+	}
+}
+
+// alloc returns n zero values of the pool id.
+func (d *binaryDecoder) alloc(id, n int) unsafe.Pointer {
+	p := &d.pools[id]
+	if p.len-p.used < n {
+		// The counts of a blob which isn't well-formed may be wrong.
+		d.fail()
+		return reflect.MakeSlice(d.schema.pools[id], n, n).UnsafePointer()
+	}
+	if p.data == nil {
+		p.data = reflect.MakeSlice(d.schema.pools[id], p.len, p.len).UnsafePointer()
+		p.size = d.schema.pools[id].Elem().Size()
+	}
+	q := unsafe.Add(p.data, uintptr(p.used)*p.size)
+	p.used += n
+	return q
+}
+
+// readTable reads the string table. The strings share the memory of a copy of
+// the bytes of the table.
+func (d *binaryDecoder) readTable() {
+	n := d.uvarint()
+	if n > uint64(len(d.data)-d.pos) {
+		d.fail()
+		return
+	}
+	lens := make([]int, n)
+	size := 0
+	for i := range lens {
+		l := d.uvarint()
+		if rest := len(d.data) - d.pos - size; d.err != nil || rest < 0 || l > uint64(rest) {
+			d.fail()
+			return
+		}
+		lens[i] = int(l)
+		size += lens[i]
+	}
+	all := string(d.data[d.pos : d.pos+size])
+	d.pos += size
+	d.table = make([]string, n)
+	for i, l := range lens {
+		d.table[i], all = all[:l], all[l:]
+	}
+}
+
+// iface decodes the value of the interface type t at p.
+func (d *binaryDecoder) iface(t reflect.Type, p unsafe.Pointer) {
+	tag := d.uvarint()
+	var x any
+	switch tag >> 1 {
+	case binaryTagNil:
+		reflect.NewAt(t, p).Elem().SetZero()
+		return
+	case binaryTagValueExpr, binaryTagParamMarkerExpr:
+		x = d.valueExpr(tag>>1 == binaryTagParamMarkerExpr)
+	default:
+		i := tag>>1 - binaryTagFirstType
+		if i >= uint64(len(d.schema.types)) {
+			d.fail()
+			return
+		}
+		if tag&1 == 1 {
+			// It's the pointer codec, without a pointer to the pointer
+			// which would escape.
+			var q unsafe.Pointer
+			if d.byte() != 0 {
+				q = d.alloc(d.schema.typePools[i], 1)
+				d.schema.typeCodecs[i].decode(d, q)
+			}
+			x = *(*any)(unsafe.Pointer(&[2]unsafe.Pointer{d.schema.ptrTypes[i], q}))
+		} else {
+			q := reflect.New(d.schema.types[i])
+			d.schema.typeCodecs[i].decode(d, q.UnsafePointer())
+			x = q.Elem().Interface()
+		}
+	}
+	if d.err != nil {
+		return
+	}
+	switch t {
+	case emptyIfaceType:
+		*(*any)(p) = x
+	case exprNodeType:
+		*(*ExprNode)(p) = x.(ExprNode)
+	case stmtNodeType:
+		*(*StmtNode)(p) = x.(StmtNode)
+	case resultSetNodeType:
+		*(*ResultSetNode)(p) = x.(ResultSetNode)
+	default:
+		reflect.NewAt(t, p).Elem().Set(reflect.ValueOf(x))
+	}
+}
+
+// valueExpr decodes a ValueExpr, or a ParamMarkerExpr if paramMarker is true,
+// and creates it with the parser driver.
+func (d *binaryDecoder) valueExpr(paramMarker bool) ValueExpr {
+	val := d.value()
+	var tp types.FieldType
+	d.schema.fieldType.decode(d, unsafe.Pointer(&tp))
+	flag := d.uvarint()
+	offset := int(d.varint())
+	text := d.string()
+	projectionOffset := int(d.varint())
+	if d.err != nil {
+		return nil
+	}
+	var n ValueExpr
+	if paramMarker {
+		n = NewParamMarkerExpr(offset)
+		if val != nil {
+			n.SetValue(val)
+		}
+	} else {
+		n = NewValueExpr(val, tp.GetCharset(), tp.GetCollate())
+	}
+	n.SetType(&tp)
+	n.SetFlag(flag)
+	n.SetOriginTextPosition(offset)
+	if text != "" {
+		n.SetText(nil, text)
+	}
+	n.SetProjectionOffset(projectionOffset)
+	return n
+}
+
+// value decodes the value of a ValueExpr.
+func (d *binaryDecoder) value() any {
+	switch d.byte() {
+	case binaryValueNull:
+		return nil
+	case binaryValueInt64:
+		return d.varint()
+	case binaryValueUint64:
+		return d.uvarint()
+	case binaryValueFloat32:
+		return math.Float32frombits(uint32(d.fixed(4)))
+	case binaryValueFloat64:
+		return math.Float64frombits(d.fixed64())
+	case binaryValueString:
+		return d.string()
+	case binaryValueBytes:
+		return d.bytes()
+	case binaryValueBinaryLiteral:
+		val, err := NewHexLiteral("x'" + hex.EncodeToString(d.bytes()) + "'")
+		if err != nil {
+			d.fail()
+		}
+		return val
+	case binaryValueDecimal:
+		val, err := NewDecimal(d.string())
+		if err != nil {
+			d.fail()
+		}
+		return val
+	case binaryValueExpr:
+		if val := d.valueExpr(false); val != nil {
+			return val
+		}
+		return nil
+	}
+	d.fail()
+	return nil
+}
+
+// bytes reads bytes written after their length.
+func (d *binaryDecoder) bytes() []byte {
+	n := d.uvarint()
+	if n > uint64(len(d.data)-d.pos) {
+		d.fail()
+		return nil
+	}
+	b := slices.Clone(d.data[d.pos : d.pos+int(n)])
+	d.pos += int(n)
+	return b
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	. "github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/internal/corpus"
+	"github.com/stretchr/testify/require"
+)
+
+// parseCorpus returns the texts of the statements of the corpus and their
+// trees.
+func parseCorpus(t testing.TB) ([]string, []StmtNode) {
+	stmts, err := corpus.Load("../testdata/corpus")
+	require.NoError(t, err)
+	require.NotEmpty(t, stmts)
+	p := parser.New()
+	sqls := make([]string, 0, len(stmts))
+	nodes := make([]StmtNode, 0, len(stmts))
+	for _, stmt := range stmts {
+		node, err := p.ParseOneStmt(stmt.SQL, "", "")
+		require.NoError(t, err, stmt.SQL)
+		sqls = append(sqls, stmt.SQL)
+		nodes = append(nodes, node)
+	}
+	return sqls, nodes
+}
+
+func TestBinaryCorpus(t *testing.T) {
+	sqls, nodes := parseCorpus(t)
+	for i, node := range nodes {
+		blob, err := EncodeBinary([]StmtNode{node})
+		require.NoError(t, err, sqls[i])
+		decoded, err := DecodeBinary(blob)
+		require.NoError(t, err, sqls[i])
+		require.Len(t, decoded, 1)
+		require.True(t, Equal(node, decoded[0]), sqls[i])
+		require.Equal(t, node.Text(), decoded[0].Text(), sqls[i])
+	}
+
+	// The statements share a blob.
+	blob, err := EncodeBinary(nodes)
+	require.NoError(t, err)
+	decoded, err := DecodeBinary(blob)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(nodes))
+	for i := range nodes {
+		require.True(t, Equal(nodes[i], decoded[i]), sqls[i])
+	}
+}
+
+func TestBinaryEqual(t *testing.T) {
+	p := parser.New()
+	a, err := p.ParseOneStmt("SELECT a, 1 FROM t WHERE b = 'x'", "", "")
+	require.NoError(t, err)
+	for _, sql := range []string{
+		"SELECT a, 2 FROM t WHERE b = 'x'",
+		"SELECT a, 1 FROM t WHERE b = 'y'",
+		"SELECT a, 1 FROM t WHERE c = 'x'",
+		"SELECT a, 1  FROM t WHERE b = 'x'",
+		"SELECT a, 1 FROM t WHERE b > 'x'",
+	} {
+		b, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		require.False(t, Equal(a, b), sql)
+	}
+	b, err := p.ParseOneStmt("SELECT a, 1 FROM t WHERE b = 'x'", "", "")
+	require.NoError(t, err)
+	require.True(t, Equal(a, b))
+	require.True(t, Equal(nil, nil))
+	require.False(t, Equal(a, nil))
+
+	// The offsets are compared.
+	b.(*SelectStmt).Where.SetOriginTextPosition(1)
+	require.False(t, Equal(a, b))
+}
+
+func TestBinaryErrors(t *testing.T) {
+	stmt, err := parser.New().ParseOneStmt("SELECT a + 1 FROM t WHERE b IN (1, 'x', NULL)", "", "")
+	require.NoError(t, err)
+	blob, err := EncodeBinary([]StmtNode{stmt})
+	require.NoError(t, err)
+
+	// A blob of another version of the schema.
+	other := bytes.Clone(blob)
+	other[len("MYAST")+1] ^= 1
+	_, err = DecodeBinary(other)
+	require.ErrorIs(t, err, ErrBinaryVersion)
+	_, err = DecodeBinary([]byte("JSON"))
+	require.ErrorIs(t, err, ErrBinaryCorrupt)
+
+	for i := range blob {
+		_, err := DecodeBinary(blob[:i])
+		require.Error(t, err, "truncated at %d", i)
+		// A corrupt blob returns an error or another tree, without a panic.
+		corrupt := bytes.Clone(blob)
+		corrupt[i] ^= 0xff
+		_, _ = DecodeBinary(corrupt)
+	}
+}
+
+// BenchmarkDecodeBinary compares decoding the statements of the corpus with
+// parsing them again.
+func BenchmarkDecodeBinary(b *testing.B) {
+	sqls, nodes := parseCorpus(b)
+	b.Run("parse", func(b *testing.B) {
+		p := parser.New()
+		b.ReportAllocs()
+		for range b.N {
+			for _, sql := range sqls {
+				if _, err := p.ParseOneStmt(sql, "", ""); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	blob, err := EncodeBinary(nodes)
+	require.NoError(b, err)
+	b.Run("decode", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(blob)))
+		for range b.N {
+			if _, err := DecodeBinary(blob); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "fmt"
+
+// CheckConstraintInfo is a check constraint defined by a statement, see
+// CollectCheckConstraints.
+type CheckConstraintInfo struct {
+	// Name is the name of the constraint. When the constraint isn't named,
+	// NameGenerated is set and Name is the one MySQL generates,
+	// <table>_chk_<n>.
+	Name          string
+	NameGenerated bool
+	Expr          ExprNode
+	Enforced      bool
+	// Column is the column of a column-level constraint, e.g. a of
+	// a INT CHECK (a > 0). It's empty for a table-level constraint.
+	Column string
+}
+
+// CollectCheckConstraints returns the check constraints defined by stmt in
+// source order, the ones of the column definitions and the table constraints
+// of a CREATE TABLE, or of the ADD COLUMN, ADD CONSTRAINT, MODIFY COLUMN and
+// CHANGE COLUMN of an ALTER TABLE. It returns nil for the other statements.
+//
+// The unnamed constraints are numbered from 1 in the statement, MySQL numbers
+// the ones of an ALTER TABLE after the constraints the table already has.
+func CollectCheckConstraints(stmt StmtNode) []CheckConstraintInfo {
+	c := &checkConstraintCollector{}
+	switch x := stmt.(type) {
+	case *CreateTableStmt:
+		c.table = x.Table
+		for _, col := range x.Cols {
+			c.addColumn(col)
+		}
+		for _, constraint := range x.Constraints {
+			c.addConstraint(constraint)
+		}
+	case *AlterTableStmt:
+		c.table = x.Table
+		for _, spec := range x.Specs {
+			switch spec.Tp {
+			case AlterTableAddColumns, AlterTableModifyColumn, AlterTableChangeColumn:
+				for _, col := range spec.NewColumns {
+					c.addColumn(col)
+				}
+			case AlterTableAddConstraint:
+				c.addConstraint(spec.Constraint)
+			}
+		}
+	}
+	return c.checks
+}
+
+type checkConstraintCollector struct {
+	table     *TableName
+	generated int
+	checks    []CheckConstraintInfo
+}
+
+func (c *checkConstraintCollector) add(name string, expr ExprNode, enforced bool, column string) {
+	check := CheckConstraintInfo{Name: name, Expr: expr, Enforced: enforced, Column: column}
+	if name == "" {
+		c.generated++
+		check.NameGenerated = true
+		var table string
+		if c.table != nil {
+			table = c.table.Name.O
+		}
+		check.Name = fmt.Sprintf("%s_chk_%d", table, c.generated)
+	}
+	c.checks = append(c.checks, check)
+}
+
+func (c *checkConstraintCollector) addColumn(col *ColumnDef) {
+	if col == nil || col.Name == nil {
+		return
+	}
+	for _, opt := range col.Options {
+		if opt.Tp == ColumnOptionCheck {
+			c.add(opt.ConstraintName, opt.Expr, opt.Enforced, col.Name.Name.O)
+		}
+	}
+}
+
+func (c *checkConstraintCollector) addConstraint(constraint *Constraint) {
+	if constraint != nil && constraint.Tp == ConstraintCheck {
+		c.add(constraint.Name, constraint.Expr, constraint.Enforced, "")
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// ColumnRef is a column referenced by a statement, see ExtractColumnsWithTables.
+type ColumnRef struct {
+	// Schema is the schema of the table of the column, as written in the
+	// statement, it's empty if the statement doesn't qualify the table.
+	Schema CIStr
+	// Table is the table of the column, or its alias, or the alias of the
+	// derived table of the column. It's empty if the column isn't resolved.
+	Table  CIStr
+	Column CIStr
+	// Ambiguous tells that the column isn't qualified and that there are several
+	// tables it may belong to, which can't be told without the schema, so Table
+	// is empty.
+	Ambiguous bool
+}
+
+// ExtractColumnsWithTables returns the columns referenced by stmt, each once,
+// in the order of their first reference, with the table they belong to. A
+// qualified column is resolved against the tables and aliases of the FROM
+// clause of its query block, then of the outer ones, and is returned as
+// written if none matches. An unqualified column belongs to the only table of
+// the innermost query block having tables, it's ambiguous if there are several
+// of them. The columns of a derived table belong to its inner tables, and the
+// columns of the outer query selecting from it to its alias. The columns of an
+// INSERT, UPDATE or DELETE are resolved against its tables too, the ones of the
+// other statements are returned as written.
+func ExtractColumnsWithTables(stmt StmtNode) []ColumnRef {
+	e := &columnExtractor{seen: make(map[ColumnRef]struct{})}
+	stmt.Accept(e)
+	return e.columns
+}
+
+// columnSource is a table or a derived table a column may belong to.
+type columnSource struct {
+	schema CIStr
+	// name is the alias of the source, or the name of the table if it has none.
+	name    CIStr
+	aliased bool
+}
+
+type columnExtractor struct {
+	// scopes are the sources of the query blocks enclosing the node being
+	// visited, the innermost last.
+	scopes  [][]columnSource
+	seen    map[ColumnRef]struct{}
+	columns []ColumnRef
+}
+
+// appendSources appends the tables and derived tables of node, a FROM clause.
+// The tables of the derived tables are in their own query blocks.
+func appendSources(sources []columnSource, node ResultSetNode) []columnSource {
+	switch x := node.(type) {
+	case *Join:
+		sources = appendSources(sources, x.Left)
+		if x.Right != nil {
+			sources = appendSources(sources, x.Right)
+		}
+	case *TableSource:
+		src := columnSource{name: x.AsName, aliased: x.AsName.L != ""}
+		if tn, ok := x.Source.(*TableName); ok {
+			src.schema = tn.Schema
+			if !src.aliased {
+				src.name = tn.Name
+			}
+		} else if !src.aliased {
+			// A derived table has an alias, but a parenthesized join doesn't.
+			return appendSources(sources, x.Source)
+		}
+		sources = append(sources, src)
+	case *TableName:
+		sources = append(sources, columnSource{schema: x.Schema, name: x.Name})
+	}
+	return sources
+}
+
+func sourcesOf(clause *TableRefsClause) []columnSource {
+	if clause == nil || clause.TableRefs == nil {
+		return nil
+	}
+	return appendSources(nil, clause.TableRefs)
+}
+
+// resolve returns the table of col.
+func (e *columnExtractor) resolve(col *ColumnName) ColumnRef {
+	ref := ColumnRef{Schema: col.Schema, Table: col.Table, Column: col.Name}
+	if col.Table.L != "" {
+		for i := len(e.scopes) - 1; i >= 0; i-- {
+			for _, src := range e.scopes[i] {
+				// The name of an aliased table isn't visible.
+				if src.name.L != col.Table.L || (col.Schema.L != "" && (src.aliased || src.schema.L != col.Schema.L)) {
+					continue
+				}
+				ref.Schema, ref.Table = src.schema, src.name
+				return ref
+			}
+		}
+		return ref
+	}
+	for i := len(e.scopes) - 1; i >= 0; i-- {
+		switch sources := e.scopes[i]; len(sources) {
+		case 0:
+			continue
+		case 1:
+			ref.Schema, ref.Table = sources[0].schema, sources[0].name
+		default:
+			ref.Ambiguous = true
+		}
+		return ref
+	}
+	return ref
+}
+
+// Enter implements Visitor interface.
+func (e *columnExtractor) Enter(in Node) (out Node, skipChildren bool) {
+	switch x := in.(type) {
+	case *SelectStmt:
+		e.scopes = append(e.scopes, sourcesOf(x.From))
+	case *UpdateStmt:
+		e.scopes = append(e.scopes, sourcesOf(x.TableRefs))
+	case *DeleteStmt:
+		e.scopes = append(e.scopes, sourcesOf(x.TableRefs))
+	case *InsertStmt:
+		e.scopes = append(e.scopes, sourcesOf(x.Table))
+	case *ColumnName:
+		ref := e.resolve(x)
+		if _, ok := e.seen[ref]; !ok {
+			e.seen[ref] = struct{}{}
+			e.columns = append(e.columns, ref)
+		}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (e *columnExtractor) Leave(in Node) (out Node, ok bool) {
+	switch in.(type) {
+	case *SelectStmt, *UpdateStmt, *DeleteStmt, *InsertStmt:
+		e.scopes = e.scopes[:len(e.scopes)-1]
+	}
+	return in, true
+}
@@ -14,8 +14,11 @@
 package ast
 
 import (
+	"strings"
+
 	"github.com/pingcap/errors"
 	"github.com/abbychau/mysql-parser/auth"
+	"github.com/abbychau/mysql-parser/charset"
 	"github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/mysql"
 	"github.com/abbychau/mysql-parser/terror"
@@ -32,6 +35,7 @@ var (
 	_ DDLNode = &CreateIndexStmt{}
 	_ DDLNode = &CreateTableStmt{}
 	_ DDLNode = &CreateViewStmt{}
+	_ DDLNode = &CreateTriggerStmt{}
 	_ DDLNode = &CreateSequenceStmt{}
 	_ DDLNode = &CreatePlacementPolicyStmt{}
 	_ DDLNode = &CreateResourceGroupStmt{}
@@ -40,6 +44,7 @@ var (
 	_ DDLNode = &DropIndexStmt{}
 	_ DDLNode = &DropTableStmt{}
 	_ DDLNode = &DropSequenceStmt{}
+	_ DDLNode = &DropTriggerStmt{}
 	_ DDLNode = &DropPlacementPolicyStmt{}
 	_ DDLNode = &DropResourceGroupStmt{}
 	_ DDLNode = &OptimizeTableStmt{}
@@ -291,10 +296,37 @@ type IndexPartSpecification struct {
 	node
 
 	Column *ColumnName
+	// Length is the prefix length of the column, types.UnspecifiedLength when
+	// absent or when the part is an expression.
 	Length int
-	// Order is parsed but should be ignored because MySQL v5.7 doesn't support it.
+	// Desc is true for a descending key part, it is kept in sync with Order.
 	Desc bool
-	Expr ExprNode
+	// Order is the ordering of the key part as written, telling an explicit ASC
+	// from an omitted one.
+	Order IndexPartOrder
+	Expr  ExprNode
+}
+
+// IndexPartOrder is the ordering of an index key part.
+type IndexPartOrder int
+
+// IndexPartOrder values.
+const (
+	// IndexPartOrderNone is an omitted ordering, which is ascending.
+	IndexPartOrderNone IndexPartOrder = iota
+	IndexPartOrderAsc
+	IndexPartOrderDesc
+)
+
+// String implements fmt.Stringer interface.
+func (o IndexPartOrder) String() string {
+	switch o {
+	case IndexPartOrderAsc:
+		return "ASC"
+	case IndexPartOrderDesc:
+		return "DESC"
+	}
+	return ""
 }
 
 // Restore implements Node interface.
@@ -305,19 +337,19 @@ func (n *IndexPartSpecification) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotate(err, "An error occurred while splicing IndexPartSpecifications")
 		}
 		ctx.WritePlain(")")
-		if n.Desc {
-			ctx.WritePlain(" DESC")
+	} else {
+		if err := n.Column.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while splicing IndexPartSpecifications")
+		}
+		if n.Length > 0 {
+			ctx.WritePlainf("(%d)", n.Length)
 		}
-		return nil
-	}
-	if err := n.Column.Restore(ctx); err != nil {
-		return errors.Annotate(err, "An error occurred while splicing IndexPartSpecifications")
-	}
-	if n.Length > 0 {
-		ctx.WritePlainf("(%d)", n.Length)
 	}
-	if n.Desc {
-		ctx.WritePlain(" DESC")
+	switch {
+	case n.Desc || n.Order == IndexPartOrderDesc:
+		ctx.WriteKeyWord(" DESC")
+	case n.Order == IndexPartOrderAsc:
+		ctx.WriteKeyWord(" ASC")
 	}
 	return nil
 }
@@ -356,6 +388,19 @@ const (
 	MatchSimple
 )
 
+// String implements fmt.Stringer interface.
+func (m MatchType) String() string {
+	switch m {
+	case MatchFull:
+		return "FULL"
+	case MatchPartial:
+		return "PARTIAL"
+	case MatchSimple:
+		return "SIMPLE"
+	}
+	return ""
+}
+
 // ReferenceDef is used for parsing foreign key reference option from SQL.
 // See http://dev.mysql.com/doc/refman/5.7/en/create-table-foreign-keys.html
 type ReferenceDef struct {
@@ -392,14 +437,7 @@ func (n *ReferenceDef) Restore(ctx *format.RestoreCtx) error {
 
 	if n.Match != MatchNone {
 		ctx.WriteKeyWord(" MATCH ")
-		switch n.Match {
-		case MatchFull:
-			ctx.WriteKeyWord("FULL")
-		case MatchPartial:
-			ctx.WriteKeyWord("PARTIAL")
-		case MatchSimple:
-			ctx.WriteKeyWord("SIMPLE")
-		}
+		ctx.WriteKeyWord(n.Match.String())
 	}
 	if n.OnDelete.ReferOpt != ReferOptionNoOption {
 		ctx.WritePlain(" ")
@@ -523,6 +561,17 @@ const (
 	ColumnOptionStorage
 	ColumnOptionAutoRandom
 	ColumnOptionSecondaryEngineAttribute
+	ColumnOptionSRID
+	// ColumnOptionCompressed is the MariaDB COMPRESSED column attribute, only
+	// parsed with DialectMariaDB. StrValue is its compression method, if any.
+	ColumnOptionCompressed
+)
+
+// The formats of ColumnOptionColumnFormat, stored in StrValue.
+const (
+	ColumnFormatDefault = "DEFAULT"
+	ColumnFormatFixed   = "FIXED"
+	ColumnFormatDynamic = "DYNAMIC"
 )
 
 var (
@@ -560,6 +609,8 @@ type ColumnOption struct {
 	ConstraintName      string
 	PrimaryKeyTp        PrimaryKeyType
 	SecondaryEngineAttr string
+	// UintValue is only used for ColumnOptionSRID.
+	UintValue uint64
 }
 
 // Restore implements Node interface.
@@ -682,6 +733,15 @@ func (n *ColumnOption) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("SECONDARY_ENGINE_ATTRIBUTE")
 		ctx.WritePlain(" = ")
 		ctx.WriteString(n.StrValue)
+	case ColumnOptionSRID:
+		ctx.WriteKeyWord("SRID ")
+		ctx.WritePlainf("%d", n.UintValue)
+	case ColumnOptionCompressed:
+		ctx.WriteKeyWord("COMPRESSED")
+		if n.StrValue != "" {
+			ctx.WritePlain("=")
+			ctx.WriteName(n.StrValue)
+		}
 	default:
 		return errors.New("An error occurred while splicing ColumnOption")
 	}
@@ -746,6 +806,9 @@ type IndexOption struct {
 	SplitOpt                   *SplitOption `json:"-"` // SplitOption contains expr nodes, which cannot marshal for DDL job arguments.
 	SecondaryEngineAttr        string
 	AddColumnarReplicaOnDemand int
+
+	// order is the order the options were merged in by Merge, see writtenKinds.
+	order []indexOptionKind
 }
 
 // IsEmpty is true if only default options are given
@@ -767,118 +830,66 @@ func (n *IndexOption) IsEmpty() bool {
 
 // Restore implements Node interface.
 func (n *IndexOption) Restore(ctx *format.RestoreCtx) error {
-	hasPrevOption := false
-
-	if n.AddColumnarReplicaOnDemand > 0 {
-		ctx.WriteKeyWord("ADD_COLUMNAR_REPLICA_ON_DEMAND")
-		hasPrevOption = true
-	}
-
-	if n.PrimaryKeyTp != PrimaryKeyTypeDefault {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDClusteredIndex, func() error {
-			ctx.WriteKeyWord(n.PrimaryKeyTp.String())
-			return nil
-		})
-		hasPrevOption = true
-	}
-	if n.KeyBlockSize > 0 {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		ctx.WriteKeyWord("KEY_BLOCK_SIZE")
-		ctx.WritePlainf("=%d", n.KeyBlockSize)
-		hasPrevOption = true
-	}
-
-	if n.Tp != IndexTypeInvalid {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		ctx.WriteKeyWord("USING ")
-		ctx.WritePlain(n.Tp.String())
-		hasPrevOption = true
-	}
-
-	if len(n.ParserName.O) > 0 {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		ctx.WriteKeyWord("WITH PARSER ")
-		ctx.WriteName(n.ParserName.O)
-		hasPrevOption = true
-	}
-
-	if n.Comment != "" {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		ctx.WriteKeyWord("COMMENT ")
-		ctx.WriteString(n.Comment)
-		hasPrevOption = true
-	}
-
-	if n.Global {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDGlobalIndex, func() error {
-			ctx.WriteKeyWord("GLOBAL")
-			return nil
-		})
-		hasPrevOption = true
-	}
-
-	if n.Visibility != IndexVisibilityDefault {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
-		}
-		switch n.Visibility {
-		case IndexVisibilityVisible:
-			ctx.WriteKeyWord("VISIBLE")
-		case IndexVisibilityInvisible:
-			ctx.WriteKeyWord("INVISIBLE")
-		}
-		hasPrevOption = true
-	}
-
-	if n.SplitOpt != nil {
-		if hasPrevOption {
+	for i, kind := range n.restoredKinds(ctx.Flags) {
+		if i > 0 {
 			ctx.WritePlain(" ")
 		}
-		err := ctx.WriteWithSpecialComments(tidb.FeatureIDPresplit, func() error {
-			ctx.WriteKeyWord("PRE_SPLIT_REGIONS")
-			ctx.WritePlain(" = ")
-			if n.SplitOpt.Num != 0 && len(n.SplitOpt.Lower) == 0 {
-				ctx.WritePlainf("%d", n.SplitOpt.Num)
-			} else {
-				ctx.WritePlain("(")
-				if err := n.SplitOpt.Restore(ctx); err != nil {
-					return errors.Annotate(err, "An error occurred while splicing IndexOption SplitOpt")
+		switch kind {
+		case indexOptionAddColumnarReplicaOnDemand:
+			ctx.WriteKeyWord("ADD_COLUMNAR_REPLICA_ON_DEMAND")
+		case indexOptionPrimaryKeyTp:
+			_ = ctx.WriteWithSpecialComments(tidb.FeatureIDClusteredIndex, func() error {
+				ctx.WriteKeyWord(n.PrimaryKeyTp.String())
+				return nil
+			})
+		case indexOptionKeyBlockSize:
+			ctx.WriteKeyWord("KEY_BLOCK_SIZE")
+			ctx.WritePlainf("=%d", n.KeyBlockSize)
+		case indexOptionTp:
+			ctx.WriteKeyWord("USING ")
+			ctx.WritePlain(n.Tp.String())
+		case indexOptionParserName:
+			ctx.WriteKeyWord("WITH PARSER ")
+			ctx.WriteName(n.ParserName.O)
+		case indexOptionComment:
+			ctx.WriteKeyWord("COMMENT ")
+			ctx.WriteString(n.Comment)
+		case indexOptionGlobal:
+			_ = ctx.WriteWithSpecialComments(tidb.FeatureIDGlobalIndex, func() error {
+				ctx.WriteKeyWord("GLOBAL")
+				return nil
+			})
+		case indexOptionVisibility:
+			switch n.Visibility {
+			case IndexVisibilityVisible:
+				ctx.WriteKeyWord("VISIBLE")
+			case IndexVisibilityInvisible:
+				ctx.WriteKeyWord("INVISIBLE")
+			}
+		case indexOptionSplitOpt:
+			err := ctx.WriteWithSpecialComments(tidb.FeatureIDPresplit, func() error {
+				ctx.WriteKeyWord("PRE_SPLIT_REGIONS")
+				ctx.WritePlain(" = ")
+				if n.SplitOpt.Num != 0 && len(n.SplitOpt.Lower) == 0 {
+					ctx.WritePlainf("%d", n.SplitOpt.Num)
+				} else {
+					ctx.WritePlain("(")
+					if err := n.SplitOpt.Restore(ctx); err != nil {
+						return errors.Annotate(err, "An error occurred while splicing IndexOption SplitOpt")
+					}
+					ctx.WritePlain(")")
 				}
-				ctx.WritePlain(")")
+				return nil
+			})
+			if err != nil {
+				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-		hasPrevOption = true
-	}
-
-	if n.SecondaryEngineAttr != "" {
-		if hasPrevOption {
-			ctx.WritePlain(" ")
+		case indexOptionSecondaryEngineAttr:
+			ctx.WriteKeyWord("SECONDARY_ENGINE_ATTRIBUTE")
+			ctx.WritePlain(" = ")
+			ctx.WriteString(n.SecondaryEngineAttr)
 		}
-		ctx.WriteKeyWord("SECONDARY_ENGINE_ATTRIBUTE")
-		ctx.WritePlain(" = ")
-		ctx.WriteString(n.SecondaryEngineAttr)
-		// If a new option is added after, please also uncomment:
-		//hasPrevOption = true
 	}
-
 	return nil
 }
 
@@ -1105,7 +1116,7 @@ func (n *ColumnDef) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotate(err, "An error occurred while splicing ColumnDef Type")
 		}
 	}
-	for i, options := range n.Options {
+	for i, options := range restoredColumnOptions(ctx.Flags, n.Options) {
 		ctx.WritePlain(" ")
 		if err := options.Restore(ctx); err != nil {
 			return errors.Annotatef(err, "An error occurred while splicing ColumnDef ColumnOption: [%v]", i)
@@ -1151,6 +1162,9 @@ func (n *ColumnDef) Validate() error {
 		if found {
 			illegalOpt4gc = msg
 		}
+		if opt.Tp == ColumnOptionSRID && n.Tp != nil && n.Tp.GetType() != mysql.TypeGeometry {
+			return ErrWrongUsage.GenWithStackByArgs("SRID", "non-geometry column")
+		}
 	}
 	if generatedCol && illegalOpt4gc != "" {
 		return ErrWrongUsage.GenWithStackByArgs(illegalOpt4gc, "generated column")
@@ -1158,6 +1172,37 @@ func (n *ColumnDef) Validate() error {
 	return nil
 }
 
+// ResolveBinaryCollation resolves the legacy BINARY attribute of a string column
+// with an explicit charset, e.g. VARCHAR(20) BINARY CHARACTER SET utf8mb4, into the
+// _bin collation of the charset, which is restored instead of the BINARY keyword
+// as SHOW CREATE TABLE does. An explicit COLLATE of the column takes precedence
+// over BINARY. The BINARY attribute without charset is kept as is, because the
+// charset of the table isn't known.
+func (n *ColumnDef) ResolveBinaryCollation() {
+	tp := n.Tp
+	if tp == nil || !mysql.HasBinaryFlag(tp.GetFlag()) || tp.GetCollate() != "" {
+		return
+	}
+	if !types.IsTypeChar(tp.GetType()) && !types.IsTypeBlob(tp.GetType()) {
+		return
+	}
+	cs := tp.GetCharset()
+	if cs == "" || cs == charset.CharsetBin {
+		return
+	}
+	for _, opt := range n.Options {
+		if opt.Tp == ColumnOptionCollate {
+			tp.DelFlag(mysql.BinaryFlag)
+			return
+		}
+	}
+	collate, err := charset.GetCollationByName(cs + "_bin")
+	if err != nil || collate.CharsetName != cs {
+		return
+	}
+	tp.SetCollate(collate.Name)
+}
+
 type TemporaryKeyword int
 
 const (
@@ -1234,7 +1279,7 @@ func (n *CreateTableStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain(")")
 	}
 
-	options := tableOptionsWithRestoreTTLFlag(ctx.Flags, n.Options)
+	options := restoredTableOptions(ctx.Flags, n.Options)
 	for i, option := range options {
 		ctx.WritePlain(" ")
 		if err := option.Restore(ctx); err != nil {
@@ -1342,6 +1387,29 @@ type DropTableStmt struct {
 	Tables           []*TableName
 	IsView           bool
 	TemporaryKeyword // make sense ONLY if/when IsView == false
+	Behavior         DropBehavior
+}
+
+// DropBehavior is the trailing RESTRICT or CASCADE of DROP TABLE and DROP VIEW,
+// which MySQL parses and ignores.
+type DropBehavior int
+
+// DropBehavior types.
+const (
+	DropBehaviorNone DropBehavior = iota
+	DropBehaviorRestrict
+	DropBehaviorCascade
+)
+
+// String implements fmt.Stringer interface.
+func (b DropBehavior) String() string {
+	switch b {
+	case DropBehaviorRestrict:
+		return "RESTRICT"
+	case DropBehaviorCascade:
+		return "CASCADE"
+	}
+	return ""
 }
 
 // Restore implements Node interface.
@@ -1370,6 +1438,10 @@ func (n *DropTableStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore DropTableStmt.Tables[%d]", index)
 		}
 	}
+	if n.Behavior != DropBehaviorNone {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(n.Behavior.String())
+	}
 
 	return nil
 }
@@ -1702,6 +1774,545 @@ func (n *CreateViewStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// TriggerTiming is the time a trigger is activated, before or after each row
+// of its event.
+type TriggerTiming int
+
+// TriggerTiming values.
+const (
+	TriggerBefore TriggerTiming = iota
+	TriggerAfter
+)
+
+// String implements fmt.Stringer interface.
+func (t TriggerTiming) String() string {
+	if t == TriggerAfter {
+		return "AFTER"
+	}
+	return "BEFORE"
+}
+
+// TriggerEvent is the operation activating a trigger.
+type TriggerEvent int
+
+// TriggerEvent values.
+const (
+	TriggerInsert TriggerEvent = iota
+	TriggerUpdate
+	TriggerDelete
+)
+
+// String implements fmt.Stringer interface.
+func (e TriggerEvent) String() string {
+	switch e {
+	case TriggerUpdate:
+		return "UPDATE"
+	case TriggerDelete:
+		return "DELETE"
+	default:
+		return "INSERT"
+	}
+}
+
+// TriggerOrderType is the position of a trigger among the triggers of the same
+// table, timing and event.
+type TriggerOrderType int
+
+// TriggerOrderType values.
+const (
+	TriggerOrderNone TriggerOrderType = iota
+	TriggerFollows
+	TriggerPrecedes
+)
+
+// String implements fmt.Stringer interface.
+func (o TriggerOrderType) String() string {
+	switch o {
+	case TriggerFollows:
+		return "FOLLOWS"
+	case TriggerPrecedes:
+		return "PRECEDES"
+	default:
+		return ""
+	}
+}
+
+// CreateTriggerStmt is a statement to create a trigger.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-trigger.html
+type CreateTriggerStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	// Definer is the DEFINER of the trigger, CURRENT_USER if there is none.
+	Definer     *auth.UserIdentity
+	TriggerName *TableName
+	Timing      TriggerTiming
+	Event       TriggerEvent
+	Table       *TableName
+	Order       TriggerOrderType
+	// OtherTrigger is the trigger of FOLLOWS or PRECEDES.
+	OtherTrigger CIStr
+	// Body is the text of the statement the trigger runs, which isn't parsed,
+	// e.g. SET NEW.a = 1, or BEGIN ... END with statements ending with ';'.
+	Body string
+}
+
+// Restore implements Node interface.
+func (n *CreateTriggerStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.Definer != nil && !n.Definer.CurrentUser {
+		ctx.WriteKeyWord("DEFINER")
+		ctx.WritePlain(" = ")
+		if err := n.Definer.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Definer")
+		}
+		ctx.WritePlain(" ")
+	}
+	ctx.WriteKeyWord("TRIGGER ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.TriggerName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.TriggerName")
+	}
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Timing.String())
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Event.String())
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateTriggerStmt.Table")
+	}
+	ctx.WriteKeyWord(" FOR EACH ROW ")
+	if n.Order != TriggerOrderNone {
+		ctx.WriteKeyWord(n.Order.String())
+		ctx.WritePlain(" ")
+		ctx.WriteName(n.OtherTrigger.O)
+		ctx.WritePlain(" ")
+	}
+	ctx.WritePlain(n.Body)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateTriggerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateTriggerStmt)
+	node, ok := n.TriggerName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.TriggerName = node.(*TableName)
+	node, ok = n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
+// DropTriggerStmt is a statement to drop a trigger.
+// See https://dev.mysql.com/doc/refman/8.0/en/drop-trigger.html
+type DropTriggerStmt struct {
+	ddlNode
+
+	IfExists    bool
+	TriggerName *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropTriggerStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP TRIGGER ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	if err := n.TriggerName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropTriggerStmt.TriggerName")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropTriggerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropTriggerStmt)
+	node, ok := n.TriggerName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.TriggerName = node.(*TableName)
+	return v.Leave(n)
+}
+
+// EventSchedule is the ON SCHEDULE clause of an event, AT a time for a
+// one-time event, or EVERY an interval for a recurring event.
+type EventSchedule struct {
+	node
+
+	// At is the time of a one-time event, nil for a recurring event.
+	At ExprNode
+	// Every and Unit are the interval of a recurring event.
+	Every ExprNode
+	Unit  TimeUnitType
+	// Starts and Ends are the optional first and last times of a recurring event.
+	Starts ExprNode
+	Ends   ExprNode
+}
+
+// Restore implements Node interface.
+func (n *EventSchedule) Restore(ctx *format.RestoreCtx) error {
+	if n.At != nil {
+		ctx.WriteKeyWord("AT ")
+		if err := n.At.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.At")
+		}
+		return nil
+	}
+	ctx.WriteKeyWord("EVERY ")
+	if err := n.Every.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore EventSchedule.Every")
+	}
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Unit.String())
+	if n.Starts != nil {
+		ctx.WriteKeyWord(" STARTS ")
+		if err := n.Starts.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Starts")
+		}
+	}
+	if n.Ends != nil {
+		ctx.WriteKeyWord(" ENDS ")
+		if err := n.Ends.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Ends")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *EventSchedule) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*EventSchedule)
+	for _, expr := range []*ExprNode{&n.At, &n.Every, &n.Starts, &n.Ends} {
+		if *expr == nil {
+			continue
+		}
+		node, ok := (*expr).Accept(v)
+		if !ok {
+			return n, false
+		}
+		*expr = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// EventCompletion is the ON COMPLETION clause of an event, which tells whether
+// the event is kept once it has expired.
+type EventCompletion int
+
+// EventCompletion values.
+const (
+	EventCompletionUnspecified EventCompletion = iota
+	EventCompletionNotPreserve
+	EventCompletionPreserve
+)
+
+// String implements fmt.Stringer interface.
+func (c EventCompletion) String() string {
+	switch c {
+	case EventCompletionNotPreserve:
+		return "ON COMPLETION NOT PRESERVE"
+	case EventCompletionPreserve:
+		return "ON COMPLETION PRESERVE"
+	default:
+		return ""
+	}
+}
+
+// EventStatus is the ENABLE or DISABLE of an event.
+type EventStatus int
+
+// EventStatus values.
+const (
+	EventStatusUnspecified EventStatus = iota
+	EventStatusEnable
+	EventStatusDisable
+	// EventStatusDisableOnReplica is DISABLE ON SLAVE or DISABLE ON REPLICA.
+	EventStatusDisableOnReplica
+)
+
+// String implements fmt.Stringer interface.
+func (s EventStatus) String() string {
+	switch s {
+	case EventStatusEnable:
+		return "ENABLE"
+	case EventStatusDisable:
+		return "DISABLE"
+	case EventStatusDisableOnReplica:
+		return "DISABLE ON SLAVE"
+	default:
+		return ""
+	}
+}
+
+// EventBody is the DO clause of an event. A single statement is parsed, but a
+// compound statement, e.g. BEGIN ... END, is kept as text.
+type EventBody struct {
+	// Stmt is the statement the event runs, nil if it's a compound statement.
+	Stmt StmtNode
+	// Text is the text of the compound statement, with the statements in it
+	// ending with ';'.
+	Text string
+}
+
+func (n *EventBody) restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord(" DO ")
+	if n.Stmt == nil {
+		ctx.WritePlain(n.Text)
+		return nil
+	}
+	return n.Stmt.Restore(ctx)
+}
+
+func (n *EventBody) accept(v Visitor) bool {
+	if n.Stmt == nil {
+		return true
+	}
+	node, ok := n.Stmt.Accept(v)
+	if !ok {
+		return false
+	}
+	n.Stmt = node.(StmtNode)
+	return true
+}
+
+// restoreEventOptions restores the options of CREATE EVENT and ALTER EVENT
+// after the ON SCHEDULE clause and the RENAME TO of ALTER EVENT.
+func restoreEventOptions(ctx *format.RestoreCtx, status EventStatus, comment *string) {
+	if status != EventStatusUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(status.String())
+	}
+	if comment != nil {
+		ctx.WriteKeyWord(" COMMENT ")
+		ctx.WriteString(*comment)
+	}
+}
+
+func restoreEventDefiner(ctx *format.RestoreCtx, definer *auth.UserIdentity) error {
+	if definer == nil || definer.CurrentUser {
+		return nil
+	}
+	ctx.WriteKeyWord("DEFINER")
+	ctx.WritePlain(" = ")
+	if err := definer.Restore(ctx); err != nil {
+		return err
+	}
+	ctx.WritePlain(" ")
+	return nil
+}
+
+// CreateEventStmt is a statement to create an event of the event scheduler.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+type CreateEventStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	// Definer is the DEFINER of the event, CURRENT_USER if there is none.
+	Definer    *auth.UserIdentity
+	EventName  *TableName
+	Schedule   *EventSchedule
+	Completion EventCompletion
+	Status     EventStatus
+	// Comment is nil if there is no COMMENT.
+	Comment *string
+	Body    EventBody
+}
+
+// Restore implements Node interface.
+func (n *CreateEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if err := restoreEventDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Definer")
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.EventName")
+	}
+	ctx.WriteKeyWord(" ON SCHEDULE ")
+	if err := n.Schedule.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Schedule")
+	}
+	if n.Completion != EventCompletionUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(n.Completion.String())
+	}
+	restoreEventOptions(ctx, n.Status, n.Comment)
+	if err := n.Body.restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	node, ok = n.Schedule.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Schedule = node.(*EventSchedule)
+	if !n.Body.accept(v) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// AlterEventStmt is a statement to change an event of the event scheduler.
+// The clauses which aren't there are left unchanged.
+// See https://dev.mysql.com/doc/refman/8.0/en/alter-event.html
+type AlterEventStmt struct {
+	ddlNode
+
+	// Definer is the DEFINER of the event, CURRENT_USER if there is none.
+	Definer   *auth.UserIdentity
+	EventName *TableName
+	// Schedule is nil if there is no ON SCHEDULE.
+	Schedule   *EventSchedule
+	Completion EventCompletion
+	// NewName is the name of RENAME TO, nil if there is none.
+	NewName *TableName
+	Status  EventStatus
+	// Comment is nil if there is no COMMENT.
+	Comment *string
+	// Body is nil if there is no DO.
+	Body *EventBody
+}
+
+// Restore implements Node interface.
+func (n *AlterEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER ")
+	if err := restoreEventDefiner(ctx, n.Definer); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Definer")
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterEventStmt.EventName")
+	}
+	if n.Schedule != nil {
+		ctx.WriteKeyWord(" ON SCHEDULE ")
+		if err := n.Schedule.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Schedule")
+		}
+	}
+	if n.Completion != EventCompletionUnspecified {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(n.Completion.String())
+	}
+	if n.NewName != nil {
+		ctx.WriteKeyWord(" RENAME TO ")
+		if err := n.NewName.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.NewName")
+		}
+	}
+	restoreEventOptions(ctx, n.Status, n.Comment)
+	if n.Body != nil {
+		if err := n.Body.restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Body")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	if n.Schedule != nil {
+		node, ok = n.Schedule.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Schedule = node.(*EventSchedule)
+	}
+	if n.NewName != nil {
+		node, ok = n.NewName.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.NewName = node.(*TableName)
+	}
+	if n.Body != nil && !n.Body.accept(v) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// DropEventStmt is a statement to drop an event of the event scheduler.
+// See https://dev.mysql.com/doc/refman/8.0/en/drop-event.html
+type DropEventStmt struct {
+	ddlNode
+
+	IfExists  bool
+	EventName *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP EVENT ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropEventStmt.EventName")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	return v.Leave(n)
+}
+
 // CreatePlacementPolicyStmt is a statement to create a policy.
 type CreatePlacementPolicyStmt struct {
 	ddlNode
@@ -2638,6 +3249,34 @@ const (
 	TokuDBRowFormatZstd
 )
 
+// The compression algorithms of TableOptionCompression, the option's value is
+// kept as written, e.g. 'zlib'.
+const (
+	CompressionZlib = "ZLIB"
+	CompressionLZ4  = "LZ4"
+	CompressionNone = "NONE"
+)
+
+// IsValidCompression tells whether s, the value of TableOptionCompression, is
+// a compression algorithm, compared case-insensitively.
+func IsValidCompression(s string) bool {
+	switch strings.ToUpper(s) {
+	case CompressionZlib, CompressionLZ4, CompressionNone:
+		return true
+	}
+	return false
+}
+
+// IsValidKeyBlockSize tells whether n is a KEY_BLOCK_SIZE of InnoDB, of a table
+// or an index, in KB. 0 is the default size.
+func IsValidKeyBlockSize(n uint64) bool {
+	switch n {
+	case 0, 1, 2, 4, 8, 16:
+		return true
+	}
+	return false
+}
+
 // OnDuplicateKeyHandlingType is the option that handle unique key values in 'CREATE TABLE ... SELECT' or `LOAD DATA`.
 // See https://dev.mysql.com/doc/refman/5.7/en/create-table-select.html
 // See https://dev.mysql.com/doc/refman/5.7/en/load-data.html
@@ -3534,8 +4173,18 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		if len(n.NewColumns[0].Options) == 1 {
 			ctx.WriteKeyWord("SET DEFAULT ")
 			expr := n.NewColumns[0].Options[0].Expr
-			if valueExpr, ok := expr.(ValueExpr); ok {
-				if err := valueExpr.Restore(ctx); err != nil {
+			// A literal, including a signed number, and a parenthesized expression
+			// are written as is. Any other expression needs the parentheses of an
+			// expression default.
+			asIs := false
+			switch x := expr.(type) {
+			case ValueExpr, *ParenthesesExpr:
+				asIs = true
+			case *UnaryOperationExpr:
+				_, asIs = x.V.(ValueExpr)
+			}
+			if asIs {
+				if err := expr.Restore(ctx); err != nil {
 					return errors.Annotate(err, "An error occurred while restore AlterTableSpec.NewColumns[0].Options[0].Expr")
 				}
 			} else {
@@ -3579,9 +4228,7 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(" TO ")
 		ctx.WriteName(n.ToKey.O)
 	case AlterTableForce:
-		// TODO: not support
 		ctx.WriteKeyWord("FORCE")
-		ctx.WritePlain(" /* AlterTableForce is not supported */ ")
 	case AlterTableAddPartitions:
 		ctx.WriteKeyWord("ADD PARTITION")
 		if n.IfNotExists {
@@ -3985,6 +4632,30 @@ func (n *AlterTableStmt) HaveOnlyPlacementOptions() bool {
 	return true
 }
 
+// GetAlgorithm returns the algorithm of the statement, the one of its last
+// ALGORITHM clause as MySQL takes it, AlgorithmTypeDefault if there is none.
+func (n *AlterTableStmt) GetAlgorithm() AlgorithmType {
+	algorithm := AlgorithmTypeDefault
+	for _, spec := range n.Specs {
+		if spec.Tp == AlterTableAlgorithm {
+			algorithm = spec.Algorithm
+		}
+	}
+	return algorithm
+}
+
+// GetLock returns the lock of the statement, the one of its last LOCK clause as
+// MySQL takes it, LockTypeDefault if there is none.
+func (n *AlterTableStmt) GetLock() LockType {
+	lock := LockTypeDefault
+	for _, spec := range n.Specs {
+		if spec.Tp == AlterTableLock {
+			lock = spec.LockType
+		}
+	}
+	return lock
+}
+
 // Restore implements Node interface.
 func (n *AlterTableStmt) Restore(ctx *format.RestoreCtx) error {
 	if ctx.Flags.HasSkipPlacementRuleForRestoreFlag() && n.HaveOnlyPlacementOptions() {
@@ -4000,7 +4671,7 @@ func (n *AlterTableStmt) Restore(ctx *format.RestoreCtx) error {
 			continue
 		}
 		if spec.Tp == AlterTableOption {
-			newOptions := tableOptionsWithRestoreTTLFlag(ctx.Flags, spec.Options)
+			newOptions := restoredTableOptions(ctx.Flags, spec.Options)
 			if len(newOptions) == 0 {
 				continue
 			}
@@ -4093,6 +4764,9 @@ var (
 	ErrUnknownCharacterSet                  = terror.ClassDDL.NewStd(mysql.ErrUnknownCharacterSet)
 	ErrCoalescePartitionNoPartition         = terror.ClassDDL.NewStd(mysql.ErrCoalescePartitionNoPartition)
 	ErrWrongUsage                           = terror.ClassDDL.NewStd(mysql.ErrWrongUsage)
+	ErrMultiplePriKey                       = terror.ClassDDL.NewStd(mysql.ErrMultiplePriKey)
+	ErrPartitionFunctionIsNotAllowed        = terror.ClassDDL.NewStd(mysql.ErrPartitionFunctionIsNotAllowed)
+	ErrWrongExprInPartitionFunc             = terror.ClassDDL.NewStd(mysql.ErrWrongExprInPartitionFunc)
 )
 
 type SubPartitionDefinition struct {
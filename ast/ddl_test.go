@@ -14,10 +14,15 @@
 package ast_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/abbychau/mysql-parser"
 	. "github.com/abbychau/mysql-parser/ast"
 	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/abbychau/mysql-parser/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,6 +50,11 @@ func TestDDLVisitorCover(t *testing.T) {
 		{&CreateIndexStmt{Table: &TableName{}}, 0, 0},
 		{&CreateTableStmt{Table: &TableName{}, ReferTable: &TableName{}}, 0, 0},
 		{&CreateViewStmt{ViewName: &TableName{}, Select: &SelectStmt{}}, 0, 0},
+		{&CreateTriggerStmt{TriggerName: &TableName{}, Table: &TableName{}}, 0, 0},
+		{&DropTriggerStmt{TriggerName: &TableName{}}, 0, 0},
+		{&CreateEventStmt{EventName: &TableName{}, Schedule: &EventSchedule{Every: ce, Starts: ce}}, 2, 2},
+		{&AlterEventStmt{EventName: &TableName{}, Schedule: &EventSchedule{At: ce}, NewName: &TableName{}}, 1, 1},
+		{&DropEventStmt{EventName: &TableName{}}, 0, 0},
 		{&AlterTableSpec{}, 0, 0},
 		{&ColumnDef{Name: &ColumnName{}, Options: []*ColumnOption{{Expr: ce}}}, 1, 1},
 		{&ColumnOption{Expr: ce}, 1, 1},
@@ -88,12 +98,55 @@ func TestDDLIndexExprRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "CREATE INDEX idx ON t (%s) USING HASH", extractNodeFunc)
 }
 
+func TestDDLIndexPartOrderRestore(t *testing.T) {
+	var testCases []NodeRestoreTestCase
+	for _, key := range []struct{ source, expect string }{
+		{"PRIMARY KEY", "PRIMARY KEY"},
+		{"UNIQUE KEY uk", "UNIQUE `uk`"},
+		{"KEY k", "INDEX `k`"},
+	} {
+		for _, parts := range []struct{ source, expect string }{
+			{"a(10) DESC, b ASC", "`a`(10) DESC, `b` ASC"},
+			{"a DESC, b(5)", "`a` DESC, `b`(5)"},
+			{"a ASC, b(5) DESC", "`a` ASC, `b`(5) DESC"},
+			{"a(10), b(5) ASC", "`a`(10), `b`(5) ASC"},
+			{"a, b", "`a`, `b`"},
+			{"(a + 1) DESC, b(5) ASC", "(`a`+1) DESC, `b`(5) ASC"},
+			{"a(10) ASC, (lower(b))", "`a`(10) ASC, (LOWER(`b`))"},
+			{"(lower(b)) ASC", "(LOWER(`b`)) ASC"},
+		} {
+			testCases = append(testCases, NodeRestoreTestCase{
+				key.source + " (" + parts.source + ")",
+				key.expect + "(" + parts.expect + ")",
+			})
+		}
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*CreateTableStmt).Constraints[0]
+	}
+	runNodeRestoreTest(t, testCases, "CREATE TABLE t (a VARCHAR(20), b VARCHAR(20), %s)", extractNodeFunc)
+
+	stmt, err := parser.New().ParseOneStmt("CREATE INDEX k ON t (a(10) DESC, b ASC, c, (c + 1))", "", "")
+	require.NoError(t, err)
+	parts := stmt.(*CreateIndexStmt).IndexPartSpecifications
+	require.Equal(t, 10, parts[0].Length)
+	require.Equal(t, IndexPartOrderDesc, parts[0].Order)
+	require.True(t, parts[0].Desc)
+	require.Equal(t, types.UnspecifiedLength, parts[1].Length)
+	require.Equal(t, IndexPartOrderAsc, parts[1].Order)
+	require.False(t, parts[1].Desc)
+	require.Equal(t, IndexPartOrderNone, parts[2].Order)
+	require.Equal(t, types.UnspecifiedLength, parts[3].Length)
+	require.Equal(t, IndexPartOrderNone, parts[3].Order)
+}
+
 func TestDDLOnDeleteRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"on delete restrict", "ON DELETE RESTRICT"},
 		{"on delete CASCADE", "ON DELETE CASCADE"},
 		{"on delete SET NULL", "ON DELETE SET NULL"},
 		{"on delete no action", "ON DELETE NO ACTION"},
+		{"on delete set default", "ON DELETE SET DEFAULT"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*CreateTableStmt).Constraints[1].Refer.OnDelete
@@ -109,6 +162,7 @@ func TestDDLOnUpdateRestore(t *testing.T) {
 		{"on update CASCADE", "ON UPDATE CASCADE"},
 		{"on update SET NULL", "ON UPDATE SET NULL"},
 		{"on update no action", "ON UPDATE NO ACTION"},
+		{"on update set default", "ON UPDATE SET DEFAULT"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*CreateTableStmt).Constraints[1].Refer.OnUpdate
@@ -118,13 +172,60 @@ func TestDDLOnUpdateRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "CREATE TABLE child ( id INT, parent_id INT, INDEX par_ind (parent_id), FOREIGN KEY (parent_id) REFERENCES parent(id)  %s )", extractNodeFunc)
 }
 
+func TestDDLReferOptionsRoundTrip(t *testing.T) {
+	actions := []ReferOptionType{ReferOptionNoOption, ReferOptionRestrict, ReferOptionCascade, ReferOptionSetNull, ReferOptionNoAction, ReferOptionSetDefault}
+	matches := []MatchType{MatchNone, MatchFull, MatchPartial, MatchSimple}
+	var testCases []NodeRestoreTestCase
+	for _, match := range matches {
+		for _, onDelete := range actions {
+			for _, onUpdate := range actions {
+				source, expect := "REFERENCES parent(id)", "REFERENCES `parent`(`id`)"
+				if match != MatchNone {
+					source += " match " + strings.ToLower(match.String())
+					expect += " MATCH " + match.String()
+				}
+				// The actions are written in both orders, and restored ON DELETE first.
+				if onUpdate != ReferOptionNoOption {
+					source += " on update " + strings.ToLower(onUpdate.String())
+				}
+				if onDelete != ReferOptionNoOption {
+					source += " on delete " + strings.ToLower(onDelete.String())
+					expect += " ON DELETE " + onDelete.String()
+				}
+				if onUpdate != ReferOptionNoOption {
+					expect += " ON UPDATE " + onUpdate.String()
+				}
+				testCases = append(testCases, NodeRestoreTestCase{source, expect})
+			}
+		}
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*CreateTableStmt).Constraints[0].Refer
+	}
+	runNodeRestoreTest(t, testCases, "CREATE TABLE child (parent_id INT, FOREIGN KEY (parent_id) %s)", extractNodeFunc)
+
+	// The actions are kept as written, NO ACTION and RESTRICT are distinct.
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("CREATE TABLE child (parent_id INT REFERENCES parent(id) MATCH SIMPLE ON DELETE NO ACTION ON UPDATE RESTRICT)", "", "")
+	require.NoError(t, err)
+	refer := stmt.(*CreateTableStmt).Cols[0].Options[0].Refer
+	require.Equal(t, MatchSimple, refer.Match)
+	require.Equal(t, ReferOptionNoAction, refer.OnDelete.ReferOpt)
+	require.Equal(t, ReferOptionRestrict, refer.OnUpdate.ReferOpt)
+
+	// SET DEFAULT is accepted with a warning, as InnoDB rejects it.
+	_, warns, err := p.Parse("CREATE TABLE child (parent_id INT, FOREIGN KEY (parent_id) REFERENCES parent(id) ON DELETE SET DEFAULT)", "", "")
+	require.NoError(t, err)
+	require.Len(t, warns, 1)
+}
+
 func TestDDLIndexOption(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"key_block_size=16", "KEY_BLOCK_SIZE=16"},
 		{"USING HASH", "USING HASH"},
 		{"comment 'hello'", "COMMENT 'hello'"},
 		{"key_block_size=16 USING HASH", "KEY_BLOCK_SIZE=16 USING HASH"},
-		{"USING HASH KEY_BLOCK_SIZE=16", "KEY_BLOCK_SIZE=16 USING HASH"},
+		{"USING HASH KEY_BLOCK_SIZE=16", "USING HASH KEY_BLOCK_SIZE=16"},
 		{"USING HASH COMMENT 'foo'", "USING HASH COMMENT 'foo'"},
 		{"COMMENT 'foo'", "COMMENT 'foo'"},
 		{"key_block_size = 32 using hash comment 'hello'", "KEY_BLOCK_SIZE=32 USING HASH COMMENT 'hello'"},
@@ -404,6 +505,12 @@ func TestDDLDropTableStmtRestore(t *testing.T) {
 		{"drop temporary table t1", "DROP TEMPORARY TABLE `t1`"},
 		{"drop temporary table if exists t1", "DROP TEMPORARY TABLE IF EXISTS `t1`"},
 		{"DROP /*!40005 TEMPORARY */ TABLE IF EXISTS `test`", "DROP TEMPORARY TABLE IF EXISTS `test`"},
+		{"drop temporary table if exists a, b restrict", "DROP TEMPORARY TABLE IF EXISTS `a`, `b` RESTRICT"},
+		{"drop global temporary table a cascade", "DROP GLOBAL TEMPORARY TABLE `a` CASCADE"},
+		{"drop tables a, db1.b, c, db2.d cascade", "DROP TABLE `a`, `db1`.`b`, `c`, `db2`.`d` CASCADE"},
+		{"drop table db1.a, b, `db 2`.c", "DROP TABLE `db1`.`a`, `b`, `db 2`.`c`"},
+		{"drop view if exists a, db1.b restrict", "DROP VIEW IF EXISTS `a`, `db1`.`b` RESTRICT"},
+		{"drop view db1.a, b, db2.c cascade", "DROP VIEW `db1`.`a`, `b`, `db2`.`c` CASCADE"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*DropTableStmt)
@@ -513,7 +620,6 @@ func TestAlterTableSpecRestore(t *testing.T) {
 		{"modify COLUMN a varchar(255) FIRST", "MODIFY COLUMN `a` VARCHAR(255) FIRST"},
 		{"modify COLUMN a varchar(255) AFTER b", "MODIFY COLUMN `a` VARCHAR(255) AFTER `b`"},
 		{"change column a b VARCHAR(255)", "CHANGE COLUMN `a` `b` VARCHAR(255)"},
-		{"change COLUMN a b varchar(255) CHARACTER SET UTF8 BINARY", "CHANGE COLUMN `a` `b` VARCHAR(255) BINARY CHARACTER SET UTF8"},
 		{"CHANGE column a b varchar(255) FIRST", "CHANGE COLUMN `a` `b` VARCHAR(255) FIRST"},
 		{"change COLUMN a b varchar(255) AFTER c", "CHANGE COLUMN `a` `b` VARCHAR(255) AFTER `c`"},
 		{"RENAME db1.t1", "RENAME AS `db1`.`t1`"},
@@ -554,6 +660,12 @@ func TestAlterTableSpecRestore(t *testing.T) {
 		return node.(*AlterTableStmt).Specs[0]
 	}
 	runNodeRestoreTest(t, testCases, "ALTER TABLE t %s", extractNodeFunc)
+
+	// The BINARY attribute is restored as the _bin collation, which is parsed
+	// into a COLLATE option.
+	runNodeRestoreTestWithFlagsStmtChange(t, []NodeRestoreTestCase{
+		{"change COLUMN a b varchar(255) CHARACTER SET UTF8 BINARY", "CHANGE COLUMN `a` `b` VARCHAR(255) CHARACTER SET UTF8 COLLATE utf8_bin"},
+	}, "ALTER TABLE t %s", extractNodeFunc, format.DefaultRestoreFlags)
 }
 
 func TestAlterTableWithSpecialCommentRestore(t *testing.T) {
@@ -651,6 +763,36 @@ func TestSequenceRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
 }
 
+func TestDropTableStmtFields(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("DROP TEMPORARY TABLE IF EXISTS a, db1.b RESTRICT", "", "")
+	require.NoError(t, err)
+	drop := stmt.(*DropTableStmt)
+	require.Equal(t, TemporaryLocal, drop.TemporaryKeyword)
+	require.True(t, drop.IfExists)
+	require.Equal(t, DropBehaviorRestrict, drop.Behavior)
+	require.Len(t, drop.Tables, 2)
+	require.Equal(t, "", drop.Tables[0].Schema.O)
+	require.Equal(t, "db1", drop.Tables[1].Schema.O)
+
+	stmt, err = p.ParseOneStmt("DROP VIEW v", "", "")
+	require.NoError(t, err)
+	require.Equal(t, DropBehaviorNone, stmt.(*DropTableStmt).Behavior)
+}
+
+func TestDropIndexLockAndAlgorithmRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"drop index idx on db1.t algorithm = inplace", "DROP INDEX `idx` ON `db1`.`t` ALGORITHM = INPLACE"},
+		{"drop index idx on t lock = none", "DROP INDEX `idx` ON `t` LOCK = NONE"},
+		{"drop index idx on t algorithm = copy lock = shared", "DROP INDEX `idx` ON `t` ALGORITHM = COPY LOCK = SHARED"},
+		{"drop index idx on t lock exclusive algorithm instant", "DROP INDEX `idx` ON `t` ALGORITHM = INSTANT LOCK = EXCLUSIVE"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node
+	}
+	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
+}
+
 func TestDropIndexRestore(t *testing.T) {
 	sourceSQL := "drop index if exists idx on t"
 	cases := []struct {
@@ -942,7 +1084,7 @@ func TestPresplitIndexSpecialComments(t *testing.T) {
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS = 4", specialCmtFlag, "ALTER TABLE `t` ADD INDEX(`a`) /*T![pre_split] PRE_SPLIT_REGIONS = 4 */"},
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS 4", specialCmtFlag, "ALTER TABLE `t` ADD INDEX(`a`) /*T![pre_split] PRE_SPLIT_REGIONS = 4 */"},
 		{"ALTER TABLE t ADD PRIMARY KEY (a) CLUSTERED PRE_SPLIT_REGIONS = 4", specialCmtFlag, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) /*T![clustered_index] CLUSTERED */ /*T![pre_split] PRE_SPLIT_REGIONS = 4 */"},
-		{"ALTER TABLE t ADD PRIMARY KEY (a) PRE_SPLIT_REGIONS = 4 NONCLUSTERED", specialCmtFlag, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) /*T![clustered_index] NONCLUSTERED */ /*T![pre_split] PRE_SPLIT_REGIONS = 4 */"},
+		{"ALTER TABLE t ADD PRIMARY KEY (a) PRE_SPLIT_REGIONS = 4 NONCLUSTERED", specialCmtFlag, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) /*T![pre_split] PRE_SPLIT_REGIONS = 4 */ /*T![clustered_index] NONCLUSTERED */"},
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS = (between (1, 'a') and (2, 'b') regions 4);", specialCmtFlag, "ALTER TABLE `t` ADD INDEX(`a`) /*T![pre_split] PRE_SPLIT_REGIONS = (BETWEEN (1,_UTF8MB4'a') AND (2,_UTF8MB4'b') REGIONS 4) */"},
 		{"ALTER TABLE t ADD INDEX idx(a) pre_split_regions = 100, ADD INDEX idx2(b) pre_split_regions = (by(1),(2),(3))", specialCmtFlag, "ALTER TABLE `t` ADD INDEX `idx`(`a`) /*T![pre_split] PRE_SPLIT_REGIONS = 100 */, ADD INDEX `idx2`(`b`) /*T![pre_split] PRE_SPLIT_REGIONS = (BY (1),(2),(3)) */"},
 		{"ALTER TABLE t ADD INDEX (a) comment 'a' PRE_SPLIT_REGIONS = (between (1, 'a') and (2, 'b') regions 4);", specialCmtFlag, "ALTER TABLE `t` ADD INDEX(`a`) COMMENT 'a' /*T![pre_split] PRE_SPLIT_REGIONS = (BETWEEN (1,_UTF8MB4'a') AND (2,_UTF8MB4'b') REGIONS 4) */"},
@@ -1059,3 +1201,277 @@ func TestResourceGroupDDLStmtRestore(t *testing.T) {
 	}
 	runNodeRestoreTest(t, alterTestCase, "%s", extractNodeFunc)
 }
+
+func TestRestoreCanonicalOptionOrder(t *testing.T) {
+	extractNodeFunc := func(node Node) Node {
+		return node
+	}
+	scrambled := "CREATE TABLE `t` (" +
+		"`c` VARCHAR(10) COMMENT 'c' STORAGE DISK UNIQUE KEY DEFAULT 1 COLUMN_FORMAT FIXED NOT NULL COLLATE utf8mb4_bin CHECK(`c`>0) ENFORCED SECONDARY_ENGINE_ATTRIBUTE = '{}' PRIMARY KEY," +
+		"`d` INT," +
+		"INDEX `i`(`d`) COMMENT 'i' INVISIBLE USING HASH KEY_BLOCK_SIZE=8" +
+		") COMMENT = 't' AUTO_INCREMENT = 10 DEFAULT CHARACTER SET = UTF8MB4 ENGINE = InnoDB"
+	canonical := "CREATE TABLE `t` (" +
+		"`c` VARCHAR(10) COLLATE utf8mb4_bin NOT NULL DEFAULT 1 COMMENT 'c' COLUMN_FORMAT FIXED STORAGE DISK SECONDARY_ENGINE_ATTRIBUTE = '{}' PRIMARY KEY UNIQUE KEY CHECK(`c`>0) ENFORCED," +
+		"`d` INT," +
+		"INDEX `i`(`d`) USING HASH KEY_BLOCK_SIZE=8 COMMENT 'i' INVISIBLE" +
+		") ENGINE = InnoDB AUTO_INCREMENT = 10 DEFAULT CHARACTER SET = UTF8MB4 COMMENT = 't'"
+
+	// The default keeps the written order.
+	runNodeRestoreTest(t, []NodeRestoreTestCase{
+		{scrambled, scrambled},
+		{canonical, canonical},
+		{"ALTER TABLE `t` COMMENT = 't' ENGINE = InnoDB", "ALTER TABLE `t` COMMENT = 't' ENGINE = InnoDB"},
+		{"CREATE INDEX `i` USING HASH ON `t` (`d`) INVISIBLE COMMENT 'i'", "CREATE INDEX `i` ON `t` (`d`) USING HASH INVISIBLE COMMENT 'i'"},
+		{"CREATE INDEX `i` ON `t` (`d`) COMMENT 'a' INVISIBLE COMMENT 'b'", "CREATE INDEX `i` ON `t` (`d`) INVISIBLE COMMENT 'b'"},
+	}, "%s", extractNodeFunc)
+
+	flags := format.DefaultRestoreFlags | format.RestoreCanonicalOptionOrder
+	runNodeRestoreTestWithFlagsStmtChange(t, []NodeRestoreTestCase{
+		{scrambled, canonical},
+		{canonical, canonical},
+		{"ALTER TABLE `t` COMMENT = 't' ENGINE = InnoDB", "ALTER TABLE `t` ENGINE = InnoDB COMMENT = 't'"},
+		{"CREATE INDEX `i` ON `t` (`d`) INVISIBLE COMMENT 'i' USING HASH", "CREATE INDEX `i` ON `t` (`d`) USING HASH COMMENT 'i' INVISIBLE"},
+	}, "%s", extractNodeFunc, flags)
+}
+
+func TestSummarizeCreateTable(t *testing.T) {
+	p := parser.New()
+	summarize := func(sql string) (*TableSummary, error) {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		return SummarizeCreateTable(stmt.(*CreateTableStmt))
+	}
+
+	// The keys defined in column definitions give the same summary as the ones
+	// defined as table constraints.
+	columnLevel, err := summarize("CREATE TABLE test.t (" +
+		"id INT PRIMARY KEY AUTO_INCREMENT, " +
+		"email VARCHAR(64) NOT NULL UNIQUE, " +
+		"parent INT REFERENCES p(id) ON DELETE CASCADE, " +
+		"age INT DEFAULT 18 CHECK (age > 0))")
+	require.NoError(t, err)
+	tableLevel, err := summarize("CREATE TABLE test.t (" +
+		"id INT AUTO_INCREMENT, " +
+		"email VARCHAR(64) NOT NULL, " +
+		"parent INT, " +
+		"age INT DEFAULT 18, " +
+		"PRIMARY KEY (id), UNIQUE (email), FOREIGN KEY (parent) REFERENCES p(id) ON DELETE CASCADE, CHECK (age > 0))")
+	require.NoError(t, err)
+	require.Equal(t, columnLevel, tableLevel)
+
+	s := columnLevel
+	require.Equal(t, "test", s.Schema)
+	require.Equal(t, "t", s.Name)
+	require.Len(t, s.Columns, 4)
+	require.Equal(t, "id", s.Columns[0].Name)
+	require.False(t, s.Columns[0].Nullable)
+	require.True(t, s.Columns[0].AutoIncrement)
+	require.False(t, s.Columns[1].Nullable)
+	require.True(t, s.Columns[2].Nullable)
+	require.False(t, s.Columns[2].HasDefault)
+	require.True(t, s.Columns[3].HasDefault)
+	require.Equal(t, "18", s.Columns[3].Default)
+	require.Equal(t, &KeySummary{Parts: []KeyPartSummary{{Column: "id", Length: types.UnspecifiedLength}}}, s.PrimaryKey)
+	require.Equal(t, []KeySummary{{Parts: []KeyPartSummary{{Column: "email", Length: types.UnspecifiedLength}}}}, s.UniqueKeys)
+	require.Equal(t, []ForeignKeySummary{{Columns: []string{"parent"}, RefTable: "p", RefColumns: []string{"id"}, OnDelete: ReferOptionCascade}}, s.ForeignKeys)
+	require.Equal(t, []CheckSummary{{Expr: "`age`>0", Enforced: true}}, s.Checks)
+
+	// Composite keys keep the prefix lengths and the ordering of their parts.
+	s, err = summarize("CREATE TABLE t (" +
+		"a INT, b VARCHAR(100), c INT, d INT, s VARCHAR(10) DEFAULT 'x', " +
+		"CONSTRAINT pk PRIMARY KEY (b(10) DESC, a), " +
+		"UNIQUE KEY uk (c, b(20)), " +
+		"CONSTRAINT fk FOREIGN KEY (c, d) REFERENCES db.r (x, y) ON DELETE SET NULL ON UPDATE RESTRICT, " +
+		"CONSTRAINT ck CHECK (c < d) NOT ENFORCED)")
+	require.NoError(t, err)
+	require.False(t, s.Columns[0].Nullable)
+	require.False(t, s.Columns[1].Nullable)
+	require.True(t, s.Columns[2].Nullable)
+	require.Equal(t, "'x'", s.Columns[4].Default)
+	require.Equal(t, &KeySummary{Name: "pk", Parts: []KeyPartSummary{
+		{Column: "b", Length: 10, Desc: true},
+		{Column: "a", Length: types.UnspecifiedLength},
+	}}, s.PrimaryKey)
+	require.Equal(t, []KeySummary{{Name: "uk", Parts: []KeyPartSummary{
+		{Column: "c", Length: types.UnspecifiedLength},
+		{Column: "b", Length: 20},
+	}}}, s.UniqueKeys)
+	require.Equal(t, []ForeignKeySummary{{
+		Name:       "fk",
+		Columns:    []string{"c", "d"},
+		RefSchema:  "db",
+		RefTable:   "r",
+		RefColumns: []string{"x", "y"},
+		OnDelete:   ReferOptionSetNull,
+		OnUpdate:   ReferOptionRestrict,
+	}}, s.ForeignKeys)
+	require.Equal(t, []CheckSummary{{Name: "ck", Expr: "`c`<`d`", Enforced: false}}, s.Checks)
+
+	// More than one primary key is an error.
+	for _, sql := range []string{
+		"CREATE TABLE t (a INT PRIMARY KEY, b INT PRIMARY KEY)",
+		"CREATE TABLE t (a INT PRIMARY KEY, b INT, PRIMARY KEY (b))",
+		"CREATE TABLE t (a INT, b INT, PRIMARY KEY (a), PRIMARY KEY (b))",
+	} {
+		_, err = summarize(sql)
+		require.ErrorIs(t, err, ErrMultiplePriKey, sql)
+	}
+}
+
+func TestCollectCheckConstraints(t *testing.T) {
+	p := parser.New()
+	collect := func(sql string) []CheckConstraintInfo {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+		return CollectCheckConstraints(stmt)
+	}
+	exprText := func(expr ExprNode) string {
+		var sb strings.Builder
+		require.NoError(t, expr.Restore(format.NewRestoreCtx(format.RestoreNameBackQuotes, &sb)))
+		return sb.String()
+	}
+
+	checks := collect("CREATE TABLE t (" +
+		"a INT CHECK (a > 0) NOT ENFORCED, " +
+		"b INT CONSTRAINT b_pos CHECK (b > 0), " +
+		"CHECK (a < b), " +
+		"CONSTRAINT ab CHECK (a <> b) NOT ENFORCED)")
+	require.Len(t, checks, 4)
+	require.Equal(t, CheckConstraintInfo{Name: "t_chk_1", NameGenerated: true, Expr: checks[0].Expr, Enforced: false, Column: "a"}, checks[0])
+	require.Equal(t, "`a`>0", exprText(checks[0].Expr))
+	require.Equal(t, CheckConstraintInfo{Name: "b_pos", Expr: checks[1].Expr, Enforced: true, Column: "b"}, checks[1])
+	require.Equal(t, CheckConstraintInfo{Name: "t_chk_2", NameGenerated: true, Expr: checks[2].Expr, Enforced: true}, checks[2])
+	require.Equal(t, "`a`<`b`", exprText(checks[2].Expr))
+	require.Equal(t, CheckConstraintInfo{Name: "ab", Expr: checks[3].Expr, Enforced: false}, checks[3])
+
+	checks = collect("ALTER TABLE db.t ADD CONSTRAINT CHECK (a > 0), ADD COLUMN c INT CHECK (c > 1) NOT ENFORCED, " +
+		"MODIFY d INT CONSTRAINT d_pos CHECK (d > 0), ALTER CHECK x NOT ENFORCED")
+	require.Len(t, checks, 3)
+	require.Equal(t, "t_chk_1", checks[0].Name)
+	require.Empty(t, checks[0].Column)
+	require.Equal(t, "t_chk_2", checks[1].Name)
+	require.Equal(t, "c", checks[1].Column)
+	require.False(t, checks[1].Enforced)
+	require.Equal(t, "d_pos", checks[2].Name)
+	require.False(t, checks[2].NameGenerated)
+
+	require.Empty(t, collect("CREATE TABLE t (a INT NOT NULL, PRIMARY KEY (a))"))
+	require.Nil(t, collect("SELECT * FROM t WHERE a > 0"))
+
+	// A column-level NOT ENFORCED is restored.
+	for _, sql := range []string{
+		"CREATE TABLE `t` (`a` INT CHECK(`a`>0) NOT ENFORCED)",
+		"CREATE TABLE `t` (`a` INT CONSTRAINT `c` CHECK(`a`>0) NOT ENFORCED NOT NULL)",
+		"ALTER TABLE `t` MODIFY COLUMN `a` INT CHECK(`a`>0) NOT ENFORCED",
+	} {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+		require.Equal(t, sql, sb.String())
+	}
+}
+
+func TestAlterTableAlgorithmAndLock(t *testing.T) {
+	p := parser.New()
+	for _, tt := range []struct {
+		sql       string
+		algorithm AlgorithmType
+		lock      LockType
+	}{
+		{"ALTER TABLE `t` ALGORITHM = INSTANT", AlgorithmTypeInstant, LockTypeDefault},
+		{"ALTER TABLE `t` LOCK = SHARED", AlgorithmTypeDefault, LockTypeShared},
+		{"ALTER TABLE `t` ALGORITHM = INSTANT, LOCK = DEFAULT, ADD COLUMN `a` INT", AlgorithmTypeInstant, LockTypeDefault},
+		{"ALTER TABLE `t` ADD COLUMN `a` INT, ALGORITHM = INPLACE, LOCK = NONE", AlgorithmTypeInplace, LockTypeNone},
+		{"ALTER TABLE `t` LOCK = NONE, ADD COLUMN `a` INT, ALGORITHM = COPY", AlgorithmTypeCopy, LockTypeNone},
+		{"ALTER TABLE `t` ENGINE = InnoDB, LOCK = EXCLUSIVE, ALGORITHM = COPY", AlgorithmTypeCopy, LockTypeExclusive},
+		// The last clause wins.
+		{"ALTER TABLE `t` ALGORITHM = INSTANT, ADD COLUMN `a` INT, LOCK = NONE, ALGORITHM = COPY, LOCK = SHARED", AlgorithmTypeCopy, LockTypeShared},
+		{"ALTER TABLE `t` ADD INDEX `i`(`a`)", AlgorithmTypeDefault, LockTypeDefault},
+	} {
+		stmt, err := p.ParseOneStmt(tt.sql, "", "")
+		require.NoError(t, err, tt.sql)
+		alter := stmt.(*AlterTableStmt)
+		require.Equal(t, tt.algorithm, alter.GetAlgorithm(), tt.sql)
+		require.Equal(t, tt.lock, alter.GetLock(), tt.sql)
+
+		// The specs are restored in their order.
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+		require.Equal(t, tt.sql, sb.String())
+	}
+}
+
+func TestResolveBinaryCollation(t *testing.T) {
+	p := parser.New()
+	cases := []struct {
+		sql     string
+		collate string
+		binary  bool
+	}{
+		{"CREATE TABLE t (a VARCHAR(20) BINARY CHARACTER SET utf8mb4)", "utf8mb4_bin", true},
+		{"CREATE TABLE t (a VARCHAR(20) CHARACTER SET latin1 BINARY)", "latin1_bin", true},
+		{"CREATE TABLE t (a LONGTEXT BINARY CHARACTER SET utf8)", "utf8_bin", true},
+		// An explicit COLLATE takes precedence over BINARY.
+		{"CREATE TABLE t (a VARCHAR(20) BINARY CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci)", "", false},
+		// The charset of the table isn't known.
+		{"CREATE TABLE t (a VARCHAR(20) BINARY)", "", true},
+		{"CREATE TABLE t (a VARCHAR(20) CHARACTER SET utf8mb4)", "", false},
+		{"CREATE TABLE t (a VARBINARY(20))", "binary", true},
+	}
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		tp := stmt.(*CreateTableStmt).Cols[0].Tp
+		require.Equal(t, c.collate, tp.GetCollate(), c.sql)
+		require.Equal(t, c.binary, mysql.HasBinaryFlag(tp.GetFlag()), c.sql)
+	}
+}
+
+func TestValidatePartitionExpr(t *testing.T) {
+	p := parser.New()
+	cases := []struct {
+		expr string
+		// err is the error returned for expr, nil if expr is allowed.
+		err *terror.Error
+		// construct is the construct named by the error.
+		construct string
+	}{
+		{"a", nil, ""},
+		{"a + 1", nil, ""},
+		{"(a * 2) DIV 3", nil, ""},
+		{"a MOD 4", nil, ""},
+		{"-a", nil, ""},
+		{"YEAR(d)", nil, ""},
+		{"TO_DAYS(d) - TO_DAYS('2000-01-01')", nil, ""},
+		{"TO_SECONDS(dt)", nil, ""},
+		{"UNIX_TIMESTAMP(ts)", nil, ""},
+		{"EXTRACT(YEAR_MONTH FROM d)", nil, ""},
+		{"DATEDIFF(d, '2000-01-01')", nil, ""},
+		{"CEILING(a) + FLOOR(a) + ABS(a)", nil, ""},
+		{"a / 2", ErrPartitionFunctionIsNotAllowed, "operator /"},
+		{"a | 1", ErrPartitionFunctionIsNotAllowed, "operator |"},
+		{"~a", ErrPartitionFunctionIsNotAllowed, "operator ~"},
+		{"RAND() * a", ErrPartitionFunctionIsNotAllowed, "function RAND"},
+		{"YEAR(NOW())", ErrPartitionFunctionIsNotAllowed, "function NOW"},
+		{"s.f(a)", ErrPartitionFunctionIsNotAllowed, "function F"},
+		{"EXTRACT(WEEK FROM d)", ErrPartitionFunctionIsNotAllowed, "EXTRACT(WEEK"},
+		{"a + @v", ErrPartitionFunctionIsNotAllowed, "expression @`v`"},
+		{"CASE WHEN a > 0 THEN 1 ELSE 0 END", ErrPartitionFunctionIsNotAllowed, "expression CASE"},
+		{"1 + 2", ErrWrongExprInPartitionFunc, ""},
+		{"TO_DAYS('2000-01-01')", ErrWrongExprInPartitionFunc, ""},
+	}
+	for _, c := range cases {
+		sql := "CREATE TABLE t (a INT, d DATE, dt DATETIME, ts TIMESTAMP) PARTITION BY HASH (" + c.expr + ") PARTITIONS 4"
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, c.expr)
+		err = ValidatePartitionExpr(stmt.(*CreateTableStmt).Partition.Expr)
+		if c.err == nil {
+			require.NoError(t, err, c.expr)
+			continue
+		}
+		require.True(t, c.err.Equal(err), "%s: %v", c.expr, err)
+		require.ErrorContains(t, err, c.construct, c.expr)
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ddldiff computes the ALTER TABLE that changes a table defined by a
+// CREATE TABLE into the one defined by another.
+package ddldiff
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/types"
+	"github.com/pingcap/errors"
+)
+
+// Diff returns the specs of the ALTER TABLE that changes the table defined by
+// from into the one defined by to, in this order:
+//
+//   - the DROP PRIMARY KEY, DROP INDEX, DROP FOREIGN KEY and DROP CHECK of the
+//     keys and constraints removed or changed;
+//   - the DROP COLUMN of the columns removed;
+//   - the ADD COLUMN and MODIFY COLUMN of the columns added, changed or moved,
+//     moved with FIRST or AFTER;
+//   - the ADD of the keys and constraints added or changed;
+//   - a table option spec with the table options added or changed.
+//
+// The columns, the keys and the constraints are matched by name, so a renamed
+// one is dropped and added. The PRIMARY KEY, UNIQUE KEY and CHECK of the
+// column definitions are taken for the table constraints MySQL turns them into,
+// the unique key being named after its column. Two definitions are the same if
+// they restore to the same text, the options in their canonical order.
+//
+// It returns an error for a CREATE TABLE ... LIKE or ... SELECT, a change of
+// the partitioning, a table option removed, or an unnamed key or foreign key
+// to drop, whose name MySQL generated. The specs share the nodes of to.
+func Diff(from, to *ast.CreateTableStmt) ([]*ast.AlterTableSpec, error) {
+	fromTable, err := newTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toTable, err := newTable(to)
+	if err != nil {
+		return nil, err
+	}
+	fromPartition, err := restorePartition(from.Partition)
+	if err != nil {
+		return nil, err
+	}
+	toPartition, err := restorePartition(to.Partition)
+	if err != nil {
+		return nil, err
+	}
+	if fromPartition != toPartition {
+		return nil, errors.New("ddldiff: the changes of the partitioning aren't supported")
+	}
+
+	d := &differ{}
+	if err := d.diffConstraints(fromTable.constraints, toTable.constraints); err != nil {
+		return nil, err
+	}
+	d.diffColumns(fromTable.cols, toTable.cols)
+	d.specs = append(d.specs, d.adds...)
+	if err := d.diffOptions(fromTable.options, toTable.options); err != nil {
+		return nil, err
+	}
+	return d.specs, nil
+}
+
+// table is a CreateTableStmt whose PRIMARY KEY, UNIQUE KEY and CHECK column
+// options are turned into table constraints.
+type table struct {
+	cols        []*column
+	constraints []*constraint
+	options     []*option
+}
+
+type column struct {
+	def  *ast.ColumnDef
+	text string
+}
+
+// constraint is a key or a constraint. Its id is the kind and the name, or the
+// text of an unnamed one, its name the one to drop it by.
+type constraint struct {
+	c    *ast.Constraint
+	id   string
+	name string
+	text string
+}
+
+type option struct {
+	opt  *ast.TableOption
+	text string
+}
+
+func newTable(stmt *ast.CreateTableStmt) (*table, error) {
+	if stmt.ReferTable != nil || stmt.Select != nil {
+		return nil, errors.New("ddldiff: CREATE TABLE ... LIKE and CREATE TABLE ... SELECT aren't supported")
+	}
+	t := &table{}
+	var columnConstraints []*ast.Constraint
+	for _, col := range stmt.Cols {
+		def := *col
+		def.Options = make([]*ast.ColumnOption, 0, len(col.Options))
+		primary, notNull := false, false
+		for _, opt := range col.Options {
+			switch opt.Tp {
+			case ast.ColumnOptionPrimaryKey:
+				primary = true
+				c := &ast.Constraint{Tp: ast.ConstraintPrimaryKey, Keys: columnKey(col)}
+				if opt.PrimaryKeyTp != ast.PrimaryKeyTypeDefault {
+					c.Option = &ast.IndexOption{PrimaryKeyTp: opt.PrimaryKeyTp}
+				}
+				columnConstraints = append(columnConstraints, c)
+			case ast.ColumnOptionUniqKey:
+				columnConstraints = append(columnConstraints, &ast.Constraint{
+					Tp:   ast.ConstraintUniq,
+					Name: col.Name.Name.O,
+					Keys: columnKey(col),
+				})
+			case ast.ColumnOptionCheck:
+				columnConstraints = append(columnConstraints, &ast.Constraint{
+					Tp:       ast.ConstraintCheck,
+					Name:     opt.ConstraintName,
+					Expr:     opt.Expr,
+					Enforced: opt.Enforced,
+				})
+			default:
+				notNull = notNull || opt.Tp == ast.ColumnOptionNotNull
+				def.Options = append(def.Options, opt)
+			}
+		}
+		// The columns of a primary key are NOT NULL.
+		if primary && !notNull {
+			def.Options = append(def.Options, &ast.ColumnOption{Tp: ast.ColumnOptionNotNull})
+		}
+		text, err := restore(&def)
+		if err != nil {
+			return nil, err
+		}
+		t.cols = append(t.cols, &column{def: &def, text: text})
+	}
+
+	// The unnamed checks are dropped by the names MySQL generates, see
+	// ast.CollectCheckConstraints, which numbers the ones of the columns first.
+	checks := ast.CollectCheckConstraints(stmt)
+	for _, c := range append(columnConstraints, stmt.Constraints...) {
+		text, err := restore(c)
+		if err != nil {
+			return nil, err
+		}
+		kind := constraintKind(c.Tp)
+		info := &constraint{c: c, name: c.Name, text: text}
+		switch {
+		case c.Tp == ast.ConstraintPrimaryKey:
+			info.id = kind
+		case c.Name != "":
+			info.id = kind + " " + strings.ToLower(c.Name)
+		default:
+			info.id = kind + " " + text
+		}
+		if c.Tp == ast.ConstraintCheck {
+			info.name, checks = checks[0].Name, checks[1:]
+		}
+		t.constraints = append(t.constraints, info)
+	}
+
+	for _, opt := range stmt.Options {
+		text, err := restore(opt)
+		if err != nil {
+			return nil, err
+		}
+		t.options = append(t.options, &option{opt: opt, text: text})
+	}
+	return t, nil
+}
+
+func columnKey(col *ast.ColumnDef) []*ast.IndexPartSpecification {
+	return []*ast.IndexPartSpecification{{
+		Column: &ast.ColumnName{Name: col.Name.Name},
+		Length: types.UnspecifiedLength,
+	}}
+}
+
+// constraintKind returns the kind of the constraints of type tp, the keys
+// sharing their names.
+func constraintKind(tp ast.ConstraintType) string {
+	switch tp {
+	case ast.ConstraintPrimaryKey:
+		return "PRIMARY KEY"
+	case ast.ConstraintForeignKey:
+		return "FOREIGN KEY"
+	case ast.ConstraintCheck:
+		return "CHECK"
+	}
+	return "KEY"
+}
+
+// restore returns the text of node, the options in their canonical order.
+func restore(node ast.Node) (string, error) {
+	var sb strings.Builder
+	flags := format.DefaultRestoreFlags | format.RestoreCanonicalOptionOrder
+	if err := node.Restore(format.NewRestoreCtx(flags, &sb)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return sb.String(), nil
+}
+
+func restorePartition(partition *ast.PartitionOptions) (string, error) {
+	if partition == nil {
+		return "", nil
+	}
+	return restore(partition)
+}
+
+type differ struct {
+	specs []*ast.AlterTableSpec
+	// adds are the ADD of the keys and constraints, which follow the changes
+	// of the columns.
+	adds []*ast.AlterTableSpec
+}
+
+func (d *differ) diffConstraints(from, to []*constraint) error {
+	toByID := make(map[string]*constraint, len(to))
+	for _, c := range to {
+		toByID[c.id] = c
+	}
+	fromByID := make(map[string]*constraint, len(from))
+	for _, c := range from {
+		fromByID[c.id] = c
+		if same, ok := toByID[c.id]; ok && same.text == c.text {
+			continue
+		}
+		spec, err := dropConstraint(c)
+		if err != nil {
+			return err
+		}
+		d.specs = append(d.specs, spec)
+	}
+	for _, c := range to {
+		if same, ok := fromByID[c.id]; ok && same.text == c.text {
+			continue
+		}
+		d.adds = append(d.adds, &ast.AlterTableSpec{Tp: ast.AlterTableAddConstraint, Constraint: c.c})
+	}
+	return nil
+}
+
+func dropConstraint(c *constraint) (*ast.AlterTableSpec, error) {
+	if c.c.Tp == ast.ConstraintPrimaryKey {
+		return &ast.AlterTableSpec{Tp: ast.AlterTableDropPrimaryKey}, nil
+	}
+	if c.name == "" {
+		return nil, errors.Errorf("ddldiff: can't drop the unnamed %s", c.text)
+	}
+	switch c.c.Tp {
+	case ast.ConstraintForeignKey:
+		return &ast.AlterTableSpec{Tp: ast.AlterTableDropForeignKey, Name: c.name}, nil
+	case ast.ConstraintCheck:
+		return &ast.AlterTableSpec{Tp: ast.AlterTableDropCheck, Constraint: &ast.Constraint{Name: c.name}}, nil
+	}
+	return &ast.AlterTableSpec{Tp: ast.AlterTableDropIndex, Name: c.name}, nil
+}
+
+func (d *differ) diffColumns(from, to []*column) {
+	toByName := make(map[string]bool, len(to))
+	for _, col := range to {
+		toByName[col.def.Name.Name.L] = true
+	}
+	// order is the order of the columns as the specs are applied.
+	fromByName := make(map[string]*column, len(from))
+	order := make([]string, 0, len(from)+len(to))
+	for _, col := range from {
+		name := col.def.Name.Name.L
+		if !toByName[name] {
+			d.specs = append(d.specs, &ast.AlterTableSpec{
+				Tp:            ast.AlterTableDropColumn,
+				OldColumnName: &ast.ColumnName{Name: col.def.Name.Name},
+			})
+			continue
+		}
+		fromByName[name] = col
+		order = append(order, name)
+	}
+
+	// The columns before the i-th one of to are in place in order.
+	for i, col := range to {
+		name := col.def.Name.Name.L
+		old, ok := fromByName[name]
+		if !ok {
+			position := &ast.ColumnPosition{Tp: ast.ColumnPositionNone}
+			if i < len(order) {
+				position = columnPosition(to, i)
+			}
+			d.specs = append(d.specs, &ast.AlterTableSpec{
+				Tp:         ast.AlterTableAddColumns,
+				NewColumns: []*ast.ColumnDef{col.def},
+				Position:   position,
+			})
+			order = insert(order, i, name)
+			continue
+		}
+		if order[i] == name && old.text == col.text {
+			continue
+		}
+		position := &ast.ColumnPosition{Tp: ast.ColumnPositionNone}
+		if order[i] != name {
+			position = columnPosition(to, i)
+			for j := i + 1; j < len(order); j++ {
+				if order[j] == name {
+					order = insert(append(order[:j], order[j+1:]...), i, name)
+					break
+				}
+			}
+		}
+		d.specs = append(d.specs, &ast.AlterTableSpec{
+			Tp:         ast.AlterTableModifyColumn,
+			NewColumns: []*ast.ColumnDef{col.def},
+			Position:   position,
+		})
+	}
+}
+
+// columnPosition returns the position of the i-th column of cols, after the
+// previous one.
+func columnPosition(cols []*column, i int) *ast.ColumnPosition {
+	if i == 0 {
+		return &ast.ColumnPosition{Tp: ast.ColumnPositionFirst}
+	}
+	return &ast.ColumnPosition{
+		Tp:             ast.ColumnPositionAfter,
+		RelativeColumn: &ast.ColumnName{Name: cols[i-1].def.Name.Name},
+	}
+}
+
+func insert(names []string, i int, name string) []string {
+	names = append(names, "")
+	copy(names[i+1:], names[i:])
+	names[i] = name
+	return names
+}
+
+func (d *differ) diffOptions(from, to []*option) error {
+	fromByTp := make(map[ast.TableOptionType]*option, len(from))
+	for _, opt := range from {
+		fromByTp[opt.opt.Tp] = opt
+	}
+	toByTp := make(map[ast.TableOptionType]bool, len(to))
+	var changed []*ast.TableOption
+	for _, opt := range to {
+		toByTp[opt.opt.Tp] = true
+		if old, ok := fromByTp[opt.opt.Tp]; !ok || old.text != opt.text {
+			changed = append(changed, opt.opt)
+		}
+	}
+	for _, opt := range from {
+		if !toByTp[opt.opt.Tp] {
+			return errors.Errorf("ddldiff: the table option %s can't be removed", opt.text)
+		}
+	}
+	if len(changed) > 0 {
+		d.specs = append(d.specs, &ast.AlterTableSpec{Tp: ast.AlterTableOption, Options: changed})
+	}
+	return nil
+}
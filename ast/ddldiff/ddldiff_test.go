@@ -0,0 +1,274 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddldiff_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/ast/ddldiff"
+	"github.com/abbychau/mysql-parser/format"
+	_ "github.com/abbychau/mysql-parser/test_driver"
+	"github.com/abbychau/mysql-parser/types"
+	"github.com/stretchr/testify/require"
+)
+
+func restore(t *testing.T, node ast.Node) string {
+	var sb strings.Builder
+	require.NoError(t, node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags|format.RestoreCanonicalOptionOrder, &sb)))
+	return sb.String()
+}
+
+// normalize turns the PRIMARY KEY, UNIQUE KEY and CHECK column options of stmt
+// into table constraints as MySQL does. It returns the names MySQL generates
+// for the unnamed checks.
+func normalize(stmt *ast.CreateTableStmt) map[*ast.Constraint]string {
+	checks := ast.CollectCheckConstraints(stmt)
+	var constraints []*ast.Constraint
+	for _, col := range stmt.Cols {
+		key := []*ast.IndexPartSpecification{{Column: &ast.ColumnName{Name: col.Name.Name}, Length: types.UnspecifiedLength}}
+		options := col.Options[:0]
+		primary, notNull := false, false
+		for _, opt := range col.Options {
+			switch opt.Tp {
+			case ast.ColumnOptionPrimaryKey:
+				primary = true
+				constraints = append(constraints, &ast.Constraint{Tp: ast.ConstraintPrimaryKey, Keys: key})
+			case ast.ColumnOptionUniqKey:
+				constraints = append(constraints, &ast.Constraint{Tp: ast.ConstraintUniq, Name: col.Name.Name.O, Keys: key})
+			case ast.ColumnOptionCheck:
+				constraints = append(constraints, &ast.Constraint{Tp: ast.ConstraintCheck, Name: opt.ConstraintName, Expr: opt.Expr, Enforced: opt.Enforced})
+			default:
+				notNull = notNull || opt.Tp == ast.ColumnOptionNotNull
+				options = append(options, opt)
+			}
+		}
+		if primary && !notNull {
+			options = append(options, &ast.ColumnOption{Tp: ast.ColumnOptionNotNull})
+		}
+		col.Options = options
+	}
+	stmt.Constraints = append(constraints, stmt.Constraints...)
+	generated := make(map[*ast.Constraint]string)
+	for _, c := range stmt.Constraints {
+		if c.Tp == ast.ConstraintCheck {
+			if c.Name == "" {
+				generated[c] = checks[0].Name
+			}
+			checks = checks[1:]
+		}
+	}
+	return generated
+}
+
+// apply applies the specs of an ALTER TABLE to stmt as MySQL does.
+func apply(t *testing.T, stmt *ast.CreateTableStmt, specs []*ast.AlterTableSpec, generated map[*ast.Constraint]string) {
+	columnIndex := func(name ast.CIStr) int {
+		for i, col := range stmt.Cols {
+			if col.Name.Name.L == name.L {
+				return i
+			}
+		}
+		t.Fatalf("no column %s", name)
+		return -1
+	}
+	place := func(col *ast.ColumnDef, position *ast.ColumnPosition) {
+		i := len(stmt.Cols)
+		switch position.Tp {
+		case ast.ColumnPositionFirst:
+			i = 0
+		case ast.ColumnPositionAfter:
+			i = columnIndex(position.RelativeColumn.Name) + 1
+		}
+		stmt.Cols = append(stmt.Cols, nil)
+		copy(stmt.Cols[i+1:], stmt.Cols[i:])
+		stmt.Cols[i] = col
+	}
+	dropConstraint := func(match func(c *ast.Constraint) bool) {
+		for i, c := range stmt.Constraints {
+			if match(c) {
+				stmt.Constraints = append(stmt.Constraints[:i], stmt.Constraints[i+1:]...)
+				return
+			}
+		}
+		t.Fatal("no constraint to drop")
+	}
+	for _, spec := range specs {
+		switch spec.Tp {
+		case ast.AlterTableDropPrimaryKey:
+			dropConstraint(func(c *ast.Constraint) bool { return c.Tp == ast.ConstraintPrimaryKey })
+		case ast.AlterTableDropIndex, ast.AlterTableDropForeignKey:
+			dropConstraint(func(c *ast.Constraint) bool {
+				return c.Tp != ast.ConstraintCheck && strings.EqualFold(c.Name, spec.Name)
+			})
+		case ast.AlterTableDropCheck:
+			dropConstraint(func(c *ast.Constraint) bool {
+				return c.Tp == ast.ConstraintCheck && (strings.EqualFold(c.Name, spec.Constraint.Name) || generated[c] == spec.Constraint.Name)
+			})
+		case ast.AlterTableDropColumn:
+			i := columnIndex(spec.OldColumnName.Name)
+			stmt.Cols = append(stmt.Cols[:i], stmt.Cols[i+1:]...)
+		case ast.AlterTableAddColumns:
+			place(spec.NewColumns[0], spec.Position)
+		case ast.AlterTableModifyColumn:
+			i := columnIndex(spec.NewColumns[0].Name.Name)
+			if spec.Position.Tp == ast.ColumnPositionNone {
+				stmt.Cols[i] = spec.NewColumns[0]
+			} else {
+				stmt.Cols = append(stmt.Cols[:i], stmt.Cols[i+1:]...)
+				place(spec.NewColumns[0], spec.Position)
+			}
+		case ast.AlterTableAddConstraint:
+			stmt.Constraints = append(stmt.Constraints, spec.Constraint)
+		case ast.AlterTableOption:
+		next:
+			for _, opt := range spec.Options {
+				for i, old := range stmt.Options {
+					if old.Tp == opt.Tp {
+						stmt.Options[i] = opt
+						continue next
+					}
+				}
+				stmt.Options = append(stmt.Options, opt)
+			}
+		default:
+			t.Fatalf("unexpected spec %d", spec.Tp)
+		}
+	}
+}
+
+// tableText returns the text of stmt, the constraints sorted.
+func tableText(t *testing.T, stmt *ast.CreateTableStmt) string {
+	sort.Slice(stmt.Constraints, func(i, j int) bool {
+		return restore(t, stmt.Constraints[i]) < restore(t, stmt.Constraints[j])
+	})
+	return restore(t, stmt)
+}
+
+func TestDiff(t *testing.T) {
+	p := parser.New()
+	parse := func(sql string) *ast.CreateTableStmt {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+		return stmt.(*ast.CreateTableStmt)
+	}
+
+	for _, tt := range []struct {
+		from, to string
+		// alter is the ALTER TABLE of the specs.
+		alter string
+	}{
+		{
+			"CREATE TABLE t (a INT, b INT)",
+			"CREATE TABLE t (a INT, b INT)",
+			"ALTER TABLE `t`",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT)",
+			"CREATE TABLE t (c INT, a INT, d INT, b INT, e INT)",
+			"ALTER TABLE `t` ADD COLUMN `c` INT FIRST, ADD COLUMN `d` INT AFTER `a`, ADD COLUMN `e` INT",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT, c INT)",
+			"CREATE TABLE t (a INT, c INT)",
+			"ALTER TABLE `t` DROP COLUMN `b`",
+		},
+		{
+			"CREATE TABLE t (a INT, b VARCHAR(10), c INT DEFAULT 1, d INT)",
+			"CREATE TABLE t (a BIGINT, b VARCHAR(10) NOT NULL, c INT DEFAULT 2, d INT COMMENT 'd')",
+			"ALTER TABLE `t` MODIFY COLUMN `a` BIGINT, MODIFY COLUMN `b` VARCHAR(10) NOT NULL, " +
+				"MODIFY COLUMN `c` INT DEFAULT 2, MODIFY COLUMN `d` INT COMMENT 'd'",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT, c INT)",
+			"CREATE TABLE t (c INT, a INT, b BIGINT)",
+			"ALTER TABLE `t` MODIFY COLUMN `c` INT FIRST, MODIFY COLUMN `b` BIGINT",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT, c INT)",
+			"CREATE TABLE t (b INT, c INT, a INT)",
+			"ALTER TABLE `t` MODIFY COLUMN `b` INT FIRST, MODIFY COLUMN `c` INT AFTER `b`",
+		},
+		{
+			// A renamed column is dropped and added.
+			"CREATE TABLE t (a INT, b INT)",
+			"CREATE TABLE t (a INT, c INT)",
+			"ALTER TABLE `t` DROP COLUMN `b`, ADD COLUMN `c` INT",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT, INDEX ia (a), INDEX ib (b), UNIQUE KEY uab (a, b))",
+			"CREATE TABLE t (a INT, b INT, INDEX ia (a, b), UNIQUE KEY uab (a, b), FULLTEXT KEY fb (b))",
+			"ALTER TABLE `t` DROP INDEX `ia`, DROP INDEX `ib`, ADD INDEX `ia`(`a`, `b`), ADD FULLTEXT `fb`(`b`)",
+		},
+		{
+			"CREATE TABLE t (a INT PRIMARY KEY, b INT UNIQUE)",
+			"CREATE TABLE t (a INT NOT NULL, b INT NOT NULL, PRIMARY KEY (a, b), UNIQUE KEY b (b))",
+			"ALTER TABLE `t` DROP PRIMARY KEY, MODIFY COLUMN `b` INT NOT NULL, ADD PRIMARY KEY(`a`, `b`)",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT, CONSTRAINT fk FOREIGN KEY (b) REFERENCES u (id))",
+			"CREATE TABLE t (a INT, b INT, CONSTRAINT fa FOREIGN KEY (a) REFERENCES u (id))",
+			"ALTER TABLE `t` DROP FOREIGN KEY `fk`, ADD CONSTRAINT `fa` FOREIGN KEY (`a`) REFERENCES `u`(`id`)",
+		},
+		{
+			// The unnamed checks are dropped by their generated names.
+			"CREATE TABLE t (a INT CHECK (a > 0), b INT, CHECK (b > 0), CONSTRAINT cab CHECK (a < b))",
+			"CREATE TABLE t (a INT, b INT, CHECK (b > 0), CONSTRAINT cab CHECK (a < b) NOT ENFORCED)",
+			"ALTER TABLE `t` DROP CHECK `t_chk_1`, DROP CHECK `cab`, ADD CONSTRAINT `cab` CHECK(`a`<`b`) NOT ENFORCED",
+		},
+		{
+			"CREATE TABLE t (a INT) ENGINE = InnoDB DEFAULT CHARSET = latin1 COMMENT = 'x'",
+			"CREATE TABLE t (a INT) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin COMMENT = 'y' AUTO_INCREMENT = 10",
+			"ALTER TABLE `t` AUTO_INCREMENT = 10 DEFAULT CHARACTER SET = UTF8MB4 DEFAULT COLLATE = UTF8MB4_BIN COMMENT = 'y'",
+		},
+		{
+			"CREATE TABLE t (a INT, b INT, INDEX ib (b)) COMMENT = 'x'",
+			"CREATE TABLE t (b INT NOT NULL, c INT, INDEX ic (c)) COMMENT = 'y'",
+			"ALTER TABLE `t` DROP INDEX `ib`, DROP COLUMN `a`, MODIFY COLUMN `b` INT NOT NULL, ADD COLUMN `c` INT, ADD INDEX `ic`(`c`), COMMENT = 'y'",
+		},
+	} {
+		from, to := parse(tt.from), parse(tt.to)
+		specs, err := ddldiff.Diff(from, to)
+		require.NoError(t, err, tt.to)
+
+		// The ALTER TABLE is valid.
+		alter := restore(t, &ast.AlterTableStmt{Table: from.Table, Specs: specs})
+		require.Equal(t, tt.alter, alter)
+		stmt, err := p.ParseOneStmt(alter, "", "")
+		require.NoError(t, err, alter)
+
+		// Applying it to from gives to.
+		from, to = parse(tt.from), parse(tt.to)
+		generated := normalize(from)
+		normalize(to)
+		apply(t, from, stmt.(*ast.AlterTableStmt).Specs, generated)
+		require.Equal(t, tableText(t, to), tableText(t, from), tt.to)
+	}
+
+	for _, tt := range []struct {
+		from, to string
+		err      string
+	}{
+		{"CREATE TABLE t (a INT) COMMENT = 'x'", "CREATE TABLE t (a INT)", "the table option COMMENT = 'x' can't be removed"},
+		{"CREATE TABLE t (a INT, INDEX (a))", "CREATE TABLE t (a INT)", "can't drop the unnamed INDEX(`a`)"},
+		{"CREATE TABLE t (a INT) PARTITION BY HASH (a)", "CREATE TABLE t (a INT)", "partitioning"},
+		{"CREATE TABLE t LIKE u", "CREATE TABLE t (a INT)", "LIKE"},
+	} {
+		_, err := ddldiff.Diff(parse(tt.from), parse(tt.to))
+		require.ErrorContains(t, err, tt.err, tt.from)
+	}
+}
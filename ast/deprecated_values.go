@@ -0,0 +1,49 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// TextPosition is a position in the statement text. It isn't named Position,
+// which is the name of the POSITION function.
+type TextPosition struct {
+	// Offset is the offset in bytes, see Node.OriginTextPosition.
+	Offset int
+}
+
+// FindDeprecatedValuesUsage returns the positions of the VALUES(col) of stmt
+// deprecated since MySQL 8.0.20, the ones of the ON DUPLICATE KEY UPDATE of an
+// INSERT, see ValuesExpr.Deprecated, in the order of the statement text. They
+// can be restored as references to the row alias of the INSERT with the
+// format.RestoreValuesAsRowAlias flag.
+func FindDeprecatedValuesUsage(stmt StmtNode) []TextPosition {
+	var f deprecatedValuesFinder
+	stmt.Accept(&f)
+	return f.positions
+}
+
+type deprecatedValuesFinder struct {
+	positions []TextPosition
+}
+
+// Enter implements Visitor interface.
+func (f *deprecatedValuesFinder) Enter(in Node) (Node, bool) {
+	if v, ok := in.(*ValuesExpr); ok && v.Deprecated {
+		f.positions = append(f.positions, TextPosition{Offset: v.OriginTextPosition()})
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (f *deprecatedValuesFinder) Leave(in Node) (Node, bool) {
+	return in, true
+}
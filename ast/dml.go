@@ -14,6 +14,7 @@
 package ast
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/pingcap/errors"
@@ -1571,6 +1572,14 @@ func (n *SelectStmt) Accept(v Visitor) (Node, bool) {
 		}
 	}
 
+	if n.SelectIntoOpt != nil {
+		node, ok := n.SelectIntoOpt.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.SelectIntoOpt = node.(*SelectIntoOption)
+	}
+
 	return v.Leave(n)
 }
 
@@ -1895,6 +1904,7 @@ type LoadDataStmt struct {
 	Format            *string
 	OnDuplicate       OnDuplicateKeyHandlingType
 	Table             *TableName
+	PartitionNames    []CIStr
 	Charset           *string
 	Columns           []*ColumnName
 	FieldsInfo        *FieldsClause
@@ -1932,6 +1942,17 @@ func (n *LoadDataStmt) Restore(ctx *format.RestoreCtx) error {
 	if err := n.Table.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore LoadDataStmt.Table")
 	}
+	if len(n.PartitionNames) != 0 {
+		ctx.WriteKeyWord(" PARTITION")
+		ctx.WritePlain("(")
+		for i := range n.PartitionNames {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(n.PartitionNames[i].String())
+		}
+		ctx.WritePlain(")")
+	}
 	if n.Charset != nil {
 		ctx.WriteKeyWord(" CHARACTER SET ")
 		ctx.WritePlain(*n.Charset)
@@ -2002,14 +2023,30 @@ func (n *LoadDataStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Table = node.(*TableName)
 	}
-	for i, val := range n.Columns {
-		node, ok := val.Accept(v)
-		if !ok {
-			return n, false
+	// The columns of ColumnsAndUserVars are visited once, Columns is kept in
+	// sync with them.
+	if len(n.ColumnsAndUserVars) != 0 {
+		columns := n.Columns[:0]
+		for i, cuVars := range n.ColumnsAndUserVars {
+			node, ok := cuVars.Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.ColumnsAndUserVars[i] = node.(*ColumnNameOrUserVar)
+			if n.ColumnsAndUserVars[i].ColumnName != nil {
+				columns = append(columns, n.ColumnsAndUserVars[i].ColumnName)
+			}
+		}
+		n.Columns = columns
+	} else {
+		for i, val := range n.Columns {
+			node, ok := val.Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.Columns[i] = node.(*ColumnName)
 		}
-		n.Columns[i] = node.(*ColumnName)
 	}
-
 	for i, assignment := range n.ColumnAssignments {
 		node, ok := assignment.Accept(v)
 		if !ok {
@@ -2017,13 +2054,6 @@ func (n *LoadDataStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.ColumnAssignments[i] = node.(*Assignment)
 	}
-	for i, cuVars := range n.ColumnsAndUserVars {
-		node, ok := cuVars.Accept(v)
-		if !ok {
-			return n, false
-		}
-		n.ColumnsAndUserVars[i] = node.(*ColumnNameOrUserVar)
-	}
 	return v.Leave(n)
 }
 
@@ -2287,6 +2317,72 @@ func (n *CallStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// DMLModifiers are the modifiers of an INSERT, REPLACE, UPDATE or DELETE
+// statement, the flags of its Priority, Quick and IgnoreErr.
+type DMLModifiers uint8
+
+// DMLModifiers flags, in the order of the MySQL syntax.
+const (
+	DMLModifierLowPriority DMLModifiers = 1 << iota
+	DMLModifierDelayed
+	DMLModifierHighPriority
+	DMLModifierQuick
+	DMLModifierIgnore
+)
+
+var dmlModifierKeywords = [...]string{"LOW_PRIORITY", "DELAYED", "HIGH_PRIORITY", "QUICK", "IGNORE"}
+
+// dmlModifiers returns the modifiers of a statement of priority p.
+func dmlModifiers(p mysql.PriorityEnum, quick, ignore bool) DMLModifiers {
+	var m DMLModifiers
+	switch p {
+	case mysql.LowPriority:
+		m |= DMLModifierLowPriority
+	case mysql.DelayedPriority:
+		m |= DMLModifierDelayed
+	case mysql.HighPriority:
+		m |= DMLModifierHighPriority
+	}
+	if quick {
+		m |= DMLModifierQuick
+	}
+	if ignore {
+		m |= DMLModifierIgnore
+	}
+	return m
+}
+
+// Has tells if m has all the modifiers of flags.
+func (m DMLModifiers) Has(flags DMLModifiers) bool {
+	return m&flags == flags
+}
+
+// String returns the keywords of m, in their canonical order.
+func (m DMLModifiers) String() string {
+	var sb strings.Builder
+	for i, keyword := range dmlModifierKeywords {
+		if m&(1<<i) == 0 {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(keyword)
+	}
+	return sb.String()
+}
+
+// Restore writes the keywords of m in their canonical order, the one of the
+// MySQL syntax, each followed by a space.
+func (m DMLModifiers) Restore(ctx *format.RestoreCtx) {
+	for i, keyword := range dmlModifierKeywords {
+		if m&(1<<i) != 0 {
+			ctx.WriteKeyWord(keyword)
+			ctx.WritePlain(" ")
+		}
+	}
+}
+
 // InsertStmt is a statement to insert new rows into an existing table.
 // See https://dev.mysql.com/doc/refman/5.7/en/insert.html
 type InsertStmt struct {
@@ -2304,6 +2400,19 @@ type InsertStmt struct {
 	// TableHints represents the table level Optimizer Hint for join type.
 	TableHints     []*TableOptimizerHint
 	PartitionNames []CIStr
+	// RowAlias is the alias of the inserted row of VALUES ... AS row_alias or
+	// SET ... AS row_alias, and RowAliasColumns the aliases of its columns. The
+	// expressions of OnDuplicate refer to them as ColumnName with the Table
+	// RowAlias, e.g. new.a for AS new(a, b).
+	RowAlias        CIStr
+	RowAliasColumns []CIStr
+	// Returning is the MariaDB RETURNING clause, only parsed with DialectMariaDB.
+	Returning *FieldList
+}
+
+// Modifiers returns the modifiers of the statement.
+func (n *InsertStmt) Modifiers() DMLModifiers {
+	return dmlModifiers(n.Priority, false, n.IgnoreErr)
 }
 
 // Restore implements Node interface.
@@ -2327,15 +2436,7 @@ func (n *InsertStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain("*/ ")
 	}
 
-	if err := n.Priority.Restore(ctx); err != nil {
-		return errors.Trace(err)
-	}
-	if n.Priority != mysql.NoPriority {
-		ctx.WritePlain(" ")
-	}
-	if n.IgnoreErr {
-		ctx.WriteKeyWord("IGNORE ")
-	}
+	n.Modifiers().Restore(ctx)
 	ctx.WriteKeyWord("INTO ")
 	if err := n.Table.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore InsertStmt.Table")
@@ -2407,6 +2508,20 @@ func (n *InsertStmt) Restore(ctx *format.RestoreCtx) error {
 			}
 		}
 	}
+	if n.RowAlias.L != "" {
+		ctx.WriteKeyWord(" AS ")
+		ctx.WriteName(n.RowAlias.O)
+		if len(n.RowAliasColumns) > 0 {
+			ctx.WritePlain("(")
+			for i, col := range n.RowAliasColumns {
+				if i != 0 {
+					ctx.WritePlain(",")
+				}
+				ctx.WriteName(col.O)
+			}
+			ctx.WritePlain(")")
+		}
+	}
 	if n.Select != nil {
 		ctx.WritePlain(" ")
 		switch v := n.Select.(type) {
@@ -2420,6 +2535,12 @@ func (n *InsertStmt) Restore(ctx *format.RestoreCtx) error {
 	}
 	if n.OnDuplicate != nil {
 		ctx.WriteKeyWord(" ON DUPLICATE KEY UPDATE ")
+		if ctx.Flags.HasValuesAsRowAliasFlag() {
+			defer func(alias format.InsertRowAlias) {
+				ctx.InsertRowAlias = alias
+			}(ctx.InsertRowAlias)
+			ctx.InsertRowAlias = n.insertRowAlias()
+		}
 		for i, v := range n.OnDuplicate {
 			if i != 0 {
 				ctx.WritePlain(",")
@@ -2429,10 +2550,33 @@ func (n *InsertStmt) Restore(ctx *format.RestoreCtx) error {
 			}
 		}
 	}
+	if n.Returning != nil {
+		ctx.WriteKeyWord(" RETURNING ")
+		if err := n.Returning.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore InsertStmt.Returning")
+		}
+	}
 
 	return nil
 }
 
+// insertRowAlias returns the row alias of n to restore the VALUES(col) of its
+// ON DUPLICATE KEY UPDATE as, see format.RestoreValuesAsRowAlias.
+func (n *InsertStmt) insertRowAlias() format.InsertRowAlias {
+	alias := format.InsertRowAlias{RowAlias: n.RowAlias.O}
+	if len(n.RowAliasColumns) > 0 {
+		// The column aliases are the ones of the column list, without it the
+		// columns of the table are needed.
+		alias.RowAliasColumns = make(map[string]string, len(n.RowAliasColumns))
+		if len(n.Columns) == len(n.RowAliasColumns) {
+			for i, col := range n.Columns {
+				alias.RowAliasColumns[col.Name.L] = n.RowAliasColumns[i].O
+			}
+		}
+	}
+	return alias
+}
+
 // Accept implements Node Accept interface.
 func (n *InsertStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
@@ -2478,6 +2622,13 @@ func (n *InsertStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.OnDuplicate[i] = node.(*Assignment)
 	}
+	if n.Returning != nil {
+		node, ok := n.Returning.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Returning = node.(*FieldList)
+	}
 	return v.Leave(n)
 }
 
@@ -2537,6 +2688,13 @@ type DeleteStmt struct {
 	// TableHints represents the table level Optimizer Hint for join type.
 	TableHints []*TableOptimizerHint
 	With       *WithClause
+	// Returning is the MariaDB RETURNING clause, only parsed with DialectMariaDB.
+	Returning *FieldList
+}
+
+// Modifiers returns the modifiers of the statement.
+func (n *DeleteStmt) Modifiers() DMLModifiers {
+	return dmlModifiers(n.Priority, n.Quick, n.IgnoreErr)
 }
 
 // Restore implements Node interface.
@@ -2564,18 +2722,7 @@ func (n *DeleteStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain("*/ ")
 	}
 
-	if err := n.Priority.Restore(ctx); err != nil {
-		return errors.Trace(err)
-	}
-	if n.Priority != mysql.NoPriority {
-		ctx.WritePlain(" ")
-	}
-	if n.Quick {
-		ctx.WriteKeyWord("QUICK ")
-	}
-	if n.IgnoreErr {
-		ctx.WriteKeyWord("IGNORE ")
-	}
+	n.Modifiers().Restore(ctx)
 
 	if n.IsMultiTable { // Multiple-Table Syntax
 		if n.BeforeFrom {
@@ -2627,6 +2774,13 @@ func (n *DeleteStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
+	if n.Returning != nil {
+		ctx.WriteKeyWord(" RETURNING ")
+		if err := n.Returning.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore DeleteStmt.Returning")
+		}
+	}
+
 	return nil
 }
 
@@ -2680,6 +2834,13 @@ func (n *DeleteStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Limit = node.(*Limit)
 	}
+	if n.Returning != nil {
+		node, ok = n.Returning.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Returning = node.(*FieldList)
+	}
 	return v.Leave(n)
 }
 
@@ -2789,6 +2950,13 @@ type UpdateStmt struct {
 	MultipleTable bool
 	TableHints    []*TableOptimizerHint
 	With          *WithClause
+	// Returning is the MariaDB RETURNING clause, only parsed with DialectMariaDB.
+	Returning *FieldList
+}
+
+// Modifiers returns the modifiers of the statement.
+func (n *UpdateStmt) Modifiers() DMLModifiers {
+	return dmlModifiers(n.Priority, false, n.IgnoreErr)
 }
 
 // Restore implements Node interface.
@@ -2816,15 +2984,7 @@ func (n *UpdateStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain("*/ ")
 	}
 
-	if err := n.Priority.Restore(ctx); err != nil {
-		return errors.Trace(err)
-	}
-	if n.Priority != mysql.NoPriority {
-		ctx.WritePlain(" ")
-	}
-	if n.IgnoreErr {
-		ctx.WriteKeyWord("IGNORE ")
-	}
+	n.Modifiers().Restore(ctx)
 
 	if err := n.TableRefs.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occur while restore UpdateStmt.TableRefs")
@@ -2868,6 +3028,13 @@ func (n *UpdateStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
+	if n.Returning != nil {
+		ctx.WriteKeyWord(" RETURNING ")
+		if err := n.Returning.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occur while restore UpdateStmt.Returning")
+		}
+	}
+
 	return nil
 }
 
@@ -2918,6 +3085,13 @@ func (n *UpdateStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Limit = node.(*Limit)
 	}
+	if n.Returning != nil {
+		node, ok = n.Returning.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Returning = node.(*FieldList)
+	}
 	return v.Leave(n)
 }
 
@@ -3632,6 +3806,30 @@ func (n *WindowSpec) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ReferencedWindows returns the names of the windows n is based on, the nearest
+// first, following the references of the definitions of defs, the WINDOW clause
+// of the query block. For example, with `WINDOW w1 AS (PARTITION BY a), w2 AS
+// (w1 ORDER BY b)`, they are w2 and w1 for `OVER w2` and `OVER (w2 ROWS
+// UNBOUNDED PRECEDING)`, and w1 for the definition of w2. The chain ends at a
+// name missing from defs, or at a name already returned if the references make
+// a cycle.
+func (n *WindowSpec) ReferencedWindows(defs []WindowSpec) []CIStr {
+	name := n.Ref
+	if n.OnlyAlias {
+		name = n.Name
+	}
+	var names []CIStr
+	for name.L != "" && !slices.ContainsFunc(names, func(x CIStr) bool { return x.L == name.L }) {
+		names = append(names, name)
+		i := slices.IndexFunc(defs, func(def WindowSpec) bool { return def.Name.L == name.L })
+		if i < 0 {
+			break
+		}
+		name = defs[i].Ref
+	}
+	return names
+}
+
 type SelectIntoType int
 
 const (
@@ -3647,12 +3845,30 @@ type SelectIntoOption struct {
 	FileName   string
 	FieldsInfo *FieldsClause
 	LinesInfo  *LinesClause
+	// Variables is only used for SelectIntoVars.
+	Variables []*VariableExpr
 }
 
 // Restore implements Node interface.
 func (n *SelectIntoOption) Restore(ctx *format.RestoreCtx) error {
+	if n.Tp == SelectIntoVars {
+		ctx.WriteKeyWord("INTO ")
+		for i, variable := range n.Variables {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			if err := variable.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore SelectInto.Variables[%d]", i)
+			}
+		}
+		return nil
+	}
+	if n.Tp == SelectIntoDumpfile {
+		ctx.WriteKeyWord("INTO DUMPFILE ")
+		ctx.WriteString(n.FileName)
+		return nil
+	}
 	if n.Tp != SelectIntoOutfile {
-		// only support SELECT/TABLE/VALUES ... INTO OUTFILE statement now
 		return errors.New("Unsupported SelectionInto type")
 	}
 
@@ -3677,6 +3893,14 @@ func (n *SelectIntoOption) Accept(v Visitor) (Node, bool) {
 	if skipChildren {
 		return v.Leave(newNode)
 	}
+	n = newNode.(*SelectIntoOption)
+	for i, variable := range n.Variables {
+		node, ok := variable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variables[i] = node.(*VariableExpr)
+	}
 	return v.Leave(n)
 }
 
@@ -15,11 +15,13 @@ package ast_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/abbychau/mysql-parser"
 	. "github.com/abbychau/mysql-parser/ast"
 	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/mysql"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,6 +39,8 @@ func TestDMLVisitorCover(t *testing.T) {
 			Order: &OrderByClause{}, Limit: &Limit{Count: ce, Offset: ce}}, 4, 4},
 		{&ShowStmt{Table: &TableName{}, Column: &ColumnName{}, Pattern: &PatternLikeOrIlikeExpr{Expr: ce, Pattern: ce}, Where: ce}, 3, 3},
 		{&LoadDataStmt{Table: &TableName{}, Columns: []*ColumnName{{}}, FieldsInfo: &FieldsClause{}, LinesInfo: &LinesClause{}}, 0, 0},
+		{&LoadDataStmt{Table: &TableName{}, ColumnsAndUserVars: []*ColumnNameOrUserVar{{ColumnName: &ColumnName{}}, {UserVar: &VariableExpr{}}},
+			ColumnAssignments: []*Assignment{{Column: &ColumnName{}, Expr: ce}}}, 1, 1},
 		{&ImportIntoStmt{Table: &TableName{}}, 0, 0},
 		{&Assignment{Column: &ColumnName{}, Expr: ce}, 1, 1},
 		{&ByItem{Expr: ce}, 1, 1},
@@ -176,6 +180,8 @@ func TestFieldListRestore(t *testing.T) {
 		{"testdb.t.*", "`testdb`.`t`.*"},
 		{"col as a", "`col` AS `a`"},
 		{"`t`.*, s.col as a", "`t`.*, `s`.`col` AS `a`"},
+		{"*, a", "*, `a`"},
+		{"a, testdb.t.*, b as c", "`a`, `testdb`.`t`.*, `b` AS `c`"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*SelectStmt).Fields
@@ -412,6 +418,57 @@ func TestWindowSpecRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "select rank() over %s from t window w as (order by a)", extractNodeFunc)
 }
 
+// windowRefCollector collects the windows referenced by each window function.
+type windowRefCollector struct {
+	defs []WindowSpec
+	refs [][]string
+}
+
+func (c *windowRefCollector) Enter(n Node) (Node, bool) {
+	switch x := n.(type) {
+	case *SelectStmt:
+		c.defs = x.WindowSpecs
+	case *WindowFuncExpr:
+		names := []string{}
+		for _, name := range x.Spec.ReferencedWindows(c.defs) {
+			names = append(names, name.O)
+		}
+		c.refs = append(c.refs, names)
+	}
+	return n, false
+}
+
+func (*windowRefCollector) Leave(n Node) (Node, bool) {
+	return n, true
+}
+
+func TestWindowSpecReferencedWindows(t *testing.T) {
+	p := parser.New()
+	sql := "SELECT NTILE(4) OVER `w2`,LAG(`x`, 1, 0) OVER (`w1` ORDER BY `y`),ROW_NUMBER() OVER (PARTITION BY `c`),RANK() OVER `w3`,SUM(`x`) OVER (`w2` ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) " +
+		"FROM `t` WINDOW `w1` AS (PARTITION BY `a`),`w2` AS (`w1` ORDER BY `b`)"
+	stmt, err := p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+
+	// The references and the order of the definitions are restored.
+	var sb strings.Builder
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, sql, sb.String())
+
+	c := &windowRefCollector{}
+	stmt.Accept(c)
+	require.Equal(t, [][]string{{"w2", "w1"}, {"w1"}, {}, {"w3"}, {"w2", "w1"}}, c.refs)
+	sel := stmt.(*SelectStmt)
+	require.Equal(t, []CIStr{NewCIStr("w1")}, sel.WindowSpecs[1].ReferencedWindows(sel.WindowSpecs))
+	require.Empty(t, sel.WindowSpecs[0].ReferencedWindows(sel.WindowSpecs))
+
+	// A cycle is followed once.
+	stmt, err = p.ParseOneStmt("select rank() over w1 from t window w1 as (w2), w2 as (w1)", "", "")
+	require.NoError(t, err)
+	c = &windowRefCollector{}
+	stmt.Accept(c)
+	require.Equal(t, [][]string{{"w1", "w2"}}, c.refs)
+}
+
 func TestLoadDataRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{
@@ -422,6 +479,10 @@ func TestLoadDataRestore(t *testing.T) {
 			sourceSQL: "load data infile '/a.csv' format 'sql file' into table `t`",
 			expectSQL: "LOAD DATA INFILE '/a.csv' FORMAT 'sql file' INTO TABLE `t`",
 		},
+		{
+			sourceSQL: "load data infile '/a.csv' replace into table `t` partition (p0, `p 1`) (a, @v, b) set c = @v + 1",
+			expectSQL: "LOAD DATA INFILE '/a.csv' REPLACE INTO TABLE `t` PARTITION(`p0`, `p 1`) (`a`,@`v`,`b`) SET `c`=@`v`+1",
+		},
 		{
 			sourceSQL: "load data infile '/a.csv' format 'sql file' into table `t` character set utf8mb4",
 			expectSQL: "LOAD DATA INFILE '/a.csv' FORMAT 'sql file' INTO TABLE `t` CHARACTER SET utf8mb4",
@@ -513,6 +574,21 @@ func TestLoadDataRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
 }
 
+func TestSelectIntoOptionRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"into outfile '/tmp/x.csv'", "INTO OUTFILE '/tmp/x.csv'"},
+		{"into outfile '/tmp/x.csv' fields terminated by ',' enclosed by '\"' escaped by '#' lines starting by 'x' terminated by '\n'", "INTO OUTFILE '/tmp/x.csv' FIELDS TERMINATED BY ',' ENCLOSED BY '\"' ESCAPED BY '#' LINES STARTING BY 'x' TERMINATED BY '\n'"},
+		{"into outfile '/tmp/x.csv' fields enclosed by '' escaped by ''", "INTO OUTFILE '/tmp/x.csv' FIELDS ENCLOSED BY '' ESCAPED BY ''"},
+		{"into outfile '/tmp/x.csv' fields optionally enclosed by ''", "INTO OUTFILE '/tmp/x.csv' FIELDS OPTIONALLY ENCLOSED BY ''"},
+		{"into dumpfile '/tmp/x.bin'", "INTO DUMPFILE '/tmp/x.bin'"},
+		{"into @a, b", "INTO @`a`,`b`"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*SelectStmt).SelectIntoOpt
+	}
+	runNodeRestoreTest(t, testCases, "SELECT a FROM t %s", extractNodeFunc)
+}
+
 func TestImportActions(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{
@@ -650,3 +726,192 @@ func TestImportIntoFromSelectInvalidStmt(t *testing.T) {
 	_, err = p.ParseOneStmt("IMPORT INTO t1(a) set a=1 FROM select a from t2;", "", "")
 	require.ErrorContains(t, err, "Cannot use SET clause in IMPORT INTO FROM SELECT statement.")
 }
+
+func TestValidateInsertShape(t *testing.T) {
+	type issue struct {
+		kind   ShapeIssueKind
+		row    int
+		column string
+		offset int
+	}
+	testCases := []struct {
+		sql    string
+		issues []issue
+	}{
+		{"insert into t (a, b) values (1, 2), (3, 4)", nil},
+		{"insert into t set a = 1, b = 2", nil},
+		{"insert into t (a, b) values (1, 2) on duplicate key update b = values(b)", nil},
+		{"insert into t values (1, 2), (3), (4, 5, 6)", []issue{
+			{ShapeNoColumnList, -1, "", -1},
+			{ShapeRowArityMismatch, 1, "", 30},
+			{ShapeRowArityMismatch, 2, "", 35},
+		}},
+		{"insert into t (a, b, c) values (?, ?), (1, 2, 3), (?)", []issue{
+			{ShapeColumnCountMismatch, 0, "", 32},
+			{ShapeColumnCountMismatch, 2, "", 51},
+		}},
+		{"replace into t (a) values ()", []issue{
+			{ShapeColumnCountMismatch, 0, "", -1},
+		}},
+		{"insert into t set a = 1, b = 2, A = 3", []issue{
+			{ShapeDuplicateColumn, 0, "a", 36},
+		}},
+		{"insert into t (a, b) values (1, 2) on duplicate key update c = 3, b = 4", []issue{
+			{ShapeOnDuplicateColumnNotListed, -1, "c", 63},
+		}},
+		{"insert into t (a, b, a) select x, y from s", []issue{
+			{ShapeDuplicateColumn, -1, "a", -1},
+		}},
+		{"insert into t (a, b) select x, y, z from s", nil},
+		{"insert into t select x, y from s", []issue{
+			{ShapeNoColumnList, -1, "", -1},
+		}},
+	}
+
+	p := parser.New()
+	for _, tc := range testCases {
+		stmt, err := p.ParseOneStmt(tc.sql, "", "")
+		require.NoError(t, err, tc.sql)
+		var issues []issue
+		for _, i := range ValidateInsertShape(stmt.(*InsertStmt)) {
+			require.NotEmpty(t, i.Message, tc.sql)
+			issues = append(issues, issue{i.Kind, i.Row, i.Column, i.Offset})
+		}
+		require.Equal(t, tc.issues, issues, tc.sql)
+	}
+
+	stmt, err := p.ParseOneStmt("insert into t values (1), (2, 3) on duplicate key update a = 1", "", "")
+	require.NoError(t, err)
+	issues := ValidateInsertShape(stmt.(*InsertStmt))
+	require.Len(t, issues, 2)
+	require.False(t, issues[0].IsError())
+	require.True(t, issues[1].IsError())
+	require.Equal(t, "2 values at row 2 but 1 at row 1", issues[1].Message)
+}
+
+func TestDMLWithClause(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("with recursive c1 (n) as (select 1 union all select n + 1 from c1 where n < 3), c2 as (select 1) delete from t where a in (select n from c1)", "", "")
+	require.NoError(t, err)
+	del := stmt.(*DeleteStmt)
+	require.NotNil(t, del.With)
+	require.True(t, del.With.IsRecursive)
+	require.Len(t, del.With.CTEs, 2)
+	require.Equal(t, "c1", del.With.CTEs[0].Name.L)
+	require.Equal(t, "c2", del.With.CTEs[1].Name.L)
+
+	stmt, err = p.ParseOneStmt("with t as (select 1 as a) update t2 set a = 1 where a in (select a from t)", "", "")
+	require.NoError(t, err)
+	upd := stmt.(*UpdateStmt)
+	require.NotNil(t, upd.With)
+	require.False(t, upd.With.IsRecursive)
+	require.Equal(t, "t", upd.With.CTEs[0].Name.L)
+}
+
+func TestDMLModifiersRestore(t *testing.T) {
+	// The modifiers are restored in the order of the MySQL syntax, whatever
+	// the order they're set.
+	del := &DeleteStmt{IgnoreErr: true, Quick: true, Priority: mysql.LowPriority}
+	require.Equal(t, DMLModifierLowPriority|DMLModifierQuick|DMLModifierIgnore, del.Modifiers())
+	var sb strings.Builder
+	del.Modifiers().Restore(format.NewRestoreCtx(format.RestoreKeyWordLowercase, &sb))
+	require.Equal(t, "low_priority quick ignore ", sb.String())
+
+	require.Equal(t, "DELAYED IGNORE", (&InsertStmt{IgnoreErr: true, Priority: mysql.DelayedPriority}).Modifiers().String())
+	require.Equal(t, "HIGH_PRIORITY", (&UpdateStmt{Priority: mysql.HighPriority}).Modifiers().String())
+	require.Empty(t, (&UpdateStmt{}).Modifiers().String())
+}
+
+// rowAliasCollector collects the column names of the visited nodes.
+type rowAliasCollector struct {
+	cols []*ColumnName
+}
+
+func (c *rowAliasCollector) Enter(n Node) (Node, bool) {
+	if col, ok := n.(*ColumnName); ok {
+		c.cols = append(c.cols, col)
+	}
+	return n, false
+}
+
+func (c *rowAliasCollector) Leave(n Node) (Node, bool) {
+	return n, true
+}
+
+func TestInsertRowAlias(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("insert into t (a, b) values (1, 2) as new(m, n) on duplicate key update b = new.m + values(b)", "", "")
+	require.NoError(t, err)
+	ins := stmt.(*InsertStmt)
+	require.Equal(t, "new", ins.RowAlias.O)
+	require.Equal(t, []CIStr{NewCIStr("m"), NewCIStr("n")}, ins.RowAliasColumns)
+
+	// The references to the alias are visited as column names.
+	var c rowAliasCollector
+	ins.Accept(&c)
+	var refs []string
+	for _, col := range c.cols {
+		refs = append(refs, col.String())
+	}
+	require.Equal(t, []string{"a", "b", "b", "new.m", "b"}, refs)
+
+	stmt, err = p.ParseOneStmt("insert into t values (1, 2) as new on duplicate key update b = new.b", "", "")
+	require.NoError(t, err)
+	ins = stmt.(*InsertStmt)
+	require.Equal(t, "new", ins.RowAlias.O)
+	require.Empty(t, ins.RowAliasColumns)
+	col := ins.OnDuplicate[0].Expr.(*ColumnNameExpr).Name
+	require.Equal(t, "new", col.Table.O)
+	require.Equal(t, "b", col.Name.O)
+
+	stmt, err = p.ParseOneStmt("insert into t values (1, 2)", "", "")
+	require.NoError(t, err)
+	require.Empty(t, stmt.(*InsertStmt).RowAlias.O)
+}
+
+func TestDeprecatedValues(t *testing.T) {
+	p := parser.New()
+	sql := "insert into t (a, b) values (1, values(a)) on duplicate key update a = values(a), b = values(b) + (select values(c))"
+	stmt, err := p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	var offsets []int
+	for _, pos := range FindDeprecatedValuesUsage(stmt) {
+		require.Equal(t, "values(", sql[pos.Offset:pos.Offset+7])
+		offsets = append(offsets, pos.Offset)
+	}
+	// The VALUES(col) of the rows isn't the deprecated one.
+	require.Equal(t, []int{strings.Index(sql, "values(a),"), strings.Index(sql, "values(b)"), strings.Index(sql, "values(c)")}, offsets)
+	require.False(t, stmt.(*InsertStmt).Lists[0][1].(*ValuesExpr).Deprecated)
+	require.True(t, stmt.(*InsertStmt).OnDuplicate[0].Expr.(*ValuesExpr).Deprecated)
+
+	stmt, err = p.ParseOneStmt("insert into t (a) values (1) as new on duplicate key update a = new.a", "", "")
+	require.NoError(t, err)
+	require.Empty(t, FindDeprecatedValuesUsage(stmt))
+
+	// The restore is unchanged by default, keeping the column list and the
+	// parentheses of the SELECT source.
+	testCases := []NodeRestoreTestCase{
+		{"insert into t (a) values (1) as new on duplicate key update a = values(a)", "INSERT INTO `t` (`a`) VALUES (1) AS `new` ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)"},
+		{"insert into t (a, b) select 1, 2 on duplicate key update a = values(a)", "INSERT INTO `t` (`a`,`b`) SELECT 1,2 ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)"},
+		{"insert into t (a, b) (select 1, 2) on duplicate key update a = values(a)", "INSERT INTO `t` (`a`,`b`) (SELECT 1,2) ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)"},
+		{"insert into t (a, b) (select 1, 2) union (select 3, 4)", "INSERT INTO `t` (`a`,`b`) (SELECT 1,2) UNION (SELECT 3,4)"},
+		{"insert into t () (select 1)", "INSERT INTO `t` () (SELECT 1)"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node
+	}
+	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
+
+	// With RestoreValuesAsRowAlias, VALUES(col) is restored as a reference to the
+	// row alias when there is one.
+	testCases = []NodeRestoreTestCase{
+		{"insert into t (a) values (1) as new on duplicate key update a = values(a) + values(t.b)", "INSERT INTO `t` (`a`) VALUES (1) AS `new` ON DUPLICATE KEY UPDATE `a`=`new`.`a`+`new`.`b`"},
+		{"insert into t (a, b) values (1, 2) as new(m, n) on duplicate key update a = values(B), b = values(c)", "INSERT INTO `t` (`a`,`b`) VALUES (1,2) AS `new`(`m`,`n`) ON DUPLICATE KEY UPDATE `a`=`new`.`n`,`b`=VALUES(`c`)"},
+		{"insert into t set a = 1 as new on duplicate key update a = values(a)", "INSERT INTO `t` SET `a`=1 AS `new` ON DUPLICATE KEY UPDATE `a`=`new`.`a`"},
+		// Without column list, the columns of the aliases are unknown.
+		{"insert into t values (1) as new(m) on duplicate key update a = values(a)", "INSERT INTO `t` VALUES (1) AS `new`(`m`) ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)"},
+		{"insert into t (a) values (1) on duplicate key update a = values(a)", "INSERT INTO `t` (`a`) VALUES (1) ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)"},
+		{"insert into t (a) select 1 on duplicate key update a = values(a)", "INSERT INTO `t` (`a`) SELECT 1 ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)"},
+	}
+	runNodeRestoreTestWithFlagsStmtChange(t, testCases, "%s", extractNodeFunc, format.DefaultRestoreFlags|format.RestoreValuesAsRowAlias)
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "sync/atomic"
+
+// DriverHooks are the functions a parser driver provides to create the values
+// of the literals, see NewValueExpr, NewParamMarkerExpr, NewDecimal,
+// NewHexLiteral and NewBitLiteral, and to locate them, see LocateLiterals.
+type DriverHooks struct {
+	NewValueExpr       func(value any, charset string, collate string) ValueExpr
+	NewParamMarkerExpr func(offset int) ParamMarkerExpr
+	NewDecimal         func(string) (any, error)
+	NewHexLiteral      func(string) (any, error)
+	NewBitLiteral      func(string) (any, error)
+	LocateLiterals     func(stmt StmtNode, literals []LiteralInfo) int
+}
+
+// driverFrozen is set by FreezeDriver.
+var driverFrozen atomic.Bool
+
+// ErrDriverFrozen is the value of the panic of RegisterDriver after
+// FreezeDriver.
+const ErrDriverFrozen = "ast: RegisterDriver called after the first parse"
+
+// RegisterDriver sets the hooks of the parser driver to the non-nil ones of d.
+// A driver calls it from its init function, which is the only safe place: it
+// isn't safe for concurrent use, and it panics with ErrDriverFrozen once
+// FreezeDriver was called, as the parser calls the hooks without
+// synchronization. Assigning the hooks directly has the same contract, but
+// isn't checked.
+func RegisterDriver(d DriverHooks) {
+	if driverFrozen.Load() {
+		panic(ErrDriverFrozen)
+	}
+	if d.NewValueExpr != nil {
+		NewValueExpr = d.NewValueExpr
+	}
+	if d.NewParamMarkerExpr != nil {
+		NewParamMarkerExpr = d.NewParamMarkerExpr
+	}
+	if d.NewDecimal != nil {
+		NewDecimal = d.NewDecimal
+	}
+	if d.NewHexLiteral != nil {
+		NewHexLiteral = d.NewHexLiteral
+	}
+	if d.NewBitLiteral != nil {
+		NewBitLiteral = d.NewBitLiteral
+	}
+	if d.LocateLiterals != nil {
+		LocateLiterals = d.LocateLiterals
+	}
+}
+
+// FreezeDriver makes RegisterDriver panic from now on. The parser calls it
+// when it parses, so that the driver can't change after the first parse. It's
+// safe for concurrent use.
+func FreezeDriver() {
+	if !driverFrozen.Load() {
+		driverFrozen.Store(true)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// Equal reports whether the trees a and b are the same, which DecodeBinary
+// keeps: their nodes have the same types, offsets and texts, and their other
+// fields, exported or not, are equal, the pointers compared by what they point
+// to. A ValueExpr of the parser driver is compared by its value, type, flag,
+// offset, original text and projection offset. Unlike ExpressionDeepEqual, the
+// offsets are compared, and the text Text caches isn't.
+func Equal(a, b Node) bool {
+	return equalValues(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem(), make(map[equalVisit]bool))
+}
+
+// equalVisit is a pair of pointers compared by Equal, which are equal while
+// they are compared, so that a cycle ends.
+type equalVisit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+func equalValues(a, b reflect.Value, seen map[equalVisit]bool) bool {
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			return false
+		}
+		if x, ok := valueExprOf(a); ok {
+			y, _ := valueExprOf(b)
+			return equalValueExprs(x, y)
+		}
+		return equalValues(a.Elem(), b.Elem(), seen)
+	case reflect.Pointer:
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		if a.IsNil() || b.IsNil() {
+			return false
+		}
+		key := equalVisit{a.Pointer(), b.Pointer(), a.Type()}
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+		return equalValues(a.Elem(), b.Elem(), seen)
+	case reflect.Struct:
+		if a.Type() == nodeStructType {
+			return equalNodes(a, b)
+		}
+		for i := range a.NumField() {
+			if !equalValues(a.Field(i), b.Field(i), seen) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		fallthrough
+	case reflect.Array:
+		for i := range a.Len() {
+			if !equalValues(a.Index(i), b.Index(i), seen) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			v := b.MapIndex(iter.Key())
+			if !v.IsValid() || !equalValues(iter.Value(), v, seen) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		// They are only equal when they are nil, like by reflect.DeepEqual.
+		return a.IsNil() && b.IsNil()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	}
+	return false
+}
+
+// equalNodes compares the offsets, the texts and the encodings of the texts of
+// two node values. The encodings are the same when they are the same values,
+// e.g. charset.EncodingUTF8Impl.
+func equalNodes(a, b reflect.Value) bool {
+	if a.FieldByName("offset").Int() != b.FieldByName("offset").Int() ||
+		a.FieldByName("text").String() != b.FieldByName("text").String() {
+		return false
+	}
+	x, y := a.FieldByName("enc"), b.FieldByName("enc")
+	if x.IsNil() || y.IsNil() {
+		return x.IsNil() == y.IsNil()
+	}
+	x, y = x.Elem(), y.Elem()
+	return x.Type() == y.Type() && (x.Kind() != reflect.Pointer || x.Pointer() == y.Pointer())
+}
+
+// valueExprOf returns the ValueExpr of the parser driver held by v, a value of
+// an interface type.
+func valueExprOf(v reflect.Value) (ValueExpr, bool) {
+	if !v.CanInterface() {
+		if !v.CanAddr() {
+			return nil, false
+		}
+		v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	x, ok := v.Interface().(ValueExpr)
+	return x, ok
+}
+
+func equalValueExprs(a, b ValueExpr) bool {
+	return reflect.DeepEqual(a.GetValue(), b.GetValue()) &&
+		reflect.DeepEqual(a.GetType(), b.GetType()) &&
+		a.GetFlag() == b.GetFlag() &&
+		a.OriginTextPosition() == b.OriginTextPosition() &&
+		a.OriginalText() == b.OriginalText() &&
+		a.GetProjectionOffset() == b.GetProjectionOffset()
+}
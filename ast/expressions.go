@@ -14,6 +14,7 @@
 package ast
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io"
 	"reflect"
@@ -21,7 +22,9 @@ import (
 	"strings"
 
 	"github.com/pingcap/errors"
+	"github.com/abbychau/mysql-parser/charset"
 	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/mysql"
 	"github.com/abbychau/mysql-parser/opcode"
 )
 
@@ -65,11 +68,76 @@ type ValueExpr interface {
 }
 
 // NewValueExpr creates a ValueExpr with value, and sets default field type.
+// It's set by the parser driver with RegisterDriver, before the first parse.
 var NewValueExpr func(value any, charset string, collate string) ValueExpr
 
 // NewParamMarkerExpr creates a ParamMarkerExpr.
+// It's set by the parser driver with RegisterDriver, before the first parse.
 var NewParamMarkerExpr func(offset int) ParamMarkerExpr
 
+// RestoreStringLiteral restores the string literal str, whose charset is cs, for
+// the implementations of ValueExpr. The introducer of cs is written unless the
+// flags of ctx leave it out. If the TargetCharset of ctx is set, a literal which
+// isn't only ASCII is converted to it, and offset is the position of the literal
+// reported if it can't be.
+func RestoreStringLiteral(ctx *format.RestoreCtx, str, cs string, offset int) error {
+	withIntroducer := cs != "" && !ctx.Flags.HasStringWithoutCharset() &&
+		(!ctx.Flags.HasStringWithoutDefaultCharset() || cs != mysql.DefaultCharset)
+	if ctx.TargetCharset == "" || isASCII(str) {
+		if withIntroducer {
+			ctx.WritePlain("_")
+			ctx.WriteKeyWord(cs)
+		}
+		ctx.WriteString(str)
+		return nil
+	}
+
+	target := strings.ToLower(ctx.TargetCharset)
+	if !charset.IsSupportedEncoding(target) {
+		return ErrUnknownCharacterSet.GenWithStackByArgs(ctx.TargetCharset)
+	}
+	// The literal is quoted and escaped before it's converted, as the bytes of
+	// the multi-byte characters of the target charset may be a quote or a
+	// backslash.
+	var sb strings.Builder
+	format.NewRestoreCtx(ctx.Flags, &sb).WriteString(str)
+	enc := charset.FindEncoding(target)
+	quoted, err := enc.Transform(nil, []byte(sb.String()), charset.OpEncode)
+	if err != nil {
+		if !ctx.Flags.HasTargetCharsetFallbackFlag() {
+			var invalid []byte
+			enc.Foreach([]byte(str), charset.OpEncode, func(from, _ []byte, ok bool) bool {
+				if !ok {
+					invalid = from
+				}
+				return ok
+			})
+			return charset.ErrInvalidCharacterString.GenWithStack("Invalid %s character string: '%s' at offset %d", target, invalid, offset)
+		}
+		ctx.WritePlain("_")
+		ctx.WriteKeyWord(mysql.UTF8MB4Charset + " ")
+		ctx.WritePlain("x'")
+		ctx.WritePlainAppend(func(b []byte) []byte { return hex.AppendEncode(b, []byte(str)) })
+		ctx.WritePlain("'")
+		return nil
+	}
+	if withIntroducer {
+		ctx.WritePlain("_")
+		ctx.WriteKeyWord(target)
+	}
+	ctx.WritePlain(string(quoted))
+	return nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
 // BetweenExpr is for "between and" or "not between and" expression.
 type BetweenExpr struct {
 	exprNode
@@ -88,7 +156,7 @@ func (n *BetweenExpr) Restore(ctx *format.RestoreCtx) error {
 	if ctx.Flags.HasRestoreBracketAroundBetweenExpr() {
 		ctx.WritePlain("(")
 	}
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precBitOr); err != nil {
 		return errors.Annotate(err, "An error occurred while restore BetweenExpr.Expr")
 	}
 	if n.Not {
@@ -96,11 +164,14 @@ func (n *BetweenExpr) Restore(ctx *format.RestoreCtx) error {
 	} else {
 		ctx.WriteKeyWord(" BETWEEN ")
 	}
-	if err := n.Left.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Left, precBitOr); err != nil {
 		return errors.Annotate(err, "An error occurred while restore BetweenExpr.Left")
 	}
 	ctx.WriteKeyWord(" AND ")
-	if err := n.Right.Restore(ctx); err != nil {
+	// The upper bound is a predicate, so "a BETWEEN b AND c AND d" is
+	// "(a BETWEEN b AND c) AND d" and "a BETWEEN b AND c BETWEEN d AND e" is
+	// "a BETWEEN b AND (c BETWEEN d AND e)".
+	if err := restoreOperand(ctx, n.Right, precPredicate); err != nil {
 		return errors.Annotate(err, "An error occurred while restore BetweenExpr.Right ")
 	}
 	if ctx.Flags.HasRestoreBracketAroundBetweenExpr() {
@@ -162,6 +233,106 @@ type BinaryOperationExpr struct {
 	R ExprNode
 }
 
+// The precedences of the expressions as the parser reads them, from the loosest
+// to the tightest. It's the table of the MySQL manual, except that the
+// comparisons and IS NULL are looser than the predicates LIKE, REGEXP, IN and
+// BETWEEN, which aren't related to each other: as in MySQL, "a = b LIKE c" is
+// "a = (b LIKE c)", and "a LIKE b BETWEEN c AND d" is a syntax error.
+const (
+	precLowest = iota
+	precLogicOr
+	precLogicXor
+	precLogicAnd
+	precNot
+	precCompare
+	precPredicate
+	precBitOr
+	precBitAnd
+	precShift
+	precPlus
+	precMul
+	precBitXor
+	precSimple
+)
+
+// binaryOpPrecedence returns the precedence of the binary operator op.
+func binaryOpPrecedence(op opcode.Op) int {
+	switch op {
+	case opcode.LogicOr:
+		return precLogicOr
+	case opcode.LogicXor:
+		return precLogicXor
+	case opcode.LogicAnd:
+		return precLogicAnd
+	case opcode.EQ, opcode.NE, opcode.LT, opcode.LE, opcode.GT, opcode.GE, opcode.NullEQ:
+		return precCompare
+	case opcode.Or:
+		return precBitOr
+	case opcode.And:
+		return precBitAnd
+	case opcode.LeftShift, opcode.RightShift:
+		return precShift
+	case opcode.Plus, opcode.Minus:
+		return precPlus
+	case opcode.Mul, opcode.Div, opcode.Mod, opcode.IntDiv:
+		return precMul
+	case opcode.Xor:
+		return precBitXor
+	}
+	return precSimple
+}
+
+// exprPrecedence returns the precedence of the restored text of n.
+func exprPrecedence(n ExprNode) int {
+	switch x := n.(type) {
+	case *BinaryOperationExpr:
+		return binaryOpPrecedence(x.Op)
+	case *UnaryOperationExpr:
+		if x.Op == opcode.Not {
+			return precNot
+		}
+	case *IsTruthExpr, *MatchAgainst:
+		return precNot
+	case *IsNullExpr, *CompareSubqueryExpr:
+		return precCompare
+	case *BetweenExpr, *PatternInExpr, *PatternLikeOrIlikeExpr, *PatternRegexpExpr:
+		return precPredicate
+	case *FuncCallExpr:
+		if x.FnName.L == JSONMemberOf {
+			return precPredicate
+		}
+	}
+	return precSimple
+}
+
+// restoreOperand restores the operand n of an expression, where the parser
+// reads an expression of precedence prec at least. It's put in parentheses if
+// it's looser, e.g. when a rewrite of the tree put it there, so that the restored
+// text is parsed back to the same tree.
+func restoreOperand(ctx *format.RestoreCtx, n ExprNode, prec int) error {
+	if exprPrecedence(n) >= prec || restoresInBrackets(ctx, n) {
+		return n.Restore(ctx)
+	}
+	ctx.WritePlain("(")
+	if err := n.Restore(ctx); err != nil {
+		return err
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// restoresInBrackets returns whether n is put in brackets by its own Restore,
+// with the flags of ctx.
+func restoresInBrackets(ctx *format.RestoreCtx, n ExprNode) bool {
+	switch n.(type) {
+	case *BinaryOperationExpr:
+		return ctx.Flags.HasRestoreBracketAroundBinaryOperation()
+	case *BetweenExpr:
+		return ctx.Flags.HasRestoreBracketAroundBetweenExpr()
+	}
+	return false
+}
+
 func restoreBinaryOpWithSpacesAround(ctx *format.RestoreCtx, op opcode.Op) error {
 	shouldInsertSpace := ctx.Flags.HasSpacesAroundBinaryOperationFlag() || op.IsKeyword()
 	if shouldInsertSpace {
@@ -183,13 +354,24 @@ func (n *BinaryOperationExpr) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain("(")
 		ctx.Flags |= format.RestoreBracketAroundBetweenExpr
 	}
-	if err := n.L.Restore(ctx); err != nil {
+	// The operators are left-associative, and the right operand of a comparison
+	// is a predicate.
+	prec := binaryOpPrecedence(n.Op)
+	rightPrec := prec + 1
+	if prec == precCompare {
+		rightPrec = precPredicate
+	}
+	if err := restoreOperand(ctx, n.L, prec); err != nil {
 		return errors.Annotate(err, "An error occurred when restore BinaryOperationExpr.L")
 	}
 	if err := restoreBinaryOpWithSpacesAround(ctx, n.Op); err != nil {
 		return errors.Annotate(err, "An error occurred when restore BinaryOperationExpr.Op")
 	}
-	if err := n.R.Restore(ctx); err != nil {
+	if n.Op == opcode.Minus && !ctx.Flags.HasSpacesAroundBinaryOperationFlag() {
+		if err := restoreAfterMinus(ctx, n.R, rightPrec); err != nil {
+			return errors.Annotate(err, "An error occurred when restore BinaryOperationExpr.R")
+		}
+	} else if err := restoreOperand(ctx, n.R, rightPrec); err != nil {
 		return errors.Annotate(err, "An error occurred when restore BinaryOperationExpr.R")
 	}
 	if ctx.Flags.HasRestoreBracketAroundBinaryOperation() {
@@ -427,7 +609,7 @@ type CompareSubqueryExpr struct {
 
 // Restore implements Node interface.
 func (n *CompareSubqueryExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.L.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.L, precCompare); err != nil {
 		return errors.Annotate(err, "An error occurred while restore CompareSubqueryExpr.L")
 	}
 	if err := restoreBinaryOpWithSpacesAround(ctx, n.Op); err != nil {
@@ -712,7 +894,7 @@ type PatternInExpr struct {
 
 // Restore implements Node interface.
 func (n *PatternInExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precBitOr); err != nil {
 		return errors.Annotate(err, "An error occurred while restore PatternInExpr.Expr")
 	}
 	if n.Not {
@@ -725,8 +907,12 @@ func (n *PatternInExpr) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotate(err, "An error occurred while restore PatternInExpr.Sel")
 		}
 	} else {
+		list := n.List
+		if ctx.Flags.HasCanonicalInListFlag() {
+			list = canonicalInList(list)
+		}
 		ctx.WritePlain("(")
-		for i, expr := range n.List {
+		for i, expr := range list {
 			if i != 0 {
 				ctx.WritePlain(",")
 			}
@@ -796,7 +982,7 @@ type IsNullExpr struct {
 
 // Restore implements Node interface.
 func (n *IsNullExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precCompare); err != nil {
 		return errors.Trace(err)
 	}
 	if n.Not {
@@ -841,11 +1027,14 @@ type IsTruthExpr struct {
 	Not bool
 	// True indicates checking true or false.
 	True int64
+	// Unknown indicates checking unknown, i.e. "is [not] unknown", True is
+	// ignored then. It's evaluated as "is [not] null".
+	Unknown bool
 }
 
 // Restore implements Node interface.
 func (n *IsTruthExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precCompare); err != nil {
 		return errors.Trace(err)
 	}
 	if n.Not {
@@ -853,9 +1042,12 @@ func (n *IsTruthExpr) Restore(ctx *format.RestoreCtx) error {
 	} else {
 		ctx.WriteKeyWord(" IS")
 	}
-	if n.True > 0 {
+	switch {
+	case n.Unknown:
+		ctx.WriteKeyWord(" UNKNOWN")
+	case n.True > 0:
 		ctx.WriteKeyWord(" TRUE")
-	} else {
+	default:
 		ctx.WriteKeyWord(" FALSE")
 	}
 	return nil
@@ -869,9 +1061,12 @@ func (n *IsTruthExpr) Format(w io.Writer) {
 	} else {
 		fmt.Fprint(w, " IS")
 	}
-	if n.True > 0 {
+	switch {
+	case n.Unknown:
+		fmt.Fprint(w, " UNKNOWN")
+	case n.True > 0:
 		fmt.Fprint(w, " TRUE")
-	} else {
+	default:
 		fmt.Fprint(w, " FALSE")
 	}
 }
@@ -911,7 +1106,7 @@ type PatternLikeOrIlikeExpr struct {
 
 // Restore implements Node interface.
 func (n *PatternLikeOrIlikeExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precBitOr); err != nil {
 		return errors.Annotate(err, "An error occurred while restore PatternLikeOrIlikeExpr.Expr")
 	}
 
@@ -929,7 +1124,9 @@ func (n *PatternLikeOrIlikeExpr) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
-	if err := n.Pattern.Restore(ctx); err != nil {
+	// The pattern is a simple expression, so that the ESCAPE which follows it is
+	// the one of this LIKE.
+	if err := restoreOperand(ctx, n.Pattern, precSimple); err != nil {
 		return errors.Annotate(err, "An error occurred while restore PatternLikeOrIlikeExpr.Pattern")
 	}
 
@@ -1094,7 +1291,7 @@ type PatternRegexpExpr struct {
 
 // Restore implements Node interface.
 func (n *PatternRegexpExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precBitOr); err != nil {
 		return errors.Annotate(err, "An error occurred while restore PatternRegexpExpr.Expr")
 	}
 
@@ -1104,7 +1301,7 @@ func (n *PatternRegexpExpr) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(" REGEXP ")
 	}
 
-	if err := n.Pattern.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Pattern, precSimple); err != nil {
 		return errors.Annotate(err, "An error occurred while restore PatternRegexpExpr.Pattern")
 	}
 
@@ -1202,12 +1399,39 @@ func (n *UnaryOperationExpr) Restore(ctx *format.RestoreCtx) error {
 	if err := n.Op.Restore(ctx); err != nil {
 		return errors.Trace(err)
 	}
-	if err := n.V.Restore(ctx); err != nil {
+	prec := precSimple
+	if n.Op == opcode.Not {
+		prec = precNot
+	}
+	if n.Op == opcode.Minus {
+		return errors.Trace(restoreAfterMinus(ctx, n.V, prec))
+	}
+	if err := restoreOperand(ctx, n.V, prec); err != nil {
 		return errors.Trace(err)
 	}
 	return nil
 }
 
+// restoreAfterMinus restores the operand node of precedence prec right after a '-',
+// with a space in between when node starts with a '-' too. "--" followed by a space
+// starts a comment in MySQL and many SQL tools take any "--" for a comment, so
+// "- -1" is written instead of "--1".
+func restoreAfterMinus(ctx *format.RestoreCtx, node ExprNode, prec int) error {
+	var sb strings.Builder
+	in := ctx.In
+	ctx.In = &sb
+	err := restoreOperand(ctx, node, prec)
+	ctx.In = in
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(sb.String(), "-") {
+		ctx.WritePlain(" ")
+	}
+	ctx.WritePlain(sb.String())
+	return nil
+}
+
 // Format the ExprNode into a Writer.
 func (n *UnaryOperationExpr) Format(w io.Writer) {
 	n.Op.Format(w)
@@ -1234,10 +1458,22 @@ type ValuesExpr struct {
 	exprNode
 	// Column is column name.
 	Column *ColumnNameExpr
+	// Deprecated is set by the parser for the VALUES(col) of the ON DUPLICATE
+	// KEY UPDATE of an INSERT, deprecated since MySQL 8.0.20 in favor of the row
+	// alias, see FindDeprecatedValuesUsage.
+	Deprecated bool
 }
 
 // Restore implements Node interface.
 func (n *ValuesExpr) Restore(ctx *format.RestoreCtx) error {
+	if ctx.Flags.HasValuesAsRowAliasFlag() {
+		if alias, col, ok := ctx.RowAliasColumn(n.Column.Name.Name.O); ok {
+			ctx.WriteName(alias)
+			ctx.WritePlain(".")
+			ctx.WriteName(col)
+			return nil
+		}
+	}
 	ctx.WriteKeyWord("VALUES")
 	ctx.WritePlain("(")
 	if err := n.Column.Restore(ctx); err != nil {
@@ -1281,6 +1517,9 @@ type VariableExpr struct {
 	IsSystem bool
 	// ExplicitScope indicates whether this variable scope is set explicitly.
 	ExplicitScope bool
+	// IsLocal indicates whether this variable is a stored program local variable,
+	// which is referenced without the `@` prefix.
+	IsLocal bool
 	// Value is the variable value.
 	Value ExprNode
 }
@@ -1297,7 +1536,7 @@ func (n *VariableExpr) Restore(ctx *format.RestoreCtx) error {
 			}
 			ctx.WritePlain(".")
 		}
-	} else {
+	} else if !n.IsLocal {
 		ctx.WritePlain("@")
 	}
 	ctx.WriteName(n.Name)
@@ -1452,7 +1691,7 @@ type SetCollationExpr struct {
 
 // Restore implements Node interface.
 func (n *SetCollationExpr) Restore(ctx *format.RestoreCtx) error {
-	if err := n.Expr.Restore(ctx); err != nil {
+	if err := restoreOperand(ctx, n.Expr, precSimple); err != nil {
 		return errors.Trace(err)
 	}
 	ctx.WriteKeyWord(" COLLATE ")
@@ -14,9 +14,12 @@
 package ast_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/abbychau/mysql-parser"
 	. "github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
 	"github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/mysql"
 	"github.com/stretchr/testify/require"
@@ -103,7 +106,13 @@ func TestExpresionsVisitorCover(t *testing.T) {
 func TestUnaryOperationExprRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"++1", "++1"},
-		{"--1", "--1"},
+		{"--1", "- -1"},
+		{"- - -1", "- - -1"},
+		{"-(-1)", "-(-1)"},
+		{"- -a", "- -`a`"},
+		{"not not a", "NOT NOT `a`"},
+		{"!!a", "!!`a`"},
+		{"~~a", "~~`a`"},
 		{"-+1", "-+1"},
 		{"-1", "-1"},
 		{"not true", "NOT TRUE"},
@@ -149,11 +158,66 @@ func TestIsTruthRestore(t *testing.T) {
 		{"a is not true", "`a` IS NOT TRUE"},
 		{"a is FALSE", "`a` IS FALSE"},
 		{"a is not false", "`a` IS NOT FALSE"},
+		{"a is unknown", "`a` IS UNKNOWN"},
+		{"a is not UNKNOWN", "`a` IS NOT UNKNOWN"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*SelectStmt).Fields.Fields[0].Expr
 	}
 	runNodeRestoreTest(t, testCases, "select %s", extractNodeFunc)
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("select a is not unknown", "", "")
+	require.NoError(t, err)
+	expr, ok := stmt.(*SelectStmt).Fields.Fields[0].Expr.(*IsTruthExpr)
+	require.True(t, ok)
+	require.True(t, expr.Unknown)
+	require.True(t, expr.Not)
+}
+
+func TestBooleanLiteralRestore(t *testing.T) {
+	var testCases []NodeRestoreTestCase
+	for _, op := range []string{"=", "!=", "<", "<=", ">", ">=", "<=>"} {
+		for _, kwd := range []string{"TRUE", "FALSE"} {
+			testCases = append(testCases,
+				NodeRestoreTestCase{"a " + op + " " + strings.ToLower(kwd), "`a`" + op + kwd},
+				NodeRestoreTestCase{kwd + " " + op + " a", kwd + op + "`a`"})
+		}
+	}
+	for _, not := range []string{"", "NOT "} {
+		for _, kwd := range []string{"TRUE", "FALSE", "UNKNOWN"} {
+			testCases = append(testCases, NodeRestoreTestCase{"(a = true) is " + not + kwd, "(`a`=TRUE) IS " + not + kwd})
+		}
+	}
+	// The keyword is kept in arithmetic, the parser doesn't fold it.
+	testCases = append(testCases,
+		NodeRestoreTestCase{"true + 1", "TRUE+1"},
+		NodeRestoreTestCase{"-false", "-FALSE"},
+		NodeRestoreTestCase{"1", "1"},
+	)
+	extractNodeFunc := func(node Node) Node {
+		return node.(*SelectStmt).Fields.Fields[0].Expr
+	}
+	runNodeRestoreTest(t, testCases, "select %s", extractNodeFunc)
+
+	// A value folded from the literal isn't a keyword anymore: TRUE+1 folded into
+	// the TRUE literal is restored as 2, not as TRUE.
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("select true", "", "")
+	require.NoError(t, err)
+	value := stmt.(*SelectStmt).Fields.Fields[0].Expr.(ValueExpr)
+	restore := func() string {
+		var sb strings.Builder
+		require.NoError(t, value.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+		return sb.String()
+	}
+	require.Equal(t, "TRUE", restore())
+	value.SetValue(false)
+	require.Equal(t, "FALSE", restore())
+	value.SetValue(int64(2))
+	require.Equal(t, "2", restore())
+	value.SetValue(int64(1))
+	require.Equal(t, "1", restore())
 }
 
 func TestBetweenExprRestore(t *testing.T) {
@@ -277,6 +341,23 @@ func TestPatternInExprRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "select %s", extractNodeFunc)
 }
 
+func TestPatternInExprRestoreCanonical(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"a in (3,1,2,2,1.0)", "`a` IN (1,2,3,1.0)"},
+		{"a not in (3,-1,null,3,1e0)", "`a` NOT IN (NULL,-1,3,1e+00)"},
+		{"a in ('b','a','A','a')", "`a` IN (_UTF8MB4'A',_UTF8MB4'a',_UTF8MB4'b')"},
+		{"a in ('b' collate utf8mb4_bin,'a')", "`a` IN (_UTF8MB4'b' COLLATE utf8mb4_bin,_UTF8MB4'a')"},
+		{"a in (3,b,1,1)", "`a` IN (3,`b`,1,1)"},
+		{"a in (3,(select 1),1)", "`a` IN (3,(SELECT 1),1)"},
+		{"a in (select 2)", "`a` IN (SELECT 2)"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*SelectStmt).Fields.Fields[0].Expr
+	}
+	runNodeRestoreTestWithFlagsStmtChange(t, testCases, "select %s", extractNodeFunc, format.DefaultRestoreFlags|format.RestoreCanonicalInList)
+	runNodeRestoreTest(t, []NodeRestoreTestCase{{"a in (3,1,1)", "`a` IN (3,1,1)"}}, "select %s", extractNodeFunc)
+}
+
 func TestPatternLikeExprRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"a like 't1'", "`a` LIKE _UTF8MB4't1'"},
@@ -405,3 +486,39 @@ func TestMatchAgainstExpr(t *testing.T) {
 	}
 	runNodeRestoreTest(t, testCases, "SELECT * FROM t WHERE %s", extractNodeFunc)
 }
+
+func TestRestoreTargetCharset(t *testing.T) {
+	p := parser.New()
+	restore := func(sql string, flags format.RestoreFlags) (string, error) {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		var sb strings.Builder
+		err = stmt.Restore(format.NewRestoreCtx(flags, &sb, format.RestoreTargetCharset("gbk")))
+		return sb.String(), err
+	}
+	gbk := charset.FindEncoding("gbk")
+
+	// The literals are converted to gbk, and decoded back to the same text.
+	restored, err := restore("select '中文', 'a\\'b'", format.DefaultRestoreFlags)
+	require.NoError(t, err)
+	decoded, err := gbk.Transform(nil, []byte(restored), charset.OpDecode)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT _GBK'中文',_UTF8MB4'a''b'", string(decoded))
+	require.NotEqual(t, restored, string(decoded))
+
+	// The literals which are only ASCII are kept as they are.
+	restored, err = restore("select 'abc', _latin1'def'", format.DefaultRestoreFlags)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT _UTF8MB4'abc',_LATIN1'def'", restored)
+
+	// A character gbk can't encode fails, unless the fallback flag is set.
+	_, err = restore("select '😀'", format.DefaultRestoreFlags)
+	require.ErrorContains(t, err, "Invalid gbk character string: '😀' at offset 7")
+	restored, err = restore("select '😀'", format.DefaultRestoreFlags|format.RestoreTargetCharsetFallback)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT _UTF8MB4 x'f09f9880'", restored)
+
+	var sb strings.Builder
+	ctx := format.NewRestoreCtx(format.DefaultRestoreFlags, &sb, format.RestoreTargetCharset("unknown"))
+	require.Error(t, RestoreStringLiteral(ctx, "中文", "", 0))
+}
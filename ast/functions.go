@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/opcode"
 	"github.com/abbychau/mysql-parser/types"
 )
 
@@ -31,6 +32,24 @@ var (
 	_ FuncNode = &WindowFuncExpr{}
 )
 
+// jsonPathFuncs lists the JSON functions taking path arguments. A path may
+// contain backslashes, e.g. `$."\u00e9"`, so the string literals of these
+// calls are always restored with their backslashes escaped.
+var jsonPathFuncs = map[string]struct{}{
+	JSONExtract:      {},
+	JSONSet:          {},
+	JSONInsert:       {},
+	JSONReplace:      {},
+	JSONRemove:       {},
+	JSONContains:     {},
+	JSONContainsPath: {},
+	JSONArrayAppend:  {},
+	JSONArrayInsert:  {},
+	JSONSearch:       {},
+	JSONKeys:         {},
+	JSONLength:       {},
+}
+
 // List scalar function names.
 const (
 	LogicAnd           = "and"
@@ -413,6 +432,10 @@ func (n *FuncCallExpr) Restore(ctx *format.RestoreCtx) error {
 	}
 
 	ctx.WritePlain("(")
+	if _, ok := jsonPathFuncs[n.FnName.L]; ok && !ctx.Flags.HasStringEscapeBackslashFlag() {
+		ctx.Flags |= format.RestoreStringEscapeBackslash
+		defer func() { ctx.Flags &^= format.RestoreStringEscapeBackslash }()
+	}
 	switch n.FnName.L {
 	case "convert":
 		if err := n.Args[0].Restore(ctx); err != nil {
@@ -603,6 +626,8 @@ type CastFunctionType int
 const (
 	CastFunction CastFunctionType = iota + 1
 	CastConvertFunction
+	// CastBinaryOperator is the deprecated `BINARY expr` form of `CAST(expr AS BINARY)`.
+	// The operand is a simple expression, `BINARY a = b` compares `BINARY a` with `b`.
 	CastBinaryOperator
 )
 
@@ -692,13 +717,33 @@ func (n *FuncCastExpr) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain(")")
 	case CastBinaryOperator:
 		ctx.WriteKeyWord("BINARY ")
+		paren := isCompoundExpr(n.Expr)
+		if paren {
+			ctx.WritePlain("(")
+		}
 		if err := n.Expr.Restore(ctx); err != nil {
 			return errors.Annotatef(err, "An error occurred while restore FuncCastExpr.Expr")
 		}
+		if paren {
+			ctx.WritePlain(")")
+		}
 	}
 	return nil
 }
 
+// isCompoundExpr reports whether expr is written with an operator looser than the
+// BINARY operator, so that it needs the parentheses to be the operand of BINARY.
+func isCompoundExpr(expr ExprNode) bool {
+	switch x := expr.(type) {
+	case *BinaryOperationExpr, *PatternLikeOrIlikeExpr, *PatternRegexpExpr, *PatternInExpr,
+		*BetweenExpr, *IsNullExpr, *IsTruthExpr, *CompareSubqueryExpr:
+		return true
+	case *UnaryOperationExpr:
+		return x.Op == opcode.Not
+	}
+	return false
+}
+
 // Format the ExprNode into a Writer.
 func (n *FuncCastExpr) Format(w io.Writer) {
 	switch n.FunctionType {
@@ -716,7 +761,13 @@ func (n *FuncCastExpr) Format(w io.Writer) {
 		fmt.Fprint(w, ")")
 	case CastBinaryOperator:
 		fmt.Fprint(w, "BINARY ")
-		n.Expr.Format(w)
+		if isCompoundExpr(n.Expr) {
+			fmt.Fprint(w, "(")
+			n.Expr.Format(w)
+			fmt.Fprint(w, ")")
+		} else {
+			n.Expr.Format(w)
+		}
 	}
 }
 
@@ -1229,3 +1280,63 @@ func (n *GetFormatSelectorExpr) Accept(v Visitor) (Node, bool) {
 	}
 	return v.Leave(n)
 }
+
+// FuncKeywordArgExpr is an argument of a function call made of an expression
+// followed by keywords, e.g. the x AS SIGNED of a vendor function, or of
+// keywords only, e.g. its DESC. The grammar doesn't give it, only the
+// FunctionArgParser of a function registered with
+// parser.RegisterFunctionSyntax does.
+type FuncKeywordArgExpr struct {
+	exprNode
+	// Expr is the expression of the argument, nil if it's only keywords.
+	Expr ExprNode
+	// Keywords are the words following Expr, e.g. AS and SIGNED, which are
+	// restored as keywords.
+	Keywords []string
+}
+
+// Restore implements Node interface.
+func (n *FuncKeywordArgExpr) Restore(ctx *format.RestoreCtx) error {
+	if n.Expr != nil {
+		if err := n.Expr.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore FuncKeywordArgExpr.Expr")
+		}
+	}
+	for i, keyword := range n.Keywords {
+		if i > 0 || n.Expr != nil {
+			ctx.WritePlain(" ")
+		}
+		ctx.WriteKeyWord(keyword)
+	}
+	return nil
+}
+
+// Format the ExprNode into a Writer.
+func (n *FuncKeywordArgExpr) Format(w io.Writer) {
+	if n.Expr != nil {
+		n.Expr.Format(w)
+	}
+	for i, keyword := range n.Keywords {
+		if i > 0 || n.Expr != nil {
+			fmt.Fprint(w, " ")
+		}
+		fmt.Fprint(w, keyword)
+	}
+}
+
+// Accept implements Node Accept interface.
+func (n *FuncKeywordArgExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*FuncKeywordArgExpr)
+	if n.Expr != nil {
+		node, ok := n.Expr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Expr = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
@@ -32,6 +32,8 @@ func TestFunctionsVisitorCover(t *testing.T) {
 		&FuncCallExpr{Args: []ExprNode{valueExpr}},
 		&FuncCastExpr{Expr: valueExpr},
 		&WindowFuncExpr{Spec: WindowSpec{}},
+		&FuncKeywordArgExpr{Expr: valueExpr, Keywords: []string{"AS", "SIGNED"}},
+		&FuncKeywordArgExpr{Keywords: []string{"DESC"}},
 	}
 
 	for _, stmt := range stmts {
@@ -264,3 +266,47 @@ func TestRestoreWithError(t *testing.T) {
 		require.Error(t, extractNodeFunc(stmt).Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
 	}
 }
+
+func TestContainsNonDeterministicFunc(t *testing.T) {
+	p := parser.New()
+	cases := []struct {
+		sql   string
+		names []string
+	}{
+		{"SELECT a + 1, UPPER(b) FROM t WHERE c = UNIX_TIMESTAMP('2020-01-01')", nil},
+		{"SELECT UUID()", []string{"uuid"}},
+		{"SELECT UUID_TO_BIN(UUID()), BIN_TO_UUID(a) FROM t", []string{"uuid"}},
+		{"SELECT a FROM t WHERE b > DATE_SUB(NOW(), INTERVAL 1 DAY) AND c < SYSDATE() ORDER BY RAND()", []string{"now", "sysdate", "rand"}},
+		{"SELECT CURRENT_TIMESTAMP, CURDATE(), CURRENT_TIME, UNIX_TIMESTAMP()", []string{"current_timestamp", "curdate", "current_time", "unix_timestamp"}},
+		{"SELECT * FROM t WHERE a IN (SELECT LAST_INSERT_ID() + ROW_COUNT() + FOUND_ROWS())", []string{"last_insert_id", "row_count", "found_rows"}},
+		{"SELECT CONNECTION_ID(), USER(), CURRENT_USER(), DATABASE(), RANDOM_BYTES(8), UUID_SHORT()", []string{"connection_id", "user", "current_user", "database", "random_bytes", "uuid_short"}},
+		{"SELECT GET_LOCK('l', 1), IS_FREE_LOCK('l'), RELEASE_LOCK('l')", []string{"get_lock", "is_free_lock", "release_lock"}},
+		{"INSERT INTO t VALUES (RAND(), RAND())", []string{"rand"}},
+		{"UPDATE t SET a = CASE WHEN b THEN NOW() ELSE 0 END", []string{"now"}},
+		// The column options are evaluated by the statements writing the rows.
+		{"CREATE TABLE t (a INT, b TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, c DOUBLE DEFAULT (RAND()))", nil},
+		{"ALTER TABLE t ALTER COLUMN c SET DEFAULT (UUID())", nil},
+		{"CREATE TABLE t (a INT) AS SELECT RAND() AS a", []string{"rand"}},
+		// A function of another schema isn't the built-in one.
+		{"SELECT s.uuid()", nil},
+	}
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		found, names := ContainsNonDeterministicFunc(stmt)
+		require.Equal(t, len(c.names) > 0, found, c.sql)
+		require.Equal(t, c.names, names, c.sql)
+	}
+
+	// The table is extended with the user-defined functions.
+	stmt, err := p.ParseOneStmt("SELECT my_udf(a) FROM t", "", "")
+	require.NoError(t, err)
+	found, names := ContainsNonDeterministicFunc(stmt)
+	require.False(t, found)
+	require.Empty(t, names)
+	NonDeterministicFuncs["my_udf"] = NonDeterministicFunc{Note: "reads an external service"}
+	defer delete(NonDeterministicFuncs, "my_udf")
+	found, names = ContainsNonDeterministicFunc(stmt)
+	require.True(t, found)
+	require.Equal(t, []string{"my_udf"}, names)
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/abbychau/mysql-parser/opcode"
+)
+
+// inListKind orders the kinds of the literals of a canonical IN list.
+type inListKind int
+
+const (
+	inListNull inListKind = iota
+	inListInteger
+	inListDecimal
+	inListFloat
+	inListString
+	inListBytes
+)
+
+// inListKey is the sort key of a literal of an IN list. Two literals with the
+// same key are duplicates.
+type inListKey struct {
+	kind inListKind
+	// number is the value of the numeric literals.
+	number *big.Rat
+	// text is the text of the strings and the bytes of the binary literals.
+	text string
+	// collate is the collation of the strings.
+	collate string
+}
+
+func (k inListKey) compare(o inListKey) int {
+	if k.kind != o.kind {
+		return int(k.kind) - int(o.kind)
+	}
+	if k.number != nil {
+		return k.number.Cmp(o.number)
+	}
+	if c := strings.Compare(k.text, o.text); c != 0 {
+		return c
+	}
+	return strings.Compare(k.collate, o.collate)
+}
+
+// inListKeyOf returns the sort key of expr, and false if expr isn't a literal.
+func inListKeyOf(expr ExprNode) (inListKey, bool) {
+	negative := false
+	if u, ok := expr.(*UnaryOperationExpr); ok && (u.Op == opcode.Minus || u.Op == opcode.Plus) {
+		negative = u.Op == opcode.Minus
+		expr = u.V
+	}
+	if _, ok := expr.(ParamMarkerExpr); ok {
+		return inListKey{}, false
+	}
+	value, ok := expr.(ValueExpr)
+	if !ok {
+		return inListKey{}, false
+	}
+	var key inListKey
+	switch v := value.GetValue().(type) {
+	case nil:
+		key.kind = inListNull
+	case int64:
+		key = inListKey{kind: inListInteger, number: new(big.Rat).SetInt64(v)}
+	case uint64:
+		key = inListKey{kind: inListInteger, number: new(big.Rat).SetInt(new(big.Int).SetUint64(v))}
+	case float32, float64:
+		f, _ := strconv.ParseFloat(fmt.Sprint(v), 64)
+		key = inListKey{kind: inListFloat, number: new(big.Rat)}
+		if key.number.SetFloat64(f) == nil {
+			return inListKey{}, false
+		}
+	case string:
+		key = inListKey{kind: inListString, text: v}
+		if tp := value.GetType(); tp != nil {
+			key.collate = tp.GetCollate()
+		}
+	default:
+		// The binary literals and the decimals are types of the drivers.
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			key = inListKey{kind: inListBytes, text: string(rv.Bytes())}
+			break
+		}
+		s, ok := v.(fmt.Stringer)
+		if !ok {
+			return inListKey{}, false
+		}
+		r, ok := new(big.Rat).SetString(s.String())
+		if !ok {
+			return inListKey{}, false
+		}
+		key = inListKey{kind: inListDecimal, number: r}
+	}
+	if negative {
+		if key.number == nil {
+			// A negative string or binary literal is a number.
+			return inListKey{}, false
+		}
+		key.number.Neg(key.number)
+	}
+	return key, true
+}
+
+// canonicalInList returns list sorted and without duplicates if it's made only
+// of literals, otherwise it returns list. It's used to restore [NOT] IN lists
+// with RestoreCanonicalInList: the result of x [NOT] IN (list) doesn't depend
+// on the order nor on the duplicates of list, NULL included.
+//
+// Only the literals of the same kind and value are duplicates, e.g. 1 and 1.0
+// aren't, nor are 'a' and 'A' of a case-insensitive collation, as the server
+// compares the elements as the type decided by all the elements and x, which
+// isn't known here. NULL comes first, then the integers, the decimals and the
+// floats, each kind sorted by value, then the strings and the binary literals
+// sorted by their bytes.
+func canonicalInList(list []ExprNode) []ExprNode {
+	keys := make([]inListKey, len(list))
+	for i, expr := range list {
+		key, ok := inListKeyOf(expr)
+		if !ok {
+			return list
+		}
+		keys[i] = key
+	}
+	order := make([]int, len(list))
+	for i := range order {
+		order[i] = i
+	}
+	// The first of the duplicates, e.g. of 1.0 and 1.00, is kept.
+	slices.SortStableFunc(order, func(a, b int) int {
+		return keys[a].compare(keys[b])
+	})
+	result := make([]ExprNode, 0, len(list))
+	for i, idx := range order {
+		if i > 0 && keys[order[i-1]].compare(keys[idx]) == 0 {
+			continue
+		}
+		result = append(result, list[idx])
+	}
+	return result
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "fmt"
+
+// ShapeIssueKind is the kind of a ShapeIssue.
+type ShapeIssueKind int
+
+// ShapeIssueKind types.
+const (
+	// ShapeRowArityMismatch is a VALUES row whose arity differs from the one of
+	// the first row, when there is no column list.
+	ShapeRowArityMismatch ShapeIssueKind = iota
+	// ShapeColumnCountMismatch is a VALUES row whose arity differs from the
+	// column list.
+	ShapeColumnCountMismatch
+	// ShapeDuplicateColumn is a column listed twice in the column list, or
+	// assigned twice in the SET form.
+	ShapeDuplicateColumn
+	// ShapeOnDuplicateColumnNotListed is a column assigned by ON DUPLICATE KEY
+	// UPDATE but absent from the column list. It may still be a column of the
+	// table, which can't be told without the schema.
+	ShapeOnDuplicateColumnNotListed
+	// ShapeNoColumnList is a statement without column list, whose values are
+	// matched against all the columns of the table, in order.
+	ShapeNoColumnList
+)
+
+// String implements fmt.Stringer interface.
+func (k ShapeIssueKind) String() string {
+	switch k {
+	case ShapeRowArityMismatch:
+		return "row arity mismatch"
+	case ShapeColumnCountMismatch:
+		return "column count mismatch"
+	case ShapeDuplicateColumn:
+		return "duplicate column"
+	case ShapeOnDuplicateColumnNotListed:
+		return "on duplicate column not listed"
+	case ShapeNoColumnList:
+		return "no column list"
+	}
+	return fmt.Sprintf("ShapeIssueKind(%d)", int(k))
+}
+
+// ShapeIssue is an issue found by ValidateInsertShape.
+type ShapeIssue struct {
+	Kind ShapeIssueKind
+	// Row is the index of the VALUES row of the issue, from 0, or -1 if the
+	// issue isn't about a row.
+	Row int
+	// Column is the lowercase name of the column of the issue, if any.
+	Column string
+	// Offset is the offset in the statement text of the expression the issue
+	// is about, e.g. the first value of a row, or -1 if it's unknown, as the
+	// offsets of the column names aren't recorded by the parser.
+	Offset  int
+	Message string
+}
+
+// IsError tells if the statement fails to execute because of the issue. The
+// other issues are only informational, as the schema is needed to tell.
+func (i ShapeIssue) IsError() bool {
+	switch i.Kind {
+	case ShapeOnDuplicateColumnNotListed, ShapeNoColumnList:
+		return false
+	}
+	return true
+}
+
+// ValidateInsertShape checks that the columns and the values of stmt line up:
+// every VALUES row has the same arity, which is the one of the column list when
+// there is one, and no column is listed or assigned twice. The arity of the
+// rows of a SELECT source isn't checked, as it's only known after the SELECT
+// is resolved. The issues are returned in the order of the statement text.
+func ValidateInsertShape(stmt *InsertStmt) []ShapeIssue {
+	var issues []ShapeIssue
+	if len(stmt.Columns) == 0 && !stmt.Setlist {
+		issues = append(issues, ShapeIssue{
+			Kind:    ShapeNoColumnList,
+			Row:     -1,
+			Offset:  -1,
+			Message: "no column list, the values are matched against all the columns of the table in order",
+		})
+	}
+
+	seen := make(map[string]struct{}, len(stmt.Columns))
+	for i, col := range stmt.Columns {
+		name := col.Name.L
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			continue
+		}
+		issue := ShapeIssue{
+			Kind:    ShapeDuplicateColumn,
+			Row:     -1,
+			Column:  name,
+			Offset:  -1,
+			Message: fmt.Sprintf("column '%s' specified twice", name),
+		}
+		if stmt.Setlist && len(stmt.Lists) == 1 && i < len(stmt.Lists[0]) {
+			issue.Row = 0
+			issue.Offset = stmt.Lists[0][i].OriginTextPosition()
+		}
+		issues = append(issues, issue)
+	}
+
+	if stmt.Select == nil && !stmt.Setlist {
+		for i, row := range stmt.Lists {
+			offset := -1
+			if len(row) > 0 {
+				offset = row[0].OriginTextPosition()
+			}
+			switch {
+			case len(stmt.Columns) > 0:
+				if len(row) != len(stmt.Columns) {
+					issues = append(issues, ShapeIssue{
+						Kind:    ShapeColumnCountMismatch,
+						Row:     i,
+						Offset:  offset,
+						Message: fmt.Sprintf("%d columns but %d values at row %d", len(stmt.Columns), len(row), i+1),
+					})
+				}
+			case i > 0 && len(row) != len(stmt.Lists[0]):
+				issues = append(issues, ShapeIssue{
+					Kind:    ShapeRowArityMismatch,
+					Row:     i,
+					Offset:  offset,
+					Message: fmt.Sprintf("%d values at row %d but %d at row 1", len(row), i+1, len(stmt.Lists[0])),
+				})
+			}
+		}
+	}
+
+	if len(stmt.Columns) > 0 {
+		for _, a := range stmt.OnDuplicate {
+			name := a.Column.Name.L
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			issues = append(issues, ShapeIssue{
+				Kind:    ShapeOnDuplicateColumnNotListed,
+				Row:     -1,
+				Column:  name,
+				Offset:  a.Expr.OriginTextPosition(),
+				Message: fmt.Sprintf("column '%s' is updated on duplicate key but isn't in the column list", name),
+			})
+		}
+	}
+	return issues
+}
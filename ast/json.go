@@ -0,0 +1,403 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+)
+
+// ToJSON returns the JSON of the tree node, e.g. to hand a parsed statement to
+// a tool written in another language.
+//
+// A node is an object whose "type" member is the name of its Go type, e.g.
+// "SelectStmt", followed by "offset", its OriginTextPosition, for an expression
+// or a statement, "endOffset", its EndPosition, for a statement, and its
+// exported fields, named as in Go unless a json tag renames or skips them. The
+// fields holding their zero value are left out. A literal is a "ValueExpr" with
+// its value in "value", and a parameter marker is a "ParamMarkerExpr".
+//
+// Among the other values, a CIStr is its original string, a value with a
+// MarshalJSON method is encoded by it, and a number with a String method, e.g.
+// an opcode.Op or a JoinType, is that string.
+//
+// The tree is walked with an explicit stack, so deep trees don't overflow the
+// goroutine stack. A pointer cycle is reported as an error.
+func ToJSON(node Node) ([]byte, error) {
+	e := &jsonEncoder{onPath: make(map[uintptr]struct{})}
+	if err := e.encode(reflect.ValueOf(node)); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+var (
+	nodeType          = reflect.TypeOf((*Node)(nil)).Elem()
+	ciStrType         = reflect.TypeOf(CIStr{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// jsonFrame is an object or an array being written by the jsonEncoder.
+type jsonFrame struct {
+	v reflect.Value
+	// keys are the sorted keys of a map.
+	keys []reflect.Value
+	next int
+	// written is the number of members or elements written.
+	written int
+	object  bool
+	// ptr is the pointer the frame was reached by, 0 if none.
+	ptr uintptr
+}
+
+type jsonEncoder struct {
+	buf   []byte
+	stack []*jsonFrame
+	// onPath holds the pointers followed from the root to the top frame.
+	onPath map[uintptr]struct{}
+}
+
+func (e *jsonEncoder) encode(root reflect.Value) error {
+	if err := e.value(root, 0); err != nil {
+		return err
+	}
+	for len(e.stack) > 0 {
+		f := e.stack[len(e.stack)-1]
+		key, child, ok := f.nextChild()
+		if !ok {
+			if f.object {
+				e.buf = append(e.buf, '}')
+			} else {
+				e.buf = append(e.buf, ']')
+			}
+			if f.ptr != 0 {
+				delete(e.onPath, f.ptr)
+			}
+			e.stack = e.stack[:len(e.stack)-1]
+			continue
+		}
+		if f.written > 0 {
+			e.buf = append(e.buf, ',')
+		}
+		f.written++
+		if f.object {
+			e.buf = appendJSONString(e.buf, key)
+			e.buf = append(e.buf, ':')
+		}
+		if err := e.value(child, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextChild returns the next member of an object frame or element of an array
+// frame, ok is false when there is none left.
+func (f *jsonFrame) nextChild() (key string, child reflect.Value, ok bool) {
+	switch f.v.Kind() {
+	case reflect.Struct:
+		t := f.v.Type()
+		for f.next < t.NumField() {
+			field := t.Field(f.next)
+			v := f.v.Field(f.next)
+			f.next++
+			name, ok := jsonFieldName(field)
+			if !ok || skipJSONValue(v) {
+				continue
+			}
+			return name, v, true
+		}
+	case reflect.Map:
+		if f.next < len(f.keys) {
+			k := f.keys[f.next]
+			f.next++
+			return fmt.Sprint(k.Interface()), f.v.MapIndex(k), true
+		}
+	default:
+		if f.next < f.v.Len() {
+			f.next++
+			return "", f.v.Index(f.next - 1), true
+		}
+	}
+	return "", reflect.Value{}, false
+}
+
+// jsonFieldName returns the member name of an exported field, ok is false for a
+// field that isn't encoded.
+func jsonFieldName(field reflect.StructField) (name string, ok bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ = strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return field.Name, true
+}
+
+func skipJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	}
+	return v.IsZero()
+}
+
+// value writes v, or writes the opening of its frame and pushes it.
+func (e *jsonEncoder) value(v reflect.Value, ptr uintptr) error {
+	if !v.IsValid() {
+		e.buf = append(e.buf, "null"...)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			e.buf = append(e.buf, "null"...)
+			return nil
+		}
+		if v.Type().Implements(nodeType) {
+			return e.node(v.Interface().(Node))
+		}
+		if v.Type().Implements(jsonMarshalerType) {
+			return e.marshal(v)
+		}
+		if v.Kind() == reflect.Pointer {
+			ptr = v.Pointer()
+			if _, ok := e.onPath[ptr]; ok {
+				return errors.Errorf("cycle at %s in the tree", v.Type())
+			}
+		}
+		return e.value(v.Elem(), ptr)
+	}
+	if v.Kind() == reflect.Struct && v.CanAddr() && reflect.PointerTo(v.Type()).Implements(nodeType) {
+		// A node held by value, e.g. the WindowSpec of a WindowFuncExpr.
+		return e.node(v.Addr().Interface().(Node))
+	}
+	if v.Type() == ciStrType {
+		e.buf = appendJSONString(e.buf, v.Interface().(CIStr).O)
+		return nil
+	}
+	if v.Type().Implements(jsonMarshalerType) || reflect.PointerTo(v.Type()).Implements(jsonMarshalerType) {
+		return e.marshal(v)
+	}
+	if v.Kind() >= reflect.Int && v.Kind() <= reflect.Uintptr && v.Type().PkgPath() != "" {
+		if s, ok := stringer(v); ok {
+			e.buf = appendJSONString(e.buf, s)
+			return nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		e.buf = strconv.AppendBool(e.buf, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.buf = strconv.AppendInt(e.buf, v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.buf = strconv.AppendUint(e.buf, v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		e.buf = appendJSONFloat(e.buf, v.Float())
+	case reflect.String:
+		e.buf = appendJSONString(e.buf, v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			e.buf = appendJSONString(e.buf, string(v.Bytes()))
+			return nil
+		}
+		e.push(&jsonFrame{v: v, ptr: ptr}, '[')
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+		e.push(&jsonFrame{v: v, keys: keys, object: true, ptr: ptr}, '{')
+	case reflect.Struct:
+		e.push(&jsonFrame{v: v, object: true, ptr: ptr}, '{')
+	default:
+		e.buf = append(e.buf, "null"...)
+	}
+	return nil
+}
+
+// node writes the members of n known by its interfaces, and pushes the frame
+// of its fields.
+func (e *jsonEncoder) node(n Node) error {
+	v := reflect.ValueOf(n)
+	ptr := uintptr(0)
+	if v.Kind() == reflect.Pointer {
+		ptr = v.Pointer()
+		if _, ok := e.onPath[ptr]; ok {
+			return errors.Errorf("cycle at %s in the tree", v.Type())
+		}
+	}
+	e.buf = append(e.buf, `{"type":`...)
+	switch n.(type) {
+	case ParamMarkerExpr:
+		e.buf = appendJSONString(e.buf, "ParamMarkerExpr")
+	case ValueExpr:
+		e.buf = appendJSONString(e.buf, "ValueExpr")
+	default:
+		e.buf = appendJSONString(e.buf, reflect.Indirect(v).Type().Name())
+	}
+	switch x := n.(type) {
+	case ExprNode:
+		e.buf = append(e.buf, `,"offset":`...)
+		e.buf = strconv.AppendInt(e.buf, int64(x.OriginTextPosition()), 10)
+	case StmtNode:
+		e.buf = append(e.buf, `,"offset":`...)
+		e.buf = strconv.AppendInt(e.buf, int64(x.OriginTextPosition()), 10)
+		e.buf = append(e.buf, `,"endOffset":`...)
+		e.buf = strconv.AppendInt(e.buf, int64(x.EndPosition()), 10)
+	}
+	switch x := n.(type) {
+	case ParamMarkerExpr:
+		e.buf = append(e.buf, '}')
+		return nil
+	case ValueExpr:
+		e.buf = append(e.buf, `,"value":`...)
+		if err := e.literal(x.GetValue()); err != nil {
+			return err
+		}
+		e.buf = append(e.buf, '}')
+		return nil
+	}
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		e.buf = append(e.buf, '}')
+		return nil
+	}
+	// The members written above make the frame non-empty.
+	e.stack = append(e.stack, &jsonFrame{v: v, written: 1, object: true, ptr: ptr})
+	if ptr != 0 {
+		e.onPath[ptr] = struct{}{}
+	}
+	return nil
+}
+
+// literal writes the value of a ValueExpr, a JSON value for the numbers, the
+// strings and NULL and the string form of the others, e.g. a decimal.
+func (e *jsonEncoder) literal(val any) error {
+	switch x := val.(type) {
+	case nil:
+		e.buf = append(e.buf, "null"...)
+	case bool:
+		e.buf = strconv.AppendBool(e.buf, x)
+	case int64:
+		e.buf = strconv.AppendInt(e.buf, x, 10)
+	case uint64:
+		e.buf = strconv.AppendUint(e.buf, x, 10)
+	case float32:
+		e.buf = appendJSONFloat(e.buf, float64(x))
+	case float64:
+		e.buf = appendJSONFloat(e.buf, x)
+	case string:
+		e.buf = appendJSONString(e.buf, x)
+	case []byte:
+		e.buf = appendJSONString(e.buf, string(x))
+	case fmt.Stringer:
+		e.buf = appendJSONString(e.buf, x.String())
+	default:
+		e.buf = appendJSONString(e.buf, fmt.Sprint(x))
+	}
+	return nil
+}
+
+func (e *jsonEncoder) push(f *jsonFrame, open byte) {
+	e.buf = append(e.buf, open)
+	e.stack = append(e.stack, f)
+	if f.ptr != 0 {
+		e.onPath[f.ptr] = struct{}{}
+	}
+}
+
+func (e *jsonEncoder) marshal(v reflect.Value) error {
+	if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface && !v.Type().Implements(jsonMarshalerType) {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	e.buf = append(e.buf, data...)
+	return nil
+}
+
+// stringer returns the String of v if its type or the pointer to it has one.
+func stringer(v reflect.Value) (string, bool) {
+	if v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String(), true
+	}
+	if reflect.PointerTo(v.Type()).Implements(stringerType) {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		return p.Interface().(fmt.Stringer).String(), true
+	}
+	return "", false
+}
+
+// appendJSONFloat appends f, or its string form if it isn't a JSON number.
+func appendJSONFloat(buf []byte, f float64) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return appendJSONString(buf, strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	return strconv.AppendFloat(buf, f, 'g', -1, 64)
+}
+
+// appendJSONString appends the JSON string of s, with the invalid UTF-8 bytes
+// replaced by U+FFFD.
+func appendJSONString(buf []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				buf = append(buf, '\\', c)
+			case c == '\n':
+				buf = append(buf, '\\', 'n')
+			case c == '\r':
+				buf = append(buf, '\\', 'r')
+			case c == '\t':
+				buf = append(buf, '\\', 't')
+			case c < 0x20:
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			default:
+				buf = append(buf, c)
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, "\\ufffd"...)
+		} else {
+			buf = append(buf, s[i:i+size]...)
+		}
+		i += size
+	}
+	return append(buf, '"')
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	. "github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/opcode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToJSONSnapshot(t *testing.T) {
+	col := func(name string) ExprNode {
+		return &ColumnNameExpr{Name: &ColumnName{Table: NewCIStr("t"), Name: NewCIStr(name)}}
+	}
+	cond := &BinaryOperationExpr{Op: opcode.EQ, L: col("a"), R: col("b\"\n")}
+	stmt := &SelectStmt{
+		Kind:   SelectStmtKindSelect,
+		Fields: &FieldList{Fields: []*SelectField{{Expr: col("a"), AsName: NewCIStr("z")}}},
+		Where:  cond,
+	}
+	stmt.SetOriginTextPosition(3)
+	stmt.SetEndPosition(20)
+	data, err := ToJSON(stmt)
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"SelectStmt","offset":3,"endOffset":20,`+
+		`"Where":{"type":"BinaryOperationExpr","offset":0,"Op":"eq",`+
+		`"L":{"type":"ColumnNameExpr","offset":0,"Name":{"type":"ColumnName","Table":"t","Name":"a"}},`+
+		`"R":{"type":"ColumnNameExpr","offset":0,"Name":{"type":"ColumnName","Table":"t","Name":"b\"\n"}}},`+
+		`"Fields":{"type":"FieldList","Fields":[{"type":"SelectField",`+
+		`"Expr":{"type":"ColumnNameExpr","offset":0,"Name":{"type":"ColumnName","Table":"t","Name":"a"}},"AsName":"z"}]}}`,
+		string(data))
+}
+
+// jsonTypes counts the "type" members of the objects of the decoded JSON v.
+func jsonTypes(v any, types map[string]int) {
+	switch x := v.(type) {
+	case map[string]any:
+		if tp, ok := x["type"].(string); ok {
+			types[tp]++
+		}
+		for _, member := range x {
+			jsonTypes(member, types)
+		}
+	case []any:
+		for _, elem := range x {
+			jsonTypes(elem, types)
+		}
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	sql := "SELECT t1.a, CASE WHEN t1.b > 1 THEN 'x' ELSE NULL END AS c, " +
+		"ROW_NUMBER() OVER (PARTITION BY t1.a ORDER BY t2.b DESC) AS rn " +
+		"FROM t1 JOIN t2 ON t1.id = t2.id LEFT JOIN t3 USING (id) " +
+		"WHERE t1.a IN (SELECT a FROM t4 WHERE d = 1.5) AND t2.e = ?"
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	data, err := ToJSON(stmt)
+	require.NoError(t, err)
+
+	var root map[string]any
+	require.NoError(t, json.Unmarshal(data, &root))
+	require.Equal(t, "SelectStmt", root["type"])
+	require.Equal(t, float64(0), root["offset"])
+	require.Equal(t, float64(len(sql)), root["endOffset"])
+
+	types := make(map[string]int)
+	jsonTypes(root, types)
+	for _, tp := range []string{"Join", "TableSource", "TableName", "OnCondition", "SubqueryExpr",
+		"PatternInExpr", "CaseExpr", "WhenClause", "WindowFuncExpr", "WindowSpec", "PartitionByClause",
+		"OrderByClause", "ByItem", "ValueExpr", "ParamMarkerExpr"} {
+		require.Positive(t, types[tp], tp)
+	}
+	require.Equal(t, 2, types["SelectStmt"])
+
+	// The literals carry their value.
+	require.Contains(t, string(data), `"value":"x"`)
+	require.Contains(t, string(data), `"value":null`)
+	require.Contains(t, string(data), `"value":"1.5"`)
+	// The unexported fields of the nodes are left out.
+	require.NotContains(t, string(data), `"node"`)
+	require.NotContains(t, string(data), `"exprNode"`)
+}
+
+func TestToJSONDeepTree(t *testing.T) {
+	// A deep tree is encoded without recursion.
+	var expr ExprNode = &ColumnNameExpr{Name: &ColumnName{Name: NewCIStr("a")}}
+	for range 200000 {
+		expr = &UnaryOperationExpr{Op: opcode.Not, V: expr}
+	}
+	data, err := ToJSON(expr)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), `{"type":"UnaryOperationExpr","offset":0,"Op":"not","V":{"type":"UnaryOperationExpr"`))
+	require.True(t, strings.HasSuffix(string(data), `"Name":{"type":"ColumnName","Name":"a"}}`+strings.Repeat("}", 200000)))
+
+	// A big IN list.
+	sql := "SELECT * FROM t WHERE a IN (" + strings.Repeat("1, ", 50000) + "1)"
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	data, err = ToJSON(stmt)
+	require.NoError(t, err)
+	require.True(t, json.Valid(data))
+	require.Equal(t, 50001, strings.Count(string(data), `"type":"ValueExpr"`))
+
+	// A cycle is reported instead of looping.
+	paren := &ParenthesesExpr{}
+	paren.Expr = paren
+	_, err = ToJSON(paren)
+	require.ErrorContains(t, err, "cycle")
+}
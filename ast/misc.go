@@ -16,6 +16,7 @@ package ast
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"net/url"
 	"strconv"
 	"strings"
@@ -33,6 +34,9 @@ var (
 	_ StmtNode = &AlterRangeStmt{}
 	_ StmtNode = &BeginStmt{}
 	_ StmtNode = &BinlogStmt{}
+	_ StmtNode = &CacheIndexStmt{}
+	_ StmtNode = &ChecksumTableStmt{}
+	_ StmtNode = &LoadIndexIntoCacheStmt{}
 	_ StmtNode = &CommitStmt{}
 	_ StmtNode = &CreateUserStmt{}
 	_ StmtNode = &DeallocateStmt{}
@@ -55,6 +59,7 @@ var (
 	_ StmtNode = &SetBindingStmt{}
 	_ StmtNode = &ShutdownStmt{}
 	_ StmtNode = &RestartStmt{}
+	_ StmtNode = &ResetPersistStmt{}
 	_ StmtNode = &RenameUserStmt{}
 	_ StmtNode = &HelpStmt{}
 	_ StmtNode = &PlanReplayerStmt{}
@@ -63,6 +68,7 @@ var (
 	_ StmtNode = &TrafficStmt{}
 
 	_ Node = &PrivElem{}
+	_ Node = &TableIndexList{}
 	_ Node = &VariableAssignment{}
 )
 
@@ -175,7 +181,11 @@ func (n *TraceStmt) Accept(v Visitor) (Node, bool) {
 type ExplainForStmt struct {
 	stmtNode
 
-	Format       string
+	// Format is the format as written, or "row" without FORMAT.
+	Format string
+	// FormatType is the format of Format, which Restore writes like the one of
+	// an ExplainStmt.
+	FormatType   ExplainFormatType
 	ConnectionID uint64
 }
 
@@ -184,7 +194,11 @@ func (n *ExplainForStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("EXPLAIN ")
 	ctx.WriteKeyWord("FORMAT ")
 	ctx.WritePlain("= ")
-	ctx.WriteString(n.Format)
+	if n.FormatType != ExplainFormatNone {
+		ctx.WriteKeyWord(n.FormatType.String())
+	} else {
+		ctx.WriteString(n.Format)
+	}
 	ctx.WritePlain(" ")
 	ctx.WriteKeyWord("FOR ")
 	ctx.WriteKeyWord("CONNECTION ")
@@ -202,15 +216,66 @@ func (n *ExplainForStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ExplainFormatType is the format of FORMAT = format of an EXPLAIN.
+type ExplainFormatType int
+
+// ExplainFormatType types.
+const (
+	// ExplainFormatNone is an EXPLAIN without FORMAT, or with a format only
+	// TiDB has, e.g. FORMAT = 'brief', which ExplainStmt.Format holds.
+	ExplainFormatNone ExplainFormatType = iota
+	ExplainFormatTraditional
+	ExplainFormatJSON
+	ExplainFormatTree
+	ExplainFormatVerbose
+)
+
+// ExplainFormatTypeOf returns the ExplainFormatType of a format as written,
+// e.g. "json" or 'JSON', and ExplainFormatNone for the other formats.
+func ExplainFormatTypeOf(format string) ExplainFormatType {
+	switch strings.ToUpper(format) {
+	case "TRADITIONAL":
+		return ExplainFormatTraditional
+	case "JSON":
+		return ExplainFormatJSON
+	case "TREE":
+		return ExplainFormatTree
+	case "VERBOSE":
+		return ExplainFormatVerbose
+	}
+	return ExplainFormatNone
+}
+
+// String returns the spelling of the format, which is empty for
+// ExplainFormatNone.
+func (t ExplainFormatType) String() string {
+	switch t {
+	case ExplainFormatTraditional:
+		return "TRADITIONAL"
+	case ExplainFormatJSON:
+		return "JSON"
+	case ExplainFormatTree:
+		return "TREE"
+	case ExplainFormatVerbose:
+		return "VERBOSE"
+	}
+	return ""
+}
+
 // ExplainStmt is a statement to provide information about how is SQL statement executed
 // or get columns information in a table.
 // See https://dev.mysql.com/doc/refman/5.7/en/explain.html
 type ExplainStmt struct {
 	stmtNode
 
-	Stmt    StmtNode
-	Format  string
-	Analyze bool
+	Stmt StmtNode
+	// Format is the format as written, or "row" without FORMAT.
+	Format string
+	// FormatType is the format of Format, which Restore writes in its MySQL
+	// spelling, e.g. FORMAT = JSON for FORMAT = 'json'. Format is written
+	// as is when it's ExplainFormatNone.
+	FormatType ExplainFormatType
+	Analyze    bool
 
 	// Explore indicates whether to use EXPLAIN EXPLORE.
 	Explore bool
@@ -244,6 +309,11 @@ func (n *ExplainStmt) Restore(ctx *format.RestoreCtx) error {
 		if n.SQLDigest != "" {
 			ctx.WriteString(n.SQLDigest)
 		}
+	} else if n.FormatType != ExplainFormatNone {
+		ctx.WriteKeyWord("FORMAT ")
+		ctx.WritePlain("= ")
+		ctx.WriteKeyWord(n.FormatType.String())
+		ctx.WritePlain(" ")
 	} else if !n.Analyze || strings.ToLower(n.Format) != "row" {
 		ctx.WriteKeyWord("FORMAT ")
 		ctx.WritePlain("= ")
@@ -461,6 +531,8 @@ const (
 
 var _ SensitiveStmtNode = (*TrafficStmt)(nil)
 
+var _ SensitiveStmtNode = (*BinlogStmt)(nil)
+
 // TrafficStmt is traffic operation statement.
 type TrafficStmt struct {
 	stmtNode
@@ -816,6 +888,7 @@ func (n *BeginStmt) Accept(v Visitor) (Node, bool) {
 // See http://dev.mysql.com/doc/refman/5.7/en/binlog.html
 type BinlogStmt struct {
 	stmtNode
+	// Str is the base64 payload, it's restored byte for byte.
 	Str string
 }
 
@@ -826,6 +899,12 @@ func (n *BinlogStmt) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// SecureText implements SensitiveStatement interface.
+// The payload holds row data, it's replaced by its length.
+func (n *BinlogStmt) SecureText() string {
+	return fmt.Sprintf("BINLOG '<%d bytes>'", len(n.Str))
+}
+
 // Accept implements Node Accept interface.
 func (n *BinlogStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
@@ -836,6 +915,215 @@ func (n *BinlogStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ChecksumTableOption is the option of CHECKSUM TABLE.
+type ChecksumTableOption int
+
+// ChecksumTableOption types.
+const (
+	ChecksumTableOptionNone ChecksumTableOption = iota
+	ChecksumTableOptionQuick
+	ChecksumTableOptionExtended
+)
+
+// ChecksumTableStmt is a statement to report the checksums of tables.
+// See https://dev.mysql.com/doc/refman/8.0/en/checksum-table.html
+type ChecksumTableStmt struct {
+	stmtNode
+
+	Tables []*TableName
+	Option ChecksumTableOption
+}
+
+// Restore implements Node interface.
+func (n *ChecksumTableStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CHECKSUM TABLE ")
+	for i, table := range n.Tables {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := table.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ChecksumTableStmt.Tables[%d]", i)
+		}
+	}
+	switch n.Option {
+	case ChecksumTableOptionQuick:
+		ctx.WriteKeyWord(" QUICK")
+	case ChecksumTableOptionExtended:
+		ctx.WriteKeyWord(" EXTENDED")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ChecksumTableStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ChecksumTableStmt)
+	for i, t := range n.Tables {
+		node, ok := t.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Tables[i] = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
+// TableIndexList is a table of CACHE INDEX or LOAD INDEX INTO CACHE, with the
+// partitions and the indexes the statement is limited to.
+type TableIndexList struct {
+	node
+
+	Table *TableName
+	// Partitions are the partitions of PARTITION (p0, ...). AllPartitions is
+	// set by PARTITION (ALL).
+	Partitions    []CIStr
+	AllPartitions bool
+	// Indexes are the indexes of INDEX (i1, ...), all the indexes are used when
+	// it's empty.
+	Indexes []CIStr
+	// IgnoreLeaves is set by IGNORE LEAVES, which only LOAD INDEX INTO CACHE has.
+	IgnoreLeaves bool
+}
+
+// Restore implements Node interface.
+func (n *TableIndexList) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore TableIndexList.Table")
+	}
+	writeNames := func(names []CIStr) {
+		ctx.WritePlain(" (")
+		for i, name := range names {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(name.O)
+		}
+		ctx.WritePlain(")")
+	}
+	if n.AllPartitions {
+		ctx.WriteKeyWord(" PARTITION ")
+		ctx.WritePlain("(")
+		ctx.WriteKeyWord("ALL")
+		ctx.WritePlain(")")
+	} else if len(n.Partitions) > 0 {
+		ctx.WriteKeyWord(" PARTITION")
+		writeNames(n.Partitions)
+	}
+	if len(n.Indexes) > 0 {
+		ctx.WriteKeyWord(" INDEX")
+		writeNames(n.Indexes)
+	}
+	if n.IgnoreLeaves {
+		ctx.WriteKeyWord(" IGNORE LEAVES")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *TableIndexList) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*TableIndexList)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
+func restoreTableIndexLists(ctx *format.RestoreCtx, tables []*TableIndexList) error {
+	for i, table := range tables {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := table.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore Tables[%d]", i)
+		}
+	}
+	return nil
+}
+
+func acceptTableIndexLists(v Visitor, tables []*TableIndexList) bool {
+	for i, table := range tables {
+		node, ok := table.Accept(v)
+		if !ok {
+			return false
+		}
+		tables[i] = node.(*TableIndexList)
+	}
+	return true
+}
+
+// CacheIndexStmt is a statement to assign the indexes of tables to a key cache.
+// See https://dev.mysql.com/doc/refman/8.0/en/cache-index.html
+type CacheIndexStmt struct {
+	stmtNode
+
+	Tables       []*TableIndexList
+	KeyCacheName CIStr
+}
+
+// Restore implements Node interface.
+func (n *CacheIndexStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CACHE INDEX ")
+	if err := restoreTableIndexLists(ctx, n.Tables); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CacheIndexStmt")
+	}
+	ctx.WriteKeyWord(" IN ")
+	ctx.WriteName(n.KeyCacheName.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CacheIndexStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CacheIndexStmt)
+	if !acceptTableIndexLists(v, n.Tables) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// LoadIndexIntoCacheStmt is a statement to preload the indexes of tables into
+// their key cache.
+// See https://dev.mysql.com/doc/refman/8.0/en/load-index.html
+type LoadIndexIntoCacheStmt struct {
+	stmtNode
+
+	Tables []*TableIndexList
+}
+
+// Restore implements Node interface.
+func (n *LoadIndexIntoCacheStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("LOAD INDEX INTO CACHE ")
+	if err := restoreTableIndexLists(ctx, n.Tables); err != nil {
+		return errors.Annotate(err, "An error occurred while restore LoadIndexIntoCacheStmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *LoadIndexIntoCacheStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*LoadIndexIntoCacheStmt)
+	if !acceptTableIndexLists(v, n.Tables) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
 // CompletionType defines completion_type used in COMMIT and ROLLBACK statements
 type CompletionType int8
 
@@ -892,14 +1180,20 @@ type RollbackStmt struct {
 	CompletionType CompletionType
 	// SavepointName is the savepoint name.
 	SavepointName string
+	// SavepointKeyword is true if the optional SAVEPOINT keyword is written in
+	// `ROLLBACK TO SAVEPOINT sp`.
+	SavepointKeyword bool
 }
 
 // Restore implements Node interface.
 func (n *RollbackStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("ROLLBACK")
 	if n.SavepointName != "" {
-		ctx.WritePlain(" TO ")
-		ctx.WritePlain(n.SavepointName)
+		ctx.WriteKeyWord(" TO ")
+		if n.SavepointKeyword {
+			ctx.WriteKeyWord("SAVEPOINT ")
+		}
+		ctx.WriteName(n.SavepointName)
 	}
 	if err := n.CompletionType.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore RollbackStmt.CompletionType")
@@ -959,6 +1253,15 @@ type VariableAssignment struct {
 	Value    ExprNode
 	IsGlobal bool
 	IsSystem bool
+	// IsPersist and IsPersistOnly are set for the PERSIST and PERSIST_ONLY scopes,
+	// which persist the assignment to mysqld-auto.cnf. IsGlobal is set with them,
+	// PERSIST_ONLY only doesn't change the global value at runtime.
+	IsPersist     bool
+	IsPersistOnly bool
+	// IsScopeKeyword is true when a PERSIST or PERSIST_ONLY scope is written as a
+	// keyword, e.g. SET PERSIST a = 1, rather than @@PERSIST.a = 1, so that
+	// Restore writes the same spelling.
+	IsScopeKeyword bool
 
 	// ExtendValue is a way to store extended info.
 	// VariableAssignment should be able to store information for SetCharset/SetPWD Stmt.
@@ -970,13 +1273,23 @@ type VariableAssignment struct {
 // Restore implements Node interface.
 func (n *VariableAssignment) Restore(ctx *format.RestoreCtx) error {
 	if n.IsSystem {
-		ctx.WritePlain("@@")
-		if n.IsGlobal {
-			ctx.WriteKeyWord("GLOBAL")
+		scope := "SESSION"
+		switch {
+		case n.IsPersistOnly:
+			scope = "PERSIST_ONLY"
+		case n.IsPersist:
+			scope = "PERSIST"
+		case n.IsGlobal:
+			scope = "GLOBAL"
+		}
+		if n.IsScopeKeyword && (n.IsPersist || n.IsPersistOnly) {
+			ctx.WriteKeyWord(scope)
+			ctx.WritePlain(" ")
 		} else {
-			ctx.WriteKeyWord("SESSION")
+			ctx.WritePlain("@@")
+			ctx.WriteKeyWord(scope)
+			ctx.WritePlain(".")
 		}
-		ctx.WritePlain(".")
 	} else if n.Name != SetNames && n.Name != SetCharset {
 		ctx.WriteKeyWord("@")
 	}
@@ -1199,7 +1512,7 @@ type SavepointStmt struct {
 // Restore implements Node interface.
 func (n *SavepointStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("SAVEPOINT ")
-	ctx.WritePlain(n.Name)
+	ctx.WriteName(n.Name)
 	return nil
 }
 
@@ -1220,7 +1533,7 @@ type ReleaseSavepointStmt struct {
 // Restore implements Node interface.
 func (n *ReleaseSavepointStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("RELEASE SAVEPOINT ")
-	ctx.WritePlain(n.Name)
+	ctx.WriteName(n.Name)
 	return nil
 }
 
@@ -1877,6 +2190,37 @@ type AlterUserStmt struct {
 	PasswordOrLockOptions    []*PasswordOrLockOption
 	CommentOrAttributeOption *CommentOrAttributeOption
 	ResourceGroupNameOption  *ResourceGroupNameOption
+	// DefaultRole is set by ALTER USER user DEFAULT ROLE, whose user is Specs[0].
+	DefaultRole *DefaultRoleOption
+}
+
+// DefaultRoleOption is the DEFAULT ROLE clause of ALTER USER.
+// See https://dev.mysql.com/doc/refman/8.0/en/alter-user.html
+type DefaultRoleOption struct {
+	// SetRoleOpt is SetRoleNone, SetRoleAll or SetRoleRegular.
+	SetRoleOpt SetRoleStmtType
+	RoleList   []*auth.RoleIdentity
+}
+
+// Restore implements Node interface.
+func (n *DefaultRoleOption) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DEFAULT ROLE")
+	switch n.SetRoleOpt {
+	case SetRoleNone:
+		ctx.WriteKeyWord(" NONE")
+	case SetRoleAll:
+		ctx.WriteKeyWord(" ALL")
+	}
+	for i, role := range n.RoleList {
+		if i != 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WritePlain(" ")
+		if err := role.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore DefaultRoleOption.RoleList[%d]", i)
+		}
+	}
+	return nil
 }
 
 // Restore implements Node interface.
@@ -1944,6 +2288,13 @@ func (n *AlterUserStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
+	if n.DefaultRole != nil {
+		ctx.WritePlain(" ")
+		if err := n.DefaultRole.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterUserStmt.DefaultRole")
+		}
+	}
+
 	return nil
 }
 
@@ -1968,20 +2319,55 @@ func (n *AlterUserStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// AlterInstanceAction is the action of an ALTER INSTANCE statement.
+type AlterInstanceAction int
+
+// AlterInstanceStmt actions.
+const (
+	AlterInstanceReloadTLS AlterInstanceAction = iota + 1
+	AlterInstanceRotateInnodbMasterKey
+	AlterInstanceEnableInnodbRedoLog
+	AlterInstanceDisableInnodbRedoLog
+)
+
+// String implements fmt.Stringer interface.
+func (a AlterInstanceAction) String() string {
+	switch a {
+	case AlterInstanceReloadTLS:
+		return "RELOAD TLS"
+	case AlterInstanceRotateInnodbMasterKey:
+		return "ROTATE INNODB MASTER KEY"
+	case AlterInstanceEnableInnodbRedoLog:
+		return "ENABLE INNODB REDO_LOG"
+	case AlterInstanceDisableInnodbRedoLog:
+		return "DISABLE INNODB REDO_LOG"
+	}
+	return ""
+}
+
 // AlterInstanceStmt modifies instance.
 // See https://dev.mysql.com/doc/refman/8.0/en/alter-instance.html
 type AlterInstanceStmt struct {
 	stmtNode
 
-	ReloadTLS         bool
+	Action AlterInstanceAction
+	// ReloadTLS is true if Action is AlterInstanceReloadTLS, it's kept for the
+	// callers which don't check Action.
+	ReloadTLS bool
+	// NoRollbackOnError is only valid with AlterInstanceReloadTLS.
 	NoRollbackOnError bool
 }
 
 // Restore implements Node interface.
 func (n *AlterInstanceStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("ALTER INSTANCE")
-	if n.ReloadTLS {
-		ctx.WriteKeyWord(" RELOAD TLS")
+	action := n.Action
+	if action == 0 && n.ReloadTLS {
+		action = AlterInstanceReloadTLS
+	}
+	if action != 0 {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(action.String())
 	}
 	if n.NoRollbackOnError {
 		ctx.WriteKeyWord(" NO ROLLBACK ON ERROR")
@@ -2941,6 +3327,8 @@ type PrivElem struct {
 
 	Priv mysql.PrivilegeType
 	Cols []*ColumnName
+	// Name is the name of a dynamic privilege, e.g. BACKUP_ADMIN, or of an extended
+	// privilege, e.g. LOAD FROM S3, as written. It's only set for mysql.ExtendedPriv.
 	Name string
 }
 
@@ -2949,7 +3337,8 @@ func (n *PrivElem) Restore(ctx *format.RestoreCtx) error {
 	if n.Priv == mysql.AllPriv {
 		ctx.WriteKeyWord("ALL")
 	} else if n.Priv == mysql.ExtendedPriv {
-		ctx.WriteKeyWord(n.Name)
+		// The dynamic privileges are case-insensitive names, keep them as written.
+		ctx.WritePlain(n.Name)
 	} else {
 		str, ok := mysql.Priv2Str[n.Priv]
 		if !ok {
@@ -3063,6 +3452,23 @@ func (n *GrantLevel) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// acceptObject visits the object of a table level grant, e.g. the procedure
+// p of GRANT EXECUTE ON PROCEDURE db.p, as a TableName, and sets the names of
+// n to the ones of the TableName returned by the visitor.
+func (n *GrantLevel) acceptObject(v Visitor) bool {
+	if n == nil || n.Level != GrantLevelTable {
+		return true
+	}
+	object := &TableName{Schema: NewCIStr(n.DBName), Name: NewCIStr(n.TableName)}
+	node, ok := object.Accept(v)
+	if !ok {
+		return false
+	}
+	object = node.(*TableName)
+	n.DBName, n.TableName = object.Schema.O, object.Name.O
+	return true
+}
+
 // RevokeStmt is the struct for REVOKE statement.
 type RevokeStmt struct {
 	stmtNode
@@ -3120,6 +3526,9 @@ func (n *RevokeStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Privs[i] = node.(*PrivElem)
 	}
+	if !n.Level.acceptObject(v) {
+		return n, false
+	}
 	return v.Leave(n)
 }
 
@@ -3252,6 +3661,9 @@ func (n *GrantStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Privs[i] = node.(*PrivElem)
 	}
+	if !n.Level.acceptObject(v) {
+		return n, false
+	}
 	return v.Leave(n)
 }
 
@@ -3393,6 +3805,67 @@ func (n *RestartStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ResetPersistStmt is a statement to remove the persisted system variables from
+// mysqld-auto.cnf, all of them if Name is empty.
+// See https://dev.mysql.com/doc/refman/8.0/en/reset-persist.html
+type ResetPersistStmt struct {
+	stmtNode
+
+	IfExists bool
+	Name     string
+}
+
+// Restore implements Node interface.
+func (n *ResetPersistStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RESET PERSIST")
+	if n.IfExists {
+		ctx.WriteKeyWord(" IF EXISTS")
+	}
+	if n.Name != "" {
+		ctx.WritePlain(" ")
+		ctx.WriteName(n.Name)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ResetPersistStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ResetPersistStmt)
+	return v.Leave(n)
+}
+
+// GenericStmt is a statement the grammar doesn't know, kept as text. It can be
+// returned by the handlers registered with Parser.RegisterStatementHandler.
+type GenericStmt struct {
+	stmtNode
+
+	// Keyword is the first keyword of the statement, e.g. ROUTE.
+	Keyword string
+	// SQL is the statement from its first keyword to its last token, without
+	// its ';'.
+	SQL string
+}
+
+// Restore implements Node interface.
+func (n *GenericStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WritePlain(n.SQL)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *GenericStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*GenericStmt)
+	return v.Leave(n)
+}
+
 // HelpStmt is a statement for server side help
 // See https://dev.mysql.com/doc/refman/8.0/en/help.html
 type HelpStmt struct {
@@ -3937,7 +4410,7 @@ type TableOptimizerHint struct {
 	// Statement Execution Time Optimizer Hints
 	// See https://dev.mysql.com/doc/refman/5.7/en/optimizer-hints.html#optimizer-hints-execution-time
 	// - MAX_EXECUTION_TIME  => uint64
-	// - MEMORY_QUOTA        => int64
+	// - MEMORY_QUOTA        => int64, in bytes
 	// - QUERY_TYPE          => CIStr
 	//
 	// Time Range is used to hint the time range of inspection tables
@@ -3947,6 +4420,11 @@ type TableOptimizerHint struct {
 	// - USE_TOJA            => bool
 	// - NTH_PLAN            => int64
 	HintData any
+	// HintDataUnit is the unit of HintData as written, in bytes, for the hints
+	// which have one, so that Restore writes the hint as written:
+	// - MEMORY_QUOTA        => HintMemoryQuotaMB, HintMemoryQuotaGB, or 1 for a
+	//                          quota of bytes without unit. 0 is MB.
+	HintDataUnit uint64
 	// QBName is the default effective query block of this hint.
 	QBName  CIStr
 	Tables  []HintTable
@@ -3959,10 +4437,76 @@ type HintTimeRange struct {
 	To   string
 }
 
-// HintSetVar is the payload of `SET_VAR` hint
+// Units of the `MEMORY_QUOTA` hint.
+const (
+	HintMemoryQuotaMB uint64 = 1024 * 1024
+	HintMemoryQuotaGB uint64 = 1024 * 1024 * 1024
+)
+
+// HintSetVar is the payload of `SET_VAR` hint. It keeps the value as written,
+// use Bytes for the value of a size in bytes.
 type HintSetVar struct {
 	VarName string
-	Value   string
+	// Value is the value, unquoted if it's a string, e.g. 16M, ON, 1.5 or
+	// STRICT_ALL_TABLES for 'STRICT_ALL_TABLES'.
+	Value string
+	// Text is the value as written in the hint, e.g. 'it''s' for the Value it's.
+	Text string
+	// Kind is the kind of the value.
+	Kind HintSetVarKind
+}
+
+// HintSetVarKind is the kind of the value of a `SET_VAR` hint.
+type HintSetVarKind int
+
+// Kinds of the value of a `SET_VAR` hint.
+const (
+	// HintSetVarString is a quoted string.
+	HintSetVarString HintSetVarKind = iota
+	// HintSetVarKeyword is a bare word, e.g. ON.
+	HintSetVarKeyword
+	// HintSetVarInt is an integer.
+	HintSetVarInt
+	// HintSetVarDecimal is a decimal number, e.g. 1.5.
+	HintSetVarDecimal
+	// HintSetVarSize is an integer with a unit suffix, K, M, G, T, P or E, e.g. 16M.
+	HintSetVarSize
+)
+
+// hintSizeUnits are the unit suffixes of the sizes, each 1024 times the last.
+const hintSizeUnits = "KMGTPE"
+
+// Bytes returns the value of a size in bytes, e.g. 16777216 for 16M, and false
+// if the value isn't a size.
+func (h HintSetVar) Bytes() (uint64, bool) {
+	if h.Kind != HintSetVarSize || len(h.Value) < 2 {
+		return 0, false
+	}
+	unit := strings.IndexByte(hintSizeUnits, h.Value[len(h.Value)-1]&^0x20)
+	if unit < 0 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(h.Value[:len(h.Value)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	shift := 10 * uint(unit+1)
+	if n > math.MaxUint64>>shift {
+		return 0, false
+	}
+	return n << shift, true
+}
+
+// Restore writes the value as written, a string quoted, e.g. `16M` or `'a'`.
+func (h HintSetVar) Restore(ctx *format.RestoreCtx) {
+	switch h.Kind {
+	case HintSetVarKeyword:
+		ctx.WriteKeyWord(h.Value)
+	case HintSetVarInt, HintSetVarDecimal, HintSetVarSize:
+		ctx.WritePlain(h.Value)
+	default:
+		ctx.WriteString(h.Value)
+	}
 }
 
 // HintTable is table in the hint. It may have query block info.
@@ -4066,7 +4610,15 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	case "query_type":
 		ctx.WriteKeyWord(n.HintData.(CIStr).String())
 	case "memory_quota":
-		ctx.WritePlainf("%d MB", n.HintData.(int64)/1024/1024)
+		quota := n.HintData.(int64)
+		switch n.HintDataUnit {
+		case 1:
+			ctx.WritePlainf("%d", quota)
+		case HintMemoryQuotaGB:
+			ctx.WritePlainf("%d GB", quota/int64(HintMemoryQuotaGB))
+		default:
+			ctx.WritePlainf("%d MB", quota/int64(HintMemoryQuotaMB))
+		}
 	case "read_from_storage":
 		ctx.WriteKeyWord(n.HintData.(CIStr).String())
 		for i, table := range n.Tables {
@@ -4089,7 +4641,7 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		hintData := n.HintData.(HintSetVar)
 		ctx.WritePlain(hintData.VarName)
 		ctx.WritePlain(" = ")
-		ctx.WriteString(hintData.Value)
+		hintData.Restore(ctx)
 	}
 	ctx.WritePlain(")")
 	return nil
@@ -4115,13 +4667,16 @@ type BinaryLiteral interface {
 	ToString() string
 }
 
-// NewDecimal creates a types.Decimal value, it's provided by parser driver.
+// NewDecimal creates a types.Decimal value, it's provided by parser driver,
+// see RegisterDriver.
 var NewDecimal func(string) (any, error)
 
-// NewHexLiteral creates a types.HexLiteral value, it's provided by parser driver.
+// NewHexLiteral creates a types.HexLiteral value, it's provided by parser driver,
+// see RegisterDriver.
 var NewHexLiteral func(string) (any, error)
 
-// NewBitLiteral creates a types.BitLiteral value, it's provided by parser driver.
+// NewBitLiteral creates a types.BitLiteral value, it's provided by parser driver,
+// see RegisterDriver.
 var NewBitLiteral func(string) (any, error)
 
 // SetResourceGroupStmt is a statement to set the resource group name for current session.
@@ -15,10 +15,12 @@ package ast_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/abbychau/mysql-parser"
 	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/mysql"
 	"github.com/stretchr/testify/require"
 )
@@ -48,6 +50,9 @@ func TestMiscVisitorCover(t *testing.T) {
 		&ast.AlterUserStmt{},
 		&ast.BeginStmt{},
 		&ast.BinlogStmt{},
+		&ast.CacheIndexStmt{Tables: []*ast.TableIndexList{{Table: &ast.TableName{}}}},
+		&ast.ChecksumTableStmt{Tables: []*ast.TableName{{}}},
+		&ast.LoadIndexIntoCacheStmt{Tables: []*ast.TableIndexList{{Table: &ast.TableName{}}}},
 		&ast.CommitStmt{},
 		&ast.CompactTableStmt{Table: &ast.TableName{}},
 		&ast.CreateUserStmt{},
@@ -80,6 +85,7 @@ func TestMiscVisitorCover(t *testing.T) {
 			},
 		},
 		&ast.ShutdownStmt{},
+		&ast.ResetPersistStmt{},
 	}
 
 	for _, v := range stmts {
@@ -139,6 +145,7 @@ func TestSensitiveStatement(t *testing.T) {
 		&ast.CreateUserStmt{},
 		&ast.AlterUserStmt{},
 		&ast.GrantStmt{},
+		&ast.BinlogStmt{},
 	}
 	for i, stmt := range positive {
 		_, ok := stmt.(ast.SensitiveStmtNode)
@@ -164,6 +171,34 @@ func TestSensitiveStatement(t *testing.T) {
 	}
 }
 
+func TestTransactionControlStmt(t *testing.T) {
+	p := parser.New()
+	for _, c := range []struct {
+		sql   string
+		label string
+		ok    bool
+	}{
+		{"begin", "Begin", true},
+		{"commit", "Commit", true},
+		{"rollback", "Rollback", true},
+		{"rollback to savepoint sp", "Rollback", true},
+		{"savepoint sp", "Savepoint", true},
+		{"release savepoint sp", "ReleaseSavepoint", true},
+		{"select 1", "Select", false},
+		{"set autocommit = 1", "Set", false},
+	} {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.label, ast.GetStmtLabel(stmt), c.sql)
+		require.Equal(t, c.ok, ast.IsTransactionControlStmt(stmt), c.sql)
+	}
+
+	stmt, err := p.ParseOneStmt("rollback to `my sp`", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "my sp", stmt.(*ast.RollbackStmt).SavepointName)
+	require.False(t, stmt.(*ast.RollbackStmt).SavepointKeyword)
+}
+
 func TestTableOptimizerHintRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"USE_INDEX(t1 c1)", "USE_INDEX(`t1` `c1`)"},
@@ -238,8 +273,11 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"QUERY_TYPE(@sel1 OLTP)", "QUERY_TYPE(@`sel1` OLTP)"},
 		{"NTH_PLAN(10)", "NTH_PLAN(10)"},
 		{"NTH_PLAN(@sel1 30)", "NTH_PLAN(@`sel1` 30)"},
-		{"MEMORY_QUOTA(1 GB)", "MEMORY_QUOTA(1024 MB)"},
-		{"MEMORY_QUOTA(@sel1 1 GB)", "MEMORY_QUOTA(@`sel1` 1024 MB)"},
+		{"MEMORY_QUOTA(1 GB)", "MEMORY_QUOTA(1 GB)"},
+		{"MEMORY_QUOTA(@sel1 1 GB)", "MEMORY_QUOTA(@`sel1` 1 GB)"},
+		{"MEMORY_QUOTA(1024 MB)", "MEMORY_QUOTA(1024 MB)"},
+		{"MEMORY_QUOTA(8 mb)", "MEMORY_QUOTA(8 MB)"},
+		{"MEMORY_QUOTA(1048576)", "MEMORY_QUOTA(1048576)"},
 		{"HASH_AGG()", "HASH_AGG()"},
 		{"HASH_AGG(@sel1)", "HASH_AGG(@`sel1`)"},
 		{"STREAM_AGG()", "STREAM_AGG()"},
@@ -315,6 +353,86 @@ func TestCompactTableStmtRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
 }
 
+func TestUtilityStmtRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{"checksum table t1", "CHECKSUM TABLE `t1`"},
+		{"checksum table t1, db.t2 quick", "CHECKSUM TABLE `t1`, `db`.`t2` QUICK"},
+		{"CHECKSUM TABLE t1 EXTENDED", "CHECKSUM TABLE `t1` EXTENDED"},
+		{"cache index t1 in hot_cache", "CACHE INDEX `t1` IN `hot_cache`"},
+		{"cache index t1 index (i1), t2 key (i2, primary) in kc", "CACHE INDEX `t1` INDEX (`i1`), `t2` INDEX (`i2`, `primary`) IN `kc`"},
+		{"cache index t1 partition (p0, p1) in kc", "CACHE INDEX `t1` PARTITION (`p0`, `p1`) IN `kc`"},
+		{"cache index t1 partition (all) index (i1) in kc", "CACHE INDEX `t1` PARTITION (ALL) INDEX (`i1`) IN `kc`"},
+		{"load index into cache t1", "LOAD INDEX INTO CACHE `t1`"},
+		{"load index into cache t1 partition (p0) ignore leaves", "LOAD INDEX INTO CACHE `t1` PARTITION (`p0`) IGNORE LEAVES"},
+		{"load index into cache t1 index (i1) ignore leaves, t2 key (i2)", "LOAD INDEX INTO CACHE `t1` INDEX (`i1`) IGNORE LEAVES, `t2` INDEX (`i2`)"},
+	}
+	extractNodeFunc := func(node ast.Node) ast.Node {
+		return node
+	}
+	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("cache index t1 partition (p0) index (i1, i2), t2 partition (all) in kc", "", "")
+	require.NoError(t, err)
+	cache := stmt.(*ast.CacheIndexStmt)
+	require.Equal(t, "CacheIndex", ast.GetStmtLabel(cache))
+	require.Equal(t, "kc", cache.KeyCacheName.O)
+	require.Len(t, cache.Tables, 2)
+	require.Equal(t, "t1", cache.Tables[0].Table.Name.O)
+	require.Equal(t, []ast.CIStr{ast.NewCIStr("p0")}, cache.Tables[0].Partitions)
+	require.Equal(t, []ast.CIStr{ast.NewCIStr("i1"), ast.NewCIStr("i2")}, cache.Tables[0].Indexes)
+	require.True(t, cache.Tables[1].AllPartitions)
+	require.Empty(t, cache.Tables[1].Indexes)
+
+	stmt, err = p.ParseOneStmt("load index into cache t1 ignore leaves, t2", "", "")
+	require.NoError(t, err)
+	load := stmt.(*ast.LoadIndexIntoCacheStmt)
+	require.Equal(t, "LoadIndexIntoCache", ast.GetStmtLabel(load))
+	require.True(t, load.Tables[0].IgnoreLeaves)
+	require.False(t, load.Tables[1].IgnoreLeaves)
+
+	stmt, err = p.ParseOneStmt("checksum table t1, t2 extended", "", "")
+	require.NoError(t, err)
+	checksum := stmt.(*ast.ChecksumTableStmt)
+	require.Equal(t, "ChecksumTable", ast.GetStmtLabel(checksum))
+	require.Len(t, checksum.Tables, 2)
+	require.Equal(t, ast.ChecksumTableOptionExtended, checksum.Option)
+
+	// IGNORE LEAVES is only for LOAD INDEX INTO CACHE.
+	_, err = p.ParseOneStmt("cache index t1 ignore leaves in kc", "", "")
+	require.Error(t, err)
+	// LEAVES isn't reserved.
+	_, err = p.ParseOneStmt("select leaves from t", "", "")
+	require.NoError(t, err)
+}
+
+func TestBinlogStmt(t *testing.T) {
+	// A multi-kilobyte payload, with the line breaks of mysqlbinlog.
+	var sb strings.Builder
+	for i := 0; sb.Len() < 8192; i++ {
+		fmt.Fprintf(&sb, "AAAAB3NzaC1yc2EAAAADAQABAAABAQC%05d+/abcdefghijklmnopqrstuvwxyz0123456789==\n", i)
+	}
+	payload := sb.String()
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("BINLOG '"+payload+"'", "", "")
+	require.NoError(t, err)
+	binlog := stmt.(*ast.BinlogStmt)
+	require.Equal(t, payload, binlog.Str)
+	require.Equal(t, "Binlog", ast.GetStmtLabel(binlog))
+
+	sb.Reset()
+	require.NoError(t, binlog.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "BINLOG '"+payload+"'", sb.String())
+	again, err := p.ParseOneStmt(sb.String(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, payload, again.(*ast.BinlogStmt).Str)
+
+	secured := binlog.SecureText()
+	require.Equal(t, fmt.Sprintf("BINLOG '<%d bytes>'", len(payload)), secured)
+	require.NotContains(t, secured, "AAAAB3")
+}
+
 func TestPlanReplayerStmtRestore(t *testing.T) {
 	testCases := []NodeRestoreTestCase{
 		{"plan replayer dump with stats as of timestamp '2023-06-28 12:34:00' explain select * from t where a > 10",
@@ -416,3 +534,126 @@ func TestRedactTrafficStmt(t *testing.T) {
 		require.Equal(t, tc.secured, n.SecureText(), tc.input)
 	}
 }
+
+func TestGrantSecureText(t *testing.T) {
+	testCases := []struct {
+		input   string
+		secured string
+	}{
+		{
+			input:   "GRANT SELECT (col1, col2), INSERT (col1) ON db.t TO u",
+			secured: "GRANT SELECT (col1, col2), INSERT (col1) ON db.t TO u",
+		},
+		{
+			input:   "GRANT backup_admin, ROLE_ADMIN ON *.* TO u WITH GRANT OPTION",
+			secured: "GRANT backup_admin, ROLE_ADMIN ON *.* TO u WITH GRANT OPTION",
+		},
+		{
+			input:   "GRANT SELECT (c), backup_admin ON db.t TO u IDENTIFIED BY 'secret' WITH GRANT OPTION",
+			secured: "GRANT SELECT (c), backup_admin ON db.t TO u ",
+		},
+		{
+			input:   "GRANT ALL PRIVILEGES ON *.* TO u IDENTIFIED WITH 'mysql_native_password' AS 'secret'",
+			secured: "GRANT ALL PRIVILEGES ON *.* TO u ",
+		},
+		{
+			input:   "GRANT EXECUTE, ALTER ROUTINE ON FUNCTION f TO u",
+			secured: "GRANT EXECUTE, ALTER ROUTINE ON FUNCTION f TO u",
+		},
+	}
+
+	p := parser.New()
+	for _, tc := range testCases {
+		node, err := p.ParseOneStmt(tc.input, "", "")
+		require.NoError(t, err, tc.input)
+		n, ok := node.(ast.SensitiveStmtNode)
+		require.True(t, ok, tc.input)
+		require.Equal(t, tc.secured, n.SecureText(), tc.input)
+	}
+
+	// GRANT PROXY carries no credential.
+	node, err := p.ParseOneStmt("GRANT PROXY ON 'a'@'%' TO 'b'@'%' WITH GRANT OPTION", "", "")
+	require.NoError(t, err)
+	require.IsType(t, &ast.GrantProxyStmt{}, node)
+}
+
+// grantObjectVisitor collects the table names and renames the table t.
+type grantObjectVisitor struct {
+	names []string
+}
+
+func (v *grantObjectVisitor) Enter(in ast.Node) (ast.Node, bool) {
+	if tn, ok := in.(*ast.TableName); ok {
+		v.names = append(v.names, tn.Schema.O+"."+tn.Name.O)
+		if tn.Name.L == "t" {
+			return &ast.TableName{Schema: tn.Schema, Name: ast.NewCIStr("t2")}, true
+		}
+	}
+	return in, false
+}
+
+func (v *grantObjectVisitor) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func TestGrantObjectVisitor(t *testing.T) {
+	testCases := []struct {
+		input    string
+		names    []string
+		restored string
+	}{
+		{"GRANT EXECUTE ON PROCEDURE db.p TO 'u'@'%'", []string{"db.p"}, "GRANT EXECUTE ON PROCEDURE `db`.`p` TO `u`@`%`"},
+		{"GRANT EXECUTE, ALTER ROUTINE ON FUNCTION f TO u", []string{".f"}, "GRANT EXECUTE, ALTER ROUTINE ON FUNCTION `f` TO `u`@`%`"},
+		{"REVOKE SELECT ON TABLE db.t FROM u", []string{"db.t"}, "REVOKE SELECT ON TABLE `db`.`t2` FROM `u`@`%`"},
+		{"GRANT SELECT ON db.* TO u", nil, "GRANT SELECT ON `db`.* TO `u`@`%`"},
+		{"REVOKE ALL ON *.* FROM u", nil, "REVOKE ALL ON *.* FROM `u`@`%`"},
+	}
+
+	p := parser.New()
+	for _, tc := range testCases {
+		node, err := p.ParseOneStmt(tc.input, "", "")
+		require.NoError(t, err, tc.input)
+		v := &grantObjectVisitor{}
+		node.Accept(v)
+		require.Equal(t, tc.names, v.names, tc.input)
+		var sb strings.Builder
+		require.NoError(t, node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), tc.input)
+		require.Equal(t, tc.restored, sb.String(), tc.input)
+	}
+}
+
+func TestAlterUserDefaultRoleAndInstanceSecureText(t *testing.T) {
+	p := parser.New()
+	for _, c := range []struct {
+		input   string
+		secured string
+	}{
+		{"ALTER USER joe DEFAULT ROLE ALL", "alter user joe@%"},
+		{"ALTER USER 'joe'@'localhost' DEFAULT ROLE NONE", "alter user joe@localhost"},
+		{"ALTER USER joe DEFAULT ROLE r1, r2", "alter user joe@%"},
+		{"ALTER USER joe IDENTIFIED BY 'secret'", "alter user {joe@% password = ***}"},
+	} {
+		node, err := p.ParseOneStmt(c.input, "", "")
+		require.NoError(t, err, c.input)
+		n, ok := node.(ast.SensitiveStmtNode)
+		require.True(t, ok, c.input)
+		require.Equal(t, c.secured, n.SecureText(), c.input)
+		require.Equal(t, "AlterUser", ast.GetStmtLabel(node), c.input)
+	}
+
+	// ALTER INSTANCE carries no credential, so it isn't a SensitiveStmtNode.
+	for _, action := range []ast.AlterInstanceAction{
+		ast.AlterInstanceReloadTLS,
+		ast.AlterInstanceRotateInnodbMasterKey,
+		ast.AlterInstanceEnableInnodbRedoLog,
+		ast.AlterInstanceDisableInnodbRedoLog,
+	} {
+		node, err := p.ParseOneStmt("ALTER INSTANCE "+action.String(), "", "")
+		require.NoError(t, err, action.String())
+		require.Equal(t, action, node.(*ast.AlterInstanceStmt).Action)
+		require.Equal(t, action == ast.AlterInstanceReloadTLS, node.(*ast.AlterInstanceStmt).ReloadTLS)
+		_, ok := node.(ast.SensitiveStmtNode)
+		require.False(t, ok, action.String())
+		require.Equal(t, "AlterInstance", ast.GetStmtLabel(node))
+	}
+}
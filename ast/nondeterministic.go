@@ -0,0 +1,118 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// NonDeterministicFunc describes a function whose result isn't determined by
+// its arguments, so that a statement calling it can't be cached.
+type NonDeterministicFunc struct {
+	// Note tells why the result of the function varies.
+	Note string
+	// WithoutArgsOnly is true if the function is only non-deterministic when
+	// it's called without argument, e.g. UNIX_TIMESTAMP().
+	WithoutArgsOnly bool
+}
+
+// NonDeterministicFuncs are the non-deterministic functions, by lowercase name,
+// checked by ContainsNonDeterministicFunc. The user-defined functions can be
+// added to it, before it's used concurrently.
+var NonDeterministicFuncs = map[string]NonDeterministicFunc{
+	UUID:        {Note: "returns a new value on every call"},
+	UUIDShort:   {Note: "returns a new value on every call"},
+	Rand:        {Note: "returns a new value on every call"},
+	RandomBytes: {Note: "returns a new value on every call"},
+
+	// NOW and its synonyms return the time the statement started, which is
+	// constant within the statement, whereas SYSDATE returns the time it's
+	// evaluated, which varies within a statement and between a source and its
+	// replicas.
+	Now:              {Note: "returns the time the statement started"},
+	CurrentTimestamp: {Note: "returns the time the statement started"},
+	LocalTime:        {Note: "returns the time the statement started"},
+	LocalTimestamp:   {Note: "returns the time the statement started"},
+	Sysdate:          {Note: "returns the time it's evaluated, even within a statement"},
+	Curdate:          {Note: "returns the date the statement started"},
+	CurrentDate:      {Note: "returns the date the statement started"},
+	Curtime:          {Note: "returns the time the statement started"},
+	CurrentTime:      {Note: "returns the time the statement started"},
+	UTCDate:          {Note: "returns the date the statement started"},
+	UTCTime:          {Note: "returns the time the statement started"},
+	UTCTimestamp:     {Note: "returns the time the statement started"},
+	UnixTimestamp:    {Note: "returns the time the statement started", WithoutArgsOnly: true},
+	ConvertTz:        {Note: "depends on the time zone tables"},
+
+	ConnectionID: {Note: "depends on the session"},
+	LastInsertId: {Note: "depends on the previous statements of the session"},
+	FoundRows:    {Note: "depends on the previous statements of the session"},
+	RowCount:     {Note: "depends on the previous statements of the session"},
+	User:         {Note: "depends on the session"},
+	CurrentUser:  {Note: "depends on the session"},
+	SessionUser:  {Note: "depends on the session"},
+	SystemUser:   {Note: "depends on the session"},
+	Database:     {Note: "depends on the session"},
+	Schema:       {Note: "depends on the session"},
+
+	GetLock:         {Note: "depends on the locks held by the sessions"},
+	ReleaseLock:     {Note: "depends on the locks held by the sessions"},
+	ReleaseAllLocks: {Note: "depends on the locks held by the sessions"},
+	IsFreeLock:      {Note: "depends on the locks held by the sessions"},
+	IsUsedLock:      {Note: "depends on the locks held by the sessions"},
+
+	NextVal:  {Note: "advances a sequence"},
+	LastVal:  {Note: "depends on the previous statements of the session"},
+	SetVal:   {Note: "changes a sequence"},
+	Sleep:    {Note: "has a side effect"},
+	LoadFile: {Note: "depends on the file system"},
+}
+
+// ContainsNonDeterministicFunc tells if stmt calls a function of
+// NonDeterministicFuncs, and returns the lowercase names of the ones it calls,
+// in the order they're first found. The functions of the column options, e.g.
+// DEFAULT NOW(), aren't evaluated by stmt itself and are ignored.
+func ContainsNonDeterministicFunc(stmt StmtNode) (bool, []string) {
+	c := &nonDeterministicChecker{}
+	stmt.Accept(c)
+	return len(c.names) > 0, c.names
+}
+
+type nonDeterministicChecker struct {
+	names []string
+}
+
+// Enter implements Visitor interface.
+func (c *nonDeterministicChecker) Enter(in Node) (Node, bool) {
+	switch x := in.(type) {
+	case *ColumnOption:
+		return in, true
+	case *FuncCallExpr:
+		if x.Schema.L != "" {
+			break
+		}
+		fn, ok := NonDeterministicFuncs[x.FnName.L]
+		if !ok || (fn.WithoutArgsOnly && len(x.Args) > 0) {
+			break
+		}
+		for _, name := range c.names {
+			if name == x.FnName.L {
+				return in, false
+			}
+		}
+		c.names = append(c.names, x.FnName.L)
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*nonDeterministicChecker) Leave(in Node) (Node, bool) {
+	return in, true
+}
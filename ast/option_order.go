@@ -0,0 +1,261 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/abbychau/mysql-parser/format"
+)
+
+// By default Restore writes table options, column options and index options in the
+// order they were written in. With format.RestoreCanonicalOptionOrder they are written
+// in the order of the output of SHOW CREATE TABLE of MySQL, as listed below. The
+// options MySQL doesn't print follow in the listed order, and the options not listed
+// at all are written last in their original order.
+
+// tableOptionCanonicalOrder is the canonical order of table options.
+var tableOptionCanonicalOrder = []TableOptionType{
+	TableOptionEngine,
+	TableOptionSecondaryEngine,
+	TableOptionSecondaryEngineNull,
+	TableOptionAutoIncrement,
+	TableOptionAutoIdCache,
+	TableOptionAutoRandomBase,
+	TableOptionCharset,
+	TableOptionCollate,
+	TableOptionMinRows,
+	TableOptionMaxRows,
+	TableOptionAvgRowLength,
+	TableOptionPackKeys,
+	TableOptionStatsPersistent,
+	TableOptionStatsAutoRecalc,
+	TableOptionStatsSamplePages,
+	TableOptionCheckSum,
+	TableOptionTableCheckSum,
+	TableOptionDelayKeyWrite,
+	TableOptionRowFormat,
+	TableOptionKeyBlockSize,
+	TableOptionCompression,
+	TableOptionEncryption,
+	TableOptionComment,
+	TableOptionConnection,
+	TableOptionPassword,
+	TableOptionTablespace,
+	TableOptionStorageMedia,
+	TableOptionDataDirectory,
+	TableOptionIndexDirectory,
+	TableOptionInsertMethod,
+	TableOptionUnion,
+	TableOptionNodegroup,
+	TableOptionEngineAttribute,
+	TableOptionSecondaryEngineAttribute,
+	TableOptionShardRowID,
+	TableOptionPreSplitRegion,
+	TableOptionPlacementPolicy,
+	TableOptionStatsBuckets,
+	TableOptionStatsTopN,
+	TableOptionStatsColsChoice,
+	TableOptionStatsColList,
+	TableOptionStatsSampleRate,
+	TableOptionTTL,
+	TableOptionTTLEnable,
+	TableOptionTTLJobInterval,
+}
+
+// columnOptionCanonicalOrder is the canonical order of column options. The keys,
+// checks and references are printed as table elements by SHOW CREATE TABLE.
+var columnOptionCanonicalOrder = []ColumnOptionType{
+	ColumnOptionCollate,
+	ColumnOptionGenerated,
+	ColumnOptionNotNull,
+	ColumnOptionNull,
+	ColumnOptionSRID,
+	ColumnOptionDefaultValue,
+	ColumnOptionOnUpdate,
+	ColumnOptionAutoIncrement,
+	ColumnOptionComment,
+	ColumnOptionColumnFormat,
+	ColumnOptionStorage,
+	ColumnOptionSecondaryEngineAttribute,
+	ColumnOptionAutoRandom,
+	ColumnOptionPrimaryKey,
+	ColumnOptionUniqKey,
+	ColumnOptionFulltext,
+	ColumnOptionCheck,
+	ColumnOptionReference,
+}
+
+// indexOptionKind is an option of IndexOption. The kinds are declared in the order
+// IndexOption restores the options it doesn't know the written order of.
+type indexOptionKind int
+
+const (
+	indexOptionAddColumnarReplicaOnDemand indexOptionKind = iota
+	indexOptionPrimaryKeyTp
+	indexOptionKeyBlockSize
+	indexOptionTp
+	indexOptionParserName
+	indexOptionComment
+	indexOptionGlobal
+	indexOptionVisibility
+	indexOptionSplitOpt
+	indexOptionSecondaryEngineAttr
+	indexOptionKindCount
+)
+
+// indexOptionCanonicalOrder is the canonical order of index options.
+var indexOptionCanonicalOrder = []indexOptionKind{
+	indexOptionTp,
+	indexOptionKeyBlockSize,
+	indexOptionParserName,
+	indexOptionComment,
+	indexOptionVisibility,
+	indexOptionSecondaryEngineAttr,
+	indexOptionPrimaryKeyTp,
+	indexOptionGlobal,
+	indexOptionSplitOpt,
+	indexOptionAddColumnarReplicaOnDemand,
+}
+
+// sortByCanonicalOrder returns options stable sorted by the position of their key in
+// order. The keys missing in order go last.
+func sortByCanonicalOrder[T any, K comparable](options []T, order []K, key func(T) K) []T {
+	rank := func(opt T) int {
+		if i := slices.Index(order, key(opt)); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	sorted := slices.Clone(options)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return cmp.Compare(rank(a), rank(b))
+	})
+	return sorted
+}
+
+// restoredTableOptions returns the table options in the order to restore them.
+func restoredTableOptions(flags format.RestoreFlags, options []*TableOption) []*TableOption {
+	options = tableOptionsWithRestoreTTLFlag(flags, options)
+	if !flags.HasCanonicalOptionOrderFlag() {
+		return options
+	}
+	return sortByCanonicalOrder(options, tableOptionCanonicalOrder, func(opt *TableOption) TableOptionType {
+		return opt.Tp
+	})
+}
+
+// restoredColumnOptions returns the column options in the order to restore them.
+func restoredColumnOptions(flags format.RestoreFlags, options []*ColumnOption) []*ColumnOption {
+	if !flags.HasCanonicalOptionOrderFlag() {
+		return options
+	}
+	return sortByCanonicalOrder(options, columnOptionCanonicalOrder, func(opt *ColumnOption) ColumnOptionType {
+		return opt.Tp
+	})
+}
+
+// kinds returns the options set in n, in the order of indexOptionKind.
+func (n *IndexOption) kinds() []indexOptionKind {
+	set := [indexOptionKindCount]bool{
+		indexOptionAddColumnarReplicaOnDemand: n.AddColumnarReplicaOnDemand > 0,
+		indexOptionPrimaryKeyTp:               n.PrimaryKeyTp != PrimaryKeyTypeDefault,
+		indexOptionKeyBlockSize:               n.KeyBlockSize > 0,
+		indexOptionTp:                         n.Tp != IndexTypeInvalid,
+		indexOptionParserName:                 len(n.ParserName.O) > 0,
+		indexOptionComment:                    n.Comment != "",
+		indexOptionGlobal:                     n.Global,
+		indexOptionVisibility:                 n.Visibility != IndexVisibilityDefault,
+		indexOptionSplitOpt:                   n.SplitOpt != nil,
+		indexOptionSecondaryEngineAttr:        len(n.SecondaryEngineAttr) > 0,
+	}
+	kinds := make([]indexOptionKind, 0, indexOptionKindCount)
+	for kind, ok := range set {
+		if ok {
+			kinds = append(kinds, indexOptionKind(kind))
+		}
+	}
+	return kinds
+}
+
+// Merge sets the options set in other to n, a later option overrides an earlier one
+// of the same kind. n remembers the order the options were merged in, which is the
+// order Restore writes them in.
+func (n *IndexOption) Merge(other *IndexOption) {
+	merged := n.writtenKinds()
+	for _, kind := range other.kinds() {
+		switch kind {
+		case indexOptionAddColumnarReplicaOnDemand:
+			n.AddColumnarReplicaOnDemand = other.AddColumnarReplicaOnDemand
+		case indexOptionPrimaryKeyTp:
+			n.PrimaryKeyTp = other.PrimaryKeyTp
+		case indexOptionKeyBlockSize:
+			n.KeyBlockSize = other.KeyBlockSize
+		case indexOptionTp:
+			n.Tp = other.Tp
+		case indexOptionParserName:
+			n.ParserName = other.ParserName
+		case indexOptionComment:
+			n.Comment = other.Comment
+		case indexOptionGlobal:
+			n.Global = true
+		case indexOptionVisibility:
+			n.Visibility = other.Visibility
+		case indexOptionSplitOpt:
+			n.SplitOpt = other.SplitOpt
+		case indexOptionSecondaryEngineAttr:
+			n.SecondaryEngineAttr = other.SecondaryEngineAttr
+		}
+		merged = append(slices.DeleteFunc(merged, func(k indexOptionKind) bool { return k == kind }), kind)
+	}
+	// Only keep the part of the order after the leading options already in the order
+	// of indexOptionKind, so that parsing the restored text gives the same order.
+	i := 1
+	for i < len(merged) && merged[i] > merged[i-1] {
+		i++
+	}
+	n.order = nil
+	if i < len(merged) {
+		n.order = merged[i:]
+	}
+}
+
+// writtenKinds returns the options set in n in the order they were written in. The
+// options not in n.order go first, in the order of indexOptionKind.
+func (n *IndexOption) writtenKinds() []indexOptionKind {
+	kinds := n.kinds()
+	written := make([]indexOptionKind, 0, len(kinds))
+	for _, kind := range kinds {
+		if !slices.Contains(n.order, kind) {
+			written = append(written, kind)
+		}
+	}
+	for _, kind := range n.order {
+		if slices.Contains(kinds, kind) {
+			written = append(written, kind)
+		}
+	}
+	return written
+}
+
+// restoredKinds returns the options set in n in the order to restore them.
+func (n *IndexOption) restoredKinds(flags format.RestoreFlags) []indexOptionKind {
+	if flags.HasCanonicalOptionOrderFlag() {
+		return sortByCanonicalOrder(n.kinds(), indexOptionCanonicalOrder, func(k indexOptionKind) indexOptionKind {
+			return k
+		})
+	}
+	return n.writtenKinds()
+}
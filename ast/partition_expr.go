@@ -0,0 +1,140 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/opcode"
+)
+
+// partitionFuncs are the functions allowed in a partitioning expression, see
+// https://dev.mysql.com/doc/refman/8.0/en/partitioning-limitations-functions.html.
+var partitionFuncs = map[string]struct{}{
+	Abs:           {},
+	Ceiling:       {},
+	Ceil:          {},
+	DateDiff:      {},
+	Day:           {},
+	DayOfMonth:    {},
+	DayOfWeek:     {},
+	DayOfYear:     {},
+	Extract:       {},
+	Floor:         {},
+	Hour:          {},
+	MicroSecond:   {},
+	Minute:        {},
+	Mod:           {},
+	Month:         {},
+	Quarter:       {},
+	Second:        {},
+	TimeToSec:     {},
+	ToDays:        {},
+	ToSeconds:     {},
+	UnixTimestamp: {},
+	Weekday:       {},
+	Year:          {},
+	YearWeek:      {},
+}
+
+// partitionOps are the binary operators allowed in a partitioning expression.
+var partitionOps = map[opcode.Op]struct{}{
+	opcode.Plus:   {},
+	opcode.Minus:  {},
+	opcode.Mul:    {},
+	opcode.IntDiv: {},
+	opcode.Mod:    {},
+}
+
+// ValidatePartitionExpr checks that expr, the expression of a PARTITION BY
+// HASH, RANGE or LIST clause, uses only the columns, constants, functions and
+// operators MySQL allows in a partitioning expression. The returned error is
+// ErrPartitionFunctionIsNotAllowed naming the first construct which isn't
+// allowed, or ErrWrongExprInPartitionFunc if expr doesn't use any column.
+func ValidatePartitionExpr(expr ExprNode) error {
+	hasColumn := false
+	if err := validatePartitionExpr(expr, &hasColumn); err != nil {
+		return err
+	}
+	if !hasColumn {
+		return ErrWrongExprInPartitionFunc.GenWithStackByArgs()
+	}
+	return nil
+}
+
+func validatePartitionExpr(expr ExprNode, hasColumn *bool) error {
+	switch x := expr.(type) {
+	case *ColumnNameExpr:
+		*hasColumn = true
+		return nil
+	case ValueExpr:
+		return nil
+	case *ParenthesesExpr:
+		return validatePartitionExpr(x.Expr, hasColumn)
+	case *UnaryOperationExpr:
+		if x.Op != opcode.Minus && x.Op != opcode.Plus {
+			return partitionNotAllowed("operator " + restorePartitionOp(x.Op))
+		}
+		return validatePartitionExpr(x.V, hasColumn)
+	case *BinaryOperationExpr:
+		if _, ok := partitionOps[x.Op]; !ok {
+			return partitionNotAllowed("operator " + restorePartitionOp(x.Op))
+		}
+		if err := validatePartitionExpr(x.L, hasColumn); err != nil {
+			return err
+		}
+		return validatePartitionExpr(x.R, hasColumn)
+	case *FuncCallExpr:
+		if _, ok := partitionFuncs[x.FnName.L]; !ok || x.Schema.L != "" {
+			return partitionNotAllowed("function " + strings.ToUpper(x.FnName.O))
+		}
+		args := x.Args
+		if x.FnName.L == Extract && len(args) > 0 {
+			if unit, ok := args[0].(*TimeUnitExpr); ok {
+				if unit.Unit == TimeUnitWeek {
+					return partitionNotAllowed("function EXTRACT(WEEK FROM ...)")
+				}
+				args = args[1:]
+			}
+		}
+		for _, arg := range args {
+			if err := validatePartitionExpr(arg, hasColumn); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return partitionNotAllowed("expression " + restorePartitionExpr(expr))
+}
+
+func partitionNotAllowed(construct string) error {
+	return ErrPartitionFunctionIsNotAllowed.GenWithStack("This partition function is not allowed: %s", construct)
+}
+
+func restorePartitionOp(op opcode.Op) string {
+	var sb strings.Builder
+	if err := op.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return op.String()
+	}
+	return sb.String()
+}
+
+func restorePartitionExpr(expr ExprNode) string {
+	var sb strings.Builder
+	if err := expr.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return "of unknown kind"
+	}
+	return sb.String()
+}
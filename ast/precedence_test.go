@@ -0,0 +1,418 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	. "github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/opcode"
+	"github.com/stretchr/testify/require"
+)
+
+// precedenceOp is an operator of precedenceLevels. sql writes the operator
+// applied to its operands, and build makes its node. r is empty, or nil, for a
+// unary operator.
+type precedenceOp struct {
+	unary bool
+	sql   func(l, r string) string
+	build func(l, r ExprNode) ExprNode
+}
+
+func binaryPrecedenceOp(op opcode.Op, sym string) precedenceOp {
+	return precedenceOp{
+		sql:   func(l, r string) string { return l + " " + sym + " " + r },
+		build: func(l, r ExprNode) ExprNode { return &BinaryOperationExpr{Op: op, L: l, R: r} },
+	}
+}
+
+func prefixPrecedenceOp(op opcode.Op, sym string) precedenceOp {
+	return precedenceOp{
+		unary: true,
+		sql:   func(l, _ string) string { return sym + l },
+		build: func(l, _ ExprNode) ExprNode { return &UnaryOperationExpr{Op: op, V: l} },
+	}
+}
+
+func likePrecedenceOp(escape byte) precedenceOp {
+	return precedenceOp{
+		sql: func(l, r string) string {
+			if escape != '\\' {
+				return fmt.Sprintf("%s LIKE %s ESCAPE '%c'", l, r, escape)
+			}
+			return l + " LIKE " + r
+		},
+		build: func(l, r ExprNode) ExprNode {
+			return &PatternLikeOrIlikeExpr{Expr: l, Pattern: r, Escape: escape, IsLike: true}
+		},
+	}
+}
+
+func precedenceColumn(name string) ExprNode {
+	return &ColumnNameExpr{Name: &ColumnName{Name: NewCIStr(name)}}
+}
+
+func precedenceInt(v int64) ExprNode {
+	return NewValueExpr(v, "", "")
+}
+
+// precedenceLevels is the operator precedence table of the MySQL manual, from
+// the tightest to the loosest level, with the operators of each level. BETWEEN
+// is written with the bound 0 as its second operand, and IN with the list (1, 2).
+var precedenceLevels = [][]precedenceOp{
+	{prefixPrecedenceOp(opcode.Not2, "!")},
+	{prefixPrecedenceOp(opcode.Minus, "-"), prefixPrecedenceOp(opcode.BitNeg, "~")},
+	{binaryPrecedenceOp(opcode.Xor, "^")},
+	{binaryPrecedenceOp(opcode.Mul, "*"), binaryPrecedenceOp(opcode.IntDiv, "DIV"), binaryPrecedenceOp(opcode.Mod, "%")},
+	{binaryPrecedenceOp(opcode.Minus, "-"), binaryPrecedenceOp(opcode.Plus, "+")},
+	{binaryPrecedenceOp(opcode.LeftShift, "<<"), binaryPrecedenceOp(opcode.RightShift, ">>")},
+	{binaryPrecedenceOp(opcode.And, "&")},
+	{binaryPrecedenceOp(opcode.Or, "|")},
+	{
+		binaryPrecedenceOp(opcode.EQ, "="),
+		binaryPrecedenceOp(opcode.NullEQ, "<=>"),
+		{
+			unary: true,
+			sql:   func(l, _ string) string { return l + " IS NULL" },
+			build: func(l, _ ExprNode) ExprNode { return &IsNullExpr{Expr: l} },
+		},
+		likePrecedenceOp('\\'),
+		likePrecedenceOp('!'),
+		{
+			sql:   func(l, r string) string { return l + " REGEXP " + r },
+			build: func(l, r ExprNode) ExprNode { return &PatternRegexpExpr{Expr: l, Pattern: r} },
+		},
+		{
+			unary: true,
+			sql:   func(l, _ string) string { return l + " IN (1, 2)" },
+			build: func(l, _ ExprNode) ExprNode {
+				return &PatternInExpr{Expr: l, List: []ExprNode{precedenceInt(1), precedenceInt(2)}}
+			},
+		},
+	},
+	{{
+		sql: func(l, r string) string { return l + " BETWEEN 0 AND " + r },
+		build: func(l, r ExprNode) ExprNode {
+			return &BetweenExpr{Expr: l, Left: precedenceInt(0), Right: r}
+		},
+	}},
+	{prefixPrecedenceOp(opcode.Not, "NOT ")},
+	{binaryPrecedenceOp(opcode.LogicAnd, "AND"), binaryPrecedenceOp(opcode.LogicAnd, "&&")},
+	{binaryPrecedenceOp(opcode.LogicXor, "XOR")},
+	{binaryPrecedenceOp(opcode.LogicOr, "OR")},
+}
+
+// forEachPrecedenceBoundary calls fn with each pair of operators of adjacent
+// levels of precedenceLevels.
+func forEachPrecedenceBoundary(fn func(tighter, looser precedenceOp)) {
+	for i := 0; i+1 < len(precedenceLevels); i++ {
+		for _, tighter := range precedenceLevels[i] {
+			for _, looser := range precedenceLevels[i+1] {
+				fn(tighter, looser)
+			}
+		}
+	}
+}
+
+type precedenceExpr struct {
+	sql  string
+	tree ExprNode
+}
+
+// precedenceNestings returns the expressions of the operator inner nested in
+// the operator outer, on the operands a, b and c, as SQL without parentheses
+// and as a tree.
+func precedenceNestings(outer, inner precedenceOp) []precedenceExpr {
+	col := precedenceColumn
+	switch {
+	case !outer.unary && !inner.unary:
+		return []precedenceExpr{
+			{outer.sql("a", inner.sql("b", "c")), outer.build(col("a"), inner.build(col("b"), col("c")))},
+			{outer.sql(inner.sql("a", "b"), "c"), outer.build(inner.build(col("a"), col("b")), col("c"))},
+		}
+	case !outer.unary:
+		return []precedenceExpr{
+			{outer.sql(inner.sql("a", ""), "b"), outer.build(inner.build(col("a"), nil), col("b"))},
+			{outer.sql("a", inner.sql("b", "")), outer.build(col("a"), inner.build(col("b"), nil))},
+		}
+	case !inner.unary:
+		return []precedenceExpr{
+			{outer.sql(inner.sql("a", "b"), ""), outer.build(inner.build(col("a"), col("b")), nil)},
+		}
+	}
+	return []precedenceExpr{
+		{outer.sql(inner.sql("a", ""), ""), outer.build(inner.build(col("a"), nil), nil)},
+	}
+}
+
+// precedenceGolden are the shapes of the expressions of precedenceNestings for
+// the tighter operator nested in the looser one, which the parser reads as
+// MySQL does. It's the table of the manual, except that the comparisons and
+// IS NULL are looser than LIKE, REGEXP, IN and BETWEEN, and that the operand
+// of LIKE and REGEXP is a simple expression.
+var precedenceGolden = []struct {
+	sql   string
+	shape string
+}{
+	{`-!a`, "(minus (! a))"},
+	{`~!a`, "(bitneg (! a))"},
+	{`-a ^ b`, "(bitxor (minus a) b)"},
+	{`a ^ -b`, "(bitxor a (minus b))"},
+	{`~a ^ b`, "(bitxor (bitneg a) b)"},
+	{`a ^ ~b`, "(bitxor a (bitneg b))"},
+	{`a * b ^ c`, "(mul a (bitxor b c))"},
+	{`a ^ b * c`, "(mul (bitxor a b) c)"},
+	{`a DIV b ^ c`, "(intdiv a (bitxor b c))"},
+	{`a ^ b DIV c`, "(intdiv (bitxor a b) c)"},
+	{`a % b ^ c`, "(mod a (bitxor b c))"},
+	{`a ^ b % c`, "(mod (bitxor a b) c)"},
+	{`a - b * c`, "(minus a (mul b c))"},
+	{`a * b - c`, "(minus (mul a b) c)"},
+	{`a + b * c`, "(plus a (mul b c))"},
+	{`a * b + c`, "(plus (mul a b) c)"},
+	{`a - b DIV c`, "(minus a (intdiv b c))"},
+	{`a DIV b - c`, "(minus (intdiv a b) c)"},
+	{`a + b DIV c`, "(plus a (intdiv b c))"},
+	{`a DIV b + c`, "(plus (intdiv a b) c)"},
+	{`a - b % c`, "(minus a (mod b c))"},
+	{`a % b - c`, "(minus (mod a b) c)"},
+	{`a + b % c`, "(plus a (mod b c))"},
+	{`a % b + c`, "(plus (mod a b) c)"},
+	{`a << b - c`, "(leftshift a (minus b c))"},
+	{`a - b << c`, "(leftshift (minus a b) c)"},
+	{`a >> b - c`, "(rightshift a (minus b c))"},
+	{`a - b >> c`, "(rightshift (minus a b) c)"},
+	{`a << b + c`, "(leftshift a (plus b c))"},
+	{`a + b << c`, "(leftshift (plus a b) c)"},
+	{`a >> b + c`, "(rightshift a (plus b c))"},
+	{`a + b >> c`, "(rightshift (plus a b) c)"},
+	{`a & b << c`, "(bitand a (leftshift b c))"},
+	{`a << b & c`, "(bitand (leftshift a b) c)"},
+	{`a & b >> c`, "(bitand a (rightshift b c))"},
+	{`a >> b & c`, "(bitand (rightshift a b) c)"},
+	{`a | b & c`, "(bitor a (bitand b c))"},
+	{`a & b | c`, "(bitor (bitand a b) c)"},
+	{`a = b | c`, "(eq a (bitor b c))"},
+	{`a | b = c`, "(eq (bitor a b) c)"},
+	{`a <=> b | c`, "(nulleq a (bitor b c))"},
+	{`a | b <=> c`, "(nulleq (bitor a b) c)"},
+	{`a | b IS NULL`, "(isnull (bitor a b))"},
+	{`a LIKE b | c`, "error"},
+	{`a | b LIKE c`, "(like (bitor a b) c)"},
+	{`a LIKE b | c ESCAPE '!'`, "error"},
+	{`a | b LIKE c ESCAPE '!'`, "(like (bitor a b) c '!')"},
+	{`a REGEXP b | c`, "error"},
+	{`a | b REGEXP c`, "(regexp (bitor a b) c)"},
+	{`a | b IN (1, 2)`, "(in (bitor a b) 1 2)"},
+	{`a BETWEEN 0 AND b = c`, "(eq (between a 0 b) c)"},
+	{`a = b BETWEEN 0 AND c`, "(eq a (between b 0 c))"},
+	{`a BETWEEN 0 AND b <=> c`, "(nulleq (between a 0 b) c)"},
+	{`a <=> b BETWEEN 0 AND c`, "(nulleq a (between b 0 c))"},
+	{`a IS NULL BETWEEN 0 AND b`, "error"},
+	{`a BETWEEN 0 AND b IS NULL`, "(isnull (between a 0 b))"},
+	{`a BETWEEN 0 AND b LIKE c`, "(between a 0 (like b c))"},
+	{`a LIKE b BETWEEN 0 AND c`, "error"},
+	{`a BETWEEN 0 AND b LIKE c ESCAPE '!'`, "(between a 0 (like b c '!'))"},
+	{`a LIKE b ESCAPE '!' BETWEEN 0 AND c`, "error"},
+	{`a BETWEEN 0 AND b REGEXP c`, "(between a 0 (regexp b c))"},
+	{`a REGEXP b BETWEEN 0 AND c`, "error"},
+	{`a IN (1, 2) BETWEEN 0 AND b`, "error"},
+	{`a BETWEEN 0 AND b IN (1, 2)`, "(between a 0 (in b 1 2))"},
+	{`NOT a BETWEEN 0 AND b`, "(not (between a 0 b))"},
+	{`NOT a AND b`, "(and (not a) b)"},
+	{`a AND NOT b`, "(and a (not b))"},
+	{`NOT a && b`, "(and (not a) b)"},
+	{`a && NOT b`, "(and a (not b))"},
+	{`a XOR b AND c`, "(xor a (and b c))"},
+	{`a AND b XOR c`, "(xor (and a b) c)"},
+	{`a XOR b && c`, "(xor a (and b c))"},
+	{`a && b XOR c`, "(xor (and a b) c)"},
+	{`a OR b XOR c`, "(or a (xor b c))"},
+	{`a XOR b OR c`, "(or (xor a b) c)"},
+}
+
+// precedenceCases are the other expressions on the boundaries of BETWEEN, LIKE
+// ESCAPE, IN and REGEXP with the logical operators.
+var precedenceCases = []struct {
+	sql   string
+	shape string
+}{
+	{`a BETWEEN b AND c AND d`, "(and (between a b c) d)"},
+	{`a BETWEEN b AND c BETWEEN d AND e`, "(between a b (between c d e))"},
+	{`a BETWEEN b AND c = d AND e`, "(and (eq (between a b c) d) e)"},
+	{`a BETWEEN b AND c AND d BETWEEN e AND f`, "(and (between a b c) (between d e f))"},
+	{`a NOT BETWEEN b AND c OR d`, "(or (notbetween a b c) d)"},
+	{`a BETWEEN b + 1 AND c - 1`, "(between a (plus b 1) (minus c 1))"},
+	{`a BETWEEN b AND NOT c`, "error"},
+	{`NOT a LIKE 'x' ESCAPE '!'`, "(not (like a 'x' '!'))"},
+	{`a LIKE 'x' ESCAPE '!' AND b LIKE 'y'`, "(and (like a 'x' '!') (like b 'y'))"},
+	{`a LIKE 'x' AND b LIKE 'y' ESCAPE '!'`, "(and (like a 'x') (like b 'y' '!'))"},
+	{`a NOT LIKE 'x' ESCAPE '!' OR b`, "(or (notlike a 'x' '!') b)"},
+	{`a LIKE 'x' ESCAPE '!' = b`, "(eq (like a 'x' '!') b)"},
+	{`a LIKE 'x' ESCAPE '!' ESCAPE '#'`, "error"},
+	{`a IN (1, 2) = b`, "(eq (in a 1 2) b)"},
+	{`a = b IN (1, 2)`, "(eq a (in b 1 2))"},
+	{`NOT a IN (1, 2) AND b`, "(and (not (in a 1 2)) b)"},
+	{`a NOT IN (1, 2) = b`, "(eq (notin a 1 2) b)"},
+	{`a IN (1, 2) IN (1, 2)`, "error"},
+	{`a REGEXP 'x' AND NOT b REGEXP 'y'`, "(and (regexp a 'x') (not (regexp b 'y')))"},
+	{`NOT a REGEXP b = c`, "(not (eq (regexp a b) c))"},
+	{`a IS NULL = b`, "(eq (isnull a) b)"},
+	{`a = b IS NULL`, "(isnull (eq a b))"},
+}
+
+// exprShape returns the tree of n as an s-expression of its operators, without
+// the parentheses of the SQL.
+func exprShape(n ExprNode) string {
+	sexpr := func(op string, not bool, args ...ExprNode) string {
+		if not {
+			op = "not" + op
+		}
+		var sb strings.Builder
+		sb.WriteString("(" + op)
+		for _, arg := range args {
+			sb.WriteString(" " + exprShape(arg))
+		}
+		sb.WriteString(")")
+		return sb.String()
+	}
+	switch x := n.(type) {
+	case *ParenthesesExpr:
+		return exprShape(x.Expr)
+	case *BinaryOperationExpr:
+		return sexpr(x.Op.String(), false, x.L, x.R)
+	case *UnaryOperationExpr:
+		return sexpr(x.Op.String(), false, x.V)
+	case *IsNullExpr:
+		return sexpr("isnull", x.Not, x.Expr)
+	case *BetweenExpr:
+		return sexpr("between", x.Not, x.Expr, x.Left, x.Right)
+	case *PatternInExpr:
+		return sexpr("in", x.Not, append([]ExprNode{x.Expr}, x.List...)...)
+	case *PatternRegexpExpr:
+		return sexpr("regexp", x.Not, x.Expr, x.Pattern)
+	case *PatternLikeOrIlikeExpr:
+		shape := sexpr("like", x.Not, x.Expr, x.Pattern)
+		if x.Escape != '\\' {
+			shape = fmt.Sprintf("%s '%c')", shape[:len(shape)-1], x.Escape)
+		}
+		return shape
+	}
+	var sb strings.Builder
+	if err := n.Restore(format.NewRestoreCtx(format.RestoreStringSingleQuotes|format.RestoreStringWithoutCharset, &sb)); err != nil {
+		return err.Error()
+	}
+	return sb.String()
+}
+
+func parsePrecedenceExpr(p *parser.Parser, sql string) (ExprNode, error) {
+	stmt, err := p.ParseOneStmt("select "+sql, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return stmt.(*SelectStmt).Fields.Fields[0].Expr, nil
+}
+
+func restorePrecedenceExpr(t *testing.T, n ExprNode) string {
+	var sb strings.Builder
+	require.NoError(t, n.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	return sb.String()
+}
+
+// checkPrecedenceShape checks that sql is parsed to shape, and that its
+// restored text is parsed back to it.
+func checkPrecedenceShape(t *testing.T, p *parser.Parser, sql, shape string) {
+	expr, err := parsePrecedenceExpr(p, sql)
+	if shape == "error" {
+		require.Error(t, err, sql)
+		return
+	}
+	require.NoError(t, err, sql)
+	require.Equal(t, shape, exprShape(expr), sql)
+
+	restored := restorePrecedenceExpr(t, expr)
+	expr, err = parsePrecedenceExpr(p, restored)
+	require.NoError(t, err, restored)
+	require.Equal(t, shape, exprShape(expr), restored)
+}
+
+func TestOperatorPrecedence(t *testing.T) {
+	p := parser.New()
+	golden := make(map[string]string, len(precedenceGolden))
+	for _, c := range precedenceGolden {
+		golden[c.sql] = c.shape
+	}
+	generated := make(map[string]bool, len(golden))
+	forEachPrecedenceBoundary(func(tighter, looser precedenceOp) {
+		for _, e := range precedenceNestings(looser, tighter) {
+			shape, ok := golden[e.sql]
+			require.Truef(t, ok, "no golden shape for %s", e.sql)
+			checkPrecedenceShape(t, p, e.sql, shape)
+			generated[e.sql] = true
+		}
+	})
+	// The golden shapes follow precedenceLevels.
+	require.Len(t, generated, len(golden))
+
+	for _, c := range precedenceCases {
+		checkPrecedenceShape(t, p, c.sql, c.shape)
+	}
+}
+
+// The trees which the parser doesn't make from SQL without parentheses, e.g.
+// after a rewrite, are restored with the parentheses which keep them.
+func TestOperatorPrecedenceRestore(t *testing.T) {
+	p := parser.New()
+	check := func(tree ExprNode) {
+		restored := restorePrecedenceExpr(t, tree)
+		expr, err := parsePrecedenceExpr(p, restored)
+		require.NoError(t, err, restored)
+		require.Equal(t, exprShape(tree), exprShape(expr), restored)
+	}
+	forEachPrecedenceBoundary(func(tighter, looser precedenceOp) {
+		for _, e := range precedenceNestings(looser, tighter) {
+			check(e.tree)
+		}
+		for _, e := range precedenceNestings(tighter, looser) {
+			check(e.tree)
+		}
+	})
+
+	col := precedenceColumn
+	and := func(l, r ExprNode) ExprNode {
+		return &BinaryOperationExpr{Op: opcode.LogicAnd, L: l, R: r}
+	}
+	like := func(l, r ExprNode, escape byte) ExprNode {
+		return &PatternLikeOrIlikeExpr{Expr: l, Pattern: r, Escape: escape, IsLike: true}
+	}
+	check(&BetweenExpr{Expr: &BetweenExpr{Expr: col("a"), Left: col("b"), Right: col("c")}, Left: col("d"), Right: col("e")})
+	check(&BetweenExpr{Expr: col("a"), Left: and(col("b"), col("c")), Right: col("d")})
+	check(&BetweenExpr{Expr: col("a"), Left: col("b"), Right: and(col("c"), col("d"))})
+	check(like(like(col("a"), col("b"), '!'), col("c"), '#'))
+	check(like(col("a"), like(col("b"), col("c"), '!'), '\\'))
+	check(&UnaryOperationExpr{Op: opcode.Not, V: and(col("a"), col("b"))})
+	check(&UnaryOperationExpr{Op: opcode.Minus, V: &UnaryOperationExpr{Op: opcode.Minus, V: col("a")}})
+	check(&BinaryOperationExpr{Op: opcode.Minus, L: col("a"), R: &BinaryOperationExpr{Op: opcode.Minus, L: col("b"), R: col("c")}})
+
+	// With RestoreBracketAroundBinaryOperation, the operations aren't put in
+	// parentheses twice.
+	var sb strings.Builder
+	tree := &BinaryOperationExpr{Op: opcode.Mul, L: &BinaryOperationExpr{Op: opcode.Plus, L: col("a"), R: col("b")}, R: col("c")}
+	require.NoError(t, tree.Restore(format.NewRestoreCtx(format.RestoreBracketAroundBinaryOperation|format.RestoreNameBackQuotes, &sb)))
+	require.Equal(t, "((`a`+`b`)*`c`)", sb.String())
+}
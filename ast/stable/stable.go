@@ -0,0 +1,136 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stable is a small read-only view of the most used AST nodes for
+// plugin authors. Unlike the fields of the concrete nodes in package ast, the
+// methods of the interfaces here only change together with Version.
+//
+// The accessors are named differently from the fields of the concrete nodes,
+// e.g. WhereExpr instead of Where, because a Go type can't have a field and a
+// method with the same name.
+package stable
+
+import (
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/opcode"
+)
+
+// Version is the version of the interfaces in this package. It's bumped on any
+// incompatible change of them.
+const Version = 1
+
+// SelectLike is implemented by *ast.SelectStmt.
+type SelectLike interface {
+	// WhereExpr returns the WHERE condition, or nil if there is none.
+	WhereExpr() ast.ExprNode
+	// FromClause returns the FROM clause, or nil if there is none.
+	FromClause() *ast.TableRefsClause
+	// FieldsCount returns the number of fields in the select list.
+	FieldsCount() int
+}
+
+// InsertLike is implemented by *ast.InsertStmt, for both INSERT and REPLACE.
+type InsertLike interface {
+	// TargetTable returns the table to insert into.
+	TargetTable() *ast.TableRefsClause
+	// WhereExpr returns the WHERE condition of INSERT ... SELECT, or nil.
+	WhereExpr() ast.ExprNode
+	// ColumnsCount returns the number of columns in the column list.
+	ColumnsCount() int
+	// RowsCount returns the number of rows in the VALUES list.
+	RowsCount() int
+}
+
+// UpdateLike is implemented by *ast.UpdateStmt.
+type UpdateLike interface {
+	// TargetTable returns the tables to update.
+	TargetTable() *ast.TableRefsClause
+	// WhereExpr returns the WHERE condition, or nil if there is none.
+	WhereExpr() ast.ExprNode
+	// AssignmentsCount returns the number of assignments in the SET clause.
+	AssignmentsCount() int
+}
+
+// DeleteLike is implemented by *ast.DeleteStmt.
+type DeleteLike interface {
+	// TargetTable returns the tables to delete from.
+	TargetTable() *ast.TableRefsClause
+	// WhereExpr returns the WHERE condition, or nil if there is none.
+	WhereExpr() ast.ExprNode
+}
+
+// ColumnNameLike is implemented by *ast.ColumnName.
+type ColumnNameLike interface {
+	// DBName returns the schema name, or "" if not specified.
+	DBName() string
+	// TblName returns the table name, or "" if not specified.
+	TblName() string
+	// ColName returns the column name.
+	ColName() string
+}
+
+// TableNameLike is implemented by *ast.TableName.
+type TableNameLike interface {
+	// DBName returns the schema name, or "" if not specified.
+	DBName() string
+	// TblName returns the table name.
+	TblName() string
+}
+
+// FuncCallLike is implemented by *ast.FuncCallExpr.
+type FuncCallLike interface {
+	// FuncName returns the function name in lower case.
+	FuncName() string
+	// ArgsCount returns the number of arguments.
+	ArgsCount() int
+	// Arg returns the i-th argument.
+	Arg(i int) ast.ExprNode
+}
+
+// BinaryOperationLike is implemented by *ast.BinaryOperationExpr.
+type BinaryOperationLike interface {
+	// Operator returns the operator.
+	Operator() opcode.Op
+	// LeftExpr returns the left operand.
+	LeftExpr() ast.ExprNode
+	// RightExpr returns the right operand.
+	RightExpr() ast.ExprNode
+}
+
+// Compile-time checks that the concrete nodes implement the interfaces.
+var (
+	_ SelectLike          = (*ast.SelectStmt)(nil)
+	_ InsertLike          = (*ast.InsertStmt)(nil)
+	_ UpdateLike          = (*ast.UpdateStmt)(nil)
+	_ DeleteLike          = (*ast.DeleteStmt)(nil)
+	_ ColumnNameLike      = (*ast.ColumnName)(nil)
+	_ TableNameLike       = (*ast.TableName)(nil)
+	_ FuncCallLike        = (*ast.FuncCallExpr)(nil)
+	_ BinaryOperationLike = (*ast.BinaryOperationExpr)(nil)
+)
+
+type inspector func(n ast.Node) bool
+
+func (f inspector) Enter(n ast.Node) (ast.Node, bool) {
+	return n, !f(n)
+}
+
+func (f inspector) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+// Inspect traverses the AST rooted at node in depth-first order, calling fn for
+// every node. The children of a node are skipped if fn returns false.
+func Inspect(node ast.Node, fn func(n ast.Node) bool) {
+	node.Accept(inspector(fn))
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stable_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/ast/stable"
+	"github.com/abbychau/mysql-parser/opcode"
+	_ "github.com/abbychau/mysql-parser/test_driver"
+	"github.com/stretchr/testify/require"
+)
+
+// stableAPI is the API of the package. Changing it breaks the plugins built on
+// it, so a change here must come with a bump of stable.Version.
+const stableAPI = `Version = 1
+BinaryOperationLike.LeftExpr() ast.ExprNode
+BinaryOperationLike.Operator() opcode.Op
+BinaryOperationLike.RightExpr() ast.ExprNode
+ColumnNameLike.ColName() string
+ColumnNameLike.DBName() string
+ColumnNameLike.TblName() string
+DeleteLike.TargetTable() *ast.TableRefsClause
+DeleteLike.WhereExpr() ast.ExprNode
+FuncCallLike.Arg(int) ast.ExprNode
+FuncCallLike.ArgsCount() int
+FuncCallLike.FuncName() string
+InsertLike.ColumnsCount() int
+InsertLike.RowsCount() int
+InsertLike.TargetTable() *ast.TableRefsClause
+InsertLike.WhereExpr() ast.ExprNode
+SelectLike.FieldsCount() int
+SelectLike.FromClause() *ast.TableRefsClause
+SelectLike.WhereExpr() ast.ExprNode
+TableNameLike.DBName() string
+TableNameLike.TblName() string
+UpdateLike.AssignmentsCount() int
+UpdateLike.TargetTable() *ast.TableRefsClause
+UpdateLike.WhereExpr() ast.ExprNode
+Inspect(ast.Node, func(ast.Node) bool)`
+
+func describeAPI() string {
+	lines := []string{fmt.Sprintf("Version = %d", stable.Version)}
+	for _, v := range []any{
+		(*stable.BinaryOperationLike)(nil),
+		(*stable.ColumnNameLike)(nil),
+		(*stable.DeleteLike)(nil),
+		(*stable.FuncCallLike)(nil),
+		(*stable.InsertLike)(nil),
+		(*stable.SelectLike)(nil),
+		(*stable.TableNameLike)(nil),
+		(*stable.UpdateLike)(nil),
+	} {
+		tp := reflect.TypeOf(v).Elem()
+		// Methods are sorted by name.
+		for i := 0; i < tp.NumMethod(); i++ {
+			m := tp.Method(i)
+			sig := strings.TrimPrefix(m.Type.String(), "func")
+			lines = append(lines, tp.Name()+"."+m.Name+sig)
+		}
+	}
+	lines = append(lines, "Inspect"+strings.TrimPrefix(reflect.TypeOf(stable.Inspect).String(), "func"))
+	return strings.Join(lines, "\n")
+}
+
+func TestStableAPI(t *testing.T) {
+	require.Equal(t, stableAPI, describeAPI(), "the stable API changed, bump stable.Version and update stableAPI")
+}
+
+func TestAccessors(t *testing.T) {
+	p := parser.New()
+	stmts, _, err := p.ParseSQL("select a, db.t.b, count(c) from db.t where a > 1;" +
+		"insert into t (a, b) values (1, 2), (3, 4);" +
+		"update t set a = 1, b = 2 where c = 3;" +
+		"delete from t where a = 1")
+	require.NoError(t, err)
+
+	sel := stmts[0].(stable.SelectLike)
+	require.Equal(t, 3, sel.FieldsCount())
+	require.NotNil(t, sel.FromClause())
+	where := sel.WhereExpr().(stable.BinaryOperationLike)
+	require.Equal(t, opcode.GT, where.Operator())
+	require.Equal(t, "a", where.LeftExpr().(*ast.ColumnNameExpr).Name.ColName())
+	require.NotNil(t, where.RightExpr())
+
+	ins := stmts[1].(stable.InsertLike)
+	require.Equal(t, 2, ins.ColumnsCount())
+	require.Equal(t, 2, ins.RowsCount())
+	require.NotNil(t, ins.TargetTable())
+	require.Nil(t, ins.WhereExpr())
+
+	upd := stmts[2].(stable.UpdateLike)
+	require.Equal(t, 2, upd.AssignmentsCount())
+	require.NotNil(t, upd.TargetTable())
+	require.NotNil(t, upd.WhereExpr())
+
+	del := stmts[3].(stable.DeleteLike)
+	require.NotNil(t, del.TargetTable())
+	require.NotNil(t, del.WhereExpr())
+
+	var (
+		cols   []string
+		tables []string
+		funcs  []string
+	)
+	stable.Inspect(stmts[0], func(n ast.Node) bool {
+		switch x := n.(type) {
+		case stable.ColumnNameLike:
+			cols = append(cols, strings.TrimPrefix(x.DBName()+"."+x.TblName()+"."+x.ColName(), ".."))
+		case stable.TableNameLike:
+			tables = append(tables, x.DBName()+"."+x.TblName())
+		case stable.FuncCallLike:
+			funcs = append(funcs, x.FuncName())
+		}
+		return true
+	})
+	require.Equal(t, []string{"a", "db.t.b", "c", "a"}, cols)
+	require.Equal(t, []string{"db.t"}, tables)
+	// count(c) is an aggregate function, not a FuncCallExpr.
+	require.Empty(t, funcs)
+
+	stmt, err := p.ParseOneStmt("select concat(a, 'x') from t", "", "")
+	require.NoError(t, err)
+	var fn stable.FuncCallLike
+	stable.Inspect(stmt, func(n ast.Node) bool {
+		if f, ok := n.(stable.FuncCallLike); ok {
+			fn = f
+			return false
+		}
+		return true
+	})
+	require.Equal(t, "concat", fn.FuncName())
+	require.Equal(t, 2, fn.ArgsCount())
+	require.NotNil(t, fn.Arg(1))
+}
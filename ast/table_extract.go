@@ -0,0 +1,115 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// TableReference is a table referenced by a statement, see ExtractTables.
+type TableReference struct {
+	// Schema is the schema written in the statement, or the default schema if
+	// the statement doesn't qualify the table.
+	Schema CIStr
+	Name   CIStr
+	// SchemaIsDefault tells that the statement doesn't qualify the table, so
+	// that Schema is the default schema, which is empty if there is none.
+	SchemaIsDefault bool
+}
+
+// ExtractTables returns the tables referenced by stmt, each once, in the order
+// of their first reference as Accept visits them, e.g. the tables of the
+// SELECT of an INSERT ... SELECT come first. The tables the statement doesn't
+// qualify are qualified with defaultSchema, stmt itself is left unchanged. The
+// references to the common table expressions of the statement aren't tables,
+// so they aren't returned.
+func ExtractTables(stmt StmtNode, defaultSchema string) []TableReference {
+	ctes := &cteNameCollector{names: make(map[string]struct{})}
+	stmt.Accept(ctes)
+	e := &tableExtractor{
+		defaultSchema: NewCIStr(defaultSchema),
+		ctes:          ctes.names,
+		seen:          make(map[[2]string]struct{}),
+	}
+	stmt.Accept(e)
+	return e.tables
+}
+
+// ExtractTablesFromStmts returns the tables of every statement of stmts, see
+// ExtractTables. If followUse is set, a USE statement makes its database the
+// default schema of the statements after it, as it does when the statements
+// are executed in a session; otherwise every statement has defaultSchema.
+func ExtractTablesFromStmts(stmts []StmtNode, defaultSchema string, followUse bool) [][]TableReference {
+	tables := make([][]TableReference, 0, len(stmts))
+	for _, stmt := range stmts {
+		tables = append(tables, ExtractTables(stmt, defaultSchema))
+		if use, ok := stmt.(*UseStmt); ok && followUse {
+			defaultSchema = use.DBName
+		}
+	}
+	return tables
+}
+
+// cteNameCollector collects the lower case names of the common table
+// expressions of a statement. A table name matching one of them is taken for a
+// reference to it wherever it's written, which is right unless a table and a
+// common table expression of different scopes have the same name.
+type cteNameCollector struct {
+	names map[string]struct{}
+}
+
+// Enter implements Visitor interface.
+func (c *cteNameCollector) Enter(in Node) (out Node, skipChildren bool) {
+	if with, ok := in.(*WithClause); ok {
+		for _, cte := range with.CTEs {
+			c.names[cte.Name.L] = struct{}{}
+		}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*cteNameCollector) Leave(in Node) (out Node, ok bool) {
+	return in, true
+}
+
+type tableExtractor struct {
+	defaultSchema CIStr
+	ctes          map[string]struct{}
+	seen          map[[2]string]struct{}
+	tables        []TableReference
+}
+
+// Enter implements Visitor interface.
+func (e *tableExtractor) Enter(in Node) (out Node, skipChildren bool) {
+	tn, ok := in.(*TableName)
+	if !ok {
+		return in, false
+	}
+	ref := TableReference{Schema: tn.Schema, Name: tn.Name}
+	if tn.Schema.O == "" {
+		if _, ok := e.ctes[tn.Name.L]; ok {
+			return in, true
+		}
+		ref.Schema = e.defaultSchema
+		ref.SchemaIsDefault = true
+	}
+	key := [2]string{ref.Schema.L, ref.Name.L}
+	if _, ok := e.seen[key]; !ok {
+		e.seen[key] = struct{}{}
+		e.tables = append(e.tables, ref)
+	}
+	return in, true
+}
+
+// Leave implements Visitor interface.
+func (*tableExtractor) Leave(in Node) (out Node, ok bool) {
+	return in, true
+}
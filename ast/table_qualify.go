@@ -0,0 +1,147 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnqualifiedTablesError is returned by QualifyTables for the table names it
+// couldn't qualify, which are left unqualified.
+type UnqualifiedTablesError struct {
+	// Tables are the table names, in the order of the statement. Their
+	// OriginTextPosition is their offset in the SQL text parsed.
+	Tables []*TableName
+}
+
+// Error implements the error interface.
+func (e *UnqualifiedTablesError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("can't qualify the tables ")
+	for i, tn := range e.Tables {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "`%s` at offset %d", tn.Name.O, tn.OriginTextPosition())
+	}
+	return sb.String()
+}
+
+// QualifyTables sets the schema of the table names of stmt which have none to
+// defaultSchema, so that its Restore is the same statement whatever the
+// current database, e.g. for a proxy forwarding it to a server of many
+// schemas. Like ExtractTablesFromStmts, a caller qualifying many statements
+// changes defaultSchema after a UseStmt.
+//
+// The table names of all the statements are qualified, in the WITH clauses,
+// the derived tables and the subqueries too, but the references to a common
+// table expression, and the aliases: the names of a multi-table DELETE list
+// which are aliases, e.g. o of DELETE o FROM orders AS o. A name of a DELETE
+// list which isn't an alias is the table of the same name of the DELETE, e.g.
+// s.t for DELETE t FROM s.t, and the table of SHOW COLUMNS FROM t FROM s is of
+// the schema s.
+//
+// It returns an UnqualifiedTablesError for the names it can't qualify: the
+// names of a DELETE list matching no table, or tables of several schemas, e.g.
+// DELETE t FROM s1.t JOIN s2.t, and every name if defaultSchema is empty. The
+// other names are qualified anyway.
+func QualifyTables(stmt StmtNode, defaultSchema string) error {
+	c := &tableSchemaCollector{
+		schemas:   make(map[*TableName]CIStr),
+		ambiguous: make(map[*TableName]struct{}),
+	}
+	stmt.Accept(c)
+	var unqualified []*TableName
+	r := newTableNameRewriter(func(tn *TableName) (string, string) {
+		if tn.Schema.L != "" {
+			return tn.Schema.O, tn.Name.O
+		}
+		if schema, ok := c.schemas[tn]; ok {
+			return schema.O, tn.Name.O
+		}
+		if _, ok := c.ambiguous[tn]; ok || defaultSchema == "" {
+			unqualified = append(unqualified, tn)
+			return "", tn.Name.O
+		}
+		return defaultSchema, tn.Name.O
+	})
+	stmt.Accept(r)
+	if len(unqualified) > 0 {
+		return &UnqualifiedTablesError{Tables: unqualified}
+	}
+	return nil
+}
+
+// tableSchemaCollector collects the schemas of the table names of a statement
+// which aren't the default schema, see QualifyTables.
+type tableSchemaCollector struct {
+	schemas map[*TableName]CIStr
+	// ambiguous are the names of the DELETE lists having no single table.
+	ambiguous map[*TableName]struct{}
+}
+
+// Enter implements Visitor interface.
+func (c *tableSchemaCollector) Enter(in Node) (out Node, skipChildren bool) {
+	switch x := in.(type) {
+	case *DeleteStmt:
+		if x.Tables == nil || x.TableRefs == nil {
+			break
+		}
+		sources := appendSources(nil, x.TableRefs.TableRefs)
+		for _, tn := range x.Tables.Tables {
+			if tn.Schema.L != "" {
+				continue
+			}
+			c.resolveDeleteTarget(tn, sources)
+		}
+	case *ShowStmt:
+		if x.Table != nil && x.Table.Schema.L == "" && x.DBName != "" {
+			c.schemas[x.Table] = NewCIStr(x.DBName)
+		}
+	}
+	return in, false
+}
+
+// resolveDeleteTarget finds the table of tn, a name of a DELETE list, among
+// the tables of the DELETE.
+func (c *tableSchemaCollector) resolveDeleteTarget(tn *TableName, sources []columnSource) {
+	var schema *CIStr
+	for i := range sources {
+		src := &sources[i]
+		if src.name.L != tn.Name.L {
+			continue
+		}
+		if src.aliased {
+			// The name is an alias, left as it is.
+			return
+		}
+		if schema != nil && schema.L != src.schema.L {
+			c.ambiguous[tn] = struct{}{}
+			return
+		}
+		schema = &src.schema
+	}
+	switch {
+	case schema == nil:
+		c.ambiguous[tn] = struct{}{}
+	case schema.L != "":
+		c.schemas[tn] = *schema
+	}
+}
+
+// Leave implements Visitor interface.
+func (*tableSchemaCollector) Leave(in Node) (out Node, ok bool) {
+	return in, true
+}
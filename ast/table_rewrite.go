@@ -0,0 +1,204 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+// RewriteTableNames renames the tables referenced by stmt with fn, which is
+// given the schema, empty if the statement doesn't qualify the table, and the
+// name of every table and returns their new ones. Every table name is renamed,
+// in the WITH clauses, the derived tables and the subqueries too, but the
+// references to a common table expression, and the names of a multi-table
+// DELETE list which are aliases. The columns qualified with the name of a
+// renamed table, which has no alias, are renamed with it, e.g. `orders`.`id`.
+// It returns the number of table names fn changed.
+func RewriteTableNames(stmt StmtNode, fn func(schema, table string) (string, string)) int {
+	r := newTableNameRewriter(func(tn *TableName) (string, string) {
+		return fn(tn.Schema.O, tn.Name.O)
+	})
+	stmt.Accept(r)
+	if len(r.renamed) > 0 {
+		stmt.Accept(&columnQualifierRewriter{renamed: r.renamed})
+	}
+	return r.count
+}
+
+// cteScope is the names of the common table expressions of a WITH clause.
+type cteScope struct {
+	// owner is the statement of the WITH clause.
+	owner Node
+	names map[string]struct{}
+}
+
+// renamedTable is a table renamed by RewriteTableNames.
+type renamedTable struct {
+	schema, name       CIStr
+	newSchema, newName CIStr
+}
+
+type tableNameRewriter struct {
+	// fn returns the new schema and name of a table name.
+	fn func(tn *TableName) (string, string)
+	// scopes are the WITH clauses enclosing the node being visited, the innermost
+	// last.
+	scopes []cteScope
+	// skip are the names of a DELETE list which are aliases.
+	skip map[*TableName]struct{}
+	// aliased are the tables having an alias.
+	aliased map[*TableName]struct{}
+	renamed []renamedTable
+	count   int
+}
+
+func newTableNameRewriter(fn func(tn *TableName) (string, string)) *tableNameRewriter {
+	return &tableNameRewriter{
+		fn:      fn,
+		skip:    make(map[*TableName]struct{}),
+		aliased: make(map[*TableName]struct{}),
+	}
+}
+
+// withOf returns the WITH clause of node, or nil if it has none.
+func withOf(node Node) *WithClause {
+	switch x := node.(type) {
+	case *SelectStmt:
+		return x.With
+	case *SetOprStmt:
+		return x.With
+	case *SetOprSelectList:
+		return x.With
+	case *UpdateStmt:
+		return x.With
+	case *DeleteStmt:
+		return x.With
+	}
+	return nil
+}
+
+func (r *tableNameRewriter) isCTE(name CIStr) bool {
+	for _, scope := range r.scopes {
+		if _, ok := scope.names[name.L]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Enter implements Visitor interface.
+func (r *tableNameRewriter) Enter(in Node) (out Node, skipChildren bool) {
+	if withOf(in) != nil {
+		r.scopes = append(r.scopes, cteScope{owner: in, names: make(map[string]struct{})})
+	}
+	switch x := in.(type) {
+	case *WithClause:
+		// The common table expressions of WITH RECURSIVE see each other, the
+		// other ones only see the ones before them.
+		if x.IsRecursive {
+			for _, cte := range x.CTEs {
+				r.scopes[len(r.scopes)-1].names[cte.Name.L] = struct{}{}
+			}
+		}
+	case *DeleteStmt:
+		if x.Tables != nil && x.TableRefs != nil {
+			aliases := make(map[string]struct{})
+			for _, src := range appendSources(nil, x.TableRefs.TableRefs) {
+				if src.aliased {
+					aliases[src.name.L] = struct{}{}
+				}
+			}
+			for _, tn := range x.Tables.Tables {
+				if _, ok := aliases[tn.Name.L]; ok && tn.Schema.L == "" {
+					r.skip[tn] = struct{}{}
+				}
+			}
+		}
+	case *TableSource:
+		if tn, ok := x.Source.(*TableName); ok && x.AsName.L != "" {
+			r.aliased[tn] = struct{}{}
+		}
+	case *TableName:
+		r.rename(x)
+	}
+	return in, false
+}
+
+func (r *tableNameRewriter) rename(tn *TableName) {
+	if _, ok := r.skip[tn]; ok {
+		return
+	}
+	if tn.Schema.L == "" && r.isCTE(tn.Name) {
+		return
+	}
+	schema, name := r.fn(tn)
+	if schema == tn.Schema.O && name == tn.Name.O {
+		return
+	}
+	if _, ok := r.aliased[tn]; !ok {
+		r.renamed = append(r.renamed, renamedTable{
+			schema: tn.Schema, name: tn.Name,
+			newSchema: NewCIStr(schema), newName: NewCIStr(name),
+		})
+	}
+	tn.Schema, tn.Name = NewCIStr(schema), NewCIStr(name)
+	r.count++
+}
+
+// Leave implements Visitor interface.
+func (r *tableNameRewriter) Leave(in Node) (out Node, ok bool) {
+	switch x := in.(type) {
+	case *CommonTableExpression:
+		if len(r.scopes) > 0 {
+			r.scopes[len(r.scopes)-1].names[x.Name.L] = struct{}{}
+		}
+	}
+	if n := len(r.scopes); n > 0 && r.scopes[n-1].owner == in {
+		r.scopes = r.scopes[:n-1]
+	}
+	return in, true
+}
+
+// columnQualifierRewriter renames the tables qualifying the columns.
+type columnQualifierRewriter struct {
+	renamed []renamedTable
+}
+
+// Enter implements Visitor interface.
+func (r *columnQualifierRewriter) Enter(in Node) (out Node, skipChildren bool) {
+	col, ok := in.(*ColumnName)
+	if !ok || col.Table.L == "" {
+		return in, false
+	}
+	var match *renamedTable
+	for i := range r.renamed {
+		t := &r.renamed[i]
+		if t.name.L != col.Table.L || (col.Schema.L != "" && t.schema.L != col.Schema.L) {
+			continue
+		}
+		if match != nil && (match.newSchema.L != t.newSchema.L || match.newName.L != t.newName.L) {
+			// The tables of several schemas are renamed differently.
+			return in, false
+		}
+		match = t
+	}
+	if match != nil {
+		if col.Schema.L != "" {
+			col.Schema = match.newSchema
+		}
+		col.Table = match.newName
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*columnQualifierRewriter) Leave(in Node) (out Node, ok bool) {
+	return in, true
+}
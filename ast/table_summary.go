@@ -0,0 +1,253 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/types"
+	"github.com/pingcap/errors"
+)
+
+// TableSummary is the summary of the columns and constraints defined by a
+// CreateTableStmt. The keys and references defined in column definitions are
+// resolved into the same form as the ones defined as table constraints.
+type TableSummary struct {
+	Schema      string
+	Name        string
+	Columns     []ColumnSummary
+	PrimaryKey  *KeySummary
+	UniqueKeys  []KeySummary
+	ForeignKeys []ForeignKeySummary
+	Checks      []CheckSummary
+}
+
+// ColumnSummary is the summary of a column definition.
+type ColumnSummary struct {
+	Name     string
+	Tp       *types.FieldType
+	Nullable bool
+	// HasDefault is true when the column has a DEFAULT clause, Default is the text
+	// of its value.
+	HasDefault    bool
+	Default       string
+	AutoIncrement bool
+}
+
+// KeyPartSummary is a part of a key. Length is types.UnspecifiedLength when the
+// key covers the whole column, Expr is the text of the expression of a functional
+// key part.
+type KeyPartSummary struct {
+	Column string
+	Expr   string
+	Length int
+	Desc   bool
+}
+
+// KeySummary is the summary of a primary key or a unique key. Name is empty
+// when the key isn't named.
+type KeySummary struct {
+	Name  string
+	Parts []KeyPartSummary
+}
+
+// ForeignKeySummary is the summary of a foreign key.
+type ForeignKeySummary struct {
+	Name       string
+	Columns    []string
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+	OnDelete   ReferOptionType
+	OnUpdate   ReferOptionType
+}
+
+// CheckSummary is the summary of a check constraint, Expr is the text of its
+// expression.
+type CheckSummary struct {
+	Name     string
+	Expr     string
+	Enforced bool
+}
+
+// SummarizeCreateTable returns the summary of the table created by stmt. It
+// returns ErrMultiplePriKey if more than one primary key is defined.
+func SummarizeCreateTable(stmt *CreateTableStmt) (*TableSummary, error) {
+	s := &TableSummary{}
+	if stmt.Table != nil {
+		s.Schema = stmt.Table.Schema.O
+		s.Name = stmt.Table.Name.O
+	}
+	for _, col := range stmt.Cols {
+		if err := s.addColumn(col); err != nil {
+			return nil, err
+		}
+	}
+	for _, constraint := range stmt.Constraints {
+		if err := s.addConstraint(constraint); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *TableSummary) addColumn(col *ColumnDef) error {
+	c := ColumnSummary{Name: col.Name.Name.O, Tp: col.Tp, Nullable: true}
+	wholeColumn := []KeyPartSummary{{Column: c.Name, Length: types.UnspecifiedLength}}
+	primary := false
+	for _, opt := range col.Options {
+		switch opt.Tp {
+		case ColumnOptionNotNull:
+			c.Nullable = false
+		case ColumnOptionNull:
+			c.Nullable = true
+		case ColumnOptionDefaultValue:
+			text, err := summaryExprText(opt.Expr)
+			if err != nil {
+				return err
+			}
+			c.HasDefault, c.Default = true, text
+		case ColumnOptionAutoIncrement:
+			c.AutoIncrement = true
+		case ColumnOptionPrimaryKey:
+			if err := s.setPrimaryKey(KeySummary{Parts: wholeColumn}); err != nil {
+				return err
+			}
+			primary = true
+		case ColumnOptionUniqKey:
+			s.UniqueKeys = append(s.UniqueKeys, KeySummary{Parts: wholeColumn})
+		case ColumnOptionReference:
+			s.ForeignKeys = append(s.ForeignKeys, summarizeForeignKey("", []string{c.Name}, opt.Refer))
+		case ColumnOptionCheck:
+			text, err := summaryExprText(opt.Expr)
+			if err != nil {
+				return err
+			}
+			s.Checks = append(s.Checks, CheckSummary{Name: opt.ConstraintName, Expr: text, Enforced: opt.Enforced})
+		}
+	}
+	if primary {
+		c.Nullable = false
+	}
+	s.Columns = append(s.Columns, c)
+	return nil
+}
+
+func (s *TableSummary) addConstraint(constraint *Constraint) error {
+	switch constraint.Tp {
+	case ConstraintPrimaryKey:
+		key, err := summarizeKey(constraint.Name, constraint.Keys)
+		if err != nil {
+			return err
+		}
+		return s.setPrimaryKey(key)
+	case ConstraintUniq, ConstraintUniqKey, ConstraintUniqIndex:
+		key, err := summarizeKey(constraint.Name, constraint.Keys)
+		if err != nil {
+			return err
+		}
+		s.UniqueKeys = append(s.UniqueKeys, key)
+	case ConstraintForeignKey:
+		columns := make([]string, 0, len(constraint.Keys))
+		for _, part := range constraint.Keys {
+			if part.Column != nil {
+				columns = append(columns, part.Column.Name.O)
+			}
+		}
+		s.ForeignKeys = append(s.ForeignKeys, summarizeForeignKey(constraint.Name, columns, constraint.Refer))
+	case ConstraintCheck:
+		text, err := summaryExprText(constraint.Expr)
+		if err != nil {
+			return err
+		}
+		s.Checks = append(s.Checks, CheckSummary{Name: constraint.Name, Expr: text, Enforced: constraint.Enforced})
+	}
+	return nil
+}
+
+// setPrimaryKey sets the primary key of s, the columns of the key are not nullable.
+func (s *TableSummary) setPrimaryKey(key KeySummary) error {
+	if s.PrimaryKey != nil {
+		return ErrMultiplePriKey
+	}
+	s.PrimaryKey = &key
+	for _, part := range key.Parts {
+		if part.Column == "" {
+			continue
+		}
+		for i := range s.Columns {
+			if strings.EqualFold(s.Columns[i].Name, part.Column) {
+				s.Columns[i].Nullable = false
+			}
+		}
+	}
+	return nil
+}
+
+func summarizeKey(name string, parts []*IndexPartSpecification) (KeySummary, error) {
+	key := KeySummary{Name: name, Parts: make([]KeyPartSummary, 0, len(parts))}
+	for _, part := range parts {
+		p := KeyPartSummary{Length: part.Length, Desc: part.Desc}
+		if part.Column != nil {
+			p.Column = part.Column.Name.O
+		}
+		if part.Expr != nil {
+			text, err := summaryExprText(part.Expr)
+			if err != nil {
+				return KeySummary{}, err
+			}
+			p.Expr, p.Length = text, types.UnspecifiedLength
+		}
+		key.Parts = append(key.Parts, p)
+	}
+	return key, nil
+}
+
+func summarizeForeignKey(name string, columns []string, refer *ReferenceDef) ForeignKeySummary {
+	fk := ForeignKeySummary{Name: name, Columns: columns}
+	if refer == nil {
+		return fk
+	}
+	if refer.Table != nil {
+		fk.RefSchema = refer.Table.Schema.O
+		fk.RefTable = refer.Table.Name.O
+	}
+	for _, part := range refer.IndexPartSpecifications {
+		if part.Column != nil {
+			fk.RefColumns = append(fk.RefColumns, part.Column.Name.O)
+		}
+	}
+	if refer.OnDelete != nil {
+		fk.OnDelete = refer.OnDelete.ReferOpt
+	}
+	if refer.OnUpdate != nil {
+		fk.OnUpdate = refer.OnUpdate.ReferOpt
+	}
+	return fk
+}
+
+// summaryExprText returns the text of expr written the way SHOW CREATE TABLE does.
+func summaryExprText(expr ExprNode) (string, error) {
+	if expr == nil {
+		return "", nil
+	}
+	var sb strings.Builder
+	flags := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase |
+		format.RestoreNameBackQuotes | format.RestoreStringWithoutCharset
+	if err := expr.Restore(format.NewRestoreCtx(flags, &sb)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/format"
+)
+
+// TextSnapshot is the text of the nodes of a tree at the time SnapshotText was
+// called. It's kept apart from the nodes, so that it isn't changed by the
+// rewrites of the tree.
+type TextSnapshot struct {
+	texts map[Node]string
+}
+
+// Text returns the text of n when the snapshot was taken, or false if n wasn't
+// in the tree, e.g. it's a node added by a rewrite.
+func (s *TextSnapshot) Text(n Node) (string, bool) {
+	text, ok := s.texts[n]
+	return text, ok
+}
+
+// SnapshotText records the text of every node of the tree root, which was
+// parsed from source, e.g. to show a node before and after rewriting the tree,
+// with CurrentText giving the text after.
+//
+// The text of a node is its source text when it's known: the text the parser
+// sets on the statements and on some clauses, and the text of the literals
+// found at their offset in source. The other nodes get their restored text.
+// source is the whole text given to the parser, as the offsets are relative to
+// it; it may be empty if it isn't known.
+func SnapshotText(root Node, source string) *TextSnapshot {
+	s := &TextSnapshot{texts: make(map[Node]string)}
+	root.Accept(&textSnapshotter{snapshot: s, source: source})
+	return s
+}
+
+// CurrentText returns the restored text of node, which reflects the rewrites of
+// its tree, unlike Text. It returns an empty string if node can't be restored.
+func CurrentText(node Node) string {
+	var sb strings.Builder
+	if err := node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return ""
+	}
+	return sb.String()
+}
+
+type textSnapshotter struct {
+	snapshot *TextSnapshot
+	source   string
+}
+
+// Enter implements Visitor interface.
+func (t *textSnapshotter) Enter(in Node) (Node, bool) {
+	text := in.OriginalText()
+	if text == "" {
+		if v, ok := in.(ValueExpr); ok {
+			text = literalSourceText(t.source, v.OriginTextPosition())
+		}
+	}
+	if text == "" {
+		text = CurrentText(in)
+	}
+	t.snapshot.texts[in] = text
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*textSnapshotter) Leave(in Node) (Node, bool) {
+	return in, true
+}
+
+// literalSourceText returns the text of the literal at offset in source: a
+// number, a string, a hexadecimal or bit string, or TRUE, FALSE and NULL. It
+// returns an empty string if there is no such literal at offset.
+func literalSourceText(source string, offset int) string {
+	if offset < 0 || offset >= len(source) {
+		return ""
+	}
+	rest := source[offset:]
+	switch c := rest[0]; {
+	case c == '\'' || c == '"':
+		// The adjacent strings are concatenated into one literal.
+		end := 0
+		for end < len(rest) && (rest[end] == '\'' || rest[end] == '"') {
+			n := quotedLen(rest[end:])
+			if n == 0 {
+				return ""
+			}
+			end += n
+			next := end
+			for next < len(rest) && isSpace(rest[next]) {
+				next++
+			}
+			if next == len(rest) || (rest[next] != '\'' && rest[next] != '"') {
+				break
+			}
+			end = next
+		}
+		return rest[:end]
+	case strings.IndexByte("xXbBnN", c) >= 0 && len(rest) > 1 && rest[1] == '\'':
+		if n := quotedLen(rest[1:]); n > 0 {
+			return rest[:1+n]
+		}
+		return ""
+	case isDigit(c) || c == '.':
+		end := 1
+		for end < len(rest) {
+			c := rest[end]
+			signOfExponent := (c == '+' || c == '-') && (rest[end-1] == 'e' || rest[end-1] == 'E') &&
+				!strings.HasPrefix(rest, "0x") && !strings.HasPrefix(rest, "0X")
+			if !isIdentChar(c) && c != '.' && !signOfExponent {
+				break
+			}
+			end++
+		}
+		return rest[:end]
+	case isLetter(c):
+		end := 1
+		for end < len(rest) && isIdentChar(rest[end]) {
+			end++
+		}
+		switch strings.ToUpper(rest[:end]) {
+		case "TRUE", "FALSE", "NULL":
+			return rest[:end]
+		}
+	}
+	return ""
+}
+
+// quotedLen returns the length of the quoted string at the start of s, with its
+// quotes, or 0 if it isn't terminated.
+func quotedLen(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isLetter(c) || isDigit(c) || c == '_'
+}
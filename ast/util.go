@@ -13,7 +13,15 @@
 
 package ast
 
-import "math"
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/types"
+)
 
 // UnspecifiedSize is unspecified size.
 const (
@@ -102,3 +110,358 @@ func (checker *readOnlyChecker) Enter(in Node) (out Node, skipChildren bool) {
 func (checker *readOnlyChecker) Leave(in Node) (out Node, ok bool) {
 	return in, checker.readOnly
 }
+
+// SystemVariable is a system variable referenced by a statement.
+type SystemVariable struct {
+	// Name is the lower case name of the variable.
+	Name string
+	// IsGlobal indicates whether the global value is referenced, otherwise it's the session value.
+	IsGlobal bool
+}
+
+// VariableUsage holds the variables referenced by a statement, see ExtractVariables.
+// Every list holds each variable once, in the order of their first reference.
+type VariableUsage struct {
+	// UserVarsRead are the lower case names of the user variables read.
+	UserVarsRead []string
+	// UserVarsAssigned are the lower case names of the user variables assigned by
+	// `@a := expr`, SET, SELECT ... INTO @a or LOAD DATA ... (@a).
+	UserVarsAssigned []string
+	// SysVarsRead are the system variables read.
+	SysVarsRead []SystemVariable
+	// SysVarsWritten are the system variables written.
+	SysVarsWritten []SystemVariable
+}
+
+// IsEmpty returns whether the statement references no variable.
+func (u *VariableUsage) IsEmpty() bool {
+	return len(u.UserVarsRead) == 0 && len(u.UserVarsAssigned) == 0 &&
+		len(u.SysVarsRead) == 0 && len(u.SysVarsWritten) == 0
+}
+
+// ExtractVariables returns the user variables and system variables read or written by stmt.
+func ExtractVariables(stmt StmtNode) VariableUsage {
+	var extractor variableExtractor
+	stmt.Accept(&extractor)
+	return extractor.usage
+}
+
+// setNamesVariables are the system variables set by SET NAMES and SET CHARACTER SET.
+var setNamesVariables = []string{"character_set_client", "character_set_results", "character_set_connection", "collation_connection"}
+
+type variableExtractor struct {
+	usage VariableUsage
+}
+
+func appendUserVar(vars []string, name string) []string {
+	name = strings.ToLower(name)
+	if slices.Contains(vars, name) {
+		return vars
+	}
+	return append(vars, name)
+}
+
+func appendSysVar(vars []SystemVariable, name string, isGlobal bool) []SystemVariable {
+	v := SystemVariable{Name: strings.ToLower(name), IsGlobal: isGlobal}
+	if slices.Contains(vars, v) {
+		return vars
+	}
+	return append(vars, v)
+}
+
+// Enter implements Visitor interface.
+func (e *variableExtractor) Enter(in Node) (out Node, skipChildren bool) {
+	switch node := in.(type) {
+	case *VariableExpr:
+		// VariableExpr with a Value is the assignment `@a := expr`.
+		switch {
+		case node.IsSystem && node.Value != nil:
+			e.usage.SysVarsWritten = appendSysVar(e.usage.SysVarsWritten, node.Name, node.IsGlobal)
+		case node.IsSystem:
+			e.usage.SysVarsRead = appendSysVar(e.usage.SysVarsRead, node.Name, node.IsGlobal)
+		case node.Value != nil:
+			e.usage.UserVarsAssigned = appendUserVar(e.usage.UserVarsAssigned, node.Name)
+		default:
+			e.usage.UserVarsRead = appendUserVar(e.usage.UserVarsRead, node.Name)
+		}
+	case *VariableAssignment:
+		switch {
+		case node.Name == SetNames || node.Name == SetCharset:
+			for _, name := range setNamesVariables {
+				e.usage.SysVarsWritten = appendSysVar(e.usage.SysVarsWritten, name, false)
+			}
+		case node.IsSystem:
+			e.usage.SysVarsWritten = appendSysVar(e.usage.SysVarsWritten, node.Name, node.IsGlobal)
+		default:
+			e.usage.UserVarsAssigned = appendUserVar(e.usage.UserVarsAssigned, node.Name)
+		}
+	case *SelectIntoOption:
+		for _, v := range node.Variables {
+			if !v.IsLocal {
+				e.usage.UserVarsAssigned = appendUserVar(e.usage.UserVarsAssigned, v.Name)
+			}
+		}
+		return in, true
+	case *ColumnNameOrUserVar:
+		if node.UserVar != nil {
+			e.usage.UserVarsAssigned = appendUserVar(e.usage.UserVarsAssigned, node.UserVar.Name)
+			return in, true
+		}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*variableExtractor) Leave(in Node) (out Node, ok bool) {
+	return in, true
+}
+
+// FindZeroDateLiterals returns the literals of node annotated with AnnotationZeroDate
+// or AnnotationZeroInDate, in the order they appear in the tree. The parser only
+// annotates the literals when parsing with parser.ZeroDateRecord.
+func FindZeroDateLiterals(node Node) []ValueExpr {
+	f := &zeroDateFinder{}
+	node.Accept(f)
+	return f.literals
+}
+
+type zeroDateFinder struct {
+	literals []ValueExpr
+}
+
+// Enter implements Visitor interface.
+func (f *zeroDateFinder) Enter(in Node) (out Node, skipChildren bool) {
+	if v, ok := in.(ValueExpr); ok {
+		if a, ok := v.(AnnotatedNode); ok && a.HasAnnotation(AnnotationZeroDate|AnnotationZeroInDate) {
+			f.literals = append(f.literals, v)
+		}
+		return in, true
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*zeroDateFinder) Leave(in Node) (out Node, ok bool) {
+	return in, true
+}
+
+// LiteralInfo is a literal of a statement, see ExtractLiterals.
+type LiteralInfo struct {
+	Expr ValueExpr
+	// Kind is the kind of the datum of Expr, one of the parser_driver.Kind
+	// constants, e.g. parser_driver.KindMysqlDecimal for 1.5.
+	Kind byte
+	// Offset is the offset of the literal in the parsed SQL text, the one of
+	// Text when it's located. A literal with a sign like `-1` starts after the
+	// sign, it's an operator. The offset of a literal which isn't located is the
+	// one the parser gives: the one of the sign of a signed default value like
+	// DEFAULT -1, and of the keyword of a temporal literal like DATE
+	// '2025-01-01'.
+	Offset int
+	// End is the offset right after Text in the parsed SQL text.
+	End int
+	// Text is the literal as written, e.g. _utf8mb4'a', 'a' 'b' or DATE
+	// '2025-01-01'. It's empty when the literal isn't located: the literals the
+	// grammar makes up, e.g. the 1 of FETCH FIRST ROWS ONLY, and all the
+	// literals of a statement parsed without the positions, see
+	// parser.ParserConfig.SkipPositionRecording.
+	Text string
+	// Type is the type inferred for the literal by the parser, e.g.
+	// mysql.TypeDate for DATE '2025-01-01', whose Expr is the string.
+	Type *types.FieldType
+	// ParamAllowed tells whether a parameter marker may replace the literal in a
+	// prepared statement.
+	ParamAllowed bool
+	// Context is the description of the rule of literalContextRules classifying
+	// the literal.
+	Context string
+}
+
+// literalContextRule classifies the literals having an ancestor matched by match,
+// child is the node under ancestor on the path to the literal.
+type literalContextRule struct {
+	context      string
+	paramAllowed bool
+	match        func(ancestor, child Node) bool
+}
+
+// literalContextRules classify the context of a literal. The rules are tried in
+// order, the first one matching an ancestor of the literal decides. A literal
+// matching no rule doesn't allow a parameter. Lengths such as the ones of index
+// parts and of string types aren't literal nodes, nor are the positions of ORDER
+// BY 1 and GROUP BY 1, which are PositionExprs, so they are never extracted.
+var literalContextRules = []literalContextRule{
+	// Column defaults, generated columns and checks are stored with the table.
+	{"column option", false, func(ancestor, _ Node) bool {
+		_, ok := ancestor.(*ColumnOption)
+		return ok
+	}},
+	{"DDL statement", false, func(ancestor, _ Node) bool {
+		_, ok := ancestor.(DDLNode)
+		return ok
+	}},
+	// These statements implement DMLNode but can't be prepared with parameters.
+	{"SHOW or LOAD statement", false, func(ancestor, _ Node) bool {
+		switch ancestor.(type) {
+		case *ShowStmt, *LoadDataStmt, *ImportIntoStmt:
+			return true
+		}
+		return false
+	}},
+	{"LIMIT", true, func(ancestor, _ Node) bool {
+		_, ok := ancestor.(*Limit)
+		return ok
+	}},
+	{"IN list", true, func(ancestor, child Node) bool {
+		in, ok := ancestor.(*PatternInExpr)
+		return ok && slices.ContainsFunc(in.List, func(e ExprNode) bool { return Node(e) == child })
+	}},
+	{"DML statement", true, func(ancestor, _ Node) bool {
+		_, ok := ancestor.(DMLNode)
+		return ok
+	}},
+	{"SET or DO statement", true, func(ancestor, _ Node) bool {
+		switch ancestor.(type) {
+		case *SetStmt, *DoStmt:
+			return true
+		}
+		return false
+	}},
+}
+
+// LocateLiterals locates the literals of stmt in its text, it's provided by the
+// parser, see RegisterDriver. It sets the Offset, the End and the Text of the
+// literals it finds, and returns the offset of the text of stmt in the parsed
+// SQL text.
+var LocateLiterals func(stmt StmtNode, literals []LiteralInfo) int
+
+// ExtractLiterals returns the literals of stmt in the order they appear in the
+// tree, with whether a parameter marker may replace each of them, which is how
+// an ad-hoc statement is turned into a prepared statement.
+func ExtractLiterals(stmt StmtNode) []LiteralInfo {
+	literals, _ := extractLiterals(stmt)
+	return literals
+}
+
+// ReplaceLiteralsWithMarkers returns the text of stmt with the literals a
+// parameter marker may replace, see LiteralInfo.ParamAllowed, replaced with ?,
+// and their values in order, e.g. "SELECT * FROM t WHERE a = ? LIMIT ?" and
+// [1 10] for SELECT * FROM t WHERE a = 1 LIMIT 10. The other literals, e.g. the
+// default values of a CREATE TABLE, and the ones which aren't located are kept.
+func ReplaceLiteralsWithMarkers(stmt StmtNode) (string, []any) {
+	literals, base := extractLiterals(stmt)
+	literals = slices.DeleteFunc(literals, func(l LiteralInfo) bool {
+		return !l.ParamAllowed || l.Text == ""
+	})
+	text := stmt.OriginalText()
+	if len(literals) == 0 {
+		return text, nil
+	}
+	slices.SortFunc(literals, func(a, b LiteralInfo) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+	var sb strings.Builder
+	values := make([]any, 0, len(literals))
+	last := 0
+	for _, l := range literals {
+		sb.WriteString(text[last : l.Offset-base])
+		sb.WriteByte('?')
+		last = l.End - base
+		values = append(values, l.Expr.GetValue())
+	}
+	sb.WriteString(text[last:])
+	return sb.String(), values
+}
+
+// extractLiterals returns the literals of stmt, and the offset of its text in
+// the parsed SQL text.
+func extractLiterals(stmt StmtNode) ([]LiteralInfo, int) {
+	e := &literalExtractor{}
+	stmt.Accept(e)
+	base := 0
+	if LocateLiterals != nil {
+		base = LocateLiterals(stmt, e.literals)
+	}
+	return e.literals, base
+}
+
+type literalExtractor struct {
+	// path is the ancestors of the node being visited.
+	path     []Node
+	literals []LiteralInfo
+}
+
+// Enter implements Visitor interface.
+func (e *literalExtractor) Enter(in Node) (out Node, skipChildren bool) {
+	if v, ok := isLiteral(in); ok {
+		info := LiteralInfo{Expr: v, Offset: v.OriginTextPosition(), Type: v.GetType()}
+		if d, ok := v.(interface{ Kind() byte }); ok {
+			info.Kind = d.Kind()
+		}
+		if len(e.path) > 1 {
+			e.locateInParent(&info)
+		}
+		info.ParamAllowed, info.Context = e.classify(in)
+		e.literals = append(e.literals, info)
+		return in, true
+	}
+	e.path = append(e.path, in)
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (e *literalExtractor) Leave(in Node) (out Node, ok bool) {
+	if _, ok := isLiteral(in); !ok {
+		e.path = e.path[:len(e.path)-1]
+	}
+	return in, true
+}
+
+// isLiteral returns the ValueExpr of in if it's a literal, not a parameter marker.
+func isLiteral(in Node) (ValueExpr, bool) {
+	if _, ok := in.(ParamMarkerExpr); ok {
+		return nil, false
+	}
+	v, ok := in.(ValueExpr)
+	return v, ok
+}
+
+// locateInParent sets the offset and the type of the literals made by the rule
+// of their parent, which the parser doesn't locate.
+func (e *literalExtractor) locateInParent(info *LiteralInfo) {
+	switch parent := e.path[len(e.path)-1].(type) {
+	case *FuncCallExpr:
+		var tp byte
+		switch parent.FnName.L {
+		case DateLiteral:
+			tp = mysql.TypeDate
+		case TimeLiteral:
+			tp = mysql.TypeDuration
+		case TimestampLiteral:
+			tp = mysql.TypeDatetime
+		default:
+			return
+		}
+		info.Offset = parent.OriginTextPosition()
+		info.Type = types.NewFieldType(tp)
+	case *UnaryOperationExpr:
+		// The number of a signed literal like the one of DEFAULT -1 has the
+		// offset of the statement, or 0.
+		if info.Offset <= e.path[0].OriginTextPosition() {
+			info.Offset = parent.OriginTextPosition()
+		}
+	}
+}
+
+func (e *literalExtractor) classify(literal Node) (paramAllowed bool, context string) {
+	for _, rule := range literalContextRules {
+		child := literal
+		for i := len(e.path) - 1; i >= 0; i-- {
+			if rule.match(e.path[i], child) {
+				return rule.paramAllowed, rule.context
+			}
+			child = e.path[i]
+		}
+	}
+	return false, "statement"
+}
@@ -22,6 +22,7 @@ import (
 	. "github.com/abbychau/mysql-parser/ast"
 	. "github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/parser_driver"
 	"github.com/abbychau/mysql-parser/test_driver"
 	"github.com/stretchr/testify/require"
 )
@@ -117,6 +118,178 @@ func TestUnionReadOnly(t *testing.T) {
 	require.False(t, IsReadOnly(setOprStmt, true))
 }
 
+func TestExtractVariables(t *testing.T) {
+	p := parser.New()
+	for _, tc := range []struct {
+		src   string
+		usage VariableUsage
+	}{
+		{"select 1 from t", VariableUsage{}},
+		{"select @a, @@global.Max_Connections, @@SQL_MODE, @A", VariableUsage{
+			UserVarsRead: []string{"a"},
+			SysVarsRead:  []SystemVariable{{Name: "max_connections", IsGlobal: true}, {Name: "sql_mode"}},
+		}},
+		{"select @a := @b + 1", VariableUsage{
+			UserVarsRead:     []string{"b"},
+			UserVarsAssigned: []string{"a"},
+		}},
+		{"set @a = @a + 1, @@session.sql_mode = '', global max_connections = 10", VariableUsage{
+			UserVarsRead:     []string{"a"},
+			UserVarsAssigned: []string{"a"},
+			SysVarsWritten:   []SystemVariable{{Name: "sql_mode"}, {Name: "max_connections", IsGlobal: true}},
+		}},
+		{"set names utf8mb4", VariableUsage{
+			SysVarsWritten: []SystemVariable{{Name: "character_set_client"}, {Name: "character_set_results"}, {Name: "character_set_connection"}, {Name: "collation_connection"}},
+		}},
+		{"select a, b from t into @x, @y", VariableUsage{
+			UserVarsAssigned: []string{"x", "y"},
+		}},
+		{"load data infile '/tmp/t.csv' into table t (a, @b) set c = @b * 2", VariableUsage{
+			UserVarsRead:     []string{"b"},
+			UserVarsAssigned: []string{"b"},
+		}},
+		{"set @a = (@b := @c + @@global.max_connections), @@session.autocommit = @a", VariableUsage{
+			UserVarsRead:     []string{"c", "a"},
+			UserVarsAssigned: []string{"a", "b"},
+			SysVarsRead:      []SystemVariable{{Name: "max_connections", IsGlobal: true}},
+			SysVarsWritten:   []SystemVariable{{Name: "autocommit"}},
+		}},
+		{"select @a := @@time_zone, @b from t where c > @b into @c, @a", VariableUsage{
+			UserVarsRead:     []string{"b"},
+			UserVarsAssigned: []string{"a", "c"},
+			SysVarsRead:      []SystemVariable{{Name: "time_zone"}},
+		}},
+	} {
+		stmt, err := p.ParseOneStmt(tc.src, "", "")
+		require.NoError(t, err, tc.src)
+		usage := ExtractVariables(stmt)
+		require.Equal(t, tc.usage, usage, tc.src)
+		require.Equal(t, tc.usage.IsEmpty(), usage.IsEmpty(), tc.src)
+	}
+}
+
+func TestExtractLiterals(t *testing.T) {
+	p := parser.New()
+	type literal struct {
+		text         string
+		paramAllowed bool
+		tp           byte
+	}
+	for _, tc := range []struct {
+		src      string
+		literals []literal
+	}{
+		{"SELECT 1, 'a' FROM t WHERE b > 2.5 AND c IN (3, 'x') ORDER BY 1 LIMIT 10 OFFSET 20", []literal{
+			{"1", true, mysql.TypeLonglong},
+			{"'a'", true, mysql.TypeVarString},
+			{"2.5", true, mysql.TypeNewDecimal},
+			{"3", true, mysql.TypeLonglong},
+			{"'x'", true, mysql.TypeVarString},
+			{"10", true, mysql.TypeLonglong},
+			{"20", true, mysql.TypeLonglong},
+		}},
+		{"UPDATE t SET a = -1 WHERE b = ? LIMIT 5", []literal{
+			{"1", true, mysql.TypeLonglong},
+			{"5", true, mysql.TypeLonglong},
+		}},
+		{"CREATE TABLE t (a INT DEFAULT 7, b VARCHAR(20) DEFAULT 'v', c INT CHECK (c IN (1, 2)), KEY (b(10)))", []literal{
+			{"7", false, mysql.TypeLonglong},
+			{"'v'", false, mysql.TypeVarString},
+			{"1", false, mysql.TypeLonglong},
+			{"2", false, mysql.TypeLonglong},
+		}},
+		{"SHOW TABLES LIKE 't%'", []literal{
+			{"'t%'", false, mysql.TypeVarString},
+		}},
+		{"INSERT INTO t VALUES (DATE '2025-01-01', TIME '10:00', {ts '2025-01-01 00:00:00'})", []literal{
+			{"DATE '2025-01-01'", true, mysql.TypeDate},
+			{"TIME '10:00'", true, mysql.TypeDuration},
+			{"{ts '2025-01-01 00:00:00'}", true, mysql.TypeDatetime},
+		}},
+		{"CREATE TABLE t (a INT DEFAULT -1, b INT DEFAULT (1))", []literal{
+			{"1", false, mysql.TypeLonglong},
+			{"1", false, mysql.TypeLonglong},
+		}},
+		{"SELECT ABS(-1), 'a' 'b', _utf8mb4'x', N'y', 0x1F, b'101', 2e3, TRUE, NULL FROM t WHERE a = CASE WHEN c THEN 'p' ELSE 'q' END", []literal{
+			{"1", true, mysql.TypeLonglong},
+			{"'a' 'b'", true, mysql.TypeVarString},
+			{"_utf8mb4'x'", true, mysql.TypeVarString},
+			{"N'y'", true, mysql.TypeVarString},
+			{"0x1F", true, mysql.TypeVarString},
+			{"b'101'", true, mysql.TypeVarString},
+			{"2e3", true, mysql.TypeDouble},
+			{"TRUE", true, mysql.TypeLonglong},
+			{"NULL", true, mysql.TypeNull},
+			{"'p'", true, mysql.TypeVarString},
+			{"'q'", true, mysql.TypeVarString},
+		}},
+	} {
+		stmt, err := p.ParseOneStmt(tc.src, "", "")
+		require.NoError(t, err, tc.src)
+		literals := ExtractLiterals(stmt)
+		require.Len(t, literals, len(tc.literals), tc.src)
+		for i, l := range literals {
+			comment := fmt.Sprintf("%s: literal %d", tc.src, i)
+			require.Equal(t, tc.literals[i].text, l.Text, comment)
+			require.Equal(t, l.Text, tc.src[l.Offset:l.End], comment)
+			require.Equal(t, tc.literals[i].paramAllowed, l.ParamAllowed, comment)
+			require.Equal(t, tc.literals[i].tp, l.Type.GetType(), comment)
+			require.NotEmpty(t, l.Context, comment)
+		}
+	}
+}
+
+func TestExtractLiteralsKind(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("SELECT 1, 'a', 1.5, x'01' FROM t LIMIT 5", "", "")
+	require.NoError(t, err)
+	literals := ExtractLiterals(stmt)
+	require.Len(t, literals, 5)
+	for i, kind := range []byte{parser_driver.KindInt64, parser_driver.KindString, parser_driver.KindMysqlDecimal, parser_driver.KindBinaryLiteral, parser_driver.KindUint64} {
+		require.Equal(t, kind, literals[i].Kind, literals[i].Text)
+	}
+
+	// The offsets are the ones of the SQL text parsed.
+	sql := "SELECT 1; /* c */ SELECT 'a', 2 FROM t"
+	stmts, _, err := p.Parse(sql, "", "")
+	require.NoError(t, err)
+	literals = ExtractLiterals(stmts[1])
+	require.Len(t, literals, 2)
+	require.Equal(t, strings.Index(sql, "'a'"), literals[0].Offset)
+	require.Equal(t, "2", sql[literals[1].Offset:literals[1].End])
+
+	// The literals aren't located without the positions.
+	p.SetParserConfig(parser.ParserConfig{SkipPositionRecording: true})
+	stmt, err = p.ParseOneStmt("SELECT 1 FROM t", "", "")
+	require.NoError(t, err)
+	literals = ExtractLiterals(stmt)
+	require.Len(t, literals, 1)
+	require.Empty(t, literals[0].Text)
+}
+
+func TestReplaceLiteralsWithMarkers(t *testing.T) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("SELECT * FROM t WHERE a = 1 AND b IN ('x', _latin1'y') LIMIT 20, 10", "", "")
+	require.NoError(t, err)
+	sql, values := ReplaceLiteralsWithMarkers(stmt)
+	require.Equal(t, "SELECT * FROM t WHERE a = ? AND b IN (?, ?) LIMIT ?, ?", sql)
+	require.Equal(t, []any{int64(1), "x", "y", uint64(20), uint64(10)}, values)
+
+	// The result is a statement with the markers.
+	stmt, err = p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	require.Empty(t, ExtractLiterals(stmt))
+
+	// The literals a marker can't replace are kept.
+	for _, sql := range []string{"SELECT a FROM t ORDER BY 1", "CREATE TABLE t (a INT DEFAULT 1)"} {
+		stmt, err = p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		text, values := ReplaceLiteralsWithMarkers(stmt)
+		require.Equal(t, sql, text)
+		require.Nil(t, values)
+	}
+}
+
 // CleanNodeText set the text of node and all child node empty.
 // For test only.
 func CleanNodeText(node Node) {
@@ -133,6 +306,9 @@ type nodeTextCleaner struct {
 func (checker *nodeTextCleaner) Enter(in Node) (out Node, skipChildren bool) {
 	in.SetText(nil, "")
 	in.SetOriginTextPosition(0)
+	if stmt, ok := in.(StmtNode); ok {
+		stmt.SetEndPosition(0)
+	}
 	if v, ok := in.(ValueExpr); ok && v != nil {
 		tpFlag := v.GetType().GetFlag()
 		if tpFlag&mysql.UnderScoreCharsetFlag != 0 {
@@ -143,6 +319,15 @@ func (checker *nodeTextCleaner) Enter(in Node) (out Node, skipChildren bool) {
 	}
 
 	switch node := in.(type) {
+	case *ColumnOption:
+		// The Accept of the nodes doesn't visit their table names.
+		if node.Refer != nil {
+			node.Refer.Accept(checker)
+		}
+	case *OptimizeTableStmt:
+		for _, tn := range node.Tables {
+			tn.SetOriginTextPosition(0)
+		}
 	case *Constraint:
 		if node.Option != nil {
 			if node.Option.KeyBlockSize == 0x0 && node.Option.Tp == 0 && node.Option.Comment == "" {
@@ -229,3 +414,275 @@ func runNodeRestoreTestWithFlagsStmtChange(t *testing.T, nodeTestCases []NodeRes
 		require.Equal(t, expectSQL, restoreSql, comment)
 	}
 }
+
+type valueExprFinder struct {
+	values []ValueExpr
+}
+
+func (f *valueExprFinder) Enter(in Node) (Node, bool) {
+	if v, ok := in.(ValueExpr); ok {
+		f.values = append(f.values, v)
+	}
+	return in, false
+}
+
+func (*valueExprFinder) Leave(in Node) (Node, bool) {
+	return in, true
+}
+
+// stringReplacer replaces the string literals by new literals of the same value
+// upper cased.
+type stringReplacer struct{}
+
+func (stringReplacer) Enter(in Node) (Node, bool) {
+	return in, false
+}
+
+func (stringReplacer) Leave(in Node) (Node, bool) {
+	if v, ok := in.(ValueExpr); ok {
+		if s, ok := v.GetValue().(string); ok {
+			return NewValueExpr(strings.ToUpper(s), mysql.DefaultCharset, mysql.DefaultCollationName), true
+		}
+	}
+	return in, true
+}
+
+func TestSnapshotText(t *testing.T) {
+	sql := "SELECT a FROM t WHERE b > 0x0A  AND c = 'it''s' 'ok' AND d < 1.50; SELECT 1"
+	stmts, _, err := parser.New().Parse(sql, "", "")
+	require.NoError(t, err)
+	stmt := stmts[0]
+	f := &valueExprFinder{}
+	stmt.Accept(f)
+	require.Len(t, f.values, 3)
+	hex, str, dec := f.values[0], f.values[1], f.values[2]
+
+	snapshot := SnapshotText(stmt, sql)
+	for _, c := range []struct {
+		node Node
+		text string
+	}{
+		{stmt, "SELECT a FROM t WHERE b > 0x0A  AND c = 'it''s' 'ok' AND d < 1.50;"},
+		{hex, "0x0A"},
+		{str, "'it''s' 'ok'"},
+		{dec, "1.50"},
+	} {
+		text, ok := snapshot.Text(c.node)
+		require.True(t, ok)
+		require.Equal(t, c.text, text)
+	}
+	where, ok := snapshot.Text(stmt.(*SelectStmt).Where)
+	require.True(t, ok)
+	require.Equal(t, CurrentText(stmt.(*SelectStmt).Where), where)
+
+	// Rewrite a literal in place, and replace another one by a new node.
+	dec.SetValue(int64(2))
+	stmt.Accept(stringReplacer{})
+	newStr := stmt.(*SelectStmt).Where.(*BinaryOperationExpr).L.(*BinaryOperationExpr).R.(*BinaryOperationExpr).R
+
+	text, ok := snapshot.Text(dec)
+	require.True(t, ok)
+	require.Equal(t, "1.50", text)
+	require.Equal(t, "2", CurrentText(dec))
+	text, ok = snapshot.Text(stmt)
+	require.True(t, ok)
+	require.Equal(t, "SELECT a FROM t WHERE b > 0x0A  AND c = 'it''s' 'ok' AND d < 1.50;", text)
+	require.Equal(t, "SELECT `a` FROM `t` WHERE `b`>x'0a' AND `c`=_UTF8MB4'IT''SOK' AND `d`<2", CurrentText(stmt))
+	_, ok = snapshot.Text(newStr)
+	require.False(t, ok)
+	require.Equal(t, "_UTF8MB4'IT''SOK'", CurrentText(newStr))
+}
+
+func TestExtractTables(t *testing.T) {
+	table := func(schema, name string, isDefault bool) TableReference {
+		return TableReference{Schema: NewCIStr(schema), Name: NewCIStr(name), SchemaIsDefault: isDefault}
+	}
+	cases := []struct {
+		sql    string
+		tables []TableReference
+	}{
+		{"select * from t", []TableReference{table("db", "t", true)}},
+		{"select * from s.t, t", []TableReference{table("s", "t", false), table("db", "t", true)}},
+		{"select * from T join t on T.a = t.a where t.b in (select b from u)", []TableReference{table("db", "T", true), table("db", "u", true)}},
+		{"insert into s.t select * from t", []TableReference{table("db", "t", true), table("s", "t", false)}},
+		{"update t, s.u set t.a = u.a", []TableReference{table("db", "t", true), table("s", "u", false)}},
+		{"delete t from t join u using (a)", []TableReference{table("db", "t", true), table("db", "u", true)}},
+		{"with cte as (select * from t) select * from cte join s.cte", []TableReference{table("db", "t", true), table("s", "cte", false)}},
+		{"create table t like s.u", []TableReference{table("db", "t", true), table("s", "u", false)}},
+		{"select 1", nil},
+	}
+	p := parser.New()
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.tables, ExtractTables(stmt, "db"), c.sql)
+	}
+
+	// Without default schema, the tables the statement doesn't qualify have no schema.
+	stmt, err := p.ParseOneStmt("select * from t", "", "")
+	require.NoError(t, err)
+	require.Equal(t, []TableReference{table("", "t", true)}, ExtractTables(stmt, ""))
+	// The statement is left unchanged.
+	var sb strings.Builder
+	require.NoError(t, stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+	require.Equal(t, "SELECT * FROM `t`", sb.String())
+}
+
+func TestRewriteTableNames(t *testing.T) {
+	cases := []struct {
+		sql      string
+		expected string
+		count    int
+	}{
+		{"select * from orders", "select * from t_orders", 1},
+		{"select orders.id, s.orders.a from s.orders join items i on orders.id = i.oid",
+			"select t_orders.id, s.t_orders.a from s.t_orders join t_items i on t_orders.id = i.oid", 2},
+		{"select * from (select * from orders) as d where exists (select 1 from items where items.id = d.id)",
+			"select * from (select * from t_orders) as d where exists (select 1 from t_items where t_items.id = d.id)", 2},
+		{"update orders join items on orders.id = items.oid set orders.a = 1 where orders.b in (select b from refs)",
+			"update t_orders join t_items on t_orders.id = t_items.oid set t_orders.a = 1 where t_orders.b in (select b from t_refs)", 3},
+		{"delete o, items from orders as o join items using (a)", "delete o, t_items from t_orders as o join t_items using (a)", 3},
+		{"delete from orders where a = 1", "delete from t_orders where a = 1", 1},
+		{"insert into orders (a) select a from items on duplicate key update a = (select max(a) from refs)",
+			"insert into t_orders (a) select a from t_items on duplicate key update a = (select max(a) from t_refs)", 3},
+		// The common table expressions shadow the tables, but not in their own
+		// definition unless they are recursive.
+		{"with orders as (select * from orders) select * from orders join s.orders",
+			"with orders as (select * from t_orders) select * from orders join s.t_orders", 2},
+		{"with a as (select 1), b as (select * from a join c) select * from b, (with c as (select 2) select * from c) as d",
+			"with a as (select 1), b as (select * from a join t_c) select * from b, (with c as (select 2) select * from c) as d", 1},
+		{"with recursive r (n) as (select 1 union all select n + 1 from r where n < 5) select * from r join items",
+			"with recursive r (n) as (select 1 union all select n + 1 from r where n < 5) select * from r join t_items", 1},
+		{"select 1", "select 1", 0},
+	}
+	p := parser.New()
+	restore := func(stmt StmtNode) string {
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+		return sb.String()
+	}
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		var called []string
+		count := RewriteTableNames(stmt, func(schema, table string) (string, string) {
+			called = append(called, schema+"."+table)
+			return schema, "t_" + table
+		})
+		require.Equal(t, c.count, count, c.sql)
+		require.Len(t, called, c.count, c.sql)
+		expected, err := p.ParseOneStmt(c.expected, "", "")
+		require.NoError(t, err, c.expected)
+		require.Equal(t, restore(expected), restore(stmt), c.sql)
+	}
+
+	// The names left unchanged aren't counted, the schema can be changed too.
+	stmt, err := p.ParseOneStmt("select orders.a, items.b from orders, items", "", "")
+	require.NoError(t, err)
+	count := RewriteTableNames(stmt, func(schema, table string) (string, string) {
+		if table == "orders" {
+			return "tenant_42", table
+		}
+		return schema, table
+	})
+	require.Equal(t, 1, count)
+	require.Equal(t, "SELECT `orders`.`a`,`items`.`b` FROM (`tenant_42`.`orders`) JOIN `items`", restore(stmt))
+}
+
+func TestQualifyTables(t *testing.T) {
+	cases := []struct {
+		sql      string
+		expected string
+	}{
+		// The common table expressions aren't tables.
+		{"with t as (select 1) select * from t, real_t", "with t as (select 1) select * from t, db.real_t"},
+		{"with orders as (select * from orders) select * from orders join s.orders",
+			"with orders as (select * from db.orders) select * from orders join s.orders"},
+		{"with recursive r (n) as (select 1 union all select n + 1 from r where n < 5) select * from r join items",
+			"with recursive r (n) as (select 1 union all select n + 1 from r where n < 5) select * from r join db.items"},
+		{"select * from (select * from orders) as d where exists (select 1 from items where items.id = d.id)",
+			"select * from (select * from db.orders) as d where exists (select 1 from db.items where items.id = d.id)"},
+		{"insert into orders (a) select a from s.items", "insert into db.orders (a) select a from s.items"},
+		{"update orders, items set orders.a = items.a", "update db.orders, db.items set orders.a = items.a"},
+		// The aliases of a DELETE list aren't tables, its other names are the
+		// tables of the DELETE.
+		{"delete o, items from orders as o join items using (a)", "delete o, db.items from db.orders as o join db.items using (a)"},
+		{"delete t from s.t join u on t.a = u.a", "delete s.t from s.t join db.u on t.a = u.a"},
+		{"create table t like u", "create table db.t like db.u"},
+		{"create view v as select * from t", "create view db.v as select * from db.t"},
+		{"alter table t rename to u", "alter table db.t rename to db.u"},
+		{"drop table t, s.u", "drop table db.t, s.u"},
+		{"show columns from t from s", "show columns from s.t from s"},
+		{"select 1", "select 1"},
+	}
+	p := parser.New()
+	restore := func(stmt StmtNode) string {
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+		return sb.String()
+	}
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.NoError(t, QualifyTables(stmt, "db"), c.sql)
+		expected, err := p.ParseOneStmt(c.expected, "", "")
+		require.NoError(t, err, c.expected)
+		require.Equal(t, restore(expected), restore(stmt), c.sql)
+	}
+
+	// The names which can't be qualified are reported, the other ones are
+	// qualified anyway.
+	stmt, err := p.ParseOneStmt("delete t, u from s1.t join s2.t join v", "", "")
+	require.NoError(t, err)
+	err = QualifyTables(stmt, "db")
+	var unqualified *UnqualifiedTablesError
+	require.ErrorAs(t, err, &unqualified)
+	require.Len(t, unqualified.Tables, 2)
+	require.Equal(t, 7, unqualified.Tables[0].OriginTextPosition())
+	require.Equal(t, 10, unqualified.Tables[1].OriginTextPosition())
+	require.Equal(t, "can't qualify the tables `t` at offset 7, `u` at offset 10", err.Error())
+	require.Equal(t, "DELETE `t`,`u` FROM (`s1`.`t` JOIN `s2`.`t`) JOIN `db`.`v`", restore(stmt))
+
+	stmt, err = p.ParseOneStmt("with c as (select 1) select * from c, t", "", "")
+	require.NoError(t, err)
+	err = QualifyTables(stmt, "")
+	require.EqualError(t, err, "can't qualify the tables `t` at offset 38")
+
+	// The offsets are in the whole SQL text parsed.
+	stmts, _, err := p.Parse("select 1; select * from s.t, t", "", "")
+	require.NoError(t, err)
+	err = QualifyTables(stmts[1], "")
+	require.EqualError(t, err, "can't qualify the tables `t` at offset 29")
+}
+
+func TestExtractColumnsWithTables(t *testing.T) {
+	col := func(schema, table, name string) ColumnRef {
+		return ColumnRef{Schema: NewCIStr(schema), Table: NewCIStr(table), Column: NewCIStr(name)}
+	}
+	ambiguous := func(name string) ColumnRef {
+		return ColumnRef{Column: NewCIStr(name), Ambiguous: true}
+	}
+	cases := []struct {
+		sql     string
+		columns []ColumnRef
+	}{
+		{"select a, b from t where c = 1", []ColumnRef{col("", "t", "a"), col("", "t", "b"), col("", "t", "c")}},
+		{"select x.a, db.t.b, t.c from db.t as x", []ColumnRef{col("db", "x", "a"), col("db", "t", "b"), col("", "t", "c")}},
+		{"select t.a, u.b, c from db.t join u on t.id = u.id", []ColumnRef{col("db", "t", "a"), col("", "u", "b"), ambiguous("c"), col("db", "t", "id"), col("", "u", "id")}},
+		{"select db.t.a from db.t, other.t", []ColumnRef{col("db", "t", "a")}},
+		{"select d.a, b from (select a, t.b from t) d", []ColumnRef{col("", "d", "a"), col("", "d", "b"), col("", "t", "a"), col("", "t", "b")}},
+		{"select a from t where b in (select c from u where u.d = t.e)", []ColumnRef{col("", "t", "a"), col("", "t", "b"), col("", "u", "c"), col("", "u", "d"), col("", "t", "e")}},
+		{"select a from (t1 join t2) left join t3 on t1.x = t3.x", []ColumnRef{ambiguous("a"), col("", "t1", "x"), col("", "t3", "x")}},
+		{"select a, z.b from t", []ColumnRef{col("", "t", "a"), col("", "z", "b")}},
+		{"select a", []ColumnRef{col("", "", "a")}},
+		{"insert into db.t (a, b) select c from u", []ColumnRef{col("", "u", "c"), col("db", "t", "a"), col("db", "t", "b")}},
+		{"update t as x set a = 1 where x.b = 2", []ColumnRef{col("", "x", "a"), col("", "x", "b")}},
+		{"delete from t where a = 1", []ColumnRef{col("", "t", "a")}},
+	}
+	p := parser.New()
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.columns, ExtractColumnsWithTables(stmt), c.sql)
+	}
+}
@@ -14,7 +14,14 @@
 package parser
 
 import (
+	"bytes"
+	"fmt"
+	goio "io"
+	"runtime"
+	"strings"
 	"testing"
+
+	myformat "github.com/abbychau/mysql-parser/format"
 )
 
 func BenchmarkSysbenchSelect(b *testing.B) {
@@ -64,3 +71,249 @@ func BenchmarkParseSimple(b *testing.B) {
 	}
 	b.ReportAllocs()
 }
+
+func BenchmarkParseSimpleSelect(b *testing.B) {
+	parser := New()
+	sql := "SELECT id, name, created_at FROM users WHERE status = 'active' AND age > 18 ORDER BY created_at DESC LIMIT 10"
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, err := parser.Parse(sql, "", "")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportAllocs()
+}
+
+func BenchmarkParseWideCreateTable(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE wide (id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY")
+	for i := range 200 {
+		fmt.Fprintf(&sb, ", col_%d VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'column %d'", i, i)
+	}
+	sb.WriteString(", KEY idx_a (col_0, col_1), UNIQUE KEY uk_b (col_2)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4")
+	sql := sb.String()
+	parser := New()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, err := parser.Parse(sql, "", "")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportAllocs()
+}
+
+func BenchmarkParseProgressHook(b *testing.B) {
+	var sb strings.Builder
+	for i := range 1000 {
+		fmt.Fprintf(&sb, "INSERT INTO t VALUES (%d, 'row %d');\n", i, i)
+	}
+	sql := sb.String()
+	for _, hook := range []struct {
+		name string
+		hook ProgressHook
+	}{
+		{"nil", nil},
+		{"counting", func(int, int64) {}},
+	} {
+		b.Run(hook.name, func(b *testing.B) {
+			parser := New()
+			parser.SetProgressHook(hook.hook)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := parser.Parse(sql, "", ""); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSniffStatementType(b *testing.B) {
+	var table = []string{
+		"SELECT pad FROM sbtest1 WHERE id=1",
+		"INSERT INTO t VALUES (1, 'a'), (2, 'b')",
+		"UPDATE t SET a = 1 WHERE id = 2",
+		"DELETE FROM t WHERE id = 3",
+		"BEGIN",
+		"SET autocommit = 1",
+	}
+	b.Run("sniff", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, v := range table {
+				if _, err := SniffStatementType(v); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("parse", func(b *testing.B) {
+		parser := New()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, v := range table {
+				if _, err := parser.ParseOneStmt(v, "", ""); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+// BenchmarkSplitAndClassify compares SplitAndClassify with ParseSQL on a
+// mysqldump-like text, the split is expected to be more than 5x faster.
+func BenchmarkSplitAndClassify(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("-- MySQL dump\n/*!40101 SET NAMES utf8mb4 */;\nSET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0;\n")
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(&sb, "DROP TABLE IF EXISTS `t%d`;\n", i)
+		fmt.Fprintf(&sb, "CREATE TABLE `t%d` (\n  `id` bigint NOT NULL AUTO_INCREMENT,\n  `name` varchar(64) DEFAULT NULL,\n"+
+			"  `score` decimal(10,2) DEFAULT '0.00',\n  PRIMARY KEY (`id`),\n  KEY `idx_name` (`name`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n", i)
+		fmt.Fprintf(&sb, "LOCK TABLES `t%d` WRITE;\nINSERT INTO `t%d` VALUES ", i, i)
+		for j := 0; j < 50; j++ {
+			if j > 0 {
+				sb.WriteString(",")
+			}
+			fmt.Fprintf(&sb, "(%d,'name;%d',%d.%02d)", j, j, j*7, j%100)
+		}
+		sb.WriteString(";\nUNLOCK TABLES;\n")
+	}
+	sql := sb.String()
+
+	b.Run("split", func(b *testing.B) {
+		b.SetBytes(int64(len(sql)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := SplitAndClassify(sql); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("parse", func(b *testing.B) {
+		parser := New()
+		b.SetBytes(int64(len(sql)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := parser.ParseSQL(sql); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// dumpReader generates a mysqldump-like text of n INSERT statements without
+// holding it.
+type dumpReader struct {
+	n, i int
+	line []byte
+	pos  int
+}
+
+func (r *dumpReader) Read(p []byte) (int, error) {
+	if r.pos == len(r.line) {
+		if r.i == r.n {
+			return 0, goio.EOF
+		}
+		r.line = fmt.Appendf(r.line[:0], "INSERT INTO `t` VALUES (%d,'name;%d',%d.50,NULL),(%d,'row %d',0.00,'x');\n", r.i, r.i, r.i, r.i+1, r.i)
+		r.pos = 0
+		r.i++
+	}
+	n := copy(p, r.line[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// heapGrowth returns the larger of peak and the bytes in use of the heap above
+// base.
+func heapGrowth(base, peak uint64) uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	if stats.HeapInuse > base && stats.HeapInuse-base > peak {
+		return stats.HeapInuse - base
+	}
+	return peak
+}
+
+// BenchmarkStreamParser compares the peak heap of StreamParser with the one of
+// ParseSQL on a large dump, the one of StreamParser is expected to stay flat
+// whatever the size of the dump.
+func BenchmarkStreamParser(b *testing.B) {
+	const stmts = 200000
+	b.Run("stream", func(b *testing.B) {
+		b.ReportAllocs()
+		var peak uint64
+		for i := 0; i < b.N; i++ {
+			runtime.GC()
+			base := heapGrowth(0, 0)
+			sp := NewStreamParser(&dumpReader{n: stmts})
+			for j := 0; ; j++ {
+				if _, err := sp.Next(); err == goio.EOF {
+					break
+				} else if err != nil {
+					b.Fatal(err)
+				}
+				if j%10000 == 0 {
+					peak = heapGrowth(base, peak)
+				}
+			}
+		}
+		b.ReportMetric(float64(peak)/(1<<20), "peak-heap-MB")
+	})
+	b.Run("parse", func(b *testing.B) {
+		b.ReportAllocs()
+		var peak uint64
+		for i := 0; i < b.N; i++ {
+			runtime.GC()
+			base := heapGrowth(0, 0)
+			sql, err := goio.ReadAll(&dumpReader{n: stmts})
+			if err != nil {
+				b.Fatal(err)
+			}
+			result, _, err := New().ParseSQL(string(sql))
+			if err != nil {
+				b.Fatal(err)
+			}
+			peak = heapGrowth(base, peak)
+			runtime.KeepAlive(result)
+		}
+		b.ReportMetric(float64(peak)/(1<<20), "peak-heap-MB")
+	})
+}
+
+// maxRestoreLargeInsertAllocs is the allocations allowed to restore the INSERT of
+// BenchmarkRestoreLargeInsert, whatever its number of rows.
+const maxRestoreLargeInsertAllocs = 16
+
+func BenchmarkRestoreLargeInsert(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO t (id, name, score, note, flag) VALUES ")
+	for i := range 1000 {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "(%d, 'name %d', %d.5e0, NULL, TRUE)", 1000000+i, i, i)
+	}
+	stmt, err := New().ParseOneStmt(sb.String(), "", "")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	restore := func() {
+		buf.Reset()
+		if err := stmt.Restore(myformat.NewRestoreCtx(myformat.DefaultRestoreFlags, &buf)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		restore()
+	}
+	b.StopTimer()
+	if allocs := testing.AllocsPerRun(10, restore); allocs > maxRestoreLargeInsertAllocs {
+		b.Fatalf("restoring the INSERT allocates %v times, expected at most %d", allocs, maxRestoreLargeInsertAllocs)
+	}
+}
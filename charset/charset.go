@@ -16,6 +16,7 @@ package charset
 import (
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -59,11 +60,18 @@ type Collation struct {
 	PadAttribute string
 }
 
+// registryMu protects the charsets and the collations, which AddCharset,
+// RemoveCharset, AddCollation and AddSupportedCollation may change while other
+// goroutines parse.
+var registryMu sync.RWMutex
+
 var collationsIDMap = make(map[int]*Collation)
 var collationsNameMap = make(map[string]*Collation)
 var supportedCollations = make([]*Collation, 0, len(supportedCollationNames))
 
-// CharacterSetInfos contains all the supported charsets.
+// CharacterSetInfos contains all the supported charsets. Reading it directly
+// isn't safe while AddCharset or RemoveCharset may be called, use the functions
+// of this package instead.
 var CharacterSetInfos = map[string]*Charset{
 	CharsetUTF8:    {CharsetUTF8, CollationUTF8, make(map[string]*Collation), "UTF-8 Unicode", 3},
 	CharsetUTF8MB4: {CharsetUTF8MB4, CollationUTF8MB4, make(map[string]*Collation), "UTF-8 Unicode", 4},
@@ -96,6 +104,8 @@ var TiFlashSupportedCharsets = map[string]struct{}{
 
 // GetSupportedCharsets gets descriptions for all charsets supported so far.
 func GetSupportedCharsets() []*Charset {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
 	charsets := make([]*Charset, 0, len(CharacterSetInfos))
 	for _, ch := range CharacterSetInfos {
 		charsets = append(charsets, ch)
@@ -110,7 +120,9 @@ func GetSupportedCharsets() []*Charset {
 
 // GetSupportedCollations gets information for all collations supported so far.
 func GetSupportedCollations() []*Collation {
-	return supportedCollations
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return slices.Clone(supportedCollations)
 }
 
 // ValidCharsetAndCollation checks the charset and the collation validity
@@ -120,7 +132,9 @@ func ValidCharsetAndCollation(cs string, co string) bool {
 	if cs == "" || cs == CharsetUTF8MB3 {
 		cs = CharsetUTF8
 	}
-	chs, err := GetCharsetInfo(cs)
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	chs, err := getCharsetInfo(cs)
 	if err != nil {
 		return false
 	}
@@ -133,6 +147,54 @@ func ValidCharsetAndCollation(cs string, co string) bool {
 	return ok
 }
 
+// ValidateCharsetCollationPair checks that the collation co belongs to the charset cs,
+// utf8mb3 names are accepted as aliases of utf8. It returns ErrUnknownCollation for an
+// unknown collation and ErrCollationCharsetMismatch if co belongs to another charset.
+// An empty cs or co is always valid, since the other one decides the pair.
+func ValidateCharsetCollationPair(cs, co string) error {
+	if cs == "" || co == "" {
+		return nil
+	}
+	collation, err := GetCollationByName(co)
+	if err != nil {
+		return err
+	}
+	csName := strings.ToLower(cs)
+	if csName == CharsetUTF8MB3 {
+		csName = CharsetUTF8
+	}
+	if collation.CharsetName != csName {
+		return ErrCollationCharsetMismatch.GenWithStackByArgs(co, cs)
+	}
+	return nil
+}
+
+// CollationsForCharset returns all the registered collations of the charset cs, ordered by ID.
+// If padAttribute is not empty, only the collations with the same pad attribute, PadSpace
+// or PadNone, are returned.
+func CollationsForCharset(cs string, padAttribute string) []*Collation {
+	csName := strings.ToLower(cs)
+	if csName == CharsetUTF8MB3 {
+		csName = CharsetUTF8
+	}
+	var result []*Collation
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for _, c := range collationsIDMap {
+		if c.CharsetName != csName {
+			continue
+		}
+		if padAttribute != "" && c.PadAttribute != padAttribute {
+			continue
+		}
+		result = append(result, c)
+	}
+	slices.SortFunc(result, func(i, j *Collation) int {
+		return i.ID - j.ID
+	})
+	return result
+}
+
 // GetDefaultCollationLegacy is compatible with the charset support in old version parser.
 func GetDefaultCollationLegacy(charset string) (string, error) {
 	switch strings.ToLower(charset) {
@@ -159,8 +221,16 @@ func GetDefaultCharsetAndCollate() (defaultCharset string, defaultCollationName
 	return mysql.DefaultCharset, mysql.DefaultCollationName
 }
 
-// GetCharsetInfo returns charset and collation for cs as name.
+// GetCharsetInfo returns charset and collation for cs as name. The Collations
+// of the charset are changed by AddCollation, which isn't safe while reading
+// them.
 func GetCharsetInfo(cs string) (*Charset, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return getCharsetInfo(cs)
+}
+
+func getCharsetInfo(cs string) (*Charset, error) {
 	if strings.ToLower(cs) == CharsetUTF8MB3 {
 		cs = CharsetUTF8
 	}
@@ -181,7 +251,10 @@ func GetCharsetInfoByID(coID int) (charsetStr string, collateStr string, err err
 	if coID == mysql.DefaultCollationID {
 		return mysql.DefaultCharset, mysql.DefaultCollationName, nil
 	}
-	if collation, ok := collationsIDMap[coID]; ok {
+	registryMu.RLock()
+	collation, ok := collationsIDMap[coID]
+	registryMu.RUnlock()
+	if ok {
 		return collation.CharsetName, collation.Name, nil
 	}
 
@@ -208,7 +281,9 @@ func utf8Alias(csname string) string {
 // GetCollationByName returns the collation by name.
 func GetCollationByName(name string) (*Collation, error) {
 	csname := utf8Alias(strings.ToLower(name))
+	registryMu.RLock()
 	collation, ok := collationsNameMap[csname]
+	registryMu.RUnlock()
 	if !ok {
 		return nil, ErrUnknownCollation.GenWithStackByArgs(name)
 	}
@@ -217,7 +292,9 @@ func GetCollationByName(name string) (*Collation, error) {
 
 // GetCollationByID returns collations by given id.
 func GetCollationByID(id int) (*Collation, error) {
+	registryMu.RLock()
 	collation, ok := collationsIDMap[id]
+	registryMu.RUnlock()
 	if !ok {
 		return nil, errors.Errorf("Unknown collation id %d", id)
 	}
@@ -622,29 +699,39 @@ var collations = []*Collation{
 
 // AddCharset adds a new charset.
 // Use only when adding a custom charset to the parser.
+// It's safe for concurrent use, also with parsing: the statements parsed after
+// it returns see the charset.
 func AddCharset(c *Charset) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
 	CharacterSetInfos[c.Name] = c
 }
 
 // RemoveCharset remove a charset.
 // Use only when remove a custom charset to the parser.
+// It's safe for concurrent use, also with parsing: the statements parsed after
+// it returns don't see the charset.
 func RemoveCharset(c string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
 	delete(CharacterSetInfos, c)
-	for i := range supportedCollations {
-		if supportedCollations[i].Name == c {
-			supportedCollations = slices.Delete(supportedCollations, i, i+1)
-		}
-	}
+	supportedCollations = slices.DeleteFunc(supportedCollations, func(co *Collation) bool {
+		return co.Name == c
+	})
 }
 
 // AddCollation adds a new collation.
 // Use only when adding a custom collation to the parser.
+// It's safe for concurrent use, also with parsing, but not with reading the
+// Collations of the charset returned by GetCharsetInfo.
 func AddCollation(c *Collation) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
 	collationsIDMap[c.ID] = c
 	collationsNameMap[c.Name] = c
 
 	if _, ok := supportedCollationNames[c.Name]; ok {
-		AddSupportedCollation(c)
+		addSupportedCollation(c)
 	}
 
 	if charset, ok := CharacterSetInfos[c.CharsetName]; ok {
@@ -658,7 +745,14 @@ func AddCollation(c *Collation) {
 
 // AddSupportedCollation adds a new collation into supportedCollations.
 // Use only when adding a custom collation to the parser.
+// It's safe for concurrent use, also with parsing.
 func AddSupportedCollation(c *Collation) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	addSupportedCollation(c)
+}
+
+func addSupportedCollation(c *Collation) {
 	supportedCollations = append(supportedCollations, c)
 }
 
@@ -175,6 +175,64 @@ func TestUTF8MB3(t *testing.T) {
 	}
 }
 
+func TestValidateCharsetCollationPair(t *testing.T) {
+	tests := []struct {
+		cs  string
+		co  string
+		err string
+	}{
+		{"utf8mb4", "utf8mb4_bin", ""},
+		{"UTF8MB4", "UTF8MB4_GENERAL_CI", ""},
+		{"utf8", "utf8mb3_general_ci", ""},
+		{"utf8mb3", "utf8_general_ci", ""},
+		{"utf8mb3", "utf8mb3_bin", ""},
+		{"", "latin1_bin", ""},
+		{"latin1", "", ""},
+		{"binary", "binary", ""},
+		{"utf8mb4", "latin1_bin", "[ddl:1253]COLLATION 'latin1_bin' is not valid for CHARACTER SET 'utf8mb4'"},
+		{"utf8", "utf8mb4_bin", "[ddl:1253]COLLATION 'utf8mb4_bin' is not valid for CHARACTER SET 'utf8'"},
+		{"utf8mb4", "utf8mb3_bin", "[ddl:1253]COLLATION 'utf8mb3_bin' is not valid for CHARACTER SET 'utf8mb4'"},
+		{"utf8mb4", "non_exist", "[ddl:1273]Unknown collation: 'non_exist'"},
+	}
+	for _, tt := range tests {
+		err := ValidateCharsetCollationPair(tt.cs, tt.co)
+		if tt.err == "" {
+			require.NoError(t, err, tt)
+		} else {
+			require.EqualError(t, err, tt.err, tt)
+		}
+	}
+	require.True(t, ErrCollationCharsetMismatch.Equal(ValidateCharsetCollationPair("latin1", "utf8mb4_bin")))
+}
+
+func TestCollationsForCharset(t *testing.T) {
+	ascii := CollationsForCharset("ascii", "")
+	require.Len(t, ascii, 2)
+	require.Equal(t, "ascii_general_ci", ascii[0].Name)
+	require.Equal(t, "ascii_bin", ascii[1].Name)
+
+	utf8mb4 := CollationsForCharset("UTF8MB4", "")
+	require.NotEmpty(t, utf8mb4)
+	padSpace := CollationsForCharset("utf8mb4", PadSpace)
+	padNone := CollationsForCharset("utf8mb4", PadNone)
+	require.NotEmpty(t, padSpace)
+	require.NotEmpty(t, padNone)
+	require.Equal(t, len(utf8mb4), len(padSpace)+len(padNone))
+	for i, c := range utf8mb4 {
+		require.Equal(t, CharsetUTF8MB4, c.CharsetName)
+		if i > 0 {
+			require.Less(t, utf8mb4[i-1].ID, c.ID)
+		}
+	}
+	for _, c := range padNone {
+		require.Equal(t, PadNone, c.PadAttribute)
+	}
+
+	require.Equal(t, CollationsForCharset("utf8", ""), CollationsForCharset("utf8mb3", ""))
+	require.Empty(t, CollationsForCharset("non_exist", ""))
+	require.Empty(t, CollationsForCharset("latin1", PadNone))
+}
+
 func BenchmarkGetCharsetDesc(b *testing.B) {
 	b.ResetTimer()
 	charsets := []string{CharsetUTF8, CharsetUTF8MB4, CharsetASCII, CharsetLatin1, CharsetBin}
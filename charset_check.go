@@ -0,0 +1,124 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/types"
+)
+
+// CharsetCollationCheck is the level of the charset and collation pair check in DDL.
+type CharsetCollationCheck int
+
+const (
+	// CharsetCollationCheckOff leaves mismatched pairs to the server, this is the default.
+	CharsetCollationCheckOff CharsetCollationCheck = iota
+	// CharsetCollationCheckWarn reports mismatched pairs as warnings.
+	CharsetCollationCheckWarn
+	// CharsetCollationCheckStrict reports mismatched pairs as errors.
+	CharsetCollationCheckStrict
+)
+
+// SetCharsetCollationCheck sets how the parser reacts to a DDL statement whose
+// collation doesn't belong to its charset, e.g. `CHARACTER SET utf8mb4 COLLATE latin1_bin`.
+// Table options, column definitions, CONVERT TO and database options are checked.
+func (parser *Parser) SetCharsetCollationCheck(level CharsetCollationCheck) {
+	parser.charsetCollationCheck = level
+}
+
+// checkCharsetCollation reports the mismatched charset and collation pairs of stmts
+// to the lexer according to the check level.
+func (parser *Parser) checkCharsetCollation(stmts []ast.StmtNode) {
+	if parser.charsetCollationCheck == CharsetCollationCheckOff {
+		return
+	}
+	for _, stmt := range stmts {
+		for _, err := range charsetCollationErrors(stmt) {
+			if parser.charsetCollationCheck == CharsetCollationCheckStrict {
+				parser.lexer.AppendError(err)
+			} else {
+				parser.lexer.AppendWarn(err)
+			}
+		}
+	}
+}
+
+func charsetCollationErrors(stmt ast.StmtNode) []error {
+	var errs []error
+	check := func(cs, co string) {
+		if err := charset.ValidateCharsetCollationPair(cs, co); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	checkColumns := func(cols []*ast.ColumnDef, tableCharset string) {
+		for _, col := range cols {
+			if col.Tp == nil {
+				continue
+			}
+			cs, co := col.Tp.GetCharset(), col.Tp.GetCollate()
+			for _, op := range col.Options {
+				if op.Tp == ast.ColumnOptionCollate {
+					co = op.StrValue
+				}
+			}
+			if cs == "" && types.HasCharset(col.Tp) {
+				cs = tableCharset
+			}
+			check(cs, co)
+		}
+	}
+
+	switch x := stmt.(type) {
+	case *ast.CreateTableStmt:
+		cs, co := tableOptionsCharsetCollation(x.Options)
+		check(cs, co)
+		checkColumns(x.Cols, cs)
+	case *ast.AlterTableStmt:
+		for _, spec := range x.Specs {
+			cs, co := tableOptionsCharsetCollation(spec.Options)
+			check(cs, co)
+			checkColumns(spec.NewColumns, "")
+		}
+	case *ast.CreateDatabaseStmt:
+		check(databaseOptionsCharsetCollation(x.Options))
+	case *ast.AlterDatabaseStmt:
+		check(databaseOptionsCharsetCollation(x.Options))
+	}
+	return errs
+}
+
+func tableOptionsCharsetCollation(options []*ast.TableOption) (cs, co string) {
+	for _, op := range options {
+		switch op.Tp {
+		case ast.TableOptionCharset:
+			cs = op.StrValue
+		case ast.TableOptionCollate:
+			co = op.StrValue
+		}
+	}
+	return cs, co
+}
+
+func databaseOptionsCharsetCollation(options []*ast.DatabaseOption) (cs, co string) {
+	for _, op := range options {
+		switch op.Tp {
+		case ast.DatabaseOptionCharset:
+			cs = op.Value
+		case ast.DatabaseOptionCollate:
+			co = op.Value
+		}
+	}
+	return cs, co
+}
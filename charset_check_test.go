@@ -0,0 +1,92 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharsetCollationCheck(t *testing.T) {
+	p := parser.New()
+
+	tests := []struct {
+		sql     string
+		invalid bool
+	}{
+		{"create table t (a int) charset utf8mb4 collate utf8mb4_bin", false},
+		{"create table t (a int) charset utf8mb4 collate latin1_bin", true},
+		{"create table t (a int) collate latin1_bin", false},
+		{"create table t (a varchar(10) charset latin1 collate latin1_bin)", false},
+		{"create table t (a varchar(10) charset latin1 collate utf8mb4_bin)", true},
+		{"create table t (a varchar(10) collate latin1_bin) charset utf8mb4", true},
+		{"create table t (a varchar(10) collate utf8mb4_bin) charset utf8mb4", false},
+		{"create table t (a int collate latin1_bin) charset utf8mb4", false},
+		{"create table t (a varchar(10) charset utf8 collate utf8mb3_general_ci)", false},
+		{"alter table t convert to charset utf8mb4 collate utf8mb4_bin", false},
+		{"alter table t convert to charset utf8mb4 collate latin1_bin", true},
+		{"alter table t charset latin1 collate utf8_bin", true},
+		{"alter table t add column a varchar(10) charset latin1 collate utf8_bin", true},
+		{"alter table t modify column a varchar(10) charset latin1 collate latin1_bin", false},
+		{"create database db charset utf8mb4 collate utf8mb4_bin", false},
+		{"create database db charset utf8mb4 collate latin1_bin", true},
+		{"alter database db charset utf8mb3 collate utf8mb3_bin", false},
+		{"alter database db charset ascii collate utf8mb3_bin", true},
+	}
+
+	for _, tt := range tests {
+		// The check is off by default.
+		p.SetCharsetCollationCheck(parser.CharsetCollationCheckOff)
+		_, warns, err := p.ParseSQL(tt.sql)
+		require.NoError(t, err, tt.sql)
+		require.Len(t, warns, 0, tt.sql)
+
+		p.SetCharsetCollationCheck(parser.CharsetCollationCheckWarn)
+		_, warns, err = p.ParseSQL(tt.sql)
+		require.NoError(t, err, tt.sql)
+		if tt.invalid {
+			require.Len(t, warns, 1, tt.sql)
+			require.True(t, terror.ErrorEqual(warns[0], charset.ErrCollationCharsetMismatch), tt.sql)
+		} else {
+			require.Len(t, warns, 0, tt.sql)
+		}
+
+		p.SetCharsetCollationCheck(parser.CharsetCollationCheckStrict)
+		_, _, err = p.ParseSQL(tt.sql)
+		if tt.invalid {
+			require.True(t, terror.ErrorEqual(err, charset.ErrCollationCharsetMismatch), tt.sql)
+		} else {
+			require.NoError(t, err, tt.sql)
+		}
+	}
+
+	// Every mismatched pair of a statement is reported.
+	p.SetCharsetCollationCheck(parser.CharsetCollationCheckWarn)
+	_, warns, err := p.ParseSQL("create table t (a varchar(10) charset ascii collate latin1_bin, b char(1) charset latin1 collate utf8mb4_bin) charset utf8mb4 collate latin1_bin")
+	require.NoError(t, err)
+	require.Len(t, warns, 3)
+	require.EqualError(t, warns[0], "[ddl:1253]COLLATION 'latin1_bin' is not valid for CHARACTER SET 'utf8mb4'")
+	require.EqualError(t, warns[1], "[ddl:1253]COLLATION 'latin1_bin' is not valid for CHARACTER SET 'ascii'")
+	require.EqualError(t, warns[2], "[ddl:1253]COLLATION 'utf8mb4_bin' is not valid for CHARACTER SET 'latin1'")
+
+	// Reset turns the check off.
+	p.Reset()
+	_, warns, err = p.ParseSQL("create table t (a int) charset utf8mb4 collate latin1_bin")
+	require.NoError(t, err)
+	require.Len(t, warns, 0)
+}
@@ -0,0 +1,941 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	gotime "time"
+
+	"github.com/abbychau/mysql-parser/ast"
+	myformat "github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/opcode"
+	"github.com/abbychau/mysql-parser/parser_driver"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/pingcap/errors"
+)
+
+var (
+	// ErrNotConstant is returned by EvalConstExpr for an expression it can't
+	// evaluate without a database, e.g. a column, a variable, a parameter
+	// marker, a subquery, or a function or an operator it doesn't support.
+	ErrNotConstant = terror.ClassParser.NewStd(mysql.ErrNotConstantExpr)
+
+	errWrongParamCount = terror.ClassParser.NewStd(mysql.ErrWrongParamcountToNativeFct)
+)
+
+// divFracIncr is the number of digits the quotient of a division has more
+// than its dividend, the default of div_precision_increment.
+const divFracIncr = 4
+
+// EvalConstExpr evaluates expr, made of literals, operators and deterministic
+// builtin functions only, to a Datum without a database, e.g. 1 + 2 * 3,
+// CONCAT('a', 'b') or DATE_ADD('2020-01-01', INTERVAL 1 DAY).
+//
+// It supports the arithmetic, comparison and logical operators, IS [NOT] NULL,
+// IS [NOT] TRUE, BETWEEN, IN with a list, CASE, CAST, the DATE, TIME and
+// TIMESTAMP literals, and the functions CONCAT, UPPER, UCASE, LOWER, LCASE,
+// DATE_ADD, ADDDATE, DATE_SUB and SUBDATE, with the type conversions of MySQL,
+// e.g. 1 + '2' is the DOUBLE 3 and 1 / 3 the DECIMAL 0.3333. A division by 0
+// is NULL with a warning appended to ctx, as in a SELECT, and the truncations
+// are handled by ctx. It returns ErrNotConstant for any other expression. All
+// the branches of a CASE are evaluated, as its type is the one of them all.
+func EvalConstExpr(ctx parser_driver.Context, expr ast.ExprNode) (parser_driver.Datum, error) {
+	return evalConst(ctx, expr)
+}
+
+func evalConst(ctx parser_driver.Context, expr ast.ExprNode) (d parser_driver.Datum, err error) {
+	switch x := expr.(type) {
+	case ast.ParamMarkerExpr:
+		// A marker is a ValueExpr without a value yet.
+	case *astValueExpr:
+		return *x.Datum.Clone(), nil
+	case ast.ValueExpr:
+		return parser_driver.NewDatum(x.GetValue()), nil
+	case *ast.ParenthesesExpr:
+		return evalConst(ctx, x.Expr)
+	case *ast.UnaryOperationExpr:
+		return evalUnaryOperation(ctx, x)
+	case *ast.BinaryOperationExpr:
+		return evalBinaryOperation(ctx, x)
+	case *ast.IsNullExpr:
+		if d, err = evalConst(ctx, x.Expr); err != nil {
+			return d, err
+		}
+		return boolDatum(d.IsNull() != x.Not), nil
+	case *ast.IsTruthExpr:
+		return evalIsTruth(ctx, x)
+	case *ast.BetweenExpr:
+		return evalBetween(ctx, x)
+	case *ast.PatternInExpr:
+		if x.Sel == nil {
+			return evalIn(ctx, x)
+		}
+	case *ast.CaseExpr:
+		return evalCase(ctx, x)
+	case *ast.FuncCastExpr:
+		return evalCast(ctx, x)
+	case *ast.FuncCallExpr:
+		return evalFuncCall(ctx, x)
+	}
+	return d, notConstant(expr)
+}
+
+func notConstant(expr ast.ExprNode) error {
+	var sb strings.Builder
+	if err := expr.Restore(myformat.NewRestoreCtx(myformat.DefaultRestoreFlags, &sb)); err != nil {
+		return ErrNotConstant.GenWithStackByArgs(fmt.Sprintf("%T", expr))
+	}
+	return ErrNotConstant.GenWithStackByArgs(sb.String())
+}
+
+func boolDatum(b bool) parser_driver.Datum {
+	if b {
+		return parser_driver.NewIntDatum(1)
+	}
+	return parser_driver.NewIntDatum(0)
+}
+
+// evalExprs evaluates exprs, in order.
+func evalExprs(ctx parser_driver.Context, exprs []ast.ExprNode) ([]parser_driver.Datum, error) {
+	ds := make([]parser_driver.Datum, len(exprs))
+	for i, expr := range exprs {
+		d, err := evalConst(ctx, expr)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+	return ds, nil
+}
+
+func evalUnaryOperation(ctx parser_driver.Context, x *ast.UnaryOperationExpr) (parser_driver.Datum, error) {
+	d, err := evalConst(ctx, x.V)
+	if err != nil {
+		return d, err
+	}
+	switch x.Op {
+	case opcode.Plus:
+		return d, nil
+	case opcode.Minus:
+		return negate(ctx, d)
+	case opcode.Not, opcode.Not2:
+		if d.IsNull() {
+			return d, nil
+		}
+		b, err := d.ToBool(ctx)
+		return boolDatum(b == 0), errors.Trace(err)
+	}
+	return parser_driver.Datum{}, notConstant(x)
+}
+
+func negate(ctx parser_driver.Context, d parser_driver.Datum) (parser_driver.Datum, error) {
+	if d.IsNull() {
+		return d, nil
+	}
+	switch et, isUnsigned := evalNumericType(&d); et {
+	case parser_driver.ETInt:
+		if isUnsigned {
+			u, err := toUint64(ctx, &d)
+			if err != nil {
+				return d, err
+			}
+			if u > 1<<63 {
+				return d, parser_driver.ErrOverflow.GenWithStackByArgs("BIGINT", fmt.Sprintf("-%d", u))
+			}
+			return parser_driver.NewIntDatum(int64(-u)), nil
+		}
+		i, err := d.ToInt64(ctx)
+		if err != nil {
+			return d, errors.Trace(err)
+		}
+		if i == math.MinInt64 {
+			return d, parser_driver.ErrOverflow.GenWithStackByArgs("BIGINT", fmt.Sprintf("-%d", i))
+		}
+		return parser_driver.NewIntDatum(-i), nil
+	case parser_driver.ETDecimal:
+		dec, err := d.ToDecimal(ctx)
+		if err != nil {
+			return d, errors.Trace(err)
+		}
+		return parser_driver.NewDecimalDatum(parser_driver.DecimalNeg(dec)), nil
+	}
+	f, err := d.ToFloat64(ctx)
+	return parser_driver.NewFloat64Datum(-f), errors.Trace(err)
+}
+
+func evalBinaryOperation(ctx parser_driver.Context, x *ast.BinaryOperationExpr) (parser_driver.Datum, error) {
+	l, err := evalConst(ctx, x.L)
+	if err != nil {
+		return l, err
+	}
+	r, err := evalConst(ctx, x.R)
+	if err != nil {
+		return r, err
+	}
+	switch x.Op {
+	case opcode.LogicAnd, opcode.LogicOr, opcode.LogicXor:
+		return evalLogic(ctx, x.Op, l, r)
+	case opcode.EQ, opcode.NE, opcode.LT, opcode.LE, opcode.GT, opcode.GE, opcode.NullEQ:
+		return evalComparison(ctx, x.Op, l, r)
+	case opcode.Plus, opcode.Minus, opcode.Mul, opcode.Div, opcode.IntDiv, opcode.Mod:
+		return evalArithmetic(ctx, x.Op, l, r)
+	}
+	return parser_driver.Datum{}, notConstant(x)
+}
+
+func evalLogic(ctx parser_driver.Context, op opcode.Op, l, r parser_driver.Datum) (parser_driver.Datum, error) {
+	// lb and rb are -1 for NULL.
+	lb, rb := int64(-1), int64(-1)
+	var err error
+	if !l.IsNull() {
+		if lb, err = l.ToBool(ctx); err != nil {
+			return l, errors.Trace(err)
+		}
+	}
+	if !r.IsNull() {
+		if rb, err = r.ToBool(ctx); err != nil {
+			return r, errors.Trace(err)
+		}
+	}
+	switch {
+	case op == opcode.LogicAnd && (lb == 0 || rb == 0):
+		return boolDatum(false), nil
+	case op == opcode.LogicOr && (lb == 1 || rb == 1):
+		return boolDatum(true), nil
+	case lb < 0 || rb < 0:
+		return parser_driver.Datum{}, nil
+	case op == opcode.LogicXor:
+		return boolDatum(lb != rb), nil
+	}
+	return boolDatum(op == opcode.LogicAnd), nil
+}
+
+// compareDatums compares l to r with the conversions of MySQL, e.g. as numbers
+// for 1 = '1', or as times for DATE '2020-01-01' = '2020-01-01'.
+func compareDatums(ctx parser_driver.Context, l, r *parser_driver.Datum) (int, error) {
+	collation := l.Collation()
+	if collation == "" {
+		collation = r.Collation()
+	}
+	cmp, err := l.Compare(ctx, r, parser_driver.GetCollator(collation))
+	return cmp, errors.Trace(err)
+}
+
+func evalComparison(ctx parser_driver.Context, op opcode.Op, l, r parser_driver.Datum) (parser_driver.Datum, error) {
+	if l.IsNull() || r.IsNull() {
+		if op == opcode.NullEQ {
+			return boolDatum(l.IsNull() && r.IsNull()), nil
+		}
+		return parser_driver.Datum{}, nil
+	}
+	cmp, err := compareDatums(ctx, &l, &r)
+	if err != nil {
+		return l, err
+	}
+	switch op {
+	case opcode.NE:
+		return boolDatum(cmp != 0), nil
+	case opcode.LT:
+		return boolDatum(cmp < 0), nil
+	case opcode.LE:
+		return boolDatum(cmp <= 0), nil
+	case opcode.GT:
+		return boolDatum(cmp > 0), nil
+	case opcode.GE:
+		return boolDatum(cmp >= 0), nil
+	}
+	return boolDatum(cmp == 0), nil
+}
+
+// evalNumericType returns the type d is evaluated as by an arithmetic operator,
+// and whether it's unsigned for ETInt: a string is a DOUBLE, a hexadecimal or
+// bit literal a BIGINT UNSIGNED, and a temporal value an integer or a DECIMAL
+// with its fractional seconds, e.g. 20200101103000.5 for 2020-01-01 10:30:00.5.
+func evalNumericType(d *parser_driver.Datum) (parser_driver.EvalType, bool) {
+	switch d.Kind() {
+	case parser_driver.KindInt64:
+		return parser_driver.ETInt, false
+	case parser_driver.KindUint64, parser_driver.KindBinaryLiteral, parser_driver.KindMysqlBit:
+		return parser_driver.ETInt, true
+	case parser_driver.KindMysqlDecimal:
+		return parser_driver.ETDecimal, false
+	case parser_driver.KindMysqlTime:
+		if d.GetMysqlTime().Fsp() > 0 {
+			return parser_driver.ETDecimal, false
+		}
+		return parser_driver.ETInt, false
+	case parser_driver.KindMysqlDuration:
+		if d.GetMysqlDuration().Fsp > 0 {
+			return parser_driver.ETDecimal, false
+		}
+		return parser_driver.ETInt, false
+	}
+	return parser_driver.ETReal, false
+}
+
+func toUint64(ctx parser_driver.Context, d *parser_driver.Datum) (uint64, error) {
+	switch d.Kind() {
+	case parser_driver.KindUint64:
+		return d.GetUint64(), nil
+	case parser_driver.KindBinaryLiteral, parser_driver.KindMysqlBit:
+		u, err := d.GetBinaryLiteral().ToInt(ctx)
+		return u, errors.Trace(err)
+	}
+	i, err := d.ToInt64(ctx)
+	return uint64(i), errors.Trace(err)
+}
+
+func evalArithmetic(ctx parser_driver.Context, op opcode.Op, l, r parser_driver.Datum) (parser_driver.Datum, error) {
+	if l.IsNull() || r.IsNull() {
+		return parser_driver.Datum{}, nil
+	}
+	lt, lUnsigned := evalNumericType(&l)
+	rt, rUnsigned := evalNumericType(&r)
+	et := parser_driver.ETInt
+	if lt == parser_driver.ETReal || rt == parser_driver.ETReal {
+		et = parser_driver.ETReal
+	} else if lt == parser_driver.ETDecimal || rt == parser_driver.ETDecimal {
+		et = parser_driver.ETDecimal
+	}
+	// The quotient of / is a DECIMAL for integers, and the one of DIV is
+	// computed as a DECIMAL for the numbers which aren't integers.
+	if (op == opcode.Div && et == parser_driver.ETInt) || (op == opcode.IntDiv && et == parser_driver.ETReal) {
+		et = parser_driver.ETDecimal
+	}
+	switch et {
+	case parser_driver.ETInt:
+		a, err := toUint64(ctx, &l)
+		if err != nil {
+			return l, err
+		}
+		b, err := toUint64(ctx, &r)
+		if err != nil {
+			return r, err
+		}
+		return arithmeticInt(ctx, op, a, b, lUnsigned, rUnsigned)
+	case parser_driver.ETDecimal:
+		a, err := l.ToDecimal(ctx)
+		if err != nil {
+			return l, errors.Trace(err)
+		}
+		b, err := r.ToDecimal(ctx)
+		if err != nil {
+			return r, errors.Trace(err)
+		}
+		return arithmeticDecimal(ctx, op, a, b, lUnsigned || rUnsigned)
+	}
+	a, err := l.ToFloat64(ctx)
+	if err != nil {
+		return l, errors.Trace(err)
+	}
+	b, err := r.ToFloat64(ctx)
+	if err != nil {
+		return r, errors.Trace(err)
+	}
+	return arithmeticReal(ctx, op, a, b)
+}
+
+// divisionByZero returns the NULL of a division by 0, with its warning.
+func divisionByZero(ctx parser_driver.Context) (parser_driver.Datum, error) {
+	ctx.AppendWarning(parser_driver.ErrDivByZero.GenWithStackByArgs())
+	return parser_driver.Datum{}, nil
+}
+
+// arithmeticInt computes a op b, whose bits are the ones of int64 values
+// unless they're unsigned. The result is unsigned if an operand is, except for
+// %, whose result has the sign of a.
+func arithmeticInt(ctx parser_driver.Context, op opcode.Op, a, b uint64, aUnsigned, bUnsigned bool) (parser_driver.Datum, error) {
+	if (op == opcode.IntDiv || op == opcode.Mod) && b == 0 {
+		return divisionByZero(ctx)
+	}
+	var (
+		u   uint64
+		err error
+	)
+	switch op {
+	case opcode.Plus:
+		switch {
+		case aUnsigned && bUnsigned:
+			u, err = parser_driver.AddUint64(a, b)
+		case aUnsigned:
+			u, err = parser_driver.AddInteger(a, int64(b))
+		case bUnsigned:
+			u, err = parser_driver.AddInteger(b, int64(a))
+		default:
+			i, err := parser_driver.AddInt64(int64(a), int64(b))
+			return parser_driver.NewIntDatum(i), errors.Trace(err)
+		}
+	case opcode.Minus:
+		switch {
+		case aUnsigned && bUnsigned:
+			u, err = parser_driver.SubUint64(a, b)
+		case aUnsigned:
+			u, err = parser_driver.SubUintWithInt(a, int64(b))
+		case bUnsigned:
+			u, err = parser_driver.SubIntWithUint(int64(a), b)
+		default:
+			i, err := parser_driver.SubInt64(int64(a), int64(b))
+			return parser_driver.NewIntDatum(i), errors.Trace(err)
+		}
+	case opcode.Mul:
+		switch {
+		case aUnsigned && bUnsigned:
+			u, err = parser_driver.MulUint64(a, b)
+		case aUnsigned:
+			u, err = parser_driver.MulInteger(a, int64(b))
+		case bUnsigned:
+			u, err = parser_driver.MulInteger(b, int64(a))
+		default:
+			i, err := parser_driver.MulInt64(int64(a), int64(b))
+			return parser_driver.NewIntDatum(i), errors.Trace(err)
+		}
+	case opcode.IntDiv:
+		switch {
+		case aUnsigned && bUnsigned:
+			u = a / b
+		case aUnsigned:
+			u, err = parser_driver.DivUintWithInt(a, int64(b))
+		case bUnsigned:
+			u, err = parser_driver.DivIntWithUint(int64(a), b)
+		default:
+			i, err := parser_driver.DivInt64(int64(a), int64(b))
+			return parser_driver.NewIntDatum(i), errors.Trace(err)
+		}
+	case opcode.Mod:
+		// The remainder of the absolute values, with the sign of a.
+		absB := b
+		if !bUnsigned && int64(b) < 0 {
+			absB = -b
+		}
+		if aUnsigned {
+			return parser_driver.NewUintDatum(a % absB), nil
+		}
+		if int64(a) < 0 {
+			return parser_driver.NewIntDatum(-int64(-a % absB)), nil
+		}
+		return parser_driver.NewIntDatum(int64(a % absB)), nil
+	}
+	return parser_driver.NewUintDatum(u), errors.Trace(err)
+}
+
+// arithmeticDecimal computes a op b. The quotient of DIV is unsigned if an
+// operand is.
+func arithmeticDecimal(ctx parser_driver.Context, op opcode.Op, a, b *parser_driver.MyDecimal, isUnsigned bool) (parser_driver.Datum, error) {
+	if (op == opcode.Div || op == opcode.IntDiv || op == opcode.Mod) && b.IsZero() {
+		return divisionByZero(ctx)
+	}
+	to := new(parser_driver.MyDecimal)
+	var err error
+	switch op {
+	case opcode.Plus:
+		err = parser_driver.DecimalAdd(a, b, to)
+	case opcode.Minus:
+		err = parser_driver.DecimalSub(a, b, to)
+	case opcode.Mul:
+		err = parser_driver.DecimalMul(a, b, to)
+	case opcode.Div, opcode.IntDiv:
+		err = parser_driver.DecimalDiv(a, b, to, divFracIncr)
+	case opcode.Mod:
+		err = parser_driver.DecimalMod(a, b, to)
+	}
+	// The fractional digits beyond the precision are rounded off.
+	if err != nil && !parser_driver.ErrTruncated.Equal(err) {
+		return parser_driver.Datum{}, errors.Trace(err)
+	}
+	if op != opcode.IntDiv {
+		return parser_driver.NewDecimalDatum(to), nil
+	}
+	if isUnsigned {
+		u, err := to.ToUint()
+		if err != nil && !parser_driver.ErrTruncated.Equal(err) {
+			return parser_driver.Datum{}, errors.Trace(err)
+		}
+		return parser_driver.NewUintDatum(u), nil
+	}
+	i, err := to.ToInt()
+	if err != nil && !parser_driver.ErrTruncated.Equal(err) {
+		return parser_driver.Datum{}, errors.Trace(err)
+	}
+	return parser_driver.NewIntDatum(i), nil
+}
+
+func arithmeticReal(ctx parser_driver.Context, op opcode.Op, a, b float64) (parser_driver.Datum, error) {
+	if (op == opcode.Div || op == opcode.Mod) && b == 0 {
+		return divisionByZero(ctx)
+	}
+	var f float64
+	switch op {
+	case opcode.Plus:
+		f = a + b
+	case opcode.Minus:
+		f = a - b
+	case opcode.Mul:
+		f = a * b
+	case opcode.Div:
+		f = a / b
+	case opcode.Mod:
+		f = math.Mod(a, b)
+	}
+	if math.IsInf(f, 0) {
+		return parser_driver.Datum{}, parser_driver.ErrOverflow.GenWithStackByArgs("DOUBLE", fmt.Sprintf("(%g %s %g)", a, op.String(), b))
+	}
+	return parser_driver.NewFloat64Datum(f), nil
+}
+
+func evalIsTruth(ctx parser_driver.Context, x *ast.IsTruthExpr) (parser_driver.Datum, error) {
+	d, err := evalConst(ctx, x.Expr)
+	if err != nil {
+		return d, err
+	}
+	var is bool
+	switch {
+	case x.Unknown:
+		is = d.IsNull()
+	case !d.IsNull():
+		b, err := d.ToBool(ctx)
+		if err != nil {
+			return d, errors.Trace(err)
+		}
+		is = b == x.True
+	}
+	return boolDatum(is != x.Not), nil
+}
+
+func evalBetween(ctx parser_driver.Context, x *ast.BetweenExpr) (parser_driver.Datum, error) {
+	ds, err := evalExprs(ctx, []ast.ExprNode{x.Expr, x.Left, x.Right})
+	if err != nil {
+		return parser_driver.Datum{}, err
+	}
+	ge, err := evalComparison(ctx, opcode.GE, ds[0], ds[1])
+	if err != nil {
+		return ge, err
+	}
+	le, err := evalComparison(ctx, opcode.LE, ds[0], ds[2])
+	if err != nil {
+		return le, err
+	}
+	d, err := evalLogic(ctx, opcode.LogicAnd, ge, le)
+	if err != nil || !x.Not || d.IsNull() {
+		return d, err
+	}
+	return boolDatum(d.GetInt64() == 0), nil
+}
+
+func evalIn(ctx parser_driver.Context, x *ast.PatternInExpr) (parser_driver.Datum, error) {
+	d, err := evalConst(ctx, x.Expr)
+	if err != nil {
+		return d, err
+	}
+	list, err := evalExprs(ctx, x.List)
+	if err != nil || d.IsNull() {
+		return parser_driver.Datum{}, err
+	}
+	var found, hasNull bool
+	for i := range list {
+		if list[i].IsNull() {
+			hasNull = true
+			continue
+		}
+		cmp, err := compareDatums(ctx, &d, &list[i])
+		if err != nil {
+			return d, err
+		}
+		if cmp == 0 {
+			found = true
+			break
+		}
+	}
+	if !found && hasNull {
+		return parser_driver.Datum{}, nil
+	}
+	return boolDatum(found != x.Not), nil
+}
+
+func evalCase(ctx parser_driver.Context, x *ast.CaseExpr) (parser_driver.Datum, error) {
+	var value parser_driver.Datum
+	if x.Value != nil {
+		var err error
+		if value, err = evalConst(ctx, x.Value); err != nil {
+			return value, err
+		}
+	}
+	results := make([]parser_driver.Datum, 0, len(x.WhenClauses)+1)
+	chosen := -1
+	for _, w := range x.WhenClauses {
+		cond, err := evalConst(ctx, w.Expr)
+		if err != nil {
+			return cond, err
+		}
+		result, err := evalConst(ctx, w.Result)
+		if err != nil {
+			return result, err
+		}
+		results = append(results, result)
+		if chosen >= 0 || cond.IsNull() {
+			continue
+		}
+		if x.Value != nil {
+			if value.IsNull() {
+				continue
+			}
+			cmp, err := compareDatums(ctx, &value, &cond)
+			if err != nil {
+				return cond, err
+			}
+			if cmp == 0 {
+				chosen = len(results) - 1
+			}
+		} else if b, err := cond.ToBool(ctx); err != nil {
+			return cond, errors.Trace(err)
+		} else if b == 1 {
+			chosen = len(results) - 1
+		}
+	}
+	if x.ElseClause != nil {
+		result, err := evalConst(ctx, x.ElseClause)
+		if err != nil {
+			return result, err
+		}
+		results = append(results, result)
+		if chosen < 0 {
+			chosen = len(results) - 1
+		}
+	}
+	if chosen < 0 {
+		return parser_driver.Datum{}, nil
+	}
+	return convertToAggType(ctx, results, results[chosen])
+}
+
+// convertToAggType converts d, one of ds, to the type MySQL gives to a result
+// among ds, e.g. the result 1 of CASE WHEN 1 THEN 1 ELSE 'a' END is the string
+// '1'.
+func convertToAggType(ctx parser_driver.Context, ds []parser_driver.Datum, d parser_driver.Datum) (parser_driver.Datum, error) {
+	if d.IsNull() {
+		return d, nil
+	}
+	fts := make([]*parser_driver.FieldType, 0, len(ds))
+	for i := range ds {
+		if ds[i].IsNull() {
+			continue
+		}
+		ft := new(parser_driver.FieldType)
+		parser_driver.InferParamTypeFromDatum(&ds[i], ft)
+		fts = append(fts, ft)
+	}
+	ft := parser_driver.AggFieldType(fts)
+	switch ft.EvalType() {
+	case parser_driver.ETInt:
+		if et, isUnsigned := evalNumericType(&d); et == parser_driver.ETInt && isUnsigned == mysql.HasUnsignedFlag(ft.GetFlag()) &&
+			(d.Kind() == parser_driver.KindInt64 || d.Kind() == parser_driver.KindUint64) {
+			return d, nil
+		}
+		if mysql.HasUnsignedFlag(ft.GetFlag()) {
+			u, err := toUint64(ctx, &d)
+			return parser_driver.NewUintDatum(u), err
+		}
+		i, err := d.ToInt64(ctx)
+		return parser_driver.NewIntDatum(i), errors.Trace(err)
+	case parser_driver.ETReal:
+		f, err := d.ToFloat64(ctx)
+		return parser_driver.NewFloat64Datum(f), errors.Trace(err)
+	case parser_driver.ETDecimal:
+		dec, err := d.ToDecimal(ctx)
+		if err != nil {
+			return d, errors.Trace(err)
+		}
+		// The digits of the result are the most fractional ones of ds, e.g.
+		// 1.0 for CASE WHEN 1 THEN 1 ELSE 2.5 END.
+		frac := 0
+		for i := range ds {
+			if ds[i].Kind() == parser_driver.KindMysqlDecimal && int(ds[i].GetMysqlDecimal().GetDigitsFrac()) > frac {
+				frac = int(ds[i].GetMysqlDecimal().GetDigitsFrac())
+			}
+		}
+		to := new(parser_driver.MyDecimal)
+		if err = dec.Round(to, frac, parser_driver.ModeHalfUp); err != nil {
+			return d, errors.Trace(err)
+		}
+		return parser_driver.NewDecimalDatum(to), nil
+	case parser_driver.ETString:
+		if d.Kind() == parser_driver.KindString || d.Kind() == parser_driver.KindBytes {
+			return d, nil
+		}
+		s, err := d.ToString()
+		if err != nil {
+			return d, errors.Trace(err)
+		}
+		if ft.GetCharset() == charsetBin {
+			return parser_driver.NewBytesDatum([]byte(s)), nil
+		}
+		return parser_driver.NewStringDatum(s), nil
+	}
+	return d, nil
+}
+
+// charsetBin is the charset of the binary strings.
+const charsetBin = "binary"
+
+func evalCast(ctx parser_driver.Context, x *ast.FuncCastExpr) (parser_driver.Datum, error) {
+	d, err := evalConst(ctx, x.Expr)
+	if err != nil || d.IsNull() {
+		return d, err
+	}
+	// An integer cast to an integer keeps its bits, e.g. CAST(-1 AS UNSIGNED)
+	// is 18446744073709551615.
+	if mysql.IsIntegerType(x.Tp.GetType()) &&
+		(d.Kind() == parser_driver.KindInt64 || d.Kind() == parser_driver.KindUint64) {
+		if mysql.HasUnsignedFlag(x.Tp.GetFlag()) {
+			return parser_driver.NewUintDatum(d.GetUint64()), nil
+		}
+		return parser_driver.NewIntDatum(d.GetInt64()), nil
+	}
+	tp := parser_driver.NewFieldType(x.Tp.GetType())
+	tp.SetFlag(x.Tp.GetFlag())
+	tp.SetFlen(x.Tp.GetFlen())
+	tp.SetDecimal(x.Tp.GetDecimal())
+	tp.SetCharset(x.Tp.GetCharset())
+	tp.SetCollate(x.Tp.GetCollate())
+	d, err = d.ConvertTo(ctx, tp)
+	return d, errors.Trace(err)
+}
+
+func evalFuncCall(ctx parser_driver.Context, x *ast.FuncCallExpr) (parser_driver.Datum, error) {
+	if x.Schema.L != "" {
+		return parser_driver.Datum{}, notConstant(x)
+	}
+	switch x.FnName.L {
+	case ast.DateAdd, ast.AddDate, ast.DateSub, ast.SubDate:
+		return evalDateArith(ctx, x)
+	case ast.DateLiteral, ast.TimeLiteral, ast.TimestampLiteral, ast.Concat,
+		ast.Upper, ast.Ucase, ast.Lower, ast.Lcase:
+	default:
+		return parser_driver.Datum{}, notConstant(x)
+	}
+	args, err := evalExprs(ctx, x.Args)
+	if err != nil {
+		return parser_driver.Datum{}, err
+	}
+	if x.FnName.L == ast.Concat && len(args) == 0 || x.FnName.L != ast.Concat && len(args) != 1 {
+		return parser_driver.Datum{}, errWrongParamCount.GenWithStackByArgs(x.FnName.O)
+	}
+	for i := range args {
+		if args[i].IsNull() {
+			return parser_driver.Datum{}, nil
+		}
+	}
+	switch x.FnName.L {
+	case ast.DateLiteral:
+		t, err := parser_driver.ParseDate(ctx, args[0].GetString())
+		return parser_driver.NewTimeDatum(t), errors.Trace(err)
+	case ast.TimeLiteral:
+		s := args[0].GetString()
+		dur, _, err := parser_driver.ParseDuration(ctx, s, parser_driver.GetFsp(s))
+		return parser_driver.NewDurationDatum(dur), errors.Trace(err)
+	case ast.TimestampLiteral:
+		s := args[0].GetString()
+		t, err := parser_driver.ParseTime(ctx, s, mysql.TypeDatetime, parser_driver.GetFsp(s))
+		return parser_driver.NewTimeDatum(t), errors.Trace(err)
+	case ast.Concat:
+		return evalConcat(args)
+	}
+	// UPPER and LOWER leave the binary strings as they are.
+	if isBinaryString(&args[0]) {
+		b, err := args[0].ToBytes()
+		return parser_driver.NewBytesDatum(b), errors.Trace(err)
+	}
+	s, err := args[0].ToString()
+	if err != nil {
+		return parser_driver.Datum{}, errors.Trace(err)
+	}
+	if x.FnName.L == ast.Upper || x.FnName.L == ast.Ucase {
+		s = strings.ToUpper(s)
+	} else {
+		s = strings.ToLower(s)
+	}
+	return parser_driver.NewCollationStringDatum(s, stringCollation(&args[0])), nil
+}
+
+// isBinaryString returns whether d is a binary string, e.g. x'41' or
+// BINARY 'a'.
+func isBinaryString(d *parser_driver.Datum) bool {
+	switch d.Kind() {
+	case parser_driver.KindBytes, parser_driver.KindBinaryLiteral, parser_driver.KindMysqlBit:
+		return true
+	}
+	return d.Kind() == parser_driver.KindString && d.Collation() == charsetBin
+}
+
+// stringCollation returns the collation of d if it's a string, the default one
+// otherwise.
+func stringCollation(d *parser_driver.Datum) string {
+	if d.Kind() == parser_driver.KindString && d.Collation() != "" {
+		return d.Collation()
+	}
+	return mysql.DefaultCollationName
+}
+
+// evalConcat concatenates args, which aren't NULL. The result is a binary
+// string if an argument is.
+func evalConcat(args []parser_driver.Datum) (parser_driver.Datum, error) {
+	var sb strings.Builder
+	isBinary := false
+	collation := ""
+	for i := range args {
+		s, err := args[i].ToString()
+		if err != nil {
+			return parser_driver.Datum{}, errors.Trace(err)
+		}
+		sb.WriteString(s)
+		if isBinaryString(&args[i]) {
+			isBinary = true
+		} else if collation == "" && args[i].Kind() == parser_driver.KindString {
+			collation = args[i].Collation()
+		}
+	}
+	if isBinary {
+		return parser_driver.NewBytesDatum([]byte(sb.String())), nil
+	}
+	if collation == "" {
+		collation = mysql.DefaultCollationName
+	}
+	return parser_driver.NewCollationStringDatum(sb.String(), collation), nil
+}
+
+// evalDateArith evaluates DATE_ADD, ADDDATE, DATE_SUB and SUBDATE, whose
+// arguments are the date, the interval and its unit, see the grammar. The
+// result is NULL with a warning for an invalid date or interval, or a result
+// out of the years 0 to 9999. It's a string for a date which isn't a temporal
+// value, e.g. '2020-01-01', and a date if the date is one and the unit is
+// days or longer.
+func evalDateArith(ctx parser_driver.Context, x *ast.FuncCallExpr) (parser_driver.Datum, error) {
+	if len(x.Args) != 3 {
+		return parser_driver.Datum{}, errWrongParamCount.GenWithStackByArgs(x.FnName.O)
+	}
+	unit, ok := x.Args[2].(*ast.TimeUnitExpr)
+	if !ok {
+		return parser_driver.Datum{}, notConstant(x)
+	}
+	args, err := evalExprs(ctx, x.Args[:2])
+	if err != nil || args[0].IsNull() || args[1].IsNull() {
+		return parser_driver.Datum{}, err
+	}
+	interval, err := args[1].ToString()
+	if err != nil {
+		return parser_driver.Datum{}, errors.Trace(err)
+	}
+	years, months, days, nanos, fsp, err := parser_driver.ParseDurationValue(unit.Unit.String(), interval)
+	if err != nil {
+		ctx.AppendWarning(err)
+		return parser_driver.Datum{}, nil
+	}
+	if x.FnName.L == ast.DateSub || x.FnName.L == ast.SubDate {
+		years, months, days, nanos = -years, -months, -days, -nanos
+	}
+	clockUnit := nanos != 0 || strings.Contains(unit.Unit.String(), "HOUR") ||
+		strings.Contains(unit.Unit.String(), "MINUTE") || strings.Contains(unit.Unit.String(), "SECOND")
+
+	date := args[0]
+	var t parser_driver.Time
+	asString := false
+	switch date.Kind() {
+	case parser_driver.KindMysqlTime:
+		t = date.GetMysqlTime()
+	case parser_driver.KindMysqlDuration:
+		dur := date.GetMysqlDuration()
+		// A TIME plus a time interval is a TIME, else the TIME is a time of
+		// the current day.
+		if years == 0 && months == 0 && days == 0 {
+			dur, err = dur.Add(parser_driver.Duration{Duration: gotime.Duration(nanos), Fsp: fsp})
+			return parser_driver.NewDurationDatum(dur), errors.Trace(err)
+		}
+		if t, err = dur.ConvertToTime(ctx, mysql.TypeDatetime); err != nil {
+			return parser_driver.Datum{}, errors.Trace(err)
+		}
+	default:
+		s, err := date.ToString()
+		if err != nil {
+			return parser_driver.Datum{}, errors.Trace(err)
+		}
+		tp := mysql.TypeDatetime
+		if isDateOnly(s) {
+			tp = mysql.TypeDate
+		}
+		if t, err = parser_driver.ParseTime(ctx, s, tp, parser_driver.GetFsp(s)); err != nil {
+			ctx.AppendWarning(err)
+			return parser_driver.Datum{}, nil
+		}
+		asString = true
+	}
+	if clockUnit && t.Type() == mysql.TypeDate {
+		t.SetType(mysql.TypeDatetime)
+	}
+	if fsp > t.Fsp() && t.Type() != mysql.TypeDate {
+		t.SetFsp(fsp)
+	}
+	res, ok := addInterval(t, years, months, days, nanos)
+	if !ok {
+		ctx.AppendWarning(parser_driver.ErrDatetimeFunctionOverflow.GenWithStackByArgs("datetime"))
+		return parser_driver.Datum{}, nil
+	}
+	if asString {
+		return parser_driver.NewStringDatum(res.String()), nil
+	}
+	return parser_driver.NewTimeDatum(res), nil
+}
+
+// maxIntervalDays is the number of days of 10000 years, more than any interval
+// between two valid dates.
+const maxIntervalDays = 3652500
+
+// addInterval returns t plus the interval, false for a zero date or a result
+// out of the years 0 to 9999. The day of a month interval is clamped to the
+// last day of the month, e.g. 2020-01-31 plus 1 month is 2020-02-29.
+func addInterval(t parser_driver.Time, years, months, days, nanos int64) (parser_driver.Time, bool) {
+	if t.InvalidZero() || years*12+months > maxIntervalDays || years*12+months < -maxIntervalDays ||
+		days > maxIntervalDays || days < -maxIntervalDays {
+		return t, false
+	}
+	monthNr := int64(t.Year())*12 + int64(t.Month()-1) + years*12 + months
+	if monthNr < 0 || monthNr >= 10000*12 {
+		return t, false
+	}
+	year, month := int(monthNr/12), int(monthNr%12)+1
+	day := t.Day()
+	if last := parser_driver.GetLastDay(year, month); day > last {
+		day = last
+	}
+	goTime := gotime.Date(year, gotime.Month(month), day, t.Hour(), t.Minute(), t.Second(), t.Microsecond()*1000, gotime.UTC)
+	goTime = goTime.AddDate(0, 0, int(days)).Add(gotime.Duration(nanos))
+	if goTime.Year() < 0 || goTime.Year() > 9999 {
+		return t, false
+	}
+	return parser_driver.NewTime(parser_driver.FromGoTime(goTime), t.Type(), t.Fsp()), true
+}
+
+// isDateOnly returns whether s, a date given as a string or a number, has no
+// time part, e.g. '2020-01-01' or 20200101.
+func isDateOnly(s string) bool {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, " T:") {
+		return false
+	}
+	if strings.Trim(s, "0123456789") == "" {
+		return len(s) <= 8
+	}
+	return true
+}
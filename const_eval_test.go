@@ -0,0 +1,127 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/parser_driver"
+	"github.com/stretchr/testify/require"
+)
+
+func parseConstExpr(t *testing.T, p *parser.Parser, expr string) ast.ExprNode {
+	stmt, err := p.ParseOneStmt("SELECT "+expr, "", "")
+	require.NoError(t, err, expr)
+	return stmt.(*ast.SelectStmt).Fields.Fields[0].Expr
+}
+
+func TestEvalConstExpr(t *testing.T) {
+	p := parser.New()
+	cases := []struct {
+		expr   string
+		kind   byte
+		result string
+	}{
+		// Arithmetic, with the types of MySQL.
+		{"1 + 2 * 3", parser_driver.KindInt64, "7"},
+		{"(1 + 2) * 3", parser_driver.KindInt64, "9"},
+		{"1 / 3", parser_driver.KindMysqlDecimal, "0.3333"},
+		{"7 DIV 2", parser_driver.KindInt64, "3"},
+		{"-7 % 3", parser_driver.KindInt64, "-1"},
+		{"1.5 + 1", parser_driver.KindMysqlDecimal, "2.5"},
+		{"0.1 + 0.2", parser_driver.KindMysqlDecimal, "0.3"},
+		{"1 + '2'", parser_driver.KindFloat64, "3"},
+		{"1e1 DIV 3", parser_driver.KindInt64, "3"},
+		{"18446744073709551615 + 0", parser_driver.KindUint64, "18446744073709551615"},
+		{"-9223372036854775808", parser_driver.KindInt64, "-9223372036854775808"},
+		{"DATE '2020-01-01' + 0", parser_driver.KindInt64, "20200101"},
+		{"CAST(-1 AS UNSIGNED)", parser_driver.KindUint64, "18446744073709551615"},
+		{"CAST(1.5 AS CHAR)", parser_driver.KindString, "1.5"},
+		{"CAST('2020-01-01' AS DATE)", parser_driver.KindMysqlTime, "2020-01-01"},
+
+		// Comparisons and logic, with the NULLs of MySQL.
+		{"1 = 1", parser_driver.KindInt64, "1"},
+		{"'10' > 9", parser_driver.KindInt64, "1"},
+		{"NULL <=> NULL", parser_driver.KindInt64, "1"},
+		{"1 < NULL", parser_driver.KindNull, ""},
+		{"1 AND NULL", parser_driver.KindNull, ""},
+		{"0 AND NULL", parser_driver.KindInt64, "0"},
+		{"1 OR NULL", parser_driver.KindInt64, "1"},
+		{"1 XOR 1", parser_driver.KindInt64, "0"},
+		{"NOT 0", parser_driver.KindInt64, "1"},
+		{"2 NOT BETWEEN 1 AND 3", parser_driver.KindInt64, "0"},
+		{"2 IN (1, 2)", parser_driver.KindInt64, "1"},
+		{"3 IN (1, NULL)", parser_driver.KindNull, ""},
+		{"NULL IS NULL", parser_driver.KindInt64, "1"},
+		{"0 IS NOT FALSE", parser_driver.KindInt64, "0"},
+
+		// CASE has the type of all its results.
+		{"CASE 1 WHEN 1 THEN 'a' ELSE 'b' END", parser_driver.KindString, "a"},
+		{"CASE WHEN 1 THEN 1 ELSE 2.5 END", parser_driver.KindMysqlDecimal, "1.0"},
+		{"CASE WHEN 0 THEN 1 END", parser_driver.KindNull, ""},
+
+		// Functions.
+		{"CONCAT('a', 1, 2.5)", parser_driver.KindString, "a12.5"},
+		{"CONCAT('a', NULL)", parser_driver.KindNull, ""},
+		{"UPPER('abc')", parser_driver.KindString, "ABC"},
+		{"LCASE('ABC')", parser_driver.KindString, "abc"},
+		{"DATE_ADD('2020-01-31', INTERVAL 1 MONTH)", parser_driver.KindString, "2020-02-29"},
+		{"DATE_SUB('2020-03-01', INTERVAL 1 DAY)", parser_driver.KindString, "2020-02-29"},
+		{"'2020-01-01 10:00:00' + INTERVAL '1:30' HOUR_MINUTE", parser_driver.KindString, "2020-01-01 11:30:00"},
+		{"DATE_ADD('2020-01-01', INTERVAL 1.5 SECOND)", parser_driver.KindString, "2020-01-01 00:00:01.5"},
+		{"DATE_ADD(DATE '2020-01-01', INTERVAL 1 DAY)", parser_driver.KindMysqlTime, "2020-01-02"},
+		{"DATE_ADD(DATE '2020-01-01', INTERVAL 1 HOUR)", parser_driver.KindMysqlTime, "2020-01-01 01:00:00"},
+		{"SUBDATE(TIMESTAMP '2020-01-01 00:00:00', INTERVAL 1 SECOND)", parser_driver.KindMysqlTime, "2019-12-31 23:59:59"},
+		{"DATE_ADD(TIME '10:00:00', INTERVAL 30 MINUTE)", parser_driver.KindMysqlDuration, "10:30:00"},
+	}
+	for _, c := range cases {
+		ctx := &parser_driver.StmtContext{}
+		d, err := parser.EvalConstExpr(ctx, parseConstExpr(t, p, c.expr))
+		require.NoError(t, err, c.expr)
+		require.Equal(t, c.kind, d.Kind(), c.expr)
+		s, err := d.ToString()
+		require.NoError(t, err, c.expr)
+		require.Equal(t, c.result, s, c.expr)
+		require.Empty(t, ctx.GetWarnings(), c.expr)
+	}
+
+	// A division by 0 or an invalid date is NULL with a warning.
+	for _, expr := range []string{"1 / 0", "5 % 0", "DATE_ADD('2020-02-30', INTERVAL 1 DAY)", "DATE_ADD('9999-12-31', INTERVAL 1 DAY)"} {
+		ctx := &parser_driver.StmtContext{}
+		d, err := parser.EvalConstExpr(ctx, parseConstExpr(t, p, expr))
+		require.NoError(t, err, expr)
+		require.True(t, d.IsNull(), expr)
+		require.Len(t, ctx.GetWarnings(), 1, expr)
+	}
+	ctx := &parser_driver.StmtContext{}
+	_, err := parser.EvalConstExpr(ctx, parseConstExpr(t, p, "1 DIV 0"))
+	require.NoError(t, err)
+	require.True(t, parser_driver.ErrDivByZero.Equal(ctx.GetWarnings()[0]))
+
+	// An overflow is an error.
+	_, err = parser.EvalConstExpr(ctx, parseConstExpr(t, p, "9223372036854775807 + 1"))
+	require.True(t, parser_driver.ErrOverflow.Equal(err), "%v", err)
+	_, err = parser.EvalConstExpr(ctx, parseConstExpr(t, p, "UPPER()"))
+	require.ErrorContains(t, err, "Incorrect parameter count")
+
+	for _, expr := range []string{"a + 1", "@a", "@@sql_mode", "RAND()", "?", "(SELECT 1)", "1 IN (SELECT 1)", "db.f(1)", "NOW()"} {
+		_, err := parser.EvalConstExpr(ctx, parseConstExpr(t, p, expr))
+		require.True(t, parser.ErrNotConstant.Equal(err), "%s: %v", expr, err)
+	}
+	// The error names the innermost expression which isn't constant.
+	_, err = parser.EvalConstExpr(ctx, parseConstExpr(t, p, "1 + CONCAT(a, 'x')"))
+	require.EqualError(t, err, "[parser:8067]`a` is not a constant expression")
+}
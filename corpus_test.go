@@ -0,0 +1,123 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/abbychau/mysql-parser/internal/corpus"
+	"github.com/stretchr/testify/require"
+)
+
+const corpusDir = "testdata/corpus"
+
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// hasNodeType tells if v has a value of the ast type named name, following
+// all the fields, exported or not, so that the nodes Accept doesn't visit are
+// found too.
+func hasNodeType(v reflect.Value, name string, seen map[visit]bool) bool {
+	switch v.Kind() {
+	case reflect.Pointer:
+		key := visit{v.Pointer(), v.Type()}
+		if v.IsNil() || seen[key] {
+			return false
+		}
+		seen[key] = true
+		return hasNodeType(v.Elem(), name, seen)
+	case reflect.Interface:
+		return !v.IsNil() && hasNodeType(v.Elem(), name, seen)
+	case reflect.Struct:
+		if v.Type().Name() == name && v.Type().PkgPath() == reflect.TypeOf(ast.SelectStmt{}).PkgPath() {
+			return true
+		}
+		for i := range v.NumField() {
+			if hasNodeType(v.Field(i), name, seen) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() <= reflect.Complex128 || v.Type().Elem().Kind() == reflect.String {
+			return false
+		}
+		for i := range v.Len() {
+			if hasNodeType(v.Index(i), name, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func restore(t *testing.T, node ast.Node, sql string) string {
+	var sb strings.Builder
+	require.NoError(t, node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), sql)
+	return sb.String()
+}
+
+// TestCorpus checks that every statement of the corpus is parsed into its
+// node, and that its restored text is parsed and restored again into the
+// same text.
+func TestCorpus(t *testing.T) {
+	stmts, err := corpus.Load(corpusDir)
+	require.NoError(t, err)
+	require.NotEmpty(t, stmts)
+
+	p := parser.New()
+	for _, stmt := range stmts {
+		nodes, _, err := p.Parse(stmt.SQL, "", "")
+		require.NoError(t, err, stmt.SQL)
+		require.Len(t, nodes, 1, stmt.SQL)
+		require.True(t, hasNodeType(reflect.ValueOf(nodes[0]), stmt.Node, make(map[visit]bool)), "%s has no %s", stmt.SQL, stmt.Node)
+
+		restored := restore(t, nodes[0], stmt.SQL)
+		again, err := p.ParseOneStmt(restored, "", "")
+		require.NoError(t, err, restored)
+		require.Equal(t, restored, restore(t, again, restored), stmt.SQL)
+	}
+}
+
+// FuzzParse checks that the parser doesn't panic, and that the statements it
+// parses are restored into statements it parses too. The corpus seeds it.
+func FuzzParse(f *testing.F) {
+	stmts, err := corpus.Load(corpusDir)
+	require.NoError(f, err)
+	for _, stmt := range stmts {
+		f.Add(stmt.SQL)
+	}
+
+	f.Fuzz(func(t *testing.T, sql string) {
+		p := parser.New()
+		nodes, _, err := p.Parse(sql, "", "")
+		if err != nil {
+			return
+		}
+		for _, node := range nodes {
+			var sb strings.Builder
+			if err := node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+				continue
+			}
+			_, _, err := p.Parse(sb.String(), "", "")
+			require.NoError(t, err, "%s is restored into %s", sql, sb.String())
+		}
+	})
+}
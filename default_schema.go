@@ -0,0 +1,37 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import "github.com/abbychau/mysql-parser/ast"
+
+// SetDefaultSchema sets the schema ExtractTables qualifies the tables the
+// statements don't qualify with, like the current database of a session. It
+// doesn't change how the statements are parsed, nor the AST. It's empty by
+// default.
+func (parser *Parser) SetDefaultSchema(schema string) {
+	parser.defaultSchema = schema
+}
+
+// DefaultSchema returns the schema set by SetDefaultSchema.
+func (parser *Parser) DefaultSchema() string {
+	return parser.defaultSchema
+}
+
+// ExtractTables returns the tables referenced by every statement of stmts, see
+// ast.ExtractTablesFromStmts, with the default schema of the parser. If
+// followUse is set, a USE statement changes the default schema of the
+// statements after it, but not the one of the parser.
+func (parser *Parser) ExtractTables(stmts []ast.StmtNode, followUse bool) [][]ast.TableReference {
+	return ast.ExtractTablesFromStmts(stmts, parser.defaultSchema, followUse)
+}
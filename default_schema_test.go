@@ -0,0 +1,62 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultSchema(t *testing.T) {
+	table := func(schema, name string, isDefault bool) []ast.TableReference {
+		return []ast.TableReference{{Schema: ast.NewCIStr(schema), Name: ast.NewCIStr(name), SchemaIsDefault: isDefault}}
+	}
+	const sql = "USE db1; SELECT * FROM t1; SELECT * FROM db3.t3; USE db2; SELECT * FROM t2;"
+
+	p := parser.New()
+	require.Empty(t, p.DefaultSchema())
+	p.SetDefaultSchema("mydb")
+	require.Equal(t, "mydb", p.DefaultSchema())
+	stmts, _, err := p.Parse(sql, "", "")
+	require.NoError(t, err)
+	require.Len(t, stmts, 5)
+
+	require.Equal(t, [][]ast.TableReference{
+		nil,
+		table("mydb", "t1", true),
+		table("db3", "t3", false),
+		nil,
+		table("mydb", "t2", true),
+	}, p.ExtractTables(stmts, false))
+
+	require.Equal(t, [][]ast.TableReference{
+		nil,
+		table("db1", "t1", true),
+		table("db3", "t3", false),
+		nil,
+		table("db2", "t2", true),
+	}, p.ExtractTables(stmts, true))
+	// USE only changes the default schema of the statements after it.
+	require.Equal(t, "mydb", p.DefaultSchema())
+
+	// The AST isn't qualified.
+	require.Empty(t, stmts[1].(*ast.SelectStmt).From.TableRefs.Left.(*ast.TableSource).Source.(*ast.TableName).Schema.O)
+
+	p.Reset()
+	require.Empty(t, p.DefaultSchema())
+	require.Equal(t, [][]ast.TableReference{nil, table("", "t1", true), table("db3", "t3", false), nil, table("", "t2", true)}, p.ExtractTables(stmts, false))
+}
@@ -0,0 +1,304 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/opcode"
+	"github.com/abbychau/mysql-parser/parser_driver"
+	"github.com/abbychau/mysql-parser/types"
+)
+
+// defaultLiteral is the literal default value of a column.
+type defaultLiteral struct {
+	text string
+	// isString tells if the literal is a string, whose text isn't a number.
+	isString bool
+	// isBinary tells if the literal is a hexadecimal or bit literal, whose text
+	// is its bytes.
+	isBinary bool
+}
+
+// literalOfDefault returns the literal of expr, the default value of a column,
+// and false if expr isn't a literal other than NULL. The values are read
+// without the parser driver, which provides the types of the literals.
+func literalOfDefault(expr ast.ExprNode) (defaultLiteral, bool) {
+	negative := false
+	if u, ok := expr.(*ast.UnaryOperationExpr); ok {
+		negative = u.Op == opcode.Minus
+		expr = u.V
+	}
+	value, ok := expr.(ast.ValueExpr)
+	if !ok {
+		return defaultLiteral{}, false
+	}
+	var lit defaultLiteral
+	switch v := value.GetValue().(type) {
+	case nil:
+		return defaultLiteral{}, false
+	case string:
+		lit = defaultLiteral{text: v, isString: true}
+	case int64:
+		lit.text = strconv.FormatInt(v, 10)
+	case uint64:
+		lit.text = strconv.FormatUint(v, 10)
+	case float32:
+		lit.text = strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		lit.text = strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		// The binary literals and the decimals are types of the drivers.
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			lit = defaultLiteral{text: string(rv.Bytes()), isBinary: true}
+		} else if s, ok := v.(fmt.Stringer); ok {
+			lit.text = s.String()
+		} else {
+			return defaultLiteral{}, false
+		}
+	}
+	if negative {
+		lit.text = "-" + lit.text
+	}
+	return lit, true
+}
+
+// decimal returns the value of the literal as a decimal, and the error of the
+// conversion if it isn't a number, e.g. ErrOverflow for a number too large.
+func (l defaultLiteral) decimal() (*parser_driver.MyDecimal, error) {
+	text := strings.TrimSpace(l.text)
+	if l.isBinary {
+		text = new(big.Int).SetBytes([]byte(l.text)).String()
+	}
+	d := new(parser_driver.MyDecimal)
+	return d, d.FromString([]byte(text))
+}
+
+// checkDefaultValue checks expr, the default value of a column of type ft, as
+// the server does when it converts the value to the type of the column. It
+// returns the rule the value breaks and why, or an empty message if it doesn't
+// break any. Only the literals are checked, the expressions are evaluated by
+// the server.
+func checkDefaultValue(ft *types.FieldType, expr ast.ExprNode) (ValidationRule, string) {
+	lit, ok := literalOfDefault(expr)
+	if ft == nil || !ok {
+		return "", ""
+	}
+	switch ft.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong,
+		mysql.TypeNewDecimal, mysql.TypeFloat, mysql.TypeDouble, mysql.TypeBit:
+		return RuleDefaultNumeric, checkNumericDefault(ft, lit)
+	case mysql.TypeString, mysql.TypeVarchar, mysql.TypeVarString:
+		return RuleDefaultTooLong, checkStringDefault(ft, lit)
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration, mysql.TypeYear:
+		return RuleDefaultTemporal, checkTemporalDefault(ft, lit)
+	case mysql.TypeEnum, mysql.TypeSet:
+		return RuleDefaultElement, checkElementDefault(ft, lit)
+	}
+	return "", ""
+}
+
+// integerBits are the bits of the integer types.
+var integerBits = map[byte]uint{
+	mysql.TypeTiny:     8,
+	mysql.TypeShort:    16,
+	mysql.TypeInt24:    24,
+	mysql.TypeLong:     32,
+	mysql.TypeLonglong: 64,
+}
+
+func checkNumericDefault(ft *types.FieldType, lit defaultLiteral) string {
+	d, err := lit.decimal()
+	outOfRange := fmt.Sprintf("%s is out of the range of %s", lit.text, ft.CompactStr())
+	if parser_driver.ErrOverflow.Equal(err) {
+		return outOfRange
+	} else if err != nil {
+		return fmt.Sprintf("'%s' isn't a number", lit.text)
+	}
+	tp := ft.GetType()
+	zero := new(parser_driver.MyDecimal)
+	switch tp {
+	case mysql.TypeNewDecimal, mysql.TypeFloat, mysql.TypeDouble:
+		flen, dec := ft.GetFlen(), ft.GetDecimal()
+		if tp == mysql.TypeNewDecimal {
+			defaultFlen, defaultDec := types.DefaultFlenDecimalForType(tp)
+			if flen == types.UnspecifiedLength {
+				flen = defaultFlen
+			}
+			if dec == types.UnspecifiedLength {
+				dec = defaultDec
+			}
+		}
+		if flen == types.UnspecifiedLength || dec == types.UnspecifiedLength {
+			// The floating point types without precision are only limited by
+			// their largest value.
+			f, _ := d.ToFloat64()
+			if (tp == mysql.TypeFloat && math.Abs(f) > math.MaxFloat32) || math.IsInf(f, 0) {
+				return fmt.Sprintf("%s is out of the range of %s", lit.text, types.TypeToStr(tp, ""))
+			}
+			return ""
+		}
+		if d.Round(d, dec, parser_driver.ModeHalfUp) != nil {
+			return outOfRange
+		}
+		// The largest value has flen-dec nines before the point and dec after.
+		upper := parser_driver.NewMaxOrMinDec(false, flen, dec)
+		lower := parser_driver.NewMaxOrMinDec(true, flen, dec)
+		if mysql.HasUnsignedFlag(ft.GetFlag()) {
+			lower = zero
+		}
+		if d.Compare(lower) < 0 || d.Compare(upper) > 0 {
+			return outOfRange
+		}
+	default:
+		if d.Round(d, 0, parser_driver.ModeHalfUp) != nil {
+			return outOfRange
+		}
+		bits, unsigned := integerBits[tp], mysql.HasUnsignedFlag(ft.GetFlag())
+		if tp == mysql.TypeBit {
+			flen := ft.GetFlen()
+			if flen == types.UnspecifiedLength {
+				flen, _ = types.DefaultFlenDecimalForType(tp)
+			}
+			bits, unsigned = uint(flen), true
+		}
+		if unsigned {
+			u, err := d.ToUint()
+			if d.Compare(zero) < 0 || err != nil || bits < 64 && u>>bits != 0 {
+				return outOfRange
+			}
+		} else {
+			i, err := d.ToInt()
+			if err != nil || bits < 64 && (i < -1<<(bits-1) || i > 1<<(bits-1)-1) {
+				return outOfRange
+			}
+		}
+	}
+	return ""
+}
+
+func checkStringDefault(ft *types.FieldType, lit defaultLiteral) string {
+	length, ok := ft.CharacterMaxLength()
+	if !ok {
+		return ""
+	}
+	n := utf8.RuneCountInString(lit.text)
+	if lit.isBinary || ft.GetCharset() == charset.CharsetBin {
+		n = len(lit.text)
+	}
+	if n > length {
+		return fmt.Sprintf("%d characters are longer than %s", n, ft.CompactStr())
+	}
+	return ""
+}
+
+// convertTemporalDefault converts lit, the literal default value of a column of
+// the temporal type ft, to the type of the column with the conversions of the
+// driver, and returns the error of the conversion, or its first warning, e.g.
+// the truncation of '2020-01-01 10:00:00 x'. The zero dates are left to
+// ZeroDateMode.
+func convertTemporalDefault(ft *types.FieldType, lit defaultLiteral) error {
+	if lit.isString && zeroDateAnnotation(strings.TrimSpace(lit.text)) != 0 {
+		return nil
+	}
+	ctx := &parser_driver.StmtContext{}
+	fsp := ft.GetDecimal()
+	if fsp < parser_driver.MinFsp || fsp > parser_driver.MaxFsp {
+		// The precision isn't written, or the grammar reports it.
+		fsp = parser_driver.MinFsp
+	}
+	var err error
+	switch tp := ft.GetType(); tp {
+	case mysql.TypeYear:
+		d := parser_driver.NewStringDatum(lit.text)
+		if !lit.isString {
+			dec, decErr := lit.decimal()
+			if decErr != nil {
+				return decErr
+			}
+			d = parser_driver.NewDecimalDatum(dec)
+		}
+		_, err = d.ConvertTo(ctx, parser_driver.NewFieldType(tp))
+	case mysql.TypeDuration:
+		_, _, err = parser_driver.ParseDuration(ctx, lit.text, fsp)
+	default:
+		_, err = parser_driver.ParseTime(ctx, lit.text, tp, fsp)
+	}
+	if warns := ctx.GetWarnings(); err == nil && len(warns) > 0 {
+		err = warns[0]
+	}
+	return err
+}
+
+func checkTemporalDefault(ft *types.FieldType, lit defaultLiteral) string {
+	if convertTemporalDefault(ft, lit) != nil {
+		return fmt.Sprintf("'%s' isn't a valid %s", lit.text, types.TypeToStr(ft.GetType(), ""))
+	}
+	return ""
+}
+
+func checkElementDefault(ft *types.FieldType, lit defaultLiteral) string {
+	elems := ft.GetElems()
+	if !lit.isString {
+		// A number is the index of an ENUM element, from 1, or the bits of the
+		// SET elements.
+		d, err := lit.decimal()
+		if err != nil || d.Compare(new(parser_driver.MyDecimal)) < 0 {
+			return fmt.Sprintf("%s isn't an element", lit.text)
+		}
+		var whole parser_driver.MyDecimal
+		_ = d.Round(&whole, 0, parser_driver.ModeTruncate)
+		n, err := d.ToUint()
+		if whole.Compare(d) != 0 || err != nil {
+			return fmt.Sprintf("%s isn't an element", lit.text)
+		}
+		if ft.GetType() == mysql.TypeEnum && (n < 1 || n > uint64(len(elems))) ||
+			ft.GetType() == mysql.TypeSet && len(elems) < 64 && n>>len(elems) != 0 {
+			return fmt.Sprintf("%s isn't an element", lit.text)
+		}
+		return ""
+	}
+	isElem := func(s string) bool {
+		for _, e := range elems {
+			if strings.EqualFold(strings.TrimRight(e, " "), strings.TrimRight(s, " ")) {
+				return true
+			}
+		}
+		return false
+	}
+	if ft.GetType() == mysql.TypeEnum {
+		if !isElem(lit.text) {
+			return fmt.Sprintf("'%s' isn't an element", lit.text)
+		}
+		return ""
+	}
+	if lit.text == "" {
+		return ""
+	}
+	for _, s := range strings.Split(lit.text, ",") {
+		if !isElem(s) {
+			return fmt.Sprintf("'%s' isn't an element", s)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+)
+
+// DefaultValueWarning is a warning of ParseSQL for the literal default value of
+// a column which doesn't convert to the type of the column.
+type DefaultValueWarning struct {
+	// Column is the name of the column.
+	Column string
+	// Offset is the offset of the default value in the SQL text parsed.
+	Offset int
+	// Err is the error of the conversion, e.g. a parser_driver.ErrWrongValue.
+	Err error
+}
+
+// Error implements the error interface.
+func (w *DefaultValueWarning) Error() string {
+	return fmt.Sprintf("Invalid default value for '%s' at offset %d: %v", w.Column, w.Offset, w.Err)
+}
+
+// Cause returns the error of the conversion, so that errors.Cause and the
+// Equal of the driver errors see it.
+func (w *DefaultValueWarning) Cause() error {
+	return w.Err
+}
+
+// Unwrap returns the error of the conversion.
+func (w *DefaultValueWarning) Unwrap() error {
+	return w.Err
+}
+
+// checkDefaultValues adds to the warnings of the parse a DefaultValueWarning
+// for the literal default values of the temporal columns of the DDL statements
+// of stmts which don't convert to the types of the columns, e.g. the invalid
+// date of DATETIME DEFAULT '2020-02-30'. They are converted as StrictValidate
+// does. The zero dates are left to the zero-date mode.
+func (parser *Parser) checkDefaultValues(stmts []ast.StmtNode) {
+	c := &defaultValueChecker{}
+	for _, stmt := range stmts {
+		if _, ok := stmt.(ast.DDLNode); ok {
+			stmt.Accept(c)
+		}
+	}
+	for _, w := range c.warnings {
+		parser.lexer.AppendWarn(w)
+	}
+}
+
+// defaultValueChecker converts the literal default values of the temporal
+// columns.
+type defaultValueChecker struct {
+	warnings []error
+}
+
+func (c *defaultValueChecker) Enter(n ast.Node) (ast.Node, bool) {
+	col, ok := n.(*ast.ColumnDef)
+	if !ok {
+		return n, false
+	}
+	if col.Tp == nil {
+		return n, true
+	}
+	switch col.Tp.GetType() {
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration, mysql.TypeYear:
+	default:
+		return n, true
+	}
+	for _, opt := range col.Options {
+		if opt.Tp != ast.ColumnOptionDefaultValue {
+			continue
+		}
+		lit, ok := literalOfDefault(opt.Expr)
+		if !ok {
+			continue
+		}
+		if err := convertTemporalDefault(col.Tp, lit); err != nil {
+			c.warnings = append(c.warnings, &DefaultValueWarning{Column: col.Name.Name.O, Offset: opt.Expr.OriginTextPosition(), Err: err})
+		}
+	}
+	return n, true
+}
+
+func (*defaultValueChecker) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/parser_driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultValueWarnings(t *testing.T) {
+	p := parser.New()
+
+	// The invalid date is a warning, not an error, naming the column and the
+	// offset of the value.
+	sql := "SELECT 1; CREATE TABLE t (a int, b datetime DEFAULT '2020-02-30')"
+	stmts, warns, err := p.ParseSQL(sql)
+	require.NoError(t, err)
+	require.Len(t, stmts, 2)
+	require.Len(t, warns, 1)
+	var w *parser.DefaultValueWarning
+	require.ErrorAs(t, warns[0], &w)
+	require.Equal(t, "b", w.Column)
+	require.Equal(t, strings.Index(sql, "'2020-02-30'"), w.Offset)
+	require.True(t, parser_driver.ErrWrongValue.Equal(warns[0]), "%v", warns[0])
+	require.EqualError(t, warns[0], "Invalid default value for 'b' at offset 52: mysql error 1292: [datetime 2020-2-30]")
+
+	cases := []struct {
+		sql   string
+		warns int
+	}{
+		{"CREATE TABLE t (a date DEFAULT '2021-13-01', b timestamp(3) DEFAULT '2020-01-01 10:00:00.5')", 1},
+		{"ALTER TABLE t ADD COLUMN a date DEFAULT '2021-04-31', MODIFY b datetime DEFAULT '2021-04-30'", 1},
+		{"CREATE TABLE t (a timestamp DEFAULT '2020-01-01 10:00:00 x')", 1},
+		{"CREATE TABLE t (a timestamp DEFAULT '2040-01-01 10:00:00')", 1},
+		{"CREATE TABLE t (a date DEFAULT '2020-02-30'); CREATE TABLE u (a date DEFAULT '2020-02-31')", 2},
+		// The zero dates are left to the zero-date mode, and the expressions to
+		// the server.
+		{"CREATE TABLE t (a date DEFAULT '0000-00-00', b date DEFAULT '2020-00-01')", 0},
+		{"CREATE TABLE t (a datetime DEFAULT CURRENT_TIMESTAMP, b date DEFAULT (CONCAT('2020-02-30')))", 0},
+		{"CREATE TABLE t (a varchar(10) DEFAULT '2020-02-30', b date DEFAULT NULL)", 0},
+		{"SELECT DATE '2020-02-28', '2020-02-30'", 0},
+		{"CREATE TABLE t (a time DEFAULT '839:00:00', b year DEFAULT 1900, c datetime DEFAULT 20200230)", 3},
+		{"CREATE TABLE t (a datetime DEFAULT '2020-01-01 10:00', b datetime DEFAULT '2020-01-01 10:00:00+08:00')", 0},
+	}
+	for _, c := range cases {
+		_, warns, err := p.ParseSQL(c.sql)
+		require.NoError(t, err, c.sql)
+		require.Len(t, warns, c.warns, "%s: %v", c.sql, warns)
+	}
+
+	// The warnings and StrictValidate agree on the temporal defaults.
+	for _, def := range []string{
+		"date DEFAULT '2020-02-30'", "date DEFAULT '2020-02-29'", "date DEFAULT '2020-00-01'",
+		"datetime DEFAULT '2020-01-01 10:00'", "datetime DEFAULT '2024-01-01 24:00:00'",
+		"timestamp DEFAULT '1969-12-31 23:59:59'", "timestamp DEFAULT '2020-01-01 10:00:00 x'",
+		"time DEFAULT '-838:59:59'", "time DEFAULT 'abc'", "year DEFAULT 2155", "year DEFAULT '2156'",
+	} {
+		stmts, warns, err := p.ParseSQL("CREATE TABLE t (a " + def + ")")
+		require.NoError(t, err, def)
+		require.Len(t, parser.StrictValidate(stmts[0]), len(warns), def)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+)
+
+// Dialect is the SQL dialect accepted by the parser besides MySQL.
+type Dialect int
+
+const (
+	// DialectMySQL accepts the MySQL syntax only. It's the default.
+	DialectMySQL Dialect = iota
+	// DialectMariaDB accepts the common MariaDB syntax too, and reports each
+	// MariaDB construct as a DialectWarning.
+	DialectMariaDB
+)
+
+// String implements fmt.Stringer interface.
+func (d Dialect) String() string {
+	switch d {
+	case DialectMySQL:
+		return "MySQL"
+	case DialectMariaDB:
+		return "MariaDB"
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// ErrDialectSyntax returns for the MariaDB syntax when the dialect is
+// DialectMySQL.
+var ErrDialectSyntax = terror.ClassParser.NewStdErr(mysql.ErrNotSupportedYet, mysql.Message("%s is MariaDB syntax, enable it with SetDialect(DialectMariaDB), at %s", nil))
+
+// DialectWarning is the warning of a construct of another dialect than MySQL,
+// accepted by SetDialect.
+type DialectWarning struct {
+	Dialect Dialect
+	// Construct names the construct, e.g. "RETURNING".
+	Construct string
+	// Offset is the byte offset of the construct in the SQL text.
+	Offset int
+}
+
+// Error implements error interface.
+func (w *DialectWarning) Error() string {
+	return fmt.Sprintf("%s is %s syntax, not MySQL, at offset %d", w.Construct, w.Dialect, w.Offset)
+}
+
+// SetDialect sets the dialect accepted by the parser. With DialectMariaDB, the
+// parser accepts the RETURNING clause of INSERT, REPLACE, UPDATE and DELETE,
+// the COMPRESSED column attribute and the `/*M! ... */` executable comments,
+// and warns about ENGINE=Aria, each reported as a DialectWarning. With
+// DialectMySQL, the default, RETURNING and COMPRESSED are errors naming them,
+// and the `/*M! ... */` comments are ignored as MySQL does.
+func (parser *Parser) SetDialect(d Dialect) {
+	parser.lexer.dialect = d
+}
+
+// dialectConstruct accepts the MariaDB construct at offset with a warning in
+// DialectMariaDB, otherwise it appends an error and returns false.
+func (parser *Parser) dialectConstruct(construct string, offset int) bool {
+	if parser.lexer.dialect == DialectMariaDB {
+		parser.lexer.AppendWarn(&DialectWarning{Dialect: DialectMariaDB, Construct: construct, Offset: offset})
+		return true
+	}
+	parser.lexer.AppendError(ErrDialectSyntax.GenWithStackByArgs(construct, parser.positionAt(offset)))
+	return false
+}
+
+// warnDialectEngine warns about the MariaDB storage engines in DialectMariaDB.
+// MySQL accepts any engine name, so they are never errors.
+func (parser *Parser) warnDialectEngine(engine string, offset int) {
+	if parser.lexer.dialect == DialectMariaDB && strings.EqualFold(engine, "Aria") {
+		parser.lexer.AppendWarn(&DialectWarning{Dialect: DialectMariaDB, Construct: "ENGINE=Aria", Offset: offset})
+	}
+}
+
+// dialectError returns the error of sql in DialectMySQL. RETURNING isn't a
+// keyword of MySQL, so that its clause is a plain syntax error there, which is
+// replaced with an ErrDialectSyntax if sql parses with DialectMariaDB.
+func (parser *Parser) dialectError(sql string, params []ParseParam, err error) error {
+	if parser.lexer.dialect != DialectMySQL || !strings.Contains(strings.ToUpper(sql), "RETURNING") {
+		return err
+	}
+	hook := parser.progressHook
+	parser.progressHook = nil
+	parser.SetDialect(DialectMariaDB)
+	_, warns, mariaDBErr := parser.ParseSQL(sql, params...)
+	parser.SetDialect(DialectMySQL)
+	parser.progressHook = hook
+	if mariaDBErr != nil {
+		return err
+	}
+	for _, warn := range warns {
+		if w, ok := warn.(*DialectWarning); ok && w.Construct == "RETURNING" {
+			return ErrDialectSyntax.GenWithStackByArgs(w.Construct, parser.positionAt(w.Offset))
+		}
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	myformat "github.com/abbychau/mysql-parser/format"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialect(t *testing.T) {
+	cases := []struct {
+		sql       string
+		construct string
+		offset    int
+		restored  string
+		// mysqlRestored is the restore of sql with DialectMySQL, or empty if it's
+		// an error there.
+		mysqlRestored string
+	}{
+		{"insert into t values (1) returning id, a+1 as b", "RETURNING", 25,
+			"INSERT INTO `t` VALUES (1) RETURNING `id`, `a`+1 AS `b`", ""},
+		{"replace into t select * from s returning *", "RETURNING", 31,
+			"REPLACE INTO `t` SELECT * FROM `s` RETURNING *", ""},
+		{"delete from t where a = 1 returning t.a", "RETURNING", 26,
+			"DELETE FROM `t` WHERE `a`=1 RETURNING `t`.`a`", ""},
+		{"update t set a = 2 where a = 1 returning a", "RETURNING", 31,
+			"UPDATE `t` SET `a`=2 WHERE `a`=1 RETURNING `a`", ""},
+		{"create table t (a blob compressed)", "COMPRESSED column attribute", 23,
+			"CREATE TABLE `t` (`a` BLOB COMPRESSED)", ""},
+		{"create table t (b text compressed=zlib)", "COMPRESSED column attribute", 23,
+			"CREATE TABLE `t` (`b` TEXT COMPRESSED=`zlib`)", ""},
+		{"select 1 /*M!100500 + 1 */", "/*M! executable comment", 9,
+			"SELECT 1+1", "SELECT 1"},
+		{"create table t (a int) engine=Aria", "ENGINE=Aria", 23,
+			"CREATE TABLE `t` (`a` INT) ENGINE = Aria", "CREATE TABLE `t` (`a` INT) ENGINE = Aria"},
+	}
+
+	restore := func(stmt ast.StmtNode) string {
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(myformat.NewRestoreCtx(myformat.DefaultRestoreFlags, &sb)))
+		return sb.String()
+	}
+
+	p := parser.New()
+	for _, c := range cases {
+		p.SetDialect(parser.DialectMariaDB)
+		stmts, warns, err := p.Parse(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Len(t, stmts, 1, c.sql)
+		require.Len(t, warns, 1, c.sql)
+		warn, ok := warns[0].(*parser.DialectWarning)
+		require.True(t, ok, c.sql)
+		require.Equal(t, parser.DialectMariaDB, warn.Dialect, c.sql)
+		require.Equal(t, c.construct, warn.Construct, c.sql)
+		require.Equal(t, c.offset, warn.Offset, c.sql)
+		require.Contains(t, warn.Error(), "MariaDB syntax", c.sql)
+		restored := restore(stmts[0])
+		require.Equal(t, c.restored, restored, c.sql)
+		_, err = p.ParseOneStmt(restored, "", "")
+		require.NoError(t, err, c.sql)
+
+		p.SetDialect(parser.DialectMySQL)
+		stmts, warns, err = p.Parse(c.sql, "", "")
+		if c.mysqlRestored == "" {
+			require.Error(t, err, c.sql)
+			require.Contains(t, err.Error(), c.construct+" is MariaDB syntax, enable it with SetDialect(DialectMariaDB)", c.sql)
+			continue
+		}
+		require.NoError(t, err, c.sql)
+		require.Empty(t, warns, c.sql)
+		require.Equal(t, c.mysqlRestored, restore(stmts[0]), c.sql)
+	}
+
+	// The RETURNING clause keeps its fields.
+	p.SetDialect(parser.DialectMariaDB)
+	stmt, err := p.ParseOneStmt(cases[0].sql, "", "")
+	require.NoError(t, err)
+	returning := stmt.(*ast.InsertStmt).Returning
+	require.Len(t, returning.Fields, 2)
+	require.Equal(t, "b", returning.Fields[1].AsName.O)
+
+	// RETURNING remains an identifier in MySQL.
+	p.SetDialect(parser.DialectMySQL)
+	_, err = p.ParseOneStmt("select returning from t", "", "")
+	require.NoError(t, err)
+	_, err = p.ParseOneStmt("insert into t values (1) returning", "", "")
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "MariaDB")
+}
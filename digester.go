@@ -137,6 +137,33 @@ func NormalizeDigestForBinding(sql string) (normalized string, digest *Digest) {
 	return
 }
 
+// NormalizedStmt is the normalized form and the digest of a statement, see
+// Parser.NormalizeStmts.
+type NormalizedStmt struct {
+	Normalized string
+	Digest     *Digest
+}
+
+// NormalizeStmts parses sql, which may hold several statements, and returns the
+// normalized form and the digest of every statement, see NormalizeDigest. The
+// normalized form replaces the literals with '?', collapses the whitespace and
+// keeps the identifiers, and it collapses the IN lists and the rows of INSERT
+// ... VALUES into '...', so that the statements of the same shape, e.g. of a
+// slow log, have the same digest. Unlike NormalizeDigest, which only scans its
+// input, it returns the error of the SQL that isn't parsed.
+func (parser *Parser) NormalizeStmts(sql, charset, collation string) ([]NormalizedStmt, error) {
+	stmts, _, err := parser.Parse(sql, charset, collation)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]NormalizedStmt, 0, len(stmts))
+	for _, stmt := range stmts {
+		normalized, digest := NormalizeDigest(stmt.Text())
+		result = append(result, NormalizedStmt{Normalized: normalized, Digest: digest})
+	}
+	return result, nil
+}
+
 var digesterPool = sync.Pool{
 	New: func() interface{} {
 		return &sqlDigester{
@@ -190,6 +190,29 @@ func TestNormalizeDigest(t *testing.T) {
 	}
 }
 
+func TestNormalizeStmts(t *testing.T) {
+	p := parser.New()
+	stmts, err := p.NormalizeStmts("select * from t where a in (1, 2, 3); insert into t values (1, 'a'), (2, 'b');\n  SELECT  *  FROM t WHERE a IN (4,5) ;", "", "")
+	require.NoError(t, err)
+	require.Len(t, stmts, 3)
+	require.Equal(t, "select * from `t` where `a` in ( ... )", stmts[0].Normalized)
+	require.Equal(t, "insert into `t` values ( ... )", stmts[1].Normalized)
+	require.Equal(t, stmts[0], stmts[2])
+	require.NotEqual(t, stmts[0].Digest, stmts[1].Digest)
+	// An IN list of any length is normalized into the same text and digest.
+	normalized, digest := parser.NormalizeDigest("select * from t where a in (7, 8, 9, 10)")
+	require.Equal(t, "select * from `t` where `a` in ( ... )", normalized)
+	require.Equal(t, parser.NormalizedStmt{Normalized: normalized, Digest: digest}, stmts[0])
+	require.Equal(t, parser.DigestNormalized("select * from `t` where `a` in ( ... )"), stmts[0].Digest)
+
+	stmts, err = p.NormalizeStmts("select `a`, 'a' from `t` where b = 1.5", "", "")
+	require.NoError(t, err)
+	require.Equal(t, []parser.NormalizedStmt{{Normalized: "select `a` , ? from `t` where `b` = ?", Digest: parser.DigestNormalized("select `a` , ? from `t` where `b` = ?")}}, stmts)
+
+	_, err = p.NormalizeStmts("select 1; select from", "", "")
+	require.Error(t, err)
+}
+
 func TestDigestHashEqForSimpleSQL(t *testing.T) {
 	sqlGroups := [][]string{
 		{"select * from b where id = 1", "select * from b where id = '1'", "select * from b where id =2"},
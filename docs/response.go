@@ -0,0 +1,237 @@
+package main
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/ast/stable"
+	_ "github.com/abbychau/mysql-parser/parser_driver"
+)
+
+// SchemaVersion is the version of schema.json, the JSON schema of the responses
+// of the exported functions. Every response carries it as schemaVersion.
+//
+// Any change of the shape of the responses must update schema.json, bump
+// SchemaVersion, and set schemaSHA256 to the hash of the new schema;
+// TestSchemaVersion fails on a schema whose hash isn't schemaSHA256.
+const SchemaVersion = 3
+
+// schemaSHA256 is the SHA-256 of schema.json at SchemaVersion.
+const schemaSHA256 = "5688420718a335177765d12607573067f7a29e9f516eff75780ed26eef32551b"
+
+// responseSchema is schema.json.
+//
+//go:embed schema.json
+var responseSchema []byte
+
+// extractColumns returns the names of the columns referenced by node. It only
+// relies on the interfaces of package ast/stable.
+func extractColumns(node ast.Node) []string {
+	var colNames []string
+	stable.Inspect(node, func(n ast.Node) bool {
+		if col, ok := n.(stable.ColumnNameLike); ok {
+			colNames = append(colNames, col.ColName())
+		}
+		return true
+	})
+	return colNames
+}
+
+// ParseResult is the response of parseSQL.
+type ParseResult struct {
+	SchemaVersion int  `json:"schemaVersion"`
+	Success       bool `json:"success"`
+	// Columns are the columns referenced by the first statement.
+	Columns []string `json:"columns"`
+	// Statements are the regions of the statements in the SQL.
+	Statements []StatementSpan `json:"statements"`
+	Error      *ParseError     `json:"error,omitempty"`
+}
+
+// StatementSpan is the region of a statement in the SQL, without its
+// terminating semicolon. The offsets are in UTF-16 code units, as the indexes
+// of a JavaScript string.
+type StatementSpan struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// ParseError is the error of a failed parse.
+type ParseError struct {
+	Message string `json:"message"`
+	// Position is where the parser stopped in the SQL, if it's known.
+	Position *Position `json:"position,omitempty"`
+}
+
+// Position is a position in the SQL. Line and Column are 1-based, and Column
+// and Offset are in UTF-16 code units.
+type Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Offset int `json:"offset"`
+}
+
+// TokenizeResult is the response of tokenizeSQL.
+type TokenizeResult struct {
+	SchemaVersion int `json:"schemaVersion"`
+	// Tokens are the tokens of the SQL, whitespaces and comments included.
+	Tokens []TokenSpan `json:"tokens"`
+	// Error is the error of the first malformed token, the tokens are still
+	// returned.
+	Error *ParseError `json:"error,omitempty"`
+}
+
+// TokenSpan is a token of the SQL, see parser.Token. The offsets are in UTF-16
+// code units.
+type TokenSpan struct {
+	Kind         string `json:"kind"`
+	Start        int    `json:"start"`
+	End          int    `json:"end"`
+	Reserved     bool   `json:"reserved,omitempty"`
+	Unterminated bool   `json:"unterminated,omitempty"`
+}
+
+// VersionResult is the response of parserVersion.
+type VersionResult struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Version       string `json:"version"`
+	// Features are the features of the parser, sorted.
+	Features []string `json:"features"`
+}
+
+func versionResponse() VersionResult {
+	features := make([]string, 0)
+	for f := range parser.Features() {
+		features = append(features, string(f))
+	}
+	sort.Strings(features)
+	return VersionResult{SchemaVersion: SchemaVersion, Version: parser.Version, Features: features}
+}
+
+func failedParse(msg string, pos *Position) ParseResult {
+	return ParseResult{
+		SchemaVersion: SchemaVersion,
+		Columns:       []string{},
+		Statements:    []StatementSpan{},
+		Error:         &ParseError{Message: msg, Position: pos},
+	}
+}
+
+// parseResponse parses sql and returns the response of parseSQL.
+func parseResponse(sql string) ParseResult {
+	stmts, _, err := parser.New().ParseSQL(sql)
+	if err != nil {
+		return failedParse(fmt.Sprintf("Parse error: %v", err), errorPosition(sql, err))
+	}
+	if len(stmts) == 0 {
+		return failedParse("No statements found", nil)
+	}
+	columns := extractColumns(stmts[0])
+	if columns == nil {
+		columns = []string{}
+	}
+	return ParseResult{
+		SchemaVersion: SchemaVersion,
+		Success:       true,
+		Columns:       columns,
+		Statements:    statementSpans(sql, stmts),
+	}
+}
+
+// tokenizeResponse tokenizes sql and returns the response of tokenizeSQL.
+func tokenizeResponse(sql string) TokenizeResult {
+	tokens, err := parser.Tokenize(sql)
+	res := TokenizeResult{SchemaVersion: SchemaVersion, Tokens: make([]TokenSpan, 0, len(tokens))}
+	// The tokens make up sql, so their offsets add up.
+	offset := 0
+	for _, tok := range tokens {
+		end := offset + utf16Len(tok.Text)
+		res.Tokens = append(res.Tokens, TokenSpan{
+			Kind:         tok.Kind.String(),
+			Start:        offset,
+			End:          end,
+			Reserved:     tok.Reserved,
+			Unterminated: tok.Unterminated,
+		})
+		offset = end
+	}
+	if err != nil {
+		res.Error = &ParseError{Message: err.Error()}
+	}
+	return res
+}
+
+// statementSpans returns the regions of stmts, which were parsed from sql, by
+// finding their text in order.
+func statementSpans(sql string, stmts []ast.StmtNode) []StatementSpan {
+	spans := make([]StatementSpan, 0, len(stmts))
+	from := 0
+	for _, stmt := range stmts {
+		text := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(stmt.OriginalText()), ";"))
+		start := strings.Index(sql[from:], text)
+		if text == "" || start < 0 {
+			break
+		}
+		start += from
+		from = start + len(text)
+		spans = append(spans, StatementSpan{Start: utf16Len(sql[:start]), End: utf16Len(sql[:from])})
+	}
+	return spans
+}
+
+// errorPos matches the position in the messages of the parse errors:
+// `line 1 column 5 near "rest of the SQL"`, with `(total length 3000)` after
+// it when the rest is truncated.
+var errorPos = regexp.MustCompile(`line \d+ column \d+ near "`)
+
+// maxErrorNearLen is the length the rest of the SQL is truncated to.
+const maxErrorNearLen = 2048
+
+var truncatedLen = regexp.MustCompile(`" ?\(total length (\d+)\)`)
+
+// errorPosition returns the position of err in sql, which is the offset of the
+// rest of the SQL the message of err quotes.
+func errorPosition(sql string, err error) *Position {
+	msg := err.Error()
+	loc := errorPos.FindStringIndex(msg)
+	if loc == nil {
+		return nil
+	}
+	rest := msg[loc[1]:]
+	offset := -1
+	if m := truncatedLen.FindStringSubmatchIndex(rest); m != nil && m[0] == maxErrorNearLen {
+		if n, err := strconv.Atoi(rest[m[2]:m[3]]); err == nil {
+			offset = len(sql) - n
+		}
+	} else if end := strings.LastIndexByte(rest, '"'); end >= 0 {
+		offset = len(sql) - end
+		if offset >= 0 && sql[offset:] != rest[:end] {
+			offset = -1
+		}
+	}
+	if offset < 0 || offset > len(sql) {
+		return nil
+	}
+	lineStart := strings.LastIndexByte(sql[:offset], '\n') + 1
+	return &Position{
+		Line:   strings.Count(sql[:offset], "\n") + 1,
+		Column: utf16Len(sql[lineStart:offset]) + 1,
+		Offset: utf16Len(sql[:offset]),
+	}
+}
+
+// utf16Len returns the length of s in UTF-16 code units.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/stretchr/testify/require"
+)
+
+// validate checks value, a decoded JSON document, against schema. It supports
+// the keywords schema.json uses: $ref to $defs, oneOf, const, type, minimum,
+// properties, required, additionalProperties and items.
+func validate(root, schema map[string]interface{}, value interface{}, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		def, ok := root["$defs"].(map[string]interface{})[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: unknown $ref %s", path, ref)
+		}
+		return validate(root, def, value, path)
+	}
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		matched := 0
+		for _, sub := range oneOf {
+			if validate(root, sub.(map[string]interface{}), value, path) == nil {
+				matched++
+			}
+		}
+		if matched != 1 {
+			return fmt.Errorf("%s: matches %d schemas of oneOf", path, matched)
+		}
+	}
+	if c, ok := schema["const"]; ok && !reflect.DeepEqual(c, value) {
+		return fmt.Errorf("%s: %v isn't %v", path, value, c)
+	}
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %v isn't an object", path, value)
+		}
+		props, _ := schema["properties"].(map[string]interface{})
+		for _, name := range schema["required"].([]interface{}) {
+			if _, ok := obj[name.(string)]; !ok {
+				return fmt.Errorf("%s: missing %s", path, name)
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			prop, ok := props[k].(map[string]interface{})
+			if !ok {
+				if schema["additionalProperties"] == false {
+					return fmt.Errorf("%s: unexpected %s", path, k)
+				}
+				continue
+			}
+			if err := validate(root, prop, obj[k], path+"."+k); err != nil {
+				return err
+			}
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %v isn't an array", path, value)
+		}
+		for i, item := range arr {
+			if err := validate(root, schema["items"].(map[string]interface{}), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: %v isn't a string", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: %v isn't a boolean", path, value)
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return fmt.Errorf("%s: %v isn't an integer", path, value)
+		}
+		if min, ok := schema["minimum"].(float64); ok && n < min {
+			return fmt.Errorf("%s: %v is less than %v", path, n, min)
+		}
+	}
+	return nil
+}
+
+func validateResponse(t *testing.T, response interface{}) map[string]interface{} {
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(responseSchema, &schema))
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+	var value map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &value))
+	require.NoError(t, validate(schema, schema, value, "$"), string(data))
+	return value
+}
+
+func TestSchemaVersion(t *testing.T) {
+	sum := sha256.Sum256(responseSchema)
+	require.Equal(t, schemaSHA256, hex.EncodeToString(sum[:]),
+		"schema.json changed: bump SchemaVersion and update schemaSHA256")
+
+	var schema struct {
+		Defs struct {
+			SchemaVersion struct {
+				Const int `json:"const"`
+			} `json:"schemaVersion"`
+		} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(responseSchema, &schema))
+	require.Equal(t, SchemaVersion, schema.Defs.SchemaVersion.Const)
+}
+
+func TestResponses(t *testing.T) {
+	version := validateResponse(t, versionResponse())
+	require.Equal(t, parser.Version, version["version"])
+	require.Contains(t, version["features"], string(parser.FeatureWindowFunctions))
+
+	// The statements are mapped to their regions, without their semicolons.
+	res := parseResponse("select a from t; select b from t2;")
+	validateResponse(t, res)
+	require.True(t, res.Success)
+	require.Equal(t, []string{"a"}, res.Columns)
+	require.Equal(t, []StatementSpan{{0, 15}, {17, 33}}, res.Statements)
+
+	res = parseResponse("select * from t")
+	validateResponse(t, res)
+	require.Equal(t, []string{}, res.Columns)
+
+	res = parseResponse("")
+	validateResponse(t, res)
+	require.False(t, res.Success)
+	require.Nil(t, res.Error.Position)
+
+	validateResponse(t, failedParse("Expected exactly one argument (SQL string)", nil))
+
+	// The SQL being typed is tokenized, the offsets are in UTF-16 code units.
+	tokens := tokenizeResponse("SELECT '😀', `a")
+	validateResponse(t, tokens)
+	require.Nil(t, tokens.Error)
+	require.Equal(t, []TokenSpan{
+		{Kind: "keyword", Start: 0, End: 6, Reserved: true},
+		{Kind: "whitespace", Start: 6, End: 7},
+		{Kind: "string", Start: 7, End: 11},
+		{Kind: "operator", Start: 11, End: 12},
+		{Kind: "whitespace", Start: 12, End: 13},
+		{Kind: "identifier", Start: 13, End: 15, Unterminated: true},
+	}, tokens.Tokens)
+	tokens = tokenizeResponse("SELECT X'1G'")
+	validateResponse(t, tokens)
+	require.NotNil(t, tokens.Error)
+
+	// The position of an error is where the parser stopped.
+	for _, c := range []struct {
+		sql string
+		pos Position
+	}{
+		{"selec 1", Position{Line: 1, Column: 1, Offset: 0}},
+		{"select 1 from", Position{Line: 1, Column: 14, Offset: 13}},
+		{"select 1;\nselect 2 from", Position{Line: 2, Column: 14, Offset: 23}},
+		// The offsets are in UTF-16 code units: the emoji is 2 of them.
+		{"select '😀' from", Position{Line: 1, Column: 17, Offset: 16}},
+		// The rest of the SQL is truncated in the message.
+		{"select 1 from " + strings.Repeat("t ", 2000), Position{Line: 1, Column: 19, Offset: 18}},
+	} {
+		res := parseResponse(c.sql)
+		validateResponse(t, res)
+		require.False(t, res.Success, c.sql)
+		require.NotNil(t, res.Error.Position, c.sql)
+		require.Equal(t, c.pos, *res.Error.Position, c.sql)
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 const (
@@ -242,6 +244,16 @@ const (
 	RestoreForNonPrepPlanCache
 
 	RestoreBracketAroundBetweenExpr
+
+	RestoreCanonicalOptionOrder
+
+	RestoreTargetCharsetFallback
+
+	RestoreCanonicalInList
+
+	RestoreNameBacktickOnlyWhenNeeded
+
+	RestoreValuesAsRowAlias
 )
 
 const (
@@ -308,6 +320,13 @@ func (rf RestoreFlags) HasNameBackQuotesFlag() bool {
 	return rf.has(RestoreNameBackQuotes)
 }
 
+// HasNameBacktickOnlyWhenNeededFlag returns a boolean indicating whether `rf` has `RestoreNameBacktickOnlyWhenNeeded` flag.
+// With it a name is restored without backquotes if it can be parsed back as the same name without them,
+// see WriteName. It has no effect with `RestoreNameDoubleQuotes`.
+func (rf RestoreFlags) HasNameBacktickOnlyWhenNeededFlag() bool {
+	return rf.has(RestoreNameBacktickOnlyWhenNeeded)
+}
+
 // HasSpacesAroundBinaryOperationFlag returns a boolean indicating
 // whether `rf` has `RestoreSpacesAroundBinaryOperation` flag.
 func (rf RestoreFlags) HasSpacesAroundBinaryOperationFlag() bool {
@@ -353,6 +372,34 @@ func (rf RestoreFlags) HasRestoreWithTTLEnableOff() bool {
 	return rf.has(RestoreWithTTLEnableOff)
 }
 
+// HasCanonicalOptionOrderFlag returns a boolean indicating whether `rf` has `RestoreCanonicalOptionOrder` flag.
+// With it table options, column options and index options are restored in the order of
+// SHOW CREATE TABLE instead of the order they were written in.
+func (rf RestoreFlags) HasCanonicalOptionOrderFlag() bool {
+	return rf.has(RestoreCanonicalOptionOrder)
+}
+
+// HasTargetCharsetFallbackFlag returns a boolean indicating whether `rf` has `RestoreTargetCharsetFallback` flag.
+// With it a string literal which can't be converted to the target charset of RestoreTargetCharset is
+// restored as the hexadecimal of its utf8mb4 bytes with a _utf8mb4 introducer, instead of failing.
+func (rf RestoreFlags) HasTargetCharsetFallbackFlag() bool {
+	return rf.has(RestoreTargetCharsetFallback)
+}
+
+// HasCanonicalInListFlag returns a boolean indicating whether `rf` has `RestoreCanonicalInList` flag.
+// With it the lists of [NOT] IN made only of literals are sorted and their duplicates removed, so that
+// lists with the same literals are restored into the same text, see PatternInExpr.
+func (rf RestoreFlags) HasCanonicalInListFlag() bool {
+	return rf.has(RestoreCanonicalInList)
+}
+
+// HasValuesAsRowAliasFlag returns a boolean indicating whether `rf` has `RestoreValuesAsRowAlias` flag.
+// With it the VALUES(col) of the ON DUPLICATE KEY UPDATE of an INSERT having a row alias, deprecated
+// since MySQL 8.0.20, are restored as references to the alias, e.g. `new`.`col`, see InsertRowAlias.
+func (rf RestoreFlags) HasValuesAsRowAliasFlag() bool {
+	return rf.has(RestoreValuesAsRowAlias)
+}
+
 // HasRestoreForNonPrepPlanCache returns a boolean indicating whether `rf` has `RestoreForNonPrepPlanCache` flag.
 func (rf RestoreFlags) HasRestoreForNonPrepPlanCache() bool {
 	return rf.has(RestoreForNonPrepPlanCache)
@@ -369,12 +416,37 @@ type RestoreCtx struct {
 	Flags     RestoreFlags
 	In        RestoreWriter
 	DefaultDB string
+	// TargetCharset is the charset the string literals are converted to, see
+	// RestoreTargetCharset. It's empty to keep them as they are.
+	TargetCharset string
 	CTERestorer
+	InsertRowAlias
+	// scratch is the buffer reused by the writes which format their text, so that
+	// they don't allocate it.
+	scratch []byte
+}
+
+// RestoreOption sets an option of a `RestoreCtx` which isn't a flag.
+type RestoreOption func(ctx *RestoreCtx)
+
+// RestoreTargetCharset converts the restored string literals to the charset cs,
+// e.g. to replay a statement on a connection whose charset is cs. The literals
+// which are only ASCII are kept as they are. Restore fails on a literal which
+// has a character cs can't encode, unless the `RestoreTargetCharsetFallback`
+// flag is set.
+func RestoreTargetCharset(cs string) RestoreOption {
+	return func(ctx *RestoreCtx) {
+		ctx.TargetCharset = cs
+	}
 }
 
 // NewRestoreCtx returns a new `RestoreCtx`.
-func NewRestoreCtx(flags RestoreFlags, in RestoreWriter) *RestoreCtx {
-	return &RestoreCtx{Flags: flags, In: in, DefaultDB: ""}
+func NewRestoreCtx(flags RestoreFlags, in RestoreWriter, opts ...RestoreOption) *RestoreCtx {
+	ctx := &RestoreCtx{Flags: flags, In: in, DefaultDB: ""}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
 }
 
 // WriteKeyWord writes the `keyWord` into writer.
@@ -382,11 +454,37 @@ func NewRestoreCtx(flags RestoreFlags, in RestoreWriter) *RestoreCtx {
 func (ctx *RestoreCtx) WriteKeyWord(keyWord string) {
 	switch {
 	case ctx.Flags.HasKeyWordUppercaseFlag():
-		keyWord = strings.ToUpper(keyWord)
+		ctx.writeCase(keyWord, true)
 	case ctx.Flags.HasKeyWordLowercaseFlag():
-		keyWord = strings.ToLower(keyWord)
+		ctx.writeCase(keyWord, false)
+	default:
+		ctx.In.WriteString(keyWord)
+	}
+}
+
+// writeCase writes s in upper or lower case. The ASCII strings are converted in
+// the scratch buffer, the other ones by strings.ToUpper and strings.ToLower.
+func (ctx *RestoreCtx) writeCase(s string, upper bool) {
+	b := ctx.scratch[:0]
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= utf8.RuneSelf:
+			if upper {
+				ctx.In.WriteString(strings.ToUpper(s))
+			} else {
+				ctx.In.WriteString(strings.ToLower(s))
+			}
+			return
+		case upper && 'a' <= c && c <= 'z':
+			c -= 'a' - 'A'
+		case !upper && 'A' <= c && c <= 'Z':
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
 	}
-	ctx.In.WriteString(keyWord)
+	ctx.scratch = b
+	ctx.In.Write(b)
 }
 
 // WriteWithSpecialComments writes a string with a special comment wrapped.
@@ -428,6 +526,8 @@ func (ctx *RestoreCtx) WriteString(str string) {
 
 // WriteName writes the name into writer
 // `name` maybe wrapped in quotes and escaped according to RestoreFlags.
+// With `RestoreNameBacktickOnlyWhenNeeded` it's only wrapped in backquotes
+// when it isn't a plain name, see isPlainName.
 func (ctx *RestoreCtx) WriteName(name string) {
 	switch {
 	case ctx.Flags.HasNameUppercaseFlag():
@@ -440,7 +540,8 @@ func (ctx *RestoreCtx) WriteName(name string) {
 	case ctx.Flags.HasNameDoubleQuotesFlag():
 		name = strings.ReplaceAll(name, `"`, `""`)
 		quotes = `"`
-	case ctx.Flags.HasNameBackQuotesFlag():
+	case ctx.Flags.HasNameBacktickOnlyWhenNeededFlag() && isPlainName(name):
+	case ctx.Flags.HasNameBackQuotesFlag() || ctx.Flags.HasNameBacktickOnlyWhenNeededFlag():
 		name = strings.ReplaceAll(name, "`", "``")
 		quotes = "`"
 	}
@@ -451,6 +552,28 @@ func (ctx *RestoreCtx) WriteName(name string) {
 	ctx.In.WriteString(quotes)
 }
 
+// IsReservedKeyword reports whether name is a reserved keyword, regardless of
+// its case. The parser package sets it. WriteName with the
+// `RestoreNameBacktickOnlyWhenNeeded` flag quotes all the names while it's nil.
+var IsReservedKeyword func(name string) bool
+
+// isPlainName reports whether name is read back as the same name without
+// quotes: it's made of ASCII letters, digits, '_' and '$', doesn't start with a
+// digit, so that it can't be taken for a number, and isn't a reserved keyword
+// in any case, e.g. `Select`.
+func isPlainName(name string) bool {
+	if len(name) == 0 || IsReservedKeyword == nil || ('0' <= name[0] && name[0] <= '9') {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '$') {
+			return false
+		}
+	}
+	return !IsReservedKeyword(name)
+}
+
 // WritePlain writes the plain text into writer without any handling.
 func (ctx *RestoreCtx) WritePlain(plainText string) {
 	ctx.In.WriteString(plainText)
@@ -461,6 +584,32 @@ func (ctx *RestoreCtx) WritePlainf(format string, a ...any) {
 	fmt.Fprintf(ctx.In, format, a...)
 }
 
+// WritePlainInt writes the decimal text of v into writer without allocating it.
+func (ctx *RestoreCtx) WritePlainInt(v int64) {
+	ctx.scratch = strconv.AppendInt(ctx.scratch[:0], v, 10)
+	ctx.In.Write(ctx.scratch)
+}
+
+// WritePlainUint writes the decimal text of v into writer without allocating it.
+func (ctx *RestoreCtx) WritePlainUint(v uint64) {
+	ctx.scratch = strconv.AppendUint(ctx.scratch[:0], v, 10)
+	ctx.In.Write(ctx.scratch)
+}
+
+// WritePlainFloat writes the text of f formatted as strconv.FormatFloat does into
+// writer without allocating it.
+func (ctx *RestoreCtx) WritePlainFloat(f float64, fmt byte, prec, bitSize int) {
+	ctx.scratch = strconv.AppendFloat(ctx.scratch[:0], f, fmt, prec, bitSize)
+	ctx.In.Write(ctx.scratch)
+}
+
+// WritePlainAppend writes the text appended by appendFn to an empty buffer into
+// writer, the buffer is reused across the writes, e.g. for hex.AppendEncode.
+func (ctx *RestoreCtx) WritePlainAppend(appendFn func([]byte) []byte) {
+	ctx.scratch = appendFn(ctx.scratch[:0])
+	ctx.In.Write(ctx.scratch)
+}
+
 // CTERestorer is used by WithClause related nodes restore.
 type CTERestorer struct {
 	CTENames []string
@@ -487,3 +636,27 @@ func (c *CTERestorer) RestoreCTEFunc() func() {
 		}
 	}
 }
+
+// InsertRowAlias is the row alias of the INSERT whose ON DUPLICATE KEY UPDATE
+// is being restored, used by the `RestoreValuesAsRowAlias` flag.
+type InsertRowAlias struct {
+	// RowAlias is the name of the alias, empty out of an INSERT having one.
+	RowAlias string
+	// RowAliasColumns maps the lowercase names of the columns of the INSERT to
+	// the names of their aliases, it's nil if the alias has no column aliases.
+	RowAliasColumns map[string]string
+}
+
+// RowAliasColumn returns the name of the alias and the name of the column the
+// VALUES(col) of the column col is restored as, or false if it can't be, e.g.
+// the column has no alias in the column aliases.
+func (a *InsertRowAlias) RowAliasColumn(col string) (alias, column string, ok bool) {
+	if a.RowAlias == "" {
+		return "", "", false
+	}
+	if a.RowAliasColumns == nil {
+		return a.RowAlias, col, true
+	}
+	column, ok = a.RowAliasColumns[strings.ToLower(col)]
+	return a.RowAlias, column, ok
+}
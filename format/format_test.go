@@ -83,6 +83,17 @@ func TestRestoreCtx(t *testing.T) {
 	}
 }
 
+func TestRestoreTargetCharsetOption(t *testing.T) {
+	var sb strings.Builder
+	ctx := NewRestoreCtx(DefaultRestoreFlags, &sb)
+	require.Equal(t, "", ctx.TargetCharset)
+	require.False(t, ctx.Flags.HasTargetCharsetFallbackFlag())
+
+	ctx = NewRestoreCtx(DefaultRestoreFlags|RestoreTargetCharsetFallback, &sb, RestoreTargetCharset("gbk"))
+	require.Equal(t, "gbk", ctx.TargetCharset)
+	require.True(t, ctx.Flags.HasTargetCharsetFallbackFlag())
+}
+
 func TestRestoreSpecialComment(t *testing.T) {
 	var sb strings.Builder
 	sb.Reset()
@@ -107,3 +118,64 @@ func TestRestoreSpecialComment(t *testing.T) {
 	})
 	require.Same(t, err, got)
 }
+
+func TestRestoreCtxScratch(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := NewRestoreCtx(RestoreKeyWordUppercase, &buf)
+	ctx.WriteKeyWord("utf8mb4")
+	ctx.WritePlain(" ")
+	ctx.WritePlainInt(-1234567890)
+	ctx.WritePlain(" ")
+	ctx.WritePlainUint(18446744073709551615)
+	ctx.WritePlain(" ")
+	ctx.WritePlainFloat(1.5, 'e', -1, 64)
+	ctx.WritePlain(" ")
+	ctx.WritePlainAppend(func(b []byte) []byte { return append(b, "x'0a'"...) })
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord("Straße")
+	require.Equal(t, "UTF8MB4 -1234567890 18446744073709551615 1.5e+00 x'0a' "+strings.ToUpper("Straße"), buf.String())
+
+	buf.Reset()
+	ctx = NewRestoreCtx(RestoreKeyWordLowercase, &buf)
+	ctx.WriteKeyWord("Select_Priv")
+	require.Equal(t, "select_priv", buf.String())
+
+	// The scratch buffer is reused across the writes.
+	buf.Grow(1024)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf.Reset()
+		ctx.WriteKeyWord("Utf8mb4")
+		ctx.WritePlainInt(1234567890)
+		ctx.WritePlainFloat(0.25, 'e', -1, 64)
+	})
+	require.Zero(t, allocs)
+}
+
+func TestRestoreNameBacktickOnlyWhenNeeded(t *testing.T) {
+	defer func(f func(string) bool) { IsReservedKeyword = f }(IsReservedKeyword)
+
+	var sb strings.Builder
+	write := func(flags RestoreFlags, names ...string) string {
+		sb.Reset()
+		ctx := NewRestoreCtx(flags, &sb)
+		for i, name := range names {
+			if i > 0 {
+				ctx.WritePlain(" ")
+			}
+			ctx.WriteName(name)
+		}
+		return sb.String()
+	}
+
+	// Without the reserved keywords, all the names are quoted.
+	IsReservedKeyword = nil
+	require.Equal(t, "`a` `b`", write(RestoreNameBacktickOnlyWhenNeeded, "a", "b"))
+
+	IsReservedKeyword = func(name string) bool { return strings.EqualFold(name, "select") }
+	flags := RestoreNameBacktickOnlyWhenNeeded
+	require.Equal(t, "a Tbl_1 $x x1$ `select` `Select` `a b` `a.b` `1a` `12` `1e3` `é` `` `a``b`",
+		write(flags, "a", "Tbl_1", "$x", "x1$", "select", "Select", "a b", "a.b", "1a", "12", "1e3", "é", "", "a`b"))
+	require.Equal(t, "abc `select`", write(flags|RestoreNameBackQuotes, "abc", "select"))
+	require.Equal(t, "ABC `SELECT`", write(flags|RestoreNameUppercase, "abc", "select"))
+	require.Equal(t, `"abc" "select"`, write(flags|RestoreNameDoubleQuotes, "abc", "select"))
+}
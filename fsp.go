@@ -0,0 +1,76 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/abbychau/mysql-parser/types"
+)
+
+// MaxFsp is the max fractional seconds precision of the temporal types and
+// functions.
+const MaxFsp = 6
+
+// ErrInvalidFsp returns for a fractional seconds precision which isn't an
+// integer, e.g. TIME(-1), NOW(1.5) or CURTIME('1'). A precision larger than
+// MaxFsp returns ErrTooBigPrecision.
+var ErrInvalidFsp = terror.ClassParser.NewStdErr(mysql.ErrIllegalValueForType, mysql.Message("Illegal fractional seconds precision '%-.64s' specified for '%-.192s' at %s, it must be an integer from 0 to 6", nil))
+
+// fspArg is the fractional seconds precision written in the parentheses of a
+// temporal type or function, e.g. the 6 of DATETIME(6) or NOW(6).
+type fspArg struct {
+	// num is the NUM, or nil when the precision isn't a non-negative integer.
+	num interface{}
+	// text is the precision as written.
+	text   string
+	offset int
+}
+
+// newFspArg returns the fspArg of the precision in src[start:end].
+func (parser *Parser) newFspArg(num interface{}, start, end int) *fspArg {
+	return &fspArg{num: num, text: parser.src[start:end], offset: start}
+}
+
+// checkFsp returns the precision of the *fspArg item for the type or function
+// name, or types.UnspecifiedLength when there is none. A precision which isn't
+// an integer from 0 to MaxFsp is reported at its position, like by the server.
+func (parser *Parser) checkFsp(item interface{}, name string) int {
+	arg, _ := item.(*fspArg)
+	if arg == nil {
+		return types.UnspecifiedLength
+	}
+	if arg.num == nil {
+		parser.lexer.AppendError(ErrInvalidFsp.GenWithStackByArgs(arg.text, name, parser.lexer.lineColumnAt(arg.offset)))
+		return types.UnspecifiedLength
+	}
+	fsp := getUint64FromNUM(arg.num)
+	if fsp > MaxFsp {
+		parser.lexer.AppendError(ErrTooBigPrecision.GenWithStack("Too-big precision %d specified for '%-.192s' at %s. Maximum is %d.", fsp, name, parser.lexer.lineColumnAt(arg.offset), MaxFsp))
+		return types.UnspecifiedLength
+	}
+	return int(fsp)
+}
+
+// fspArgs returns the arguments of the temporal function name: none, or the
+// precision of the *fspArg item checked by checkFsp.
+func (parser *Parser) fspArgs(item interface{}, name string) []ast.ExprNode {
+	arg, _ := item.(*fspArg)
+	if arg == nil {
+		return []ast.ExprNode{}
+	}
+	parser.checkFsp(arg, name)
+	return []ast.ExprNode{ast.NewValueExpr(arg.num, parser.charset, parser.collation)}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFsp(t *testing.T) {
+	p := parser.New()
+	templates := []string{
+		"create table t (a datetime(%s))",
+		"create table t (a timestamp(%s))",
+		"create table t (a time(%s))",
+		"alter table t add column a datetime(%s)",
+		"select cast(a as datetime(%s))",
+		"select cast(a as time(%s))",
+		"select convert(a, datetime(%s))",
+		"select current_timestamp(%s)",
+		"select localtimestamp(%s), 1",
+		"select utc_time(%s)",
+		"select now(%s)",
+		"select sysdate(%s)",
+		"select curtime(%s)",
+		"create table t (a timestamp(6) default current_timestamp(%s))",
+		"create table t (a datetime(6) on update now(%s))",
+	}
+	for _, tpl := range templates {
+		_, _, err := p.ParseSQL(fmt.Sprintf(tpl, "6"))
+		require.NoError(t, err, tpl)
+		_, _, err = p.ParseSQL(fmt.Sprintf(tpl, " 0 "))
+		require.NoError(t, err, tpl)
+
+		sql := fmt.Sprintf(tpl, "7")
+		_, _, err = p.ParseSQL(sql)
+		require.True(t, terror.ErrorEqual(parser.ErrTooBigPrecision, err), "%s: %v", tpl, err)
+		require.ErrorContains(t, err, fmt.Sprintf("Too-big precision 7 specified for '%s' at line 1 column %d. Maximum is 6.", fspName(tpl), strings.LastIndex(sql, "(7)")+1), tpl)
+
+		for _, arg := range []string{"'a'", "-1", "1.5", "1e0", "null"} {
+			sql := fmt.Sprintf(tpl, arg)
+			_, _, err = p.ParseSQL(sql)
+			require.True(t, terror.ErrorEqual(parser.ErrInvalidFsp, err), "%s: %v", sql, err)
+			require.ErrorContains(t, err, fmt.Sprintf("precision '%s' specified for '%s' at line 1 column %d", arg, fspName(tpl), strings.LastIndex(sql, arg)), sql)
+		}
+	}
+
+	// An identifier or an expression is a syntax error, as by the server.
+	for _, sql := range []string{"create table t (a datetime(b))", "select now(1 + 1)", "select cast(a as time(b))"} {
+		_, _, err := p.ParseSQL(sql)
+		var syntaxErr *parser.SyntaxError
+		require.True(t, errors.As(err, &syntaxErr), "%s: %v", sql, err)
+	}
+
+	// The precision is kept.
+	stmt, err := p.ParseOneStmt("create table t (a datetime(6) default now(6), b timestamp(0))", "", "")
+	require.NoError(t, err)
+	cols := stmt.(*ast.CreateTableStmt).Cols
+	require.Equal(t, 6, cols[0].Tp.GetDecimal())
+	require.Equal(t, 0, cols[1].Tp.GetDecimal())
+	stmt, err = p.ParseOneStmt("select now(), now(3), cast(a as datetime(2))", "", "")
+	require.NoError(t, err)
+	fields := stmt.(*ast.SelectStmt).Fields.Fields
+	require.Empty(t, fields[0].Expr.(*ast.FuncCallExpr).Args)
+	require.Equal(t, int64(3), fields[1].Expr.(*ast.FuncCallExpr).Args[0].(ast.ValueExpr).GetValue())
+	require.Equal(t, 2, fields[2].Expr.(*ast.FuncCastExpr).Tp.GetDecimal())
+}
+
+// fspName returns the name reported by the precision errors of the template.
+func fspName(tpl string) string {
+	switch {
+	case strings.Contains(tpl, "cast") || strings.Contains(tpl, "convert"):
+		return "CAST"
+	case strings.HasPrefix(tpl, "select "):
+		return tpl[len("select "):strings.Index(tpl, "(")]
+	case strings.Contains(tpl, "default"):
+		return "current_timestamp"
+	case strings.Contains(tpl, "on update"):
+		return "now"
+	}
+	return strings.ToUpper(tpl[strings.LastIndex(tpl, " ")+1 : strings.Index(tpl, "(%")])
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/pingcap/errors"
+)
+
+// FunctionArgParser parses the arguments of a call of a function registered
+// with Parser.RegisterFunctionSyntax. args is the text between the parentheses
+// of the call, and offset is the offset of args in the SQL text.
+type FunctionArgParser func(args string, offset int) ([]ast.ExprNode, error)
+
+// RegisterFunctionSyntax registers argParser for the calls of the function
+// name, compared case-insensitively, whose arguments have a syntax the grammar
+// doesn't know, e.g. the `BF_ADD(key AS BINARY, val)` of a vendor function.
+// The text between the parentheses of a call is given to argParser instead of
+// the grammar, and the call is an ast.FuncCallExpr with the arguments it
+// returns. The arguments which aren't expressions can be an
+// ast.FuncKeywordArgExpr, which restores the keywords following an
+// expression. An error of argParser fails the parse.
+//
+// A call qualified by a schema name, or whose name is quoted, isn't given to
+// argParser. The keywords of
+// the parser, reserved or not, can't be registered, so that the functions of
+// the grammar, e.g. CAST or TRIM, are never taken over. A nil argParser
+// unregisters name.
+func (parser *Parser) RegisterFunctionSyntax(name string, argParser FunctionArgParser) error {
+	s := NewScanner(name)
+	if tok, _, lit := s.scan(); tok != identifier || lit != name {
+		return errors.Errorf("%q is not an identifier", name)
+	}
+	word := strings.ToUpper(name)
+	_, isKeyword := tokenMap[word]
+	_, isWindowFuncKeyword := windowFuncTokenMap[word]
+	if isKeyword || isWindowFuncKeyword {
+		return errors.Errorf("%s is a keyword of the parser", name)
+	}
+	if argParser == nil {
+		delete(parser.lexer.funcArgParsers, word)
+		return nil
+	}
+	if parser.lexer.funcArgParsers == nil {
+		parser.lexer.funcArgParsers = make(map[string]FunctionArgParser)
+	}
+	parser.lexer.funcArgParsers[word] = argParser
+	return nil
+}
+
+// lexRegisteredFuncCall scans the call of the function registered with
+// RegisterFunctionSyntax whose name is the identifier v at offset, as a
+// registeredFuncCall token whose item is the call. It returns 0, without
+// scanning more, when the identifier isn't the name of a call of a registered
+// function.
+func (s *Scanner) lexRegisteredFuncCall(v *yySymType, offset int) int {
+	argParser, ok := s.funcArgParsers[strings.ToUpper(v.ident)]
+	if !ok || (offset > 0 && s.r.s[offset-1] == '.') {
+		return 0
+	}
+	r, inBangComment := s.r, s.inBangComment
+	if tok, _, _ := s.scan(); tok != '(' {
+		s.r, s.inBangComment = r, inBangComment
+		return 0
+	}
+	start, end := s.r.pos().Offset, -1
+	for depth := 1; end < 0; {
+		tok, pos, _ := s.scan()
+		switch tok {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				end = pos.Offset
+			}
+		case 0, invalid:
+			// The grammar reports the missing ')'.
+			s.r, s.inBangComment = r, inBangComment
+			return 0
+		}
+	}
+	args, err := argParser(s.r.s[start:end], start)
+	if err != nil {
+		s.AppendError(err)
+		return invalid
+	}
+	v.item = &ast.FuncCallExpr{FnName: ast.NewCIStr(v.ident), Args: args}
+	return registeredFuncCall
+}
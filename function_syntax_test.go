@@ -0,0 +1,132 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/stretchr/testify/require"
+)
+
+// parseVendorArgs parses the arguments of a vendor function, separated by
+// commas, each of them an expression followed by keywords, e.g. k AS BINARY,
+// or keywords only.
+func parseVendorArgs(args string, _ int) ([]ast.ExprNode, error) {
+	var exprs []ast.ExprNode
+	for _, arg := range strings.Split(args, ",") {
+		words := strings.Fields(arg)
+		if len(words) == 0 {
+			return nil, errors.New("empty argument")
+		}
+		if strings.ToUpper(words[0]) == "DESC" {
+			exprs = append(exprs, &ast.FuncKeywordArgExpr{Keywords: words})
+			continue
+		}
+		stmt, err := parser.New().ParseOneStmt("SELECT "+words[0], "", "")
+		if err != nil {
+			return nil, err
+		}
+		expr := stmt.(*ast.SelectStmt).Fields.Fields[0].Expr
+		if len(words) > 1 {
+			expr = &ast.FuncKeywordArgExpr{Expr: expr, Keywords: words[1:]}
+		}
+		exprs = append(exprs, expr)
+	}
+	return exprs, nil
+}
+
+func TestRegisterFunctionSyntax(t *testing.T) {
+	type call struct {
+		args   string
+		offset int
+	}
+	var calls []call
+	record := func(args string, offset int) ([]ast.ExprNode, error) {
+		calls = append(calls, call{args, offset})
+		return parseVendorArgs(args, offset)
+	}
+	p := parser.New()
+	require.NoError(t, p.RegisterFunctionSyntax("Bf_Add", record))
+
+	restore := func(node ast.Node) string {
+		var sb strings.Builder
+		require.NoError(t, node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+		return sb.String()
+	}
+	sql := "SELECT bf_add(k AS BINARY, v), BF_ADD ( 1 , desc ) + 1 FROM t WHERE `bf_add`(a) > 0"
+	stmt, err := p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	require.Equal(t, []call{
+		{"k AS BINARY, v", strings.Index(sql, "k AS")},
+		{" 1 , desc ", strings.Index(sql, " 1 ,")},
+	}, calls)
+	require.Equal(t, "SELECT BF_ADD(`k` AS BINARY, `v`),BF_ADD(1, DESC)+1 FROM `t` WHERE BF_ADD(`a`)>0", restore(stmt))
+	call0 := stmt.(*ast.SelectStmt).Fields.Fields[0].Expr.(*ast.FuncCallExpr)
+	require.Equal(t, "bf_add", call0.FnName.L)
+	require.Equal(t, strings.Index(sql, "bf_add"), call0.OriginTextPosition())
+	require.Equal(t, "BINARY", call0.Args[0].(*ast.FuncKeywordArgExpr).Keywords[1])
+
+	// The parentheses and the strings of the arguments are skipped, and the
+	// error of the callback fails the parse.
+	calls = nil
+	errArgs := errors.New("unsupported arguments")
+	require.NoError(t, p.RegisterFunctionSyntax("bf_add", func(args string, offset int) ([]ast.ExprNode, error) {
+		calls = append(calls, call{args, offset})
+		return nil, errArgs
+	}))
+	_, err = p.ParseOneStmt("SELECT bf_add(f(x, ')'), '(') FROM t", "", "")
+	require.ErrorIs(t, err, errArgs)
+	require.Equal(t, []call{{"f(x, ')'), '('", len("SELECT bf_add(")}}, calls)
+	require.NoError(t, p.RegisterFunctionSyntax("bf_add", record))
+
+	// The columns, the qualified functions, the quoted names and the calls
+	// without ')' aren't calls of the function.
+	calls = nil
+	stmt, err = p.ParseOneStmt("SELECT bf_add, t.bf_add FROM bf_add AS t WHERE db.bf_add(1, 2) AND `bf_add`(3)", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT `bf_add`,`t`.`bf_add` FROM `bf_add` AS `t` WHERE `db`.`bf_add`(1, 2) AND BF_ADD(3)", restore(stmt))
+	_, err = p.ParseOneStmt("SELECT bf_add(1 AS x", "", "")
+	var syntaxErr *parser.SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Empty(t, calls)
+
+	// The other functions keep their grammar.
+	_, err = p.ParseOneStmt("SELECT bf_sub(k AS BINARY)", "", "")
+	require.Error(t, err)
+	stmt, err = p.ParseOneStmt("SELECT CAST(a AS SIGNED), CONVERT(a USING utf8mb4), TRIM(BOTH 'x' FROM a), bf_sub(a, b)", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT CAST(`a` AS SIGNED),CONVERT(`a` USING 'utf8mb4'),TRIM(BOTH 'x' FROM `a`),BF_SUB(`a`, `b`)", restore(stmt))
+	require.Empty(t, calls)
+
+	// The keywords can't be registered.
+	for _, name := range []string{"CAST", "trim", "Convert", "OVER", "`x`", "a b", ""} {
+		require.Error(t, p.RegisterFunctionSyntax(name, parseVendorArgs), name)
+	}
+
+	// A nil argParser unregisters, and so does Reset.
+	require.NoError(t, p.RegisterFunctionSyntax("BF_ADD", nil))
+	_, err = p.ParseOneStmt("SELECT bf_add(k AS BINARY)", "", "")
+	require.Error(t, err)
+	require.NoError(t, p.RegisterFunctionSyntax("BF_ADD", parseVendorArgs))
+	_, err = p.ParseOneStmt("SELECT bf_add(k AS BINARY)", "", "")
+	require.NoError(t, err)
+	p.Reset()
+	_, err = p.ParseOneStmt("SELECT bf_add(k AS BINARY)", "", "")
+	require.Error(t, err)
+}
@@ -2,3 +2,12 @@ package parser
 
 // Generate keywords.go
 //go:generate ./genkeyword
+
+// Generate token_match.go
+//go:generate go run ./generate_tokenmatch
+
+// Generate ast/binary_types.go
+//go:generate go run ./generate_binarytypes
+
+// Generate testdata/corpus
+//go:generate go run ./generate_corpus
@@ -0,0 +1,109 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// genbinarytypes generates ast/binary_types.go, the registry of the types of
+// package ast which ast.EncodeBinary tags the values of the interfaces with,
+// e.g. the nodes of an ExprNode field. The registry has the exported struct
+// types of the package, sorted by name, so that a type gets the same tag
+// whenever the registry is regenerated.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"slices"
+	"strings"
+)
+
+const fileStart = `// Code generated by genbinarytypes. DO NOT EDIT.
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import "reflect"
+
+// WARNING: This file is generated by 'genbinarytypes'
+
+// binaryTypes are the types whose values EncodeBinary tags by their index in
+// an interface.
+var binaryTypes = []reflect.Type{
+`
+
+// structTypes returns the names of the exported struct types declared in the
+// files of pkg.
+func structTypes(pkg *ast.Package) []string {
+	var names []string
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if _, ok := ts.Type.(*ast.StructType); !ok || !ts.Name.IsExported() || ts.TypeParams != nil || ts.Assign.IsValid() {
+					continue
+				}
+				names = append(names, ts.Name.Name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "ast", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatalf("Failed to parse package ast: %s", err)
+	}
+	pkg, ok := pkgs["ast"]
+	if !ok {
+		log.Fatalf("Failed to find package ast")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fileStart)
+	for _, name := range structTypes(pkg) {
+		fmt.Fprintf(&buf, "reflect.TypeOf(%s{}),\n", name)
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format ast/binary_types.go: %s", err)
+	}
+	if err := os.WriteFile("ast/binary_types.go", src, 0600); err != nil {
+		log.Fatalf("Failed to write ast/binary_types.go: %s", err)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// gencorpus generates testdata/corpus, the SQL corpus of the nodes of package
+// ast, see package internal/corpus.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/abbychau/mysql-parser/internal/corpus"
+)
+
+func main() {
+	dir := flag.String("dir", "testdata/corpus", "the directory the corpus is written to")
+	seed := flag.Int64("seed", corpus.DefaultSeed, "the seed of the placeholders")
+	flag.Parse()
+	if err := corpus.Write(*dir, *seed); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// gentokenmatch generates token_match.go, the case-insensitive matchers of the
+// keyword maps of misc.go. The matchers switch on the length and the first byte
+// of the identifier, then compare it with the keywords of the bucket, so that the
+// lexer doesn't need to upper case the identifier to look it up.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const fileStart = `// Code generated by gentokenmatch. DO NOT EDIT.
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+// WARNING: This file is generated by 'gentokenmatch'
+`
+
+// matchers maps the keyword maps of misc.go to the names of their matchers.
+var matchers = []struct {
+	mapName  string
+	funcName string
+}{
+	{"tokenMap", "matchToken"},
+	{"btFuncTokenMap", "matchBtFuncToken"},
+	{"windowFuncTokenMap", "matchWindowFuncToken"},
+	{"mariaDBTokenMap", "matchMariaDBToken"},
+}
+
+type entry struct {
+	word  string
+	token string
+}
+
+// readMaps returns the entries of the map variables of file.
+func readMaps(fset *token.FileSet, file *ast.File) map[string][]entry {
+	maps := make(map[string][]entry)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			var entries []entry
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.BasicLit)
+				if !ok || key.Kind != token.STRING {
+					continue
+				}
+				word, err := strconv.Unquote(key.Value)
+				if err != nil {
+					log.Fatalf("Failed to unquote %s: %s", key.Value, err)
+				}
+				var value bytes.Buffer
+				if err := printer.Fprint(&value, fset, kv.Value); err != nil {
+					log.Fatalf("Failed to print the token of %s: %s", word, err)
+				}
+				entries = append(entries, entry{word: word, token: value.String()})
+			}
+			maps[vs.Names[0].Name] = entries
+		}
+	}
+	return maps
+}
+
+// isUpper reports whether word has no lower case ASCII letter. The lexer upper
+// cases the identifiers, so the other words never match.
+func isUpper(word string) bool {
+	for i := range len(word) {
+		if word[i] >= 'a' && word[i] <= 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func writeMatcher(buf *bytes.Buffer, mapName, funcName string, entries []entry) {
+	byLen := make(map[int]map[byte][]entry)
+	for _, e := range entries {
+		if e.word == "" || !isUpper(e.word) {
+			continue
+		}
+		first := e.word[0] &^ 0x20
+		if byLen[len(e.word)] == nil {
+			byLen[len(e.word)] = make(map[byte][]entry)
+		}
+		byLen[len(e.word)][first] = append(byLen[len(e.word)][first], e)
+	}
+	lens := make([]int, 0, len(byLen))
+	for n := range byLen {
+		lens = append(lens, n)
+	}
+	slices.Sort(lens)
+
+	fmt.Fprintf(buf, "\n// %s returns the token of lit in %s, lit is matched with its ASCII letters\n", funcName, mapName)
+	fmt.Fprintf(buf, "// upper cased.\n")
+	fmt.Fprintf(buf, "func %s(lit string) (int, bool) {\n", funcName)
+	fmt.Fprintf(buf, "switch len(lit) {\n")
+	for _, n := range lens {
+		fmt.Fprintf(buf, "case %d:\n", n)
+		fmt.Fprintf(buf, "switch lit[0] &^ 0x20 {\n")
+		firsts := make([]byte, 0, len(byLen[n]))
+		for c := range byLen[n] {
+			firsts = append(firsts, c)
+		}
+		slices.Sort(firsts)
+		for _, c := range firsts {
+			fmt.Fprintf(buf, "case %s:\n", strconv.QuoteRune(rune(c)))
+			bucket := byLen[n][c]
+			slices.SortFunc(bucket, func(a, b entry) int {
+				return strings.Compare(a.word, b.word)
+			})
+			for _, e := range bucket {
+				fmt.Fprintf(buf, "if equalFoldUpper(lit, %q) {\nreturn %s, true\n}\n", e.word, e.token)
+			}
+		}
+		fmt.Fprintf(buf, "}\n")
+	}
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "return 0, false\n")
+	fmt.Fprintf(buf, "}\n")
+}
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "misc.go", nil, 0)
+	if err != nil {
+		log.Fatalf("Failed to parse misc.go: %s", err)
+	}
+	maps := readMaps(fset, file)
+
+	var buf bytes.Buffer
+	buf.WriteString(fileStart)
+	for _, m := range matchers {
+		entries, ok := maps[m.mapName]
+		if !ok {
+			log.Fatalf("Failed to find %s in misc.go", m.mapName)
+		}
+		writeMatcher(&buf, m.mapName, m.funcName, entries)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format token_match.go: %s", err)
+	}
+	if err := os.WriteFile("token_match.go", src, 0600); err != nil {
+		log.Fatalf("Failed to write token_match.go: %s", err)
+	}
+}
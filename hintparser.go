@@ -33,6 +33,7 @@ type yyhintSymType struct {
 	hints       []*ast.TableOptimizerHint
 	table       ast.HintTable
 	modelIdents []ast.CIStr
+	setVar      ast.HintSetVar
 }
 
 type yyhintXError struct {
@@ -40,239 +41,241 @@ type yyhintXError struct {
 }
 
 const (
-	yyhintDefault             = 57434
+	yyhintDefault             = 57435
 	yyhintEOFCode             = 57344
 	yyhintErrCode             = 57345
-	hintAggToCop              = 57380
-	hintBCJoin                = 57402
-	hintBKA                   = 57355
-	hintBNL                   = 57357
-	hintDupsWeedOut           = 57430
-	hintFalse                 = 57426
-	hintFirstMatch            = 57431
-	hintForceIndex            = 57416
-	hintGB                    = 57429
-	hintHashAgg               = 57382
-	hintHashJoin              = 57359
-	hintHashJoinBuild         = 57360
-	hintHashJoinProbe         = 57361
-	hintHypoIndex             = 57379
-	hintIdentifier            = 57347
-	hintIgnoreIndex           = 57385
-	hintIgnorePlanCache       = 57381
-	hintIndexHashJoin         = 57389
-	hintIndexJoin             = 57386
-	hintIndexMerge            = 57365
-	hintIndexMergeJoin        = 57393
-	hintInlHashJoin           = 57388
-	hintInlJoin               = 57391
-	hintInlMergeJoin          = 57392
+	hintAggToCop              = 57381
+	hintBCJoin                = 57403
+	hintBKA                   = 57356
+	hintBNL                   = 57358
+	hintDecLit                = 57351
+	hintDupsWeedOut           = 57431
+	hintFalse                 = 57427
+	hintFirstMatch            = 57432
+	hintForceIndex            = 57417
+	hintGB                    = 57430
+	hintHashAgg               = 57383
+	hintHashJoin              = 57360
+	hintHashJoinBuild         = 57361
+	hintHashJoinProbe         = 57362
+	hintHypoIndex             = 57380
+	hintIdentifier            = 57348
+	hintIgnoreIndex           = 57386
+	hintIgnorePlanCache       = 57382
+	hintIndexHashJoin         = 57390
+	hintIndexJoin             = 57387
+	hintIndexMerge            = 57366
+	hintIndexMergeJoin        = 57394
+	hintInlHashJoin           = 57389
+	hintInlJoin               = 57392
+	hintInlMergeJoin          = 57393
 	hintIntLit                = 57346
-	hintInvalid               = 57348
-	hintJoinFixedOrder        = 57351
-	hintJoinOrder             = 57352
-	hintJoinPrefix            = 57353
-	hintJoinSuffix            = 57354
-	hintLeading               = 57418
-	hintLimitToCop            = 57415
-	hintLooseScan             = 57432
-	hintMB                    = 57428
-	hintMRR                   = 57367
-	hintMaterialization       = 57433
-	hintMaxExecutionTime      = 57375
-	hintMemoryQuota           = 57395
-	hintMerge                 = 57363
-	hintMpp1PhaseAgg          = 57383
-	hintMpp2PhaseAgg          = 57384
-	hintNoBKA                 = 57356
-	hintNoBNL                 = 57358
-	hintNoDecorrelate         = 57420
-	hintNoHashJoin            = 57362
-	hintNoICP                 = 57369
-	hintNoIndexHashJoin       = 57390
-	hintNoIndexJoin           = 57387
-	hintNoIndexMerge          = 57366
-	hintNoIndexMergeJoin      = 57394
-	hintNoMRR                 = 57368
-	hintNoMerge               = 57364
-	hintNoOrderIndex          = 57409
-	hintNoRangeOptimization   = 57370
-	hintNoSMJoin              = 57401
-	hintNoSemijoin            = 57374
-	hintNoSkipScan            = 57372
-	hintNoSwapJoinInputs      = 57396
-	hintNthPlan               = 57414
-	hintOLAP                  = 57421
-	hintOLTP                  = 57422
-	hintOrderIndex            = 57408
-	hintPartition             = 57423
-	hintQBName                = 57378
-	hintQueryType             = 57397
-	hintReadConsistentReplica = 57398
-	hintReadFromStorage       = 57399
-	hintResourceGroup         = 57377
-	hintSMJoin                = 57400
-	hintSemiJoinRewrite       = 57419
-	hintSemijoin              = 57373
-	hintSetVar                = 57376
-	hintShuffleJoin           = 57403
-	hintSingleAtIdentifier    = 57349
-	hintSkipScan              = 57371
-	hintStraightJoin          = 57417
-	hintStreamAgg             = 57404
-	hintStringLit             = 57350
-	hintSwapJoinInputs        = 57405
-	hintTiFlash               = 57425
-	hintTiKV                  = 57424
-	hintTimeRange             = 57412
-	hintTrue                  = 57427
-	hintUseCascades           = 57413
-	hintUseIndex              = 57407
-	hintUseIndexMerge         = 57406
-	hintUsePlanCache          = 57410
-	hintUseToja               = 57411
+	hintInvalid               = 57349
+	hintJoinFixedOrder        = 57352
+	hintJoinOrder             = 57353
+	hintJoinPrefix            = 57354
+	hintJoinSuffix            = 57355
+	hintLeading               = 57419
+	hintLimitToCop            = 57416
+	hintLooseScan             = 57433
+	hintMB                    = 57429
+	hintMRR                   = 57368
+	hintMaterialization       = 57434
+	hintMaxExecutionTime      = 57376
+	hintMemoryQuota           = 57396
+	hintMerge                 = 57364
+	hintMpp1PhaseAgg          = 57384
+	hintMpp2PhaseAgg          = 57385
+	hintNoBKA                 = 57357
+	hintNoBNL                 = 57359
+	hintNoDecorrelate         = 57421
+	hintNoHashJoin            = 57363
+	hintNoICP                 = 57370
+	hintNoIndexHashJoin       = 57391
+	hintNoIndexJoin           = 57388
+	hintNoIndexMerge          = 57367
+	hintNoIndexMergeJoin      = 57395
+	hintNoMRR                 = 57369
+	hintNoMerge               = 57365
+	hintNoOrderIndex          = 57410
+	hintNoRangeOptimization   = 57371
+	hintNoSMJoin              = 57402
+	hintNoSemijoin            = 57375
+	hintNoSkipScan            = 57373
+	hintNoSwapJoinInputs      = 57397
+	hintNthPlan               = 57415
+	hintOLAP                  = 57422
+	hintOLTP                  = 57423
+	hintOrderIndex            = 57409
+	hintPartition             = 57424
+	hintQBName                = 57379
+	hintQueryType             = 57398
+	hintReadConsistentReplica = 57399
+	hintReadFromStorage       = 57400
+	hintResourceGroup         = 57378
+	hintSMJoin                = 57401
+	hintSemiJoinRewrite       = 57420
+	hintSemijoin              = 57374
+	hintSetVar                = 57377
+	hintShuffleJoin           = 57404
+	hintSingleAtIdentifier    = 57350
+	hintSkipScan              = 57372
+	hintStraightJoin          = 57418
+	hintStreamAgg             = 57405
+	hintStringLit             = 57347
+	hintSwapJoinInputs        = 57406
+	hintTiFlash               = 57426
+	hintTiKV                  = 57425
+	hintTimeRange             = 57413
+	hintTrue                  = 57428
+	hintUseCascades           = 57414
+	hintUseIndex              = 57408
+	hintUseIndexMerge         = 57407
+	hintUsePlanCache          = 57411
+	hintUseToja               = 57412
 
 	yyhintMaxDepth = 200
-	yyhintTabOfs   = -219
+	yyhintTabOfs   = -223
 )
 
 var (
 	yyhintXLAT = map[int]int{
-		41:    0,   // ')' (163x)
-		57380: 1,   // hintAggToCop (152x)
-		57402: 2,   // hintBCJoin (152x)
-		57355: 3,   // hintBKA (152x)
-		57357: 4,   // hintBNL (152x)
-		57416: 5,   // hintForceIndex (152x)
-		57382: 6,   // hintHashAgg (152x)
-		57359: 7,   // hintHashJoin (152x)
-		57360: 8,   // hintHashJoinBuild (152x)
-		57361: 9,   // hintHashJoinProbe (152x)
-		57379: 10,  // hintHypoIndex (152x)
-		57347: 11,  // hintIdentifier (152x)
-		57385: 12,  // hintIgnoreIndex (152x)
-		57381: 13,  // hintIgnorePlanCache (152x)
-		57389: 14,  // hintIndexHashJoin (152x)
-		57386: 15,  // hintIndexJoin (152x)
-		57365: 16,  // hintIndexMerge (152x)
-		57393: 17,  // hintIndexMergeJoin (152x)
-		57388: 18,  // hintInlHashJoin (152x)
-		57391: 19,  // hintInlJoin (152x)
-		57392: 20,  // hintInlMergeJoin (152x)
-		57351: 21,  // hintJoinFixedOrder (152x)
-		57352: 22,  // hintJoinOrder (152x)
-		57353: 23,  // hintJoinPrefix (152x)
-		57354: 24,  // hintJoinSuffix (152x)
-		57418: 25,  // hintLeading (152x)
-		57415: 26,  // hintLimitToCop (152x)
-		57375: 27,  // hintMaxExecutionTime (152x)
-		57395: 28,  // hintMemoryQuota (152x)
-		57363: 29,  // hintMerge (152x)
-		57383: 30,  // hintMpp1PhaseAgg (152x)
-		57384: 31,  // hintMpp2PhaseAgg (152x)
-		57367: 32,  // hintMRR (152x)
-		57356: 33,  // hintNoBKA (152x)
-		57358: 34,  // hintNoBNL (152x)
-		57420: 35,  // hintNoDecorrelate (152x)
-		57362: 36,  // hintNoHashJoin (152x)
-		57369: 37,  // hintNoICP (152x)
-		57390: 38,  // hintNoIndexHashJoin (152x)
-		57387: 39,  // hintNoIndexJoin (152x)
-		57366: 40,  // hintNoIndexMerge (152x)
-		57394: 41,  // hintNoIndexMergeJoin (152x)
-		57364: 42,  // hintNoMerge (152x)
-		57368: 43,  // hintNoMRR (152x)
-		57409: 44,  // hintNoOrderIndex (152x)
-		57370: 45,  // hintNoRangeOptimization (152x)
-		57374: 46,  // hintNoSemijoin (152x)
-		57372: 47,  // hintNoSkipScan (152x)
-		57401: 48,  // hintNoSMJoin (152x)
-		57396: 49,  // hintNoSwapJoinInputs (152x)
-		57414: 50,  // hintNthPlan (152x)
-		57408: 51,  // hintOrderIndex (152x)
-		57378: 52,  // hintQBName (152x)
-		57397: 53,  // hintQueryType (152x)
-		57398: 54,  // hintReadConsistentReplica (152x)
-		57399: 55,  // hintReadFromStorage (152x)
-		57377: 56,  // hintResourceGroup (152x)
-		57373: 57,  // hintSemijoin (152x)
-		57419: 58,  // hintSemiJoinRewrite (152x)
-		57376: 59,  // hintSetVar (152x)
-		57403: 60,  // hintShuffleJoin (152x)
-		57371: 61,  // hintSkipScan (152x)
-		57400: 62,  // hintSMJoin (152x)
-		57417: 63,  // hintStraightJoin (152x)
-		57404: 64,  // hintStreamAgg (152x)
-		57405: 65,  // hintSwapJoinInputs (152x)
-		57412: 66,  // hintTimeRange (152x)
-		57413: 67,  // hintUseCascades (152x)
-		57407: 68,  // hintUseIndex (152x)
-		57406: 69,  // hintUseIndexMerge (152x)
-		57410: 70,  // hintUsePlanCache (152x)
-		57411: 71,  // hintUseToja (152x)
+		41:    0,   // ')' (167x)
+		57381: 1,   // hintAggToCop (152x)
+		57403: 2,   // hintBCJoin (152x)
+		57356: 3,   // hintBKA (152x)
+		57358: 4,   // hintBNL (152x)
+		57417: 5,   // hintForceIndex (152x)
+		57383: 6,   // hintHashAgg (152x)
+		57360: 7,   // hintHashJoin (152x)
+		57361: 8,   // hintHashJoinBuild (152x)
+		57362: 9,   // hintHashJoinProbe (152x)
+		57380: 10,  // hintHypoIndex (152x)
+		57348: 11,  // hintIdentifier (152x)
+		57386: 12,  // hintIgnoreIndex (152x)
+		57382: 13,  // hintIgnorePlanCache (152x)
+		57390: 14,  // hintIndexHashJoin (152x)
+		57387: 15,  // hintIndexJoin (152x)
+		57366: 16,  // hintIndexMerge (152x)
+		57394: 17,  // hintIndexMergeJoin (152x)
+		57389: 18,  // hintInlHashJoin (152x)
+		57392: 19,  // hintInlJoin (152x)
+		57393: 20,  // hintInlMergeJoin (152x)
+		57352: 21,  // hintJoinFixedOrder (152x)
+		57353: 22,  // hintJoinOrder (152x)
+		57354: 23,  // hintJoinPrefix (152x)
+		57355: 24,  // hintJoinSuffix (152x)
+		57419: 25,  // hintLeading (152x)
+		57416: 26,  // hintLimitToCop (152x)
+		57376: 27,  // hintMaxExecutionTime (152x)
+		57396: 28,  // hintMemoryQuota (152x)
+		57364: 29,  // hintMerge (152x)
+		57384: 30,  // hintMpp1PhaseAgg (152x)
+		57385: 31,  // hintMpp2PhaseAgg (152x)
+		57368: 32,  // hintMRR (152x)
+		57357: 33,  // hintNoBKA (152x)
+		57359: 34,  // hintNoBNL (152x)
+		57421: 35,  // hintNoDecorrelate (152x)
+		57363: 36,  // hintNoHashJoin (152x)
+		57370: 37,  // hintNoICP (152x)
+		57391: 38,  // hintNoIndexHashJoin (152x)
+		57388: 39,  // hintNoIndexJoin (152x)
+		57367: 40,  // hintNoIndexMerge (152x)
+		57395: 41,  // hintNoIndexMergeJoin (152x)
+		57365: 42,  // hintNoMerge (152x)
+		57369: 43,  // hintNoMRR (152x)
+		57410: 44,  // hintNoOrderIndex (152x)
+		57371: 45,  // hintNoRangeOptimization (152x)
+		57375: 46,  // hintNoSemijoin (152x)
+		57373: 47,  // hintNoSkipScan (152x)
+		57402: 48,  // hintNoSMJoin (152x)
+		57397: 49,  // hintNoSwapJoinInputs (152x)
+		57415: 50,  // hintNthPlan (152x)
+		57409: 51,  // hintOrderIndex (152x)
+		57379: 52,  // hintQBName (152x)
+		57398: 53,  // hintQueryType (152x)
+		57399: 54,  // hintReadConsistentReplica (152x)
+		57400: 55,  // hintReadFromStorage (152x)
+		57378: 56,  // hintResourceGroup (152x)
+		57374: 57,  // hintSemijoin (152x)
+		57420: 58,  // hintSemiJoinRewrite (152x)
+		57377: 59,  // hintSetVar (152x)
+		57404: 60,  // hintShuffleJoin (152x)
+		57372: 61,  // hintSkipScan (152x)
+		57401: 62,  // hintSMJoin (152x)
+		57418: 63,  // hintStraightJoin (152x)
+		57405: 64,  // hintStreamAgg (152x)
+		57406: 65,  // hintSwapJoinInputs (152x)
+		57413: 66,  // hintTimeRange (152x)
+		57414: 67,  // hintUseCascades (152x)
+		57408: 68,  // hintUseIndex (152x)
+		57407: 69,  // hintUseIndexMerge (152x)
+		57411: 70,  // hintUsePlanCache (152x)
+		57412: 71,  // hintUseToja (152x)
 		44:    72,  // ',' (146x)
-		57430: 73,  // hintDupsWeedOut (125x)
-		57431: 74,  // hintFirstMatch (125x)
-		57432: 75,  // hintLooseScan (125x)
-		57433: 76,  // hintMaterialization (125x)
-		57425: 77,  // hintTiFlash (125x)
-		57424: 78,  // hintTiKV (125x)
-		57426: 79,  // hintFalse (124x)
-		57421: 80,  // hintOLAP (124x)
-		57422: 81,  // hintOLTP (124x)
-		57427: 82,  // hintTrue (124x)
-		57429: 83,  // hintGB (123x)
-		57428: 84,  // hintMB (123x)
-		57349: 85,  // hintSingleAtIdentifier (104x)
+		57431: 73,  // hintDupsWeedOut (125x)
+		57432: 74,  // hintFirstMatch (125x)
+		57433: 75,  // hintLooseScan (125x)
+		57434: 76,  // hintMaterialization (125x)
+		57426: 77,  // hintTiFlash (125x)
+		57425: 78,  // hintTiKV (125x)
+		57427: 79,  // hintFalse (124x)
+		57422: 80,  // hintOLAP (124x)
+		57423: 81,  // hintOLTP (124x)
+		57428: 82,  // hintTrue (124x)
+		57430: 83,  // hintGB (123x)
+		57429: 84,  // hintMB (123x)
+		57350: 85,  // hintSingleAtIdentifier (104x)
 		57346: 86,  // hintIntLit (101x)
 		93:    87,  // ']' (94x)
 		46:    88,  // '.' (93x)
-		57423: 89,  // hintPartition (88x)
+		57424: 89,  // hintPartition (88x)
 		61:    90,  // '=' (85x)
 		40:    91,  // '(' (80x)
 		57344: 92,  // $end (29x)
-		57454: 93,  // QueryBlockOpt (21x)
-		57446: 94,  // Identifier (18x)
-		57350: 95,  // hintStringLit (6x)
-		57436: 96,  // CommaOpt (5x)
-		57442: 97,  // HintTable (4x)
-		57443: 98,  // HintTableList (4x)
-		91:    99,  // '[' (3x)
-		43:    100, // '+' (2x)
-		45:    101, // '-' (2x)
-		57435: 102, // BooleanHintName (2x)
-		57437: 103, // HintIndexList (2x)
-		57439: 104, // HintStorageType (2x)
-		57440: 105, // HintStorageTypeAndTable (2x)
-		57444: 106, // HintTableListOpt (2x)
-		57449: 107, // JoinOrderOptimizerHintName (2x)
-		57450: 108, // NullaryHintName (2x)
-		57452: 109, // PartitionList (2x)
-		57453: 110, // PartitionListOpt (2x)
-		57456: 111, // StorageOptimizerHintOpt (2x)
-		57457: 112, // SubqueryOptimizerHintName (2x)
-		57460: 113, // SubqueryStrategy (2x)
-		57461: 114, // SupportedIndexLevelOptimizerHintName (2x)
-		57462: 115, // SupportedTableLevelOptimizerHintName (2x)
-		57463: 116, // TableOptimizerHintOpt (2x)
-		57465: 117, // UnsupportedIndexLevelOptimizerHintName (2x)
-		57466: 118, // UnsupportedTableLevelOptimizerHintName (2x)
-		57467: 119, // Value (2x)
-		57468: 120, // ViewName (2x)
-		57438: 121, // HintQueryType (1x)
-		57441: 122, // HintStorageTypeAndTableList (1x)
-		57445: 123, // HintTrueOrFalse (1x)
-		57447: 124, // IndexNameList (1x)
-		57448: 125, // IndexNameListOpt (1x)
-		57451: 126, // OptimizerHintList (1x)
-		57455: 127, // Start (1x)
-		57458: 128, // SubqueryStrategies (1x)
-		57459: 129, // SubqueryStrategiesOpt (1x)
-		57464: 130, // UnitOfBytes (1x)
-		57469: 131, // ViewNameList (1x)
-		57434: 132, // $default (0x)
-		57345: 133, // error (0x)
-		57348: 134, // hintInvalid (0x)
+		57455: 93,  // QueryBlockOpt (21x)
+		57447: 94,  // Identifier (18x)
+		57347: 95,  // hintStringLit (6x)
+		57437: 96,  // CommaOpt (5x)
+		57351: 97,  // hintDecLit (4x)
+		57443: 98,  // HintTable (4x)
+		57444: 99,  // HintTableList (4x)
+		91:    100, // '[' (3x)
+		43:    101, // '+' (2x)
+		45:    102, // '-' (2x)
+		57436: 103, // BooleanHintName (2x)
+		57438: 104, // HintIndexList (2x)
+		57440: 105, // HintStorageType (2x)
+		57441: 106, // HintStorageTypeAndTable (2x)
+		57445: 107, // HintTableListOpt (2x)
+		57450: 108, // JoinOrderOptimizerHintName (2x)
+		57451: 109, // NullaryHintName (2x)
+		57453: 110, // PartitionList (2x)
+		57454: 111, // PartitionListOpt (2x)
+		57457: 112, // StorageOptimizerHintOpt (2x)
+		57458: 113, // SubqueryOptimizerHintName (2x)
+		57461: 114, // SubqueryStrategy (2x)
+		57462: 115, // SupportedIndexLevelOptimizerHintName (2x)
+		57463: 116, // SupportedTableLevelOptimizerHintName (2x)
+		57464: 117, // TableOptimizerHintOpt (2x)
+		57466: 118, // UnsupportedIndexLevelOptimizerHintName (2x)
+		57467: 119, // UnsupportedTableLevelOptimizerHintName (2x)
+		57468: 120, // Value (2x)
+		57469: 121, // ViewName (2x)
+		57439: 122, // HintQueryType (1x)
+		57442: 123, // HintStorageTypeAndTableList (1x)
+		57446: 124, // HintTrueOrFalse (1x)
+		57448: 125, // IndexNameList (1x)
+		57449: 126, // IndexNameListOpt (1x)
+		57452: 127, // OptimizerHintList (1x)
+		57456: 128, // Start (1x)
+		57459: 129, // SubqueryStrategies (1x)
+		57460: 130, // SubqueryStrategiesOpt (1x)
+		57465: 131, // UnitOfBytesOpt (1x)
+		57470: 132, // ViewNameList (1x)
+		57435: 133, // $default (0x)
+		57345: 134, // error (0x)
+		57349: 135, // hintInvalid (0x)
 	}
 
 	yyhintSymNames = []string{
@@ -373,6 +376,7 @@ var (
 		"Identifier",
 		"hintStringLit",
 		"CommaOpt",
+		"hintDecLit",
 		"HintTable",
 		"HintTableList",
 		"'['",
@@ -406,7 +410,7 @@ var (
 		"Start",
 		"SubqueryStrategies",
 		"SubqueryStrategiesOpt",
-		"UnitOfBytes",
+		"UnitOfBytesOpt",
 		"ViewNameList",
 		"$default",
 		"error",
@@ -415,76 +419,109 @@ var (
 
 	yyhintReductions = []struct{ xsym, components int }{
 		{0, 1},
+		{128, 1},
 		{127, 1},
-		{126, 1},
-		{126, 3},
-		{126, 1},
-		{126, 3},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 4},
-		{116, 5},
-		{116, 5},
-		{116, 5},
-		{116, 6},
-		{116, 4},
-		{116, 4},
-		{116, 6},
-		{116, 6},
-		{116, 6},
-		{116, 5},
-		{116, 4},
-		{116, 5},
-		{116, 5},
-		{116, 4},
-		{116, 6},
-		{116, 6},
-		{111, 5},
-		{122, 1},
-		{122, 3},
-		{105, 4},
+		{127, 3},
+		{127, 1},
+		{127, 3},
+		{117, 4},
+		{117, 4},
+		{117, 4},
+		{117, 4},
+		{117, 4},
+		{117, 4},
+		{117, 5},
+		{117, 5},
+		{117, 5},
+		{117, 6},
+		{117, 4},
+		{117, 4},
+		{117, 6},
+		{117, 6},
+		{117, 6},
+		{117, 5},
+		{117, 4},
+		{117, 5},
+		{117, 5},
+		{117, 4},
+		{117, 6},
+		{117, 6},
+		{112, 5},
+		{123, 1},
+		{123, 3},
+		{106, 4},
 		{93, 0},
 		{93, 1},
 		{96, 0},
 		{96, 1},
-		{110, 0},
-		{110, 4},
-		{109, 1},
-		{109, 3},
-		{106, 1},
-		{106, 1},
-		{98, 2},
+		{111, 0},
+		{111, 4},
+		{110, 1},
+		{110, 3},
+		{107, 1},
+		{107, 1},
+		{99, 2},
+		{99, 3},
 		{98, 3},
-		{97, 3},
-		{97, 5},
-		{131, 3},
-		{131, 1},
+		{98, 5},
+		{132, 3},
+		{132, 1},
+		{121, 2},
+		{121, 1},
+		{104, 4},
+		{126, 0},
+		{126, 1},
+		{125, 1},
+		{125, 3},
+		{130, 0},
+		{130, 1},
+		{129, 1},
+		{129, 3},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 2},
 		{120, 2},
 		{120, 1},
-		{103, 4},
-		{125, 0},
-		{125, 1},
+		{120, 2},
+		{120, 2},
+		{131, 0},
+		{131, 1},
+		{131, 1},
 		{124, 1},
-		{124, 3},
-		{129, 0},
-		{129, 1},
-		{128, 1},
-		{128, 3},
+		{124, 1},
+		{108, 1},
+		{108, 1},
+		{108, 1},
 		{119, 1},
 		{119, 1},
 		{119, 1},
-		{119, 2},
-		{119, 2},
-		{130, 1},
-		{130, 1},
-		{123, 1},
-		{123, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
+		{119, 1},
+		{119, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{116, 1},
+		{118, 1},
+		{118, 1},
 		{118, 1},
 		{118, 1},
 		{118, 1},
@@ -496,60 +533,31 @@ var (
 		{115, 1},
 		{115, 1},
 		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{115, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{114, 1},
-		{114, 1},
+		{113, 1},
+		{113, 1},
 		{114, 1},
 		{114, 1},
 		{114, 1},
 		{114, 1},
-		{112, 1},
-		{112, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{102, 1},
-		{102, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{108, 1},
-		{121, 1},
-		{121, 1},
-		{104, 1},
-		{104, 1},
+		{103, 1},
+		{103, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{109, 1},
+		{122, 1},
+		{122, 1},
+		{105, 1},
+		{105, 1},
 		{94, 1},
 		{94, 1},
 		{94, 1},
@@ -637,36 +645,36 @@ var (
 
 	yyhintXErrors = map[yyhintXError]string{}
 
-	yyhintParseTab = [318][]uint16{
+	yyhintParseTab = [321][]uint16{
 		// 0
-		{1: 295, 253, 246, 248, 283, 291, 267, 269, 270, 272, 241, 281, 299, 260, 256, 273, 265, 259, 255, 264, 224, 243, 244, 245, 271, 296, 231, 236, 258, 292, 293, 274, 247, 249, 302, 268, 276, 261, 257, 297, 266, 250, 275, 285, 277, 287, 279, 252, 263, 232, 284, 235, 240, 298, 242, 234, 286, 301, 233, 254, 278, 251, 300, 294, 262, 237, 289, 280, 282, 290, 288, 102: 238, 107: 225, 239, 111: 223, 230, 114: 229, 227, 222, 228, 226, 126: 221, 220},
-		{92: 219},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 92: 218, 96: 534},
-		{1: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 92: 217},
-		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 92: 215},
+		{1: 299, 257, 250, 252, 287, 295, 271, 273, 274, 276, 245, 285, 303, 264, 260, 277, 269, 263, 259, 268, 228, 247, 248, 249, 275, 300, 235, 240, 262, 296, 297, 278, 251, 253, 306, 272, 280, 265, 261, 301, 270, 254, 279, 289, 281, 291, 283, 256, 267, 236, 288, 239, 244, 302, 246, 238, 290, 305, 237, 258, 282, 255, 304, 298, 266, 241, 293, 284, 286, 294, 292, 103: 242, 108: 229, 243, 112: 227, 234, 115: 233, 231, 226, 232, 230, 127: 225, 224},
+		{92: 223},
+		{1: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 412, 92: 222, 96: 541},
+		{1: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 92: 221},
+		{1: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 92: 219},
 		// 5
-		{91: 531},
-		{91: 528},
-		{91: 525},
-		{91: 520},
-		{91: 517},
+		{91: 538},
+		{91: 535},
+		{91: 532},
+		{91: 527},
+		{91: 524},
 		// 10
-		{91: 506},
-		{91: 494},
-		{91: 490},
-		{91: 486},
-		{91: 481},
+		{91: 513},
+		{91: 501},
+		{91: 497},
+		{91: 493},
+		{91: 488},
 		// 15
-		{91: 478},
+		{91: 485},
+		{91: 473},
 		{91: 466},
-		{91: 459},
-		{91: 454},
-		{91: 448},
+		{91: 461},
+		{91: 455},
 		// 20
-		{91: 445},
-		{91: 439},
-		{91: 419},
-		{91: 303},
+		{91: 452},
+		{91: 446},
+		{91: 423},
+		{91: 307},
 		{91: 151},
 		// 25
 		{91: 150},
@@ -739,22 +747,22 @@ var (
 		{91: 90},
 		{91: 89},
 		{91: 88},
-		{77: 187, 187, 85: 305, 93: 304},
+		{77: 191, 191, 85: 309, 93: 308},
 		// 85
-		{77: 310, 309, 104: 308, 307, 122: 306},
-		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 86: 186, 186, 186, 186},
-		{416, 72: 417},
-		{190, 72: 190},
-		{99: 311},
+		{77: 314, 313, 105: 312, 311, 123: 310},
+		{190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 86: 190, 190, 190, 190},
+		{420, 72: 421},
+		{194, 72: 194},
+		{100: 315},
 		// 90
-		{99: 85},
-		{99: 84},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 313, 98: 312},
-		{72: 414, 87: 413},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 314},
+		{100: 85},
+		{100: 84},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 73: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 93: 317, 99: 316},
+		{72: 418, 87: 417},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 319, 98: 318},
 		// 95
-		{177, 72: 177, 87: 177},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 87: 187, 400, 187, 93: 399},
+		{181, 72: 181, 87: 181},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 87: 191, 404, 191, 93: 403},
 		{83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83},
 		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
 		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
@@ -855,171 +863,175 @@ var (
 		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
 		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
 		// 180
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 87: 183, 89: 403, 110: 412},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 401},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 87: 187, 89: 187, 93: 402},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 87: 183, 89: 403, 110: 404},
-		{91: 405},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 87: 187, 89: 407, 111: 416},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 405},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 87: 191, 89: 191, 93: 406},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 87: 187, 89: 407, 111: 408},
+		{91: 409},
 		// 185
-		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 87: 174},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 407, 109: 406},
-		{409, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 96: 410},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181},
-		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 73: 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 86: 184, 95: 184},
+		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 87: 178},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 411, 110: 410},
+		{413, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 412, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 96: 414},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 73: 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 86: 188, 95: 188},
 		// 190
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 87: 182},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 411},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 86: 180},
-		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 87: 175},
-		{188, 72: 188},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 87: 186},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 415},
+		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 86: 184},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 87: 179},
+		{192, 72: 192},
 		// 195
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 415},
-		{176, 72: 176, 87: 176},
-		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 92: 191},
-		{77: 310, 309, 104: 308, 418},
-		{189, 72: 189},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 319, 98: 419},
+		{180, 72: 180, 87: 180},
+		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 92: 195},
+		{77: 314, 313, 105: 312, 422},
+		{193, 72: 193},
 		// 200
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 305, 187, 93: 420, 422, 109: 421},
-		{86: 437},
-		{433, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 86: 185, 96: 434},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 86: 181, 90: 423},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 86: 427, 94: 426, 425, 100: 428, 429, 119: 424},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 309, 191, 93: 424, 426, 110: 425},
+		{86: 444},
+		{440, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 412, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 86: 189, 96: 441},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 86: 185, 90: 427},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 86: 431, 94: 430, 429, 97: 434, 101: 432, 433, 120: 428},
 		// 205
-		{432},
-		{160},
-		{159},
-		{158},
-		{86: 431},
+		{439},
+		{164},
+		{163},
+		{162},
+		{86: 437, 97: 438},
 		// 210
-		{86: 430},
-		{156},
+		{86: 435, 97: 436},
+		{159},
+		{160},
 		{157},
-		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 92: 192},
-		{1: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 92: 194},
+		{161},
 		// 215
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 86: 435, 94: 411},
-		{436},
-		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 92: 193},
-		{438},
-		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 92: 195},
+		{158},
+		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 92: 196},
+		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 92: 198},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 86: 442, 94: 415},
+		{443},
 		// 220
-		{80: 187, 187, 85: 305, 93: 440},
-		{80: 442, 443, 121: 441},
-		{444},
+		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 92: 197},
+		{445},
+		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 92: 199},
+		{80: 191, 191, 85: 309, 93: 447},
+		{80: 449, 450, 122: 448},
+		// 225
+		{451},
 		{87},
 		{86},
-		// 225
-		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 92: 196},
-		{187, 85: 305, 93: 446},
-		{447},
-		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 92: 197},
-		{79: 187, 82: 187, 85: 305, 93: 449},
+		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 92: 200},
+		{191, 85: 309, 93: 453},
 		// 230
-		{79: 452, 82: 451, 123: 450},
-		{453},
+		{454},
+		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 92: 201},
+		{79: 191, 82: 191, 85: 309, 93: 456},
+		{79: 459, 82: 458, 124: 457},
+		{460},
+		// 235
 		{153},
 		{152},
-		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 92: 198},
-		// 235
-		{95: 455},
-		{72: 408, 95: 185, 456},
-		{95: 457},
-		{458},
-		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 92: 199},
+		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 92: 202},
+		{95: 462},
+		{72: 412, 95: 189, 463},
 		// 240
-		{85: 305, 187, 93: 460},
-		{86: 461},
-		{83: 464, 463, 130: 462},
+		{95: 464},
 		{465},
-		{155},
+		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 92: 203},
+		{85: 309, 191, 93: 467},
+		{86: 468},
 		// 245
+		{156, 83: 471, 470, 131: 469},
+		{472},
+		{155},
 		{154},
-		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 92: 200},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 467},
-		{468, 72: 469},
-		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 92: 202},
+		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 92: 204},
 		// 250
-		{187, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 305, 88: 187, 93: 473, 472, 120: 471, 131: 470},
-		{475, 88: 476},
-		{172, 88: 172},
-		{187, 85: 305, 88: 187, 93: 474},
-		{170, 88: 170},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 474},
+		{475, 72: 476},
+		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 92: 206},
+		{191, 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 309, 88: 191, 93: 480, 479, 121: 478, 132: 477},
+		{482, 88: 483},
 		// 255
-		{171, 88: 171},
-		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 92: 201},
-		{187, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 305, 88: 187, 93: 473, 472, 120: 477},
-		{173, 88: 173},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 479},
+		{176, 88: 176},
+		{191, 85: 309, 88: 191, 93: 481},
+		{174, 88: 174},
+		{175, 88: 175},
+		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 92: 205},
 		// 260
-		{480},
-		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 92: 203},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 482},
-		{90: 483},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 86: 427, 94: 426, 425, 100: 428, 429, 119: 484},
+		{191, 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 309, 88: 191, 93: 480, 479, 121: 484},
+		{177, 88: 177},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 486},
+		{487},
+		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 92: 207},
 		// 265
-		{485},
-		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 92: 204},
-		{85: 305, 187, 93: 487},
-		{86: 488},
-		{489},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 489},
+		{90: 490},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 86: 431, 94: 430, 429, 97: 434, 101: 432, 433, 120: 491},
+		{492},
+		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 92: 208},
 		// 270
-		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 92: 205},
-		{85: 305, 187, 93: 491},
-		{86: 492},
-		{493},
-		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 92: 206},
+		{85: 309, 191, 93: 494},
+		{86: 495},
+		{496},
+		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 92: 209},
+		{85: 309, 191, 93: 498},
 		// 275
-		{187, 73: 187, 187, 187, 187, 85: 305, 93: 495},
-		{164, 73: 499, 500, 501, 502, 113: 498, 128: 497, 496},
-		{505},
-		{163, 72: 503},
-		{162, 72: 162},
+		{86: 499},
+		{500},
+		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 92: 210},
+		{191, 73: 191, 191, 191, 191, 85: 309, 93: 502},
+		{168, 73: 506, 507, 508, 509, 114: 505, 129: 504, 503},
 		// 280
+		{512},
+		{167, 72: 510},
+		{166, 72: 166},
 		{106, 72: 106},
 		{105, 72: 105},
+		// 285
 		{104, 72: 104},
 		{103, 72: 103},
-		{73: 499, 500, 501, 502, 113: 504},
-		// 285
-		{161, 72: 161},
-		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 92: 207},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 508, 103: 507},
-		{516},
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 509},
+		{73: 506, 507, 508, 509, 114: 511},
+		{165, 72: 165},
+		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 92: 211},
 		// 290
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 408, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 96: 510},
-		{168, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 513, 124: 512, 511},
-		{169},
-		{167, 72: 514},
-		{166, 72: 166},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 73: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 93: 515, 104: 514},
+		{523},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 319, 98: 516},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 412, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 96: 517},
+		{172, 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 520, 125: 519, 518},
 		// 295
-		{1: 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 515},
-		{165, 72: 165},
-		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 92: 208},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 508, 103: 518},
-		{519},
+		{173},
+		{171, 72: 521},
+		{170, 72: 170},
+		{1: 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 522},
+		{169, 72: 169},
 		// 300
-		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 92: 209},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 523, 98: 522, 106: 521},
-		{524},
-		{179, 72: 414},
-		{178, 346, 369, 321, 323, 382, 349, 325, 326, 327, 345, 316, 352, 348, 354, 357, 331, 360, 353, 356, 359, 317, 318, 319, 320, 384, 347, 341, 362, 329, 350, 351, 333, 322, 324, 386, 328, 335, 355, 358, 332, 361, 330, 334, 376, 336, 340, 338, 368, 363, 381, 375, 344, 364, 365, 366, 343, 339, 385, 342, 370, 337, 367, 383, 371, 372, 379, 380, 374, 373, 377, 378, 73: 395, 396, 397, 398, 390, 389, 391, 387, 388, 392, 394, 393, 94: 315, 97: 314},
-		// 305
-		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 92: 210},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 523, 98: 522, 106: 526},
-		{527},
-		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 92: 211},
-		{1: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 73: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 305, 93: 313, 98: 529},
-		// 310
-		{530, 72: 414},
 		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 92: 212},
-		{187, 85: 305, 93: 532},
-		{533},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 73: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 93: 515, 104: 525},
+		{526},
 		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 92: 213},
-		// 315
-		{1: 295, 253, 246, 248, 283, 291, 267, 269, 270, 272, 241, 281, 299, 260, 256, 273, 265, 259, 255, 264, 224, 243, 244, 245, 271, 296, 231, 236, 258, 292, 293, 274, 247, 249, 302, 268, 276, 261, 257, 297, 266, 250, 275, 285, 277, 287, 279, 252, 263, 232, 284, 235, 240, 298, 242, 234, 286, 301, 233, 254, 278, 251, 300, 294, 262, 237, 289, 280, 282, 290, 288, 102: 238, 107: 225, 239, 111: 536, 230, 114: 229, 227, 535, 228, 226},
-		{1: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 92: 216},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 73: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 93: 530, 99: 529, 107: 528},
+		// 305
+		{531},
+		{183, 72: 418},
+		{182, 350, 373, 325, 327, 386, 353, 329, 330, 331, 349, 320, 356, 352, 358, 361, 335, 364, 357, 360, 363, 321, 322, 323, 324, 388, 351, 345, 366, 333, 354, 355, 337, 326, 328, 390, 332, 339, 359, 362, 336, 365, 334, 338, 380, 340, 344, 342, 372, 367, 385, 379, 348, 368, 369, 370, 347, 343, 389, 346, 374, 341, 371, 387, 375, 376, 383, 384, 378, 377, 381, 382, 73: 399, 400, 401, 402, 394, 393, 395, 391, 392, 396, 398, 397, 94: 319, 98: 318},
 		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 92: 214},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 73: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 93: 530, 99: 529, 107: 533},
+		// 310
+		{534},
+		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 92: 215},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 73: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 93: 317, 99: 536},
+		{537, 72: 418},
+		{1: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 92: 216},
+		// 315
+		{191, 85: 309, 93: 539},
+		{540},
+		{1: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 92: 217},
+		{1: 299, 257, 250, 252, 287, 295, 271, 273, 274, 276, 245, 285, 303, 264, 260, 277, 269, 263, 259, 268, 228, 247, 248, 249, 275, 300, 235, 240, 262, 296, 297, 278, 251, 253, 306, 272, 280, 265, 261, 301, 270, 254, 279, 289, 281, 291, 283, 256, 267, 236, 288, 239, 244, 302, 246, 238, 290, 305, 237, 258, 282, 255, 304, 298, 266, 241, 293, 284, 286, 294, 292, 103: 242, 108: 229, 243, 112: 543, 234, 115: 233, 231, 542, 232, 230},
+		{1: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 92: 220},
+		// 320
+		{1: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 92: 218},
 	}
 )
 
@@ -1059,7 +1071,7 @@ func yyhintlex1(yylex yyhintLexer, lval *yyhintSymType) (n int) {
 }
 
 func yyhintParse(yylex yyhintLexer, parser *hintParser) int {
-	const yyError = 133
+	const yyError = 134
 
 	yyEx, _ := yylex.(yyhintLexerEx)
 	var yyn int
@@ -1315,12 +1327,11 @@ yynewstate:
 		}
 	case 15:
 		{
+			setVar := yyS[yypt-1].setVar
+			setVar.VarName = yyS[yypt-3].ident
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-5].ident),
-				HintData: ast.HintSetVar{
-					VarName: yyS[yypt-3].ident,
-					Value:   yyS[yypt-1].ident,
-				},
+				HintData: setVar,
 			}
 		}
 	case 16:
@@ -1350,9 +1361,10 @@ yynewstate:
 			maxValue := uint64(math.MaxInt64) / yyS[yypt-1].number
 			if yyS[yypt-2].number <= maxValue {
 				parser.yyVAL.hint = &ast.TableOptimizerHint{
-					HintName: ast.NewCIStr(yyS[yypt-5].ident),
-					HintData: int64(yyS[yypt-2].number * yyS[yypt-1].number),
-					QBName:   ast.NewCIStr(yyS[yypt-3].ident),
+					HintName:     ast.NewCIStr(yyS[yypt-5].ident),
+					HintData:     int64(yyS[yypt-2].number * yyS[yypt-1].number),
+					HintDataUnit: yyS[yypt-1].number,
+					QBName:       ast.NewCIStr(yyS[yypt-3].ident),
 				}
 			} else {
 				yylex.AppendError(ErrWarnMemoryQuotaOverflow.GenWithStackByArgs(math.MaxInt))
@@ -1365,8 +1377,8 @@ yynewstate:
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: ast.NewCIStr(yyS[yypt-5].ident),
 				HintData: ast.HintTimeRange{
-					From: yyS[yypt-3].ident,
-					To:   yyS[yypt-1].ident,
+					From: yyS[yypt-3].setVar.Value,
+					To:   yyS[yypt-1].setVar.Value,
 				},
 			}
 		}
@@ -1541,39 +1553,71 @@ yynewstate:
 			h.Indexes = append(h.Indexes, ast.NewCIStr(yyS[yypt-0].ident))
 			parser.yyVAL.hint = h
 		}
+	case 59:
+		{
+			parser.yyVAL.setVar = yyS[yypt-0].setVar
+		}
+	case 60:
+		{
+			// A size is lexed as an identifier, e.g. 16M.
+			parser.yyVAL.setVar = ast.HintSetVar{Value: yyS[yypt-0].ident, Text: yyS[yypt-0].ident, Kind: ast.HintSetVarSize}
+			if _, ok := parser.yyVAL.setVar.Bytes(); !ok {
+				parser.yyVAL.setVar.Kind = ast.HintSetVarKeyword
+			}
+		}
 	case 61:
 		{
-			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
+			v := strconv.FormatUint(yyS[yypt-0].number, 10)
+			parser.yyVAL.setVar = ast.HintSetVar{Value: v, Text: v, Kind: ast.HintSetVarInt}
 		}
 	case 62:
 		{
-			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
+			v := strconv.FormatUint(yyS[yypt-0].number, 10)
+			parser.yyVAL.setVar = ast.HintSetVar{Value: v, Text: v, Kind: ast.HintSetVarInt}
 		}
 	case 63:
 		{
+			var v string
 			if yyS[yypt-0].number > 9223372036854775808 {
 				yylex.AppendError(yylex.Errorf("the Signed Value should be at the range of [-9223372036854775808, 9223372036854775807]."))
 				return 1
 			} else if yyS[yypt-0].number == 9223372036854775808 {
 				signed_one := int64(1)
-				parser.yyVAL.ident = strconv.FormatInt(signed_one<<63, 10)
+				v = strconv.FormatInt(signed_one<<63, 10)
 			} else {
-				parser.yyVAL.ident = strconv.FormatInt(-int64(yyS[yypt-0].number), 10)
+				v = strconv.FormatInt(-int64(yyS[yypt-0].number), 10)
 			}
+			parser.yyVAL.setVar = ast.HintSetVar{Value: v, Text: v, Kind: ast.HintSetVarInt}
 		}
 	case 64:
 		{
-			parser.yyVAL.number = 1024 * 1024
+			parser.yyVAL.setVar = ast.HintSetVar{Value: yyS[yypt-0].ident, Text: yyS[yypt-0].ident, Kind: ast.HintSetVarDecimal}
 		}
 	case 65:
 		{
-			parser.yyVAL.number = 1024 * 1024 * 1024
+			parser.yyVAL.setVar = ast.HintSetVar{Value: yyS[yypt-0].ident, Text: yyS[yypt-0].ident, Kind: ast.HintSetVarDecimal}
 		}
 	case 66:
 		{
-			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: true}
+			parser.yyVAL.setVar = ast.HintSetVar{Value: "-" + yyS[yypt-0].ident, Text: "-" + yyS[yypt-0].ident, Kind: ast.HintSetVarDecimal}
 		}
 	case 67:
+		{
+			parser.yyVAL.number = 1
+		}
+	case 68:
+		{
+			parser.yyVAL.number = ast.HintMemoryQuotaMB
+		}
+	case 69:
+		{
+			parser.yyVAL.number = ast.HintMemoryQuotaGB
+		}
+	case 70:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: true}
+		}
+	case 71:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: false}
 		}
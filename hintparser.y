@@ -18,7 +18,7 @@ import (
 	"math"
 	"strconv"
 
-	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/abbychau/mysql-parser/ast"
 )
 
 %}
@@ -31,6 +31,7 @@ import (
 	hints []*ast.TableOptimizerHint
 	table 	ast.HintTable
 	modelIdents []ast.CIStr
+	setVar  ast.HintSetVar
 }
 
 %token	<number>
@@ -38,6 +39,11 @@ import (
 	/*yy:token "%d" */
 	hintIntLit "a 64-bit unsigned integer"
 
+%token	<setVar>
+
+	/*yy:token "'%c'" */
+	hintStringLit
+
 %token	<ident>
 
 	/*yy:token "%c" */
@@ -47,8 +53,8 @@ import (
 	/*yy:token "@%c" */
 	hintSingleAtIdentifier "identifier with single leading at"
 
-	/*yy:token "'%c'" */
-	hintStringLit
+	/*yy:token "%d.%d" */
+	hintDecLit "a decimal number"
 
 	/* MySQL 8.0 hint names */
 	hintJoinFixedOrder      "JOIN_FIXED_ORDER"
@@ -151,13 +157,15 @@ import (
 	BooleanHintName                        "name of hints which take a boolean input"
 	NullaryHintName                        "name of hints which take no input"
 	SubqueryStrategy
-	Value                                  "the value in the SET_VAR() hint"
 	HintQueryType                          "query type in optimizer hint (OLAP or OLTP)"
 	HintStorageType                        "storage type in optimizer hint (TiKV or TiFlash)"
 
+%type	<setVar>
+	Value "the value in the SET_VAR() hint"
+
 %type	<number>
-	UnitOfBytes "unit of bytes (MB or GB)"
-	CommaOpt    "optional ','"
+	UnitOfBytesOpt "optional unit of bytes (MB or GB)"
+	CommaOpt       "optional ','"
 
 %type	<hints>
 	OptimizerHintList           "optimizer hint list"
@@ -276,12 +284,11 @@ TableOptimizerHintOpt:
 	}
 |	"SET_VAR" '(' Identifier '=' Value ')'
 	{
+		setVar := $5
+		setVar.VarName = $3
 		$$ = &ast.TableOptimizerHint{
 			HintName: ast.NewCIStr($1),
-			HintData: ast.HintSetVar{
-				VarName: $3,
-				Value:   $5,
-			},
+			HintData: setVar,
 		}
 	}
 |	"RESOURCE_GROUP" '(' Identifier ')'
@@ -306,14 +313,15 @@ TableOptimizerHintOpt:
 			Tables:   $5.Tables,
 		}
 	}
-|	"MEMORY_QUOTA" '(' QueryBlockOpt hintIntLit UnitOfBytes ')'
+|	"MEMORY_QUOTA" '(' QueryBlockOpt hintIntLit UnitOfBytesOpt ')'
 	{
 		maxValue := uint64(math.MaxInt64) / $5
 		if $4 <= maxValue {
 			$$ = &ast.TableOptimizerHint{
-				HintName: ast.NewCIStr($1),
-				HintData: int64($4 * $5),
-				QBName:   ast.NewCIStr($3),
+				HintName:     ast.NewCIStr($1),
+				HintData:     int64($4 * $5),
+				HintDataUnit: $5,
+				QBName:       ast.NewCIStr($3),
 			}
 		} else {
 			yylex.AppendError(ErrWarnMemoryQuotaOverflow.GenWithStackByArgs(math.MaxInt))
@@ -326,8 +334,8 @@ TableOptimizerHintOpt:
 		$$ = &ast.TableOptimizerHint{
 			HintName: ast.NewCIStr($1),
 			HintData: ast.HintTimeRange{
-				From: $3,
-				To:   $5,
+				From: $3.Value,
+				To:   $5.Value,
 			},
 		}
 	}
@@ -569,36 +577,65 @@ SubqueryStrategies:
 
 Value:
 	hintStringLit
+	{
+		$$ = $1
+	}
 |	Identifier
+	{
+		// A size is lexed as an identifier, e.g. 16M.
+		$$ = ast.HintSetVar{Value: $1, Text: $1, Kind: ast.HintSetVarSize}
+		if _, ok := $$.Bytes(); !ok {
+			$$.Kind = ast.HintSetVarKeyword
+		}
+	}
 |	hintIntLit
 	{
-		$$ = strconv.FormatUint($1, 10)
+		v := strconv.FormatUint($1, 10)
+		$$ = ast.HintSetVar{Value: v, Text: v, Kind: ast.HintSetVarInt}
 	}
 |	'+' hintIntLit
 	{
-		$$ = strconv.FormatUint($2, 10)
+		v := strconv.FormatUint($2, 10)
+		$$ = ast.HintSetVar{Value: v, Text: v, Kind: ast.HintSetVarInt}
 	}
 |	'-' hintIntLit
 	{
+		var v string
 		if $2 > 9223372036854775808 {
 			yylex.AppendError(yylex.Errorf("the Signed Value should be at the range of [-9223372036854775808, 9223372036854775807]."))
 			return 1
 		} else if $2 == 9223372036854775808 {
 			signed_one := int64(1)
-			$$ = strconv.FormatInt(signed_one<<63, 10)
+			v = strconv.FormatInt(signed_one<<63, 10)
 		} else {
-			$$ = strconv.FormatInt(-int64($2), 10)
+			v = strconv.FormatInt(-int64($2), 10)
 		}
+		$$ = ast.HintSetVar{Value: v, Text: v, Kind: ast.HintSetVarInt}
+	}
+|	hintDecLit
+	{
+		$$ = ast.HintSetVar{Value: $1, Text: $1, Kind: ast.HintSetVarDecimal}
+	}
+|	'+' hintDecLit
+	{
+		$$ = ast.HintSetVar{Value: $2, Text: $2, Kind: ast.HintSetVarDecimal}
+	}
+|	'-' hintDecLit
+	{
+		$$ = ast.HintSetVar{Value: "-" + $2, Text: "-" + $2, Kind: ast.HintSetVarDecimal}
 	}
 
-UnitOfBytes:
-	"MB"
+UnitOfBytesOpt:
+	{
+		$$ = 1
+	}
+|	"MB"
 	{
-		$$ = 1024 * 1024
+		$$ = ast.HintMemoryQuotaMB
 	}
 |	"GB"
 	{
-		$$ = 1024 * 1024 * 1024
+		$$ = ast.HintMemoryQuotaGB
 	}
 
 HintTrueOrFalse:
@@ -14,10 +14,12 @@
 package parser_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/abbychau/mysql-parser"
 	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/mysql"
 	"github.com/stretchr/testify/require"
 )
@@ -37,12 +39,24 @@ func TestParseHint(t *testing.T) {
 			input: "MEMORY_QUOTA(8 MB) MEMORY_QUOTA(6 GB)",
 			output: []*ast.TableOptimizerHint{
 				{
-					HintName: ast.NewCIStr("MEMORY_QUOTA"),
-					HintData: int64(8 * 1024 * 1024),
+					HintName:     ast.NewCIStr("MEMORY_QUOTA"),
+					HintData:     int64(8 * 1024 * 1024),
+					HintDataUnit: ast.HintMemoryQuotaMB,
 				},
 				{
-					HintName: ast.NewCIStr("MEMORY_QUOTA"),
-					HintData: int64(6 * 1024 * 1024 * 1024),
+					HintName:     ast.NewCIStr("MEMORY_QUOTA"),
+					HintData:     int64(6 * 1024 * 1024 * 1024),
+					HintDataUnit: ast.HintMemoryQuotaGB,
+				},
+			},
+		},
+		{
+			input: "MEMORY_QUOTA(1048576)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName:     ast.NewCIStr("MEMORY_QUOTA"),
+					HintData:     int64(1048576),
+					HintDataUnit: 1,
 				},
 			},
 		},
@@ -199,6 +213,8 @@ func TestParseHint(t *testing.T) {
 					HintData: ast.HintSetVar{
 						VarName: "sbs",
 						Value:   "16M",
+						Text:    `16M`,
+						Kind:    ast.HintSetVarSize,
 					},
 				},
 				{
@@ -206,6 +222,8 @@ func TestParseHint(t *testing.T) {
 					HintData: ast.HintSetVar{
 						VarName: "fkc",
 						Value:   "OFF",
+						Text:    `OFF`,
+						Kind:    ast.HintSetVarKeyword,
 					},
 				},
 				{
@@ -213,6 +231,8 @@ func TestParseHint(t *testing.T) {
 					HintData: ast.HintSetVar{
 						VarName: "os",
 						Value:   "mcb=off",
+						Text:    `"mcb=off"`,
+						Kind:    ast.HintSetVarString,
 					},
 				},
 				{
@@ -220,6 +240,8 @@ func TestParseHint(t *testing.T) {
 					HintData: ast.HintSetVar{
 						VarName: "abc",
 						Value:   "1",
+						Text:    `1`,
+						Kind:    ast.HintSetVarInt,
 					},
 				},
 				{
@@ -227,6 +249,8 @@ func TestParseHint(t *testing.T) {
 					HintData: ast.HintSetVar{
 						VarName: "os2",
 						Value:   "mcb2=off",
+						Text:    `'mcb2=off'`,
+						Kind:    ast.HintSetVarString,
 					},
 				},
 			},
@@ -305,8 +329,24 @@ func TestParseHint(t *testing.T) {
 		},
 		{
 			input: "set_var(timestamp = 1.5)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: ast.NewCIStr("set_var"),
+					HintData: ast.HintSetVar{VarName: "timestamp", Value: "1.5", Text: "1.5", Kind: ast.HintSetVarDecimal},
+				},
+			},
+		},
+		{
+			input: "set_var(timestamp = 1e5)",
 			errs: []string{
-				`Cannot use decimal number`,
+				`Cannot use floating point number`,
+				`Optimizer hint syntax error at line 1 `,
+			},
+		},
+		{
+			input: "set_var(sql_mode = 'STRICT_ALL_TABLES) NO_INDEX_MERGE()",
+			errs: []string{
+				`Optimizer hint syntax error at line 1 column 22 near "'STRICT_ALL_TABLES) NO_INDEX_MERGE()*/"unterminated quoted string`,
 				`Optimizer hint syntax error at line 1 `,
 			},
 		},
@@ -357,3 +397,83 @@ func TestParseHint(t *testing.T) {
 		require.Equalf(t, tc.output, output, "input = %s,\n... output = %q", tc.input, output)
 	}
 }
+
+func TestSetVarHintValues(t *testing.T) {
+	cases := []struct {
+		sql      string
+		restored string
+		kind     ast.HintSetVarKind
+		value    string
+		text     string
+	}{
+		{"select /*+ SET_VAR(sort_buffer_size=16M) */ 1", "SELECT /*+ SET_VAR(sort_buffer_size = 16M)*/ 1",
+			ast.HintSetVarSize, "16M", "16M"},
+		{"select /*+ SET_VAR(max_heap_table_size = 1g) */ 1", "SELECT /*+ SET_VAR(max_heap_table_size = 1g)*/ 1",
+			ast.HintSetVarSize, "1g", "1g"},
+		{"select /*+ SET_VAR(sql_mode='STRICT_ALL_TABLES') */ 1", "SELECT /*+ SET_VAR(sql_mode = 'STRICT_ALL_TABLES')*/ 1",
+			ast.HintSetVarString, "STRICT_ALL_TABLES", "'STRICT_ALL_TABLES'"},
+		{`select /*+ SET_VAR(sql_mode='it''s \'a\'') */ 1`, `SELECT /*+ SET_VAR(sql_mode = 'it''s ''a''')*/ 1`,
+			ast.HintSetVarString, "it's 'a'", `'it''s \'a\''`},
+		{"select /*+ SET_VAR(foreign_key_checks=OFF) */ 1", "SELECT /*+ SET_VAR(foreign_key_checks = OFF)*/ 1",
+			ast.HintSetVarKeyword, "OFF", "OFF"},
+		{"select /*+ SET_VAR(optimizer_switch=on) */ 1", "SELECT /*+ SET_VAR(optimizer_switch = ON)*/ 1",
+			ast.HintSetVarKeyword, "on", "on"},
+		{"select /*+ SET_VAR(long_query_time=1.5) */ 1", "SELECT /*+ SET_VAR(long_query_time = 1.5)*/ 1",
+			ast.HintSetVarDecimal, "1.5", "1.5"},
+		{"select /*+ SET_VAR(div_precision_increment=-0.25) */ 1", "SELECT /*+ SET_VAR(div_precision_increment = -0.25)*/ 1",
+			ast.HintSetVarDecimal, "-0.25", "-0.25"},
+		{"select /*+ SET_VAR(max_execution_time=+100) */ 1", "SELECT /*+ SET_VAR(max_execution_time = 100)*/ 1",
+			ast.HintSetVarInt, "100", "100"},
+	}
+
+	p := parser.New()
+	for _, c := range cases {
+		stmt, warns, err := p.Parse(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Empty(t, warns, c.sql)
+		hints := stmt[0].(*ast.SelectStmt).TableHints
+		require.Len(t, hints, 1, c.sql)
+		setVar := hints[0].HintData.(ast.HintSetVar)
+		require.Equal(t, c.kind, setVar.Kind, c.sql)
+		require.Equal(t, c.value, setVar.Value, c.sql)
+		require.Equal(t, c.text, setVar.Text, c.sql)
+		var sb strings.Builder
+		require.NoError(t, stmt[0].Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), c.sql)
+		require.Equal(t, c.restored, sb.String(), c.sql)
+		again, _, err := p.Parse(sb.String(), "", "")
+		require.NoError(t, err, c.sql)
+		restoredSetVar := again[0].(*ast.SelectStmt).TableHints[0].HintData.(ast.HintSetVar)
+		require.Equal(t, c.kind, restoredSetVar.Kind, c.sql)
+		require.True(t, strings.EqualFold(c.value, restoredSetVar.Value), c.sql)
+	}
+
+	// The sizes in bytes.
+	for value, bytes := range map[string]uint64{"16M": 16 << 20, "1g": 1 << 30, "8K": 8 << 10, "2T": 2 << 40, "15E": 15 << 60} {
+		n, ok := ast.HintSetVar{Value: value, Kind: ast.HintSetVarSize}.Bytes()
+		require.True(t, ok, value)
+		require.Equal(t, bytes, n, value)
+	}
+	for _, value := range []string{"16", "M", "16X", "16E", "-1M", "1.5M"} {
+		_, ok := ast.HintSetVar{Value: value, Kind: ast.HintSetVarSize}.Bytes()
+		require.False(t, ok, value)
+	}
+	_, ok := ast.HintSetVar{Value: "16M", Kind: ast.HintSetVarKeyword}.Bytes()
+	require.False(t, ok)
+
+	// Several hints in a statement.
+	sql := "select /*+ SET_VAR(sort_buffer_size = 16M) SET_VAR(sql_mode = 'ANSI') SET_VAR(unique_checks = OFF) SET_VAR(long_query_time = 0.5) */ a from t"
+	stmt, warns, err := p.Parse(sql, "", "")
+	require.NoError(t, err)
+	require.Empty(t, warns)
+	var sb strings.Builder
+	require.NoError(t, stmt[0].Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "SELECT /*+ SET_VAR(sort_buffer_size = 16M) SET_VAR(sql_mode = 'ANSI') SET_VAR(unique_checks = OFF) SET_VAR(long_query_time = 0.5)*/ `a` FROM `t`", sb.String())
+
+	// An unterminated string is a warning located at its quote, the statement
+	// is parsed without the hints.
+	stmt, warns, err = p.Parse("select /*+ SET_VAR(sql_mode='ANSI) */ a from t", "", "")
+	require.NoError(t, err)
+	require.Empty(t, stmt[0].(*ast.SelectStmt).TableHints)
+	require.NotEmpty(t, warns)
+	require.Contains(t, warns[0].Error(), `line 1 column 28 near "'ANSI) */"unterminated quoted string`)
+}
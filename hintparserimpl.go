@@ -71,10 +71,11 @@ func (hs *hintScanner) Lex(lval *yyhintSymType) int {
 		return hintIdentifier
 
 	case stringLit:
-		lval.ident = lit
 		if hs.sqlMode.HasANSIQuotesMode() && hs.r.s[pos.Offset] == '"' {
+			lval.ident = lit
 			return hintIdentifier
 		}
+		lval.setVar = ast.HintSetVar{Value: lit, Text: hs.r.data(&pos), Kind: ast.HintSetVarString}
 		return hintStringLit
 
 	case bitLit:
@@ -101,7 +102,19 @@ func (hs *hintScanner) Lex(lval *yyhintSymType) int {
 	case floatLit:
 		errorTokenType = "floating point number"
 	case decLit:
-		errorTokenType = "decimal number"
+		lval.ident = lit
+		return hintDecLit
+
+	case invalid:
+		if pos.Offset < len(hs.r.s) && (hs.r.s[pos.Offset] == '\'' || hs.r.s[pos.Offset] == '"') {
+			// Locate the error at the opening quote rather than at the end.
+			end := hs.r.pos()
+			hs.r.updatePos(pos)
+			hs.AppendError(hs.Errorf("unterminated quoted string"))
+			hs.r.updatePos(end)
+			return hintInvalid
+		}
+		errorTokenType = "unknown token"
 
 	default:
 		if tok <= 0x7f {
@@ -0,0 +1,183 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+)
+
+const (
+	// MaxIdentifierLength is the max number of characters of a name, e.g. of a
+	// table, a column, an index, a constraint, a partition, a view or a user variable.
+	MaxIdentifierLength = 64
+	// MaxAliasLength is the max number of characters of an alias.
+	MaxAliasLength = 255
+)
+
+var (
+	// ErrIdentifierTooLong returns for a name longer than MaxIdentifierLength,
+	// or an alias longer than MaxAliasLength.
+	ErrIdentifierTooLong = terror.ClassParser.NewStdErr(mysql.ErrTooLongIdent, mysql.Message("Identifier name '%-.100s' is too long at %s", nil))
+	// ErrEmptyIdentifier returns for an empty quoted identifier, e.g. ``.
+	ErrEmptyIdentifier = terror.ClassParser.NewStd(mysql.ErrEmptyIdent)
+	// ErrInvalidIdentifierEncoding returns for an identifier which isn't valid
+	// utf8mb4, with the hex of the invalid bytes.
+	ErrInvalidIdentifierEncoding = terror.ClassParser.NewStdErr(mysql.ErrInvalidCharacterString, mysql.Message("Invalid utf8mb4 character string: '%s' in identifier at %s", nil))
+)
+
+// identToken is an identifier which may break the identifier length limits.
+type identToken struct {
+	tok  int
+	name string
+	pos  Pos
+}
+
+// SetIdentifierCheck enables or disables the check of identifier lengths: names
+// longer than MaxIdentifierLength, aliases longer than MaxAliasLength and empty
+// quoted identifiers are reported as errors with their position. It's disabled
+// by default for compatibility.
+func (parser *Parser) SetIdentifierCheck(val bool) {
+	parser.lexer.identCheck = val
+}
+
+// SetLenientIdentifierEncoding sets how the parser reacts to an identifier with
+// bytes which aren't valid utf8mb4, e.g. from a mangled dump. By default it's an
+// ErrInvalidIdentifierEncoding error; when lenient, the invalid sequences are
+// replaced with U+FFFD and the error is reported as a warning instead.
+func (parser *Parser) SetLenientIdentifierEncoding(val bool) {
+	parser.lexer.lenientIdentEncoding = val
+}
+
+// checkIdentEncoding checks that the identifier lit at pos is valid utf8mb4 and
+// returns it, with the invalid sequences replaced in lenient mode. Only the client
+// charsets whose text is kept as is are checked, the others are decoded by
+// convert2System.
+func (s *Scanner) checkIdentEncoding(lit string, pos Pos) string {
+	if utf8.ValidString(lit) {
+		return lit
+	}
+	switch s.client.Tp() {
+	case charset.EncodingTpUTF8, charset.EncodingTpUTF8MB3Strict, charset.EncodingTpBin:
+	default:
+		return lit
+	}
+	var hex strings.Builder
+	for i := 0; i < len(lit); {
+		r, w := utf8.DecodeRuneInString(lit[i:])
+		if r == utf8.RuneError && w == 1 {
+			fmt.Fprintf(&hex, "%02X", lit[i])
+		}
+		i += w
+	}
+	err := ErrInvalidIdentifierEncoding.GenWithStackByArgs(hex.String(), s.lineColumnAt(pos.Offset))
+	if !s.lenientIdentEncoding {
+		s.AppendError(err)
+		return lit
+	}
+	s.AppendWarn(err)
+	return strings.ToValidUTF8(lit, "\uFFFD")
+}
+
+// recordIdent records the identifier tokens which may be too long or empty. The
+// position of a quoted identifier is the one of its opening quote, and the one of
+// a user variable is the one of its name, after the '@'.
+func (s *Scanner) recordIdent(tok int, name string, pos Pos) {
+	if !s.identCheck {
+		return
+	}
+	n := utf8.RuneCountInString(name)
+	switch tok {
+	case identifier:
+		if n > MaxIdentifierLength || n == 0 {
+			s.longIdents = append(s.longIdents, identToken{tok: tok, name: name, pos: pos})
+		}
+	case singleAtIdentifier:
+		if n > MaxIdentifierLength {
+			// The name starts after the '@'.
+			pos.Col++
+			pos.Offset++
+			s.longIdents = append(s.longIdents, identToken{tok: tok, name: name, pos: pos})
+		}
+	case stringLit:
+		// A string can be an alias, e.g. `SELECT 1 AS 'a'`.
+		if n > MaxAliasLength {
+			s.longIdents = append(s.longIdents, identToken{tok: tok, name: name, pos: pos})
+		}
+	}
+}
+
+// checkIdentifiers reports the first identifier of stmts which breaks the length
+// limits. The identifiers used as aliases, either when defined or referenced, are
+// allowed up to MaxAliasLength characters.
+func (parser *Parser) checkIdentifiers(stmts []ast.StmtNode) {
+	if !parser.lexer.identCheck || len(parser.lexer.longIdents) == 0 {
+		return
+	}
+	collector := &aliasCollector{aliases: make(map[string]struct{})}
+	for _, stmt := range stmts {
+		stmt.Accept(collector)
+	}
+	for _, ident := range parser.lexer.longIdents {
+		pos := parser.lexer.lineColumnAt(ident.pos.Offset)
+		n := utf8.RuneCountInString(ident.name)
+		if n == 0 {
+			parser.lexer.AppendError(ErrEmptyIdentifier.GenWithStackByArgs(pos))
+			return
+		}
+		limit := MaxIdentifierLength
+		if _, ok := collector.aliases[ident.name]; ok {
+			limit = MaxAliasLength
+		} else if ident.tok == stringLit {
+			// Not an alias.
+			continue
+		}
+		if ident.tok == singleAtIdentifier {
+			limit = MaxIdentifierLength
+		}
+		if n > limit {
+			parser.lexer.AppendError(ErrIdentifierTooLong.GenWithStackByArgs(ident.name, pos))
+			return
+		}
+	}
+}
+
+// aliasCollector collects the aliases of fields and tables.
+type aliasCollector struct {
+	aliases map[string]struct{}
+}
+
+func (c *aliasCollector) Enter(n ast.Node) (ast.Node, bool) {
+	switch x := n.(type) {
+	case *ast.SelectField:
+		if x.AsName.O != "" {
+			c.aliases[x.AsName.O] = struct{}{}
+		}
+	case *ast.TableSource:
+		if x.AsName.O != "" {
+			c.aliases[x.AsName.O] = struct{}{}
+		}
+	}
+	return n, false
+}
+
+func (*aliasCollector) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifierCheck(t *testing.T) {
+	p := parser.New()
+	p.SetIdentifierCheck(true)
+
+	templates := []string{
+		"create table %s (a int)",
+		"create table db.%s (a int)",
+		"create table t (%s int)",
+		"create table t (a int, index %s (a))",
+		"create table t (a int, constraint %s unique (a))",
+		"create table t (a int, constraint %s check (a > 0))",
+		"create table t (a int) partition by range (a) (partition %s values less than (10))",
+		"create index %s on t (a)",
+		"create view %s as select 1",
+		"create database %s",
+		"alter table t add column %s int",
+		"alter table t rename to %s",
+		"alter table t rename index a to %s",
+		"select %s from t",
+		"set @%s = 1",
+	}
+	ok := strings.Repeat("a", parser.MaxIdentifierLength)
+	long := strings.Repeat("b", parser.MaxIdentifierLength+1)
+	for _, tpl := range templates {
+		_, _, err := p.ParseSQL(fmt.Sprintf(tpl, ok))
+		require.NoError(t, err, tpl)
+
+		for _, name := range []string{long, "`" + long + "`"} {
+			sql := fmt.Sprintf(tpl, name)
+			_, _, err = p.ParseSQL(sql)
+			require.True(t, terror.ErrorEqual(parser.ErrIdentifierTooLong, err), "%s: %v", tpl, err)
+			require.ErrorContains(t, err, fmt.Sprintf("at line 1 column %d", strings.Index(sql, name)), tpl)
+		}
+
+		if strings.Contains(tpl, "@") {
+			continue
+		}
+		sql := fmt.Sprintf(tpl, "``")
+		_, _, err = p.ParseSQL(sql)
+		require.True(t, terror.ErrorEqual(parser.ErrEmptyIdentifier, err), "%s: %v", tpl, err)
+		require.False(t, terror.ErrorEqual(parser.ErrWrongValue, err), "%s: %v", tpl, err)
+		require.ErrorContains(t, err, fmt.Sprintf("at line 1 column %d", strings.Index(sql, "``")), tpl)
+	}
+
+	// Aliases are allowed up to 255 characters, either defined or referenced.
+	alias := strings.Repeat("c", parser.MaxAliasLength)
+	for _, tpl := range []string{
+		"select 1 as %s",
+		"select 1 as `%s`",
+		"select 1 as '%s'",
+		"select a %s from t order by %s",
+		"select * from t as %s",
+		"select %s.a from t %s",
+	} {
+		_, _, err := p.ParseSQL(strings.ReplaceAll(tpl, "%s", alias))
+		require.NoError(t, err, tpl)
+		sql := strings.ReplaceAll(tpl, "%s", alias+"c")
+		_, _, err = p.ParseSQL(sql)
+		require.True(t, terror.ErrorEqual(parser.ErrIdentifierTooLong, err), "%s: %v", tpl, err)
+		// The position of a quoted alias is the one of its opening quote.
+		offset := strings.Index(sql, alias)
+		if sql[offset-1] == '`' || sql[offset-1] == '\'' {
+			offset--
+		}
+		require.ErrorContains(t, err, fmt.Sprintf("at line 1 column %d", offset), tpl)
+	}
+
+	// The position is counted from the start of the line.
+	_, _, err := p.ParseSQL("create table t (\n  a int,\n  " + long + " int)")
+	require.ErrorContains(t, err, "at line 3 column 2")
+
+	// Long strings are not aliases.
+	_, _, err = p.ParseSQL("select '" + alias + alias + "'")
+	require.NoError(t, err)
+
+	// The check is disabled by default.
+	p = parser.New()
+	_, _, err = p.ParseSQL(fmt.Sprintf("create table %s (`` int)", long))
+	require.NoError(t, err)
+}
+
+func TestIdentifierEncoding(t *testing.T) {
+	p := parser.New()
+	sql := "select `a\xffb` from t"
+
+	// Invalid utf8mb4 is an error by default.
+	_, _, err := p.ParseSQL(sql)
+	require.True(t, terror.ErrorEqual(parser.ErrInvalidIdentifierEncoding, err), "%v", err)
+	require.ErrorContains(t, err, "'FF' in identifier at line 1 column 7")
+	_, _, err = p.ParseSQL("select 1;\nselect @\xfe\xff", parser.CharsetClient("binary"))
+	require.True(t, terror.ErrorEqual(parser.ErrInvalidIdentifierEncoding, err), "%v", err)
+	require.ErrorContains(t, err, "'FEFF' in identifier at line 2 column 7")
+	// The client charsets which are decoded are left to the decoder.
+	_, _, err = p.ParseSQL(sql, parser.CharsetClient("latin1"))
+	require.NoError(t, err)
+
+	// In lenient mode it's replaced and warned.
+	p.SetLenientIdentifierEncoding(true)
+	stmts, warns, err := p.ParseSQL(sql)
+	require.NoError(t, err)
+	require.Len(t, warns, 1)
+	require.True(t, terror.ErrorEqual(parser.ErrInvalidIdentifierEncoding, warns[0]), "%v", warns[0])
+	field := stmts[0].(*ast.SelectStmt).Fields.Fields[0]
+	require.Equal(t, "a\uFFFDb", field.Expr.(*ast.ColumnNameExpr).Name.Name.O)
+
+	// The lenient tree is valid JSON.
+	data, err := ast.ToJSON(stmts[0])
+	require.NoError(t, err)
+	require.True(t, json.Valid(data))
+	require.Contains(t, string(data), "\"Name\":\"a\uFFFDb\"")
+	data, err = json.Marshal(field.Expr.(*ast.ColumnNameExpr).Name)
+	require.NoError(t, err)
+	require.True(t, json.Valid(data))
+
+	// The identifiers beyond the BMP are valid.
+	p = parser.New()
+	for _, sql := range []string{"select `\U0001F600` from t", "select \U0001F600 from t"} {
+		stmts, warns, err = p.ParseSQL(sql)
+		require.NoError(t, err, sql)
+		require.Empty(t, warns, sql)
+		name := stmts[0].(*ast.SelectStmt).Fields.Fields[0].Expr.(*ast.ColumnNameExpr).Name.Name.O
+		require.Equal(t, "\U0001F600", name)
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/pingcap/errors"
+)
+
+// scanStatementEnds scans sql from the offset start and calls fn with the end offset
+// of every statement, that is the offset right after its terminating ';', or len(sql)
+// for a last statement without one. Scanning stops early when fn returns false.
+// The error is the one of the first invalid token or unclosed comment, e.g. an
+// unterminated string literal, which makes the statement boundaries unreliable.
+//
+// A ';' inside a BEGIN ... END compound statement or a CASE ... END CASE
+// statement, e.g. the body of CREATE PROCEDURE p(i INT) CASE i WHEN 1 THEN
+// SELECT 1; END CASE, doesn't end the statement, so stored programs are kept in
+// one piece. Neither does a ';' inside a `/*! ... */`
+// comment unless splitInBangComment is set, which splits as the parser does; fn
+// is told then whether the next statement starts inside the comment. The ';'
+// tokens are found by the Scanner, so the ones in string literals, quoted
+// identifiers and comments are skipped.
+func scanStatementEnds(sql string, start int, splitInBangComment bool, fn func(end int, inBangComment bool) bool) error {
+	s := NewScanner(sql[start:])
+	var (
+		blockDepth   int
+		caseDepth    int
+		pendingBegin bool
+		pendingEnd   bool
+		lastEnd      = start
+		err          error
+	)
+	for {
+		tok, pos, lit := s.scan()
+		if tok == invalid && err == nil {
+			s.lastScanOffset = pos.Offset
+			err = s.Errorf("")
+		}
+		word := ""
+		if tok == identifier {
+			word = strings.ToUpper(lit)
+		}
+		if pendingBegin {
+			// BEGIN, BEGIN WORK, BEGIN PESSIMISTIC and BEGIN OPTIMISTIC start a transaction.
+			switch {
+			case tok == ';' || tok == 0:
+			case word == "WORK" || word == "PESSIMISTIC" || word == "OPTIMISTIC":
+			default:
+				blockDepth++
+			}
+			pendingBegin = false
+		}
+		if pendingEnd {
+			pendingEnd = false
+			switch {
+			case word == "CASE":
+				// END CASE closes a CASE statement, don't count it as a new CASE.
+				if caseDepth > 0 {
+					caseDepth--
+				}
+				continue
+			case word == "IF" || word == "WHILE" || word == "LOOP" || word == "REPEAT":
+			case caseDepth > 0:
+				caseDepth--
+			case blockDepth > 0:
+				blockDepth--
+			}
+		}
+		switch {
+		case tok == 0:
+			if lastEnd < len(sql) {
+				fn(len(sql), false)
+			}
+			if _, errs := s.Errors(); err == nil && len(errs) > 0 {
+				err = errs[0]
+			}
+			return err
+		case tok == ';' && blockDepth == 0 && caseDepth == 0 && (splitInBangComment || !s.inBangComment):
+			lastEnd = start + pos.Offset + 1
+			if !fn(lastEnd, s.inBangComment) {
+				return nil
+			}
+		case word == "BEGIN":
+			pendingBegin = true
+		case word == "END":
+			pendingEnd = true
+		case word == "CASE":
+			caseDepth++
+		}
+	}
+}
+
+// stmtChunk is a range of the text of an Incremental session holding zero or more
+// statements, and the cached result of parsing it.
+type stmtChunk struct {
+	start, end int
+	parsed     bool
+	stmts      []ast.StmtNode
+	err        error
+	// offsetBase is the start the offsets in stmts were last shifted to, they are
+	// shifted again when an edit moves the chunk.
+	offsetBase int
+}
+
+// Incremental is an experimental parsing session for editor integrations, which
+// re-parses only the statements touched by the edits since the last call of
+// Statements and reuses the others.
+//
+// The statements returned by Statements are shared between calls, callers must
+// treat them as read-only. The offsets recorded in the AST nodes are the ones in
+// the whole text, like the ones of a Parser.ParseSQL of the whole text.
+type Incremental struct {
+	parser *Parser
+	text   string
+	chunks []*stmtChunk
+	// retired are the parsed chunks removed by the last edits, by their text, which
+	// are reused when an edit brings their text back behind it, e.g. when a
+	// removed ';' is added back.
+	retired map[string]*stmtChunk
+}
+
+// maxRetiredChunks bounds the retired chunks kept by an Incremental session.
+const maxRetiredChunks = 64
+
+// NewIncremental creates an Incremental session for the text.
+func NewIncremental(text string) *Incremental {
+	s := &Incremental{parser: New(), text: text}
+	scanStatementEnds(text, 0, false, func(end int, _ bool) bool {
+		s.chunks = append(s.chunks, &stmtChunk{start: s.textEnd(), end: end})
+		return true
+	})
+	return s
+}
+
+func (s *Incremental) textEnd() int {
+	if len(s.chunks) == 0 {
+		return 0
+	}
+	return s.chunks[len(s.chunks)-1].end
+}
+
+// Text returns the current text of the session.
+func (s *Incremental) Text() string {
+	return s.text
+}
+
+// Edit replaces deletedLen bytes at offset of the current text with inserted.
+// The affected statements are re-parsed by the next call of Statements.
+func (s *Incremental) Edit(offset, deletedLen int, inserted string) error {
+	if offset < 0 || deletedLen < 0 || offset+deletedLen > len(s.text) {
+		return errors.Errorf("edit [%d, %d) is out of the text range [0, %d)", offset, offset+deletedLen, len(s.text))
+	}
+	oldEditEnd := offset + deletedLen
+	newEditEnd := offset + len(inserted)
+	delta := len(inserted) - deletedLen
+	oldText := s.text
+	s.text = s.text[:offset] + inserted + s.text[oldEditEnd:]
+
+	// The first affected chunk is the one containing offset, all chunks before it
+	// keep their text since the scanner reads the text forward only.
+	first := 0
+	for first+1 < len(s.chunks) && s.chunks[first+1].start <= offset {
+		first++
+	}
+	from := 0
+	if first < len(s.chunks) {
+		from = s.chunks[first].start
+	}
+
+	// Re-split from the first affected chunk until a statement end lines up with an
+	// old one behind the edit, the chunks after it are unchanged.
+	var (
+		newChunks []*stmtChunk
+		rest      []*stmtChunk
+		next      = first
+	)
+	scanStatementEnds(s.text, from, false, func(end int, _ bool) bool {
+		start := from
+		if len(newChunks) > 0 {
+			start = newChunks[len(newChunks)-1].end
+		}
+		newChunks = append(newChunks, &stmtChunk{start: start, end: end})
+		if end <= newEditEnd {
+			return true
+		}
+		for next < len(s.chunks) && s.chunks[next].end < end-delta {
+			next++
+		}
+		if next < len(s.chunks) && s.chunks[next].end == end-delta {
+			rest = s.chunks[next+1:]
+			return false
+		}
+		return true
+	})
+	for _, c := range rest {
+		c.start += delta
+		c.end += delta
+	}
+	// The new chunks behind the edit having the text of a retired chunk reuse it,
+	// the chunks holding the edit are always re-parsed.
+	for _, c := range newChunks {
+		if c.start < newEditEnd {
+			continue
+		}
+		text := s.text[c.start:c.end]
+		if old, ok := s.retired[text]; ok {
+			c.parsed, c.stmts, c.err, c.offsetBase = true, old.stmts, old.err, old.offsetBase
+			delete(s.retired, text)
+		}
+	}
+	s.retire(oldText, s.chunks[first:len(s.chunks)-len(rest)])
+	chunks := make([]*stmtChunk, 0, first+len(newChunks)+len(rest))
+	chunks = append(chunks, s.chunks[:first]...)
+	chunks = append(chunks, newChunks...)
+	s.chunks = append(chunks, rest...)
+	return nil
+}
+
+// retire keeps the parsed chunks of oldText removed by an edit for reuse.
+func (s *Incremental) retire(oldText string, removed []*stmtChunk) {
+	for _, c := range removed {
+		if !c.parsed {
+			continue
+		}
+		if len(s.retired) >= maxRetiredChunks {
+			clear(s.retired)
+		}
+		if s.retired == nil {
+			s.retired = make(map[string]*stmtChunk)
+		}
+		s.retired[oldText[c.start:c.end]] = c
+	}
+}
+
+// Statements returns the statements of the current text. changed holds the indexes
+// in stmts of the statements parsed by this call, and errs the parse errors of the
+// statements that failed, in text order. The statements that failed to parse are
+// missing in stmts.
+func (s *Incremental) Statements() (stmts []ast.StmtNode, changed []int, errs []error) {
+	for _, c := range s.chunks {
+		if !c.parsed {
+			c.stmts, c.err = nil, nil
+			// A chunk starting with a token is parsed behind a newline, so that no
+			// node of it is at the offset 0, which is the one of the nodes without a
+			// recorded offset. The statement text leaves out a leading newline.
+			text := s.text[c.start:c.end]
+			c.offsetBase = 0
+			if !strings.HasPrefix(text, "\n") {
+				text = "\n" + text
+				c.offsetBase = 1
+			}
+			result, _, err := s.parser.ParseSQL(text)
+			// The next parse sets the offsets of the expressions left in the stack
+			// of the parser, which are the ones of this chunk.
+			clear(s.parser.cache)
+			if err != nil {
+				c.err = err
+			} else {
+				// ParseSQL reuses its result slice.
+				c.stmts = slices.Clone(result)
+			}
+			c.parsed = true
+			for i := range c.stmts {
+				changed = append(changed, len(stmts)+i)
+			}
+		}
+		if c.offsetBase != c.start {
+			shiftOffsets(c.stmts, c.start-c.offsetBase)
+			c.offsetBase = c.start
+		}
+		if c.err != nil {
+			errs = append(errs, c.err)
+		}
+		stmts = append(stmts, c.stmts...)
+	}
+	return stmts, changed, errs
+}
+
+// shiftOffsets adds delta to the recorded offsets of stmts and their nodes.
+func shiftOffsets(stmts []ast.StmtNode, delta int) {
+	v := offsetShifter{delta: delta, visited: make(map[ast.Node]struct{})}
+	for _, stmt := range stmts {
+		stmt.SetEndPosition(stmt.EndPosition() + delta)
+		stmt.Accept(&v)
+	}
+}
+
+// offsetShifter adds delta to the offsets of the nodes it visits, except the
+// nodes without a recorded offset. A node shared by several parents is
+// shifted once.
+type offsetShifter struct {
+	delta   int
+	visited map[ast.Node]struct{}
+}
+
+// Enter implements Visitor interface.
+func (v *offsetShifter) Enter(n ast.Node) (ast.Node, bool) {
+	if _, ok := v.visited[n]; ok {
+		return n, true
+	}
+	v.visited[n] = struct{}{}
+	switch x := n.(type) {
+	case *astParamMarkerExpr:
+		// Its SetOriginTextPosition doesn't change the offset.
+		x.Offset += v.delta
+		return n, false
+	case *ast.SelectField:
+		x.Offset += v.delta
+	// The Accept of these nodes doesn't visit the table names.
+	case *ast.OptimizeTableStmt:
+		for _, t := range x.Tables {
+			t.Accept(v)
+		}
+	case *ast.ColumnOption:
+		if x.Refer != nil {
+			x.Refer.Accept(v)
+		}
+	}
+	if offset := n.OriginTextPosition(); offset != 0 {
+		n.SetOriginTextPosition(offset + v.delta)
+	}
+	return n, false
+}
+
+// Leave implements Visitor interface.
+func (v *offsetShifter) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/stretchr/testify/require"
+)
+
+var incrementalCorpus = []string{
+	"select * from t where a = 1;",
+	"\nselect 'a;b', `c;d` from t2 -- comment; here\n;",
+	"insert into t values (1, 'x'), (2, \"y;\");",
+	"/* a ; comment */ update t set a = a + 1 where b in (1, 2, 3);",
+	"delete from t where id = 10 # trailing; comment\n;",
+	"create table t (a int primary key, b varchar(10) default 'a;b');",
+	"begin;",
+	"begin pessimistic;",
+	"commit;",
+	"select case when a > 1 then 'x' else 'y' end from t;",
+	"create procedure p() begin declare a int; set a = 1; select case a when 1 then 2 end; end;",
+	"/*!40101 set @a = 1 */;",
+	"optimize table t1, t2;",
+	"create table t3 (a int references t1 (a));",
+	"select ?, a from t where b = ?;",
+	"select 1",
+}
+
+func restoreStmt(t *testing.T, stmt ast.StmtNode) string {
+	var sb strings.Builder
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	return sb.String()
+}
+
+// requireSameAsFullParse checks the statements of the session are the same as the
+// ones from parsing the whole text at once.
+func requireSameAsFullParse(t *testing.T, s *parser.Incremental) {
+	stmts, _, errs := s.Statements()
+	expected, _, err := parser.New().ParseSQL(s.Text())
+	if err != nil {
+		require.NotEmpty(t, errs, s.Text())
+		return
+	}
+	require.Empty(t, errs, s.Text())
+	require.Len(t, stmts, len(expected), s.Text())
+	for i := range expected {
+		require.Equal(t, expected[i].Text(), stmts[i].Text(), s.Text())
+		require.Equal(t, restoreStmt(t, expected[i]), restoreStmt(t, stmts[i]), s.Text())
+		require.Equal(t, expected[i].OriginTextPosition(), stmts[i].OriginTextPosition(), s.Text())
+		require.Equal(t, expected[i].EndPosition(), stmts[i].EndPosition(), s.Text())
+		require.Equal(t, nodeOffsets(expected[i]), nodeOffsets(stmts[i]), s.Text())
+	}
+}
+
+// offsetCollector collects the offsets of the nodes it visits, in visiting order.
+type offsetCollector struct {
+	offsets []int
+}
+
+func (c *offsetCollector) Enter(n ast.Node) (ast.Node, bool) {
+	c.offsets = append(c.offsets, n.OriginTextPosition())
+	switch x := n.(type) {
+	case *ast.SelectField:
+		c.offsets = append(c.offsets, x.Offset)
+	case *ast.OptimizeTableStmt:
+		for _, t := range x.Tables {
+			t.Accept(c)
+		}
+	case *ast.ColumnOption:
+		if x.Refer != nil {
+			x.Refer.Accept(c)
+		}
+	}
+	return n, false
+}
+
+func (c *offsetCollector) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+func nodeOffsets(stmt ast.StmtNode) []int {
+	var c offsetCollector
+	stmt.Accept(&c)
+	return c.offsets
+}
+
+func TestIncremental(t *testing.T) {
+	text := strings.Join(incrementalCorpus, " ")
+	s := parser.NewIncremental(text)
+	stmts, changed, errs := s.Statements()
+	require.Empty(t, errs)
+	require.Len(t, stmts, len(incrementalCorpus))
+	require.Len(t, changed, len(stmts))
+	requireSameAsFullParse(t, s)
+
+	// Nothing is re-parsed without edits.
+	_, changed, _ = s.Statements()
+	require.Empty(t, changed)
+
+	// Editing inside a statement only re-parses it.
+	offset := strings.Index(s.Text(), "a = 1")
+	require.NoError(t, s.Edit(offset, 1, "b"))
+	stmts, changed, errs = s.Statements()
+	require.Empty(t, errs)
+	require.Equal(t, []int{0}, changed)
+	require.Equal(t, "SELECT * FROM `t` WHERE `b`=1", restoreStmt(t, stmts[0]))
+
+	// Removing a ';' joins two statements.
+	offset = strings.Index(s.Text(), "commit;")
+	require.NoError(t, s.Edit(offset+len("commit"), 1, ""))
+	_, _, errs = s.Statements()
+	require.Len(t, errs, 1)
+	requireSameAsFullParse(t, s)
+
+	// Adding it back splits them again.
+	require.NoError(t, s.Edit(offset+len("commit"), 0, ";"))
+	_, changed, errs = s.Statements()
+	require.Empty(t, errs)
+	require.Len(t, changed, 1)
+	requireSameAsFullParse(t, s)
+
+	// Opening a quote changes the statement boundaries after the edit.
+	require.NoError(t, s.Edit(0, 0, "select '"))
+	_, _, errs = s.Statements()
+	require.NotEmpty(t, errs)
+	requireSameAsFullParse(t, s)
+	require.NoError(t, s.Edit(0, len("select '"), ""))
+	requireSameAsFullParse(t, s)
+
+	require.Error(t, s.Edit(-1, 0, "a"))
+	require.Error(t, s.Edit(len(s.Text()), 1, ""))
+	require.NoError(t, s.Edit(0, len(s.Text()), ""))
+	stmts, _, errs = s.Statements()
+	require.Empty(t, stmts)
+	require.Empty(t, errs)
+}
+
+func TestIncrementalRandomEdits(t *testing.T) {
+	const alphabet = "abt1 ,;;;'\"`()*/-#\n=_"
+	rnd := rand.New(rand.NewSource(20250101))
+	for round := 0; round < 20; round++ {
+		corpus := make([]string, 0, 10)
+		for i := 0; i < 10; i++ {
+			corpus = append(corpus, incrementalCorpus[rnd.Intn(len(incrementalCorpus)-1)])
+		}
+		s := parser.NewIncremental(strings.Join(corpus, "\n"))
+		requireSameAsFullParse(t, s)
+		for i := 0; i < 50; i++ {
+			offset := rnd.Intn(len(s.Text()) + 1)
+			deleted := 0
+			if offset < len(s.Text()) {
+				deleted = rnd.Intn(min(len(s.Text())-offset, 5) + 1)
+			}
+			var inserted strings.Builder
+			for n := rnd.Intn(4); n > 0; n-- {
+				inserted.WriteByte(alphabet[rnd.Intn(len(alphabet))])
+			}
+			require.NoError(t, s.Edit(offset, deleted, inserted.String()))
+			// Check after some of the edits only, the others are accumulated.
+			if rnd.Intn(3) == 0 {
+				requireSameAsFullParse(t, s)
+			}
+		}
+		requireSameAsFullParse(t, s)
+	}
+}
+
+func BenchmarkIncrementalEdit(b *testing.B) {
+	var sb strings.Builder
+	for i := 0; i < 10000; i++ {
+		sb.WriteString("select a, b, c from t where id = 1 and name = 'x';\n")
+	}
+	s := parser.NewIncremental(sb.String())
+	s.Statements()
+	offset := strings.Index(s.Text(), "id = 1") + len("id = ")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := s.Edit(offset, 1, "2"); err != nil {
+			b.Fatal(err)
+		}
+		_, changed, _ := s.Statements()
+		if len(changed) != 1 {
+			b.Fatalf("expect 1 statement re-parsed, got %d", len(changed))
+		}
+	}
+}
@@ -0,0 +1,747 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package corpus generates the SQL corpus of testdata/corpus, which has a
+// statement for every statement and expression node of package ast. The
+// corpus seeds the fuzz targets, is checked by the restore tests and shows
+// the supported syntax by example.
+//
+// Every node has templates, whose placeholders are filled by a random source
+// seeded with DefaultSeed, so that the corpus is the same whenever it's
+// regenerated, with `go generate`.
+package corpus
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultSeed is the seed of the checked-in corpus.
+const DefaultSeed = 1
+
+// Variants is the number of statements generated from every template. The
+// statements filled the same way are only written once.
+const Variants = 2
+
+// Statement is a statement of the corpus.
+type Statement struct {
+	// Node is the name of the ast type the statement has, e.g. "SelectStmt".
+	Node string
+	SQL  string
+}
+
+// File is a file of the corpus, e.g. dml.sql.
+type File struct {
+	Name       string
+	Statements []Statement
+}
+
+// entry is the templates of a node.
+type entry struct {
+	node      string
+	file      string
+	templates []string
+}
+
+// Unreachable are the nodes of package ast no statement is parsed into, by
+// the reason why.
+var Unreachable = map[string]string{
+	"ChangeStmt":         "CHANGE PUMP and CHANGE DRAINER aren't in the grammar",
+	"GenericStmt":        "it's only returned by the handlers of Parser.RegisterStatementHandler",
+	"FuncKeywordArgExpr": "it's only returned by the FunctionArgParser of Parser.RegisterFunctionSyntax",
+}
+
+// The templates have the placeholders {tbl}, {col}, {ident}, {int}, {str} and
+// {expr}, which are replaced by a table name, a column name, an identifier,
+// a positive integer, a string literal and a scalar expression. The names
+// which must match, e.g. the labels of a loop, are written out.
+var entries = []entry{
+	// dml.sql
+	{"SelectStmt", "dml", []string{
+		"SELECT {col}, {expr} FROM {tbl} WHERE {col} > {int} ORDER BY {col} LIMIT {int}",
+		"SELECT DISTINCT x.{col} FROM {tbl} AS x JOIN {tbl} AS y ON x.{col} = y.{col} GROUP BY x.{col}",
+	}},
+	{"SetOprStmt", "dml", []string{
+		"SELECT {col} FROM {tbl} UNION ALL SELECT {col} FROM {tbl}",
+		"SELECT {col} FROM {tbl} EXCEPT SELECT {col} FROM {tbl}",
+	}},
+	{"InsertStmt", "dml", []string{
+		"INSERT INTO {tbl} (a, b) VALUES ({expr}, {str}), ({int}, {str})",
+		"INSERT INTO {tbl} SELECT * FROM {tbl} WHERE {col} < {int}",
+		"REPLACE INTO {tbl} SET a = {int}, b = {str}",
+	}},
+	{"UpdateStmt", "dml", []string{
+		"UPDATE {tbl} SET {col} = {expr} WHERE {col} = {int}",
+	}},
+	{"DeleteStmt", "dml", []string{
+		"DELETE FROM {tbl} WHERE {col} < {int} ORDER BY {col} LIMIT {int}",
+	}},
+	{"LoadDataStmt", "dml", []string{
+		"LOAD DATA INFILE '/tmp/t.csv' INTO TABLE {tbl} FIELDS TERMINATED BY ',' IGNORE {int} LINES",
+	}},
+	{"ImportIntoStmt", "dml", []string{
+		"IMPORT INTO {tbl} FROM '/tmp/t.csv'",
+	}},
+	{"CallStmt", "dml", []string{
+		"CALL {ident}({int}, {str})",
+	}},
+	{"ShowStmt", "dml", []string{
+		"SHOW CREATE TABLE {tbl}",
+		"SHOW COLUMNS FROM {tbl}",
+	}},
+	{"NonTransactionalDMLStmt", "dml", []string{
+		"BATCH ON {col} LIMIT {int} DELETE FROM {tbl} WHERE {col} = {int}",
+	}},
+	{"DistributeTableStmt", "dml", []string{
+		"DISTRIBUTE TABLE {tbl} RULE = 'leader-scatter' ENGINE = 'tikv'",
+	}},
+	{"SplitRegionStmt", "dml", []string{
+		"SPLIT TABLE {tbl} INDEX idx_a BY ({str}), ({str})",
+	}},
+	{"SplitOption", "dml", []string{
+		"SPLIT TABLE {tbl} BETWEEN (0) AND (1000000) REGIONS {int}",
+	}},
+
+	// expr.sql
+	{"BetweenExpr", "expr", []string{
+		"SELECT {col} BETWEEN {int} AND {int}, {col} NOT BETWEEN {int} AND {int} FROM {tbl}",
+	}},
+	{"BinaryOperationExpr", "expr", []string{
+		"SELECT {col} + {int} * {col}, {col} DIV {int} FROM {tbl} WHERE {col} > {int} AND {col} <> {str}",
+	}},
+	{"CaseExpr", "expr", []string{
+		"SELECT CASE WHEN {col} > {int} THEN {str} ELSE {str} END FROM {tbl}",
+		"SELECT CASE {col} WHEN {int} THEN {str} WHEN {int} THEN {str} END FROM {tbl}",
+	}},
+	{"SubqueryExpr", "expr", []string{
+		"SELECT (SELECT MAX({col}) FROM {tbl}) FROM {tbl}",
+	}},
+	{"CompareSubqueryExpr", "expr", []string{
+		"SELECT * FROM {tbl} WHERE {col} > ANY (SELECT {col} FROM {tbl})",
+		"SELECT * FROM {tbl} WHERE {col} = ALL (SELECT {col} FROM {tbl})",
+	}},
+	{"TableNameExpr", "expr", []string{
+		"SELECT NEXTVAL({ident})",
+	}},
+	{"ColumnNameExpr", "expr", []string{
+		"SELECT {col}, {tbl}.{col} FROM {tbl}",
+	}},
+	{"DefaultExpr", "expr", []string{
+		"INSERT INTO {tbl} (a, b) VALUES ({int}, DEFAULT)",
+		"SELECT DEFAULT({col}) FROM {tbl}",
+	}},
+	{"ExistsSubqueryExpr", "expr", []string{
+		"SELECT * FROM {tbl} WHERE NOT EXISTS (SELECT 1 FROM {tbl} WHERE {col} = {int})",
+	}},
+	{"PatternInExpr", "expr", []string{
+		"SELECT * FROM {tbl} WHERE {col} IN ({int}, {int}, {str})",
+		"SELECT * FROM {tbl} WHERE {col} NOT IN (SELECT {col} FROM {tbl})",
+	}},
+	{"IsNullExpr", "expr", []string{
+		"SELECT * FROM {tbl} WHERE {col} IS NULL OR {col} IS NOT NULL",
+	}},
+	{"IsTruthExpr", "expr", []string{
+		"SELECT {col} IS TRUE, {col} IS NOT FALSE FROM {tbl}",
+	}},
+	{"PatternLikeOrIlikeExpr", "expr", []string{
+		"SELECT * FROM {tbl} WHERE {col} LIKE {str} OR {col} NOT ILIKE {str}",
+	}},
+	{"ParenthesesExpr", "expr", []string{
+		"SELECT ({col} + {int}) * {int} FROM {tbl}",
+	}},
+	{"PositionExpr", "expr", []string{
+		"SELECT {col}, {col} FROM {tbl} ORDER BY 2, 1 DESC",
+	}},
+	{"PatternRegexpExpr", "expr", []string{
+		"SELECT * FROM {tbl} WHERE {col} REGEXP {str}",
+	}},
+	{"RowExpr", "expr", []string{
+		"SELECT ROW({col}, {int}) = ROW({int}, {col}) FROM {tbl}",
+	}},
+	{"UnaryOperationExpr", "expr", []string{
+		"SELECT -{col}, ~{col}, NOT {col} FROM {tbl}",
+	}},
+	{"ValuesExpr", "expr", []string{
+		"INSERT INTO {tbl} (a, b) VALUES ({int}, {str}) ON DUPLICATE KEY UPDATE b = VALUES(b)",
+	}},
+	{"VariableExpr", "expr", []string{
+		"SELECT @{ident}, @@sql_mode, @@SESSION.autocommit",
+	}},
+	{"MaxValueExpr", "expr", []string{
+		"CREATE TABLE {tbl} (a INT) PARTITION BY RANGE (a) (PARTITION p0 VALUES LESS THAN ({int}), PARTITION p1 VALUES LESS THAN MAXVALUE)",
+	}},
+	{"MatchAgainst", "expr", []string{
+		"SELECT * FROM {tbl} WHERE MATCH ({col}) AGAINST ({str} IN BOOLEAN MODE)",
+	}},
+	{"SetCollationExpr", "expr", []string{
+		"SELECT {col} COLLATE utf8mb4_bin FROM {tbl}",
+	}},
+	{"TrimDirectionExpr", "expr", []string{
+		"SELECT TRIM(LEADING {str} FROM {col}) FROM {tbl}",
+	}},
+	{"TimeUnitExpr", "expr", []string{
+		"SELECT DATE_ADD({col}, INTERVAL {int} DAY) FROM {tbl}",
+	}},
+	{"GetFormatSelectorExpr", "expr", []string{
+		"SELECT GET_FORMAT(DATE, 'USA')",
+	}},
+	{"FuncCallExpr", "expr", []string{
+		"SELECT CONCAT({col}, {str}), IFNULL({col}, {int}) FROM {tbl}",
+	}},
+	{"FuncCastExpr", "expr", []string{
+		"SELECT CAST({col} AS CHAR), CONVERT({col}, SIGNED) FROM {tbl}",
+	}},
+	{"AggregateFuncExpr", "expr", []string{
+		"SELECT COUNT(*), SUM({col}), MAX(DISTINCT {col}) FROM {tbl} GROUP BY {col} HAVING COUNT(*) > {int}",
+	}},
+	{"WindowFuncExpr", "expr", []string{
+		"SELECT ROW_NUMBER() OVER (PARTITION BY {col} ORDER BY {col}) FROM {tbl}",
+	}},
+	{"JSONSumCrc32Expr", "expr", []string{
+		"SELECT JSON_SUM_CRC32({col} AS UNSIGNED ARRAY) FROM {tbl}",
+	}},
+
+	// ddl.sql
+	{"CreateDatabaseStmt", "ddl", []string{
+		"CREATE DATABASE IF NOT EXISTS {ident} CHARACTER SET utf8mb4",
+	}},
+	{"AlterDatabaseStmt", "ddl", []string{
+		"ALTER DATABASE {ident} CHARACTER SET = utf8mb4",
+	}},
+	{"DropDatabaseStmt", "ddl", []string{
+		"DROP DATABASE IF EXISTS {ident}",
+	}},
+	{"FlashBackDatabaseStmt", "ddl", []string{
+		"FLASHBACK DATABASE {ident} TO {ident}",
+	}},
+	{"CreateTableStmt", "ddl", []string{
+		"CREATE TABLE IF NOT EXISTS {tbl} (id BIGINT NOT NULL AUTO_INCREMENT, a INT DEFAULT {int}, b VARCHAR(64), PRIMARY KEY (id), KEY idx_a (a))",
+		"CREATE TABLE {tbl} LIKE {tbl}",
+	}},
+	{"DropTableStmt", "ddl", []string{
+		"DROP TABLE IF EXISTS {tbl}, {tbl}",
+	}},
+	{"AlterTableStmt", "ddl", []string{
+		"ALTER TABLE {tbl} ADD COLUMN {ident} INT DEFAULT {int}",
+		"ALTER TABLE {tbl} DROP INDEX idx_a, ADD INDEX idx_b (b)",
+	}},
+	{"RenameTableStmt", "ddl", []string{
+		"RENAME TABLE {tbl} TO {ident}",
+	}},
+	{"TruncateTableStmt", "ddl", []string{
+		"TRUNCATE TABLE {tbl}",
+	}},
+	{"CreateIndexStmt", "ddl", []string{
+		"CREATE UNIQUE INDEX {ident} ON {tbl} (a, b(10))",
+	}},
+	{"DropIndexStmt", "ddl", []string{
+		"DROP INDEX {ident} ON {tbl}",
+	}},
+	{"CreateViewStmt", "ddl", []string{
+		"CREATE VIEW {ident} AS SELECT {col} FROM {tbl}",
+	}},
+	{"CreateTriggerStmt", "ddl", []string{
+		"CREATE TRIGGER {ident} BEFORE INSERT ON {tbl} FOR EACH ROW SET NEW.a = {int}",
+		"CREATE TRIGGER {ident} AFTER UPDATE ON {tbl} FOR EACH ROW BEGIN INSERT INTO log VALUES (OLD.{col}); END",
+	}},
+	{"DropTriggerStmt", "ddl", []string{
+		"DROP TRIGGER IF EXISTS {ident}",
+	}},
+	{"CreateEventStmt", "ddl", []string{
+		"CREATE EVENT {ident} ON SCHEDULE EVERY {int} DAY DO DELETE FROM {tbl} WHERE {col} < NOW() - INTERVAL {int} DAY",
+		"CREATE EVENT IF NOT EXISTS {ident} ON SCHEDULE AT CURRENT_TIMESTAMP + INTERVAL {int} HOUR ON COMPLETION PRESERVE DO BEGIN UPDATE {tbl} SET {col} = {int}; END",
+	}},
+	{"AlterEventStmt", "ddl", []string{
+		"ALTER EVENT {ident} ON SCHEDULE EVERY {int} HOUR DISABLE",
+	}},
+	{"DropEventStmt", "ddl", []string{
+		"DROP EVENT IF EXISTS {ident}",
+	}},
+	{"CreateSequenceStmt", "ddl", []string{
+		"CREATE SEQUENCE {ident} START WITH {int} INCREMENT BY {int}",
+	}},
+	{"AlterSequenceStmt", "ddl", []string{
+		"ALTER SEQUENCE {ident} INCREMENT BY {int}",
+	}},
+	{"DropSequenceStmt", "ddl", []string{
+		"DROP SEQUENCE IF EXISTS {ident}",
+	}},
+	{"CreatePlacementPolicyStmt", "ddl", []string{
+		"CREATE PLACEMENT POLICY {ident} FOLLOWERS = {int}",
+	}},
+	{"AlterPlacementPolicyStmt", "ddl", []string{
+		"ALTER PLACEMENT POLICY {ident} FOLLOWERS = {int}",
+	}},
+	{"DropPlacementPolicyStmt", "ddl", []string{
+		"DROP PLACEMENT POLICY IF EXISTS {ident}",
+	}},
+	{"AlterRangeStmt", "ddl", []string{
+		"ALTER RANGE global PLACEMENT POLICY {ident}",
+	}},
+	{"CreateResourceGroupStmt", "ddl", []string{
+		"CREATE RESOURCE GROUP {ident} RU_PER_SEC = {int}",
+	}},
+	{"AlterResourceGroupStmt", "ddl", []string{
+		"ALTER RESOURCE GROUP {ident} RU_PER_SEC = {int}",
+	}},
+	{"DropResourceGroupStmt", "ddl", []string{
+		"DROP RESOURCE GROUP {ident}",
+	}},
+	{"OptimizeTableStmt", "ddl", []string{
+		"OPTIMIZE TABLE {tbl}",
+	}},
+	{"LockTablesStmt", "ddl", []string{
+		"LOCK TABLES {tbl} READ, {tbl} WRITE",
+	}},
+	{"UnlockTablesStmt", "ddl", []string{
+		"UNLOCK TABLES",
+	}},
+	{"CleanupTableLockStmt", "ddl", []string{
+		"ADMIN CLEANUP TABLE LOCK {tbl}",
+	}},
+	{"RepairTableStmt", "ddl", []string{
+		"ADMIN REPAIR TABLE {tbl} CREATE TABLE {tbl} (a INT)",
+	}},
+	{"RecoverTableStmt", "ddl", []string{
+		"RECOVER TABLE BY JOB {int}",
+		"RECOVER TABLE {tbl}",
+	}},
+	{"FlashBackToTimestampStmt", "ddl", []string{
+		"FLASHBACK CLUSTER TO TIMESTAMP '2021-05-26 16:45:26'",
+	}},
+	{"FlashBackTableStmt", "ddl", []string{
+		"FLASHBACK TABLE {tbl} TO {ident}",
+	}},
+	{"CompactTableStmt", "ddl", []string{
+		"ALTER TABLE {tbl} COMPACT TIFLASH REPLICA",
+	}},
+	{"CreateStatisticsStmt", "ddl", []string{
+		"CREATE STATISTICS {ident} (CARDINALITY) ON {tbl}(a, b)",
+	}},
+	{"DropStatisticsStmt", "ddl", []string{
+		"DROP STATISTICS {ident}",
+	}},
+
+	// admin.sql
+	{"AnalyzeTableStmt", "admin", []string{
+		"ANALYZE TABLE {tbl}",
+	}},
+	{"DropStatsStmt", "admin", []string{
+		"DROP STATS {tbl}",
+	}},
+	{"LoadStatsStmt", "admin", []string{
+		"LOAD STATS '/tmp/stats.json'",
+	}},
+	{"LockStatsStmt", "admin", []string{
+		"LOCK STATS {tbl}",
+	}},
+	{"UnlockStatsStmt", "admin", []string{
+		"UNLOCK STATS {tbl}",
+	}},
+	{"RefreshStatsStmt", "admin", []string{
+		"REFRESH STATS {ident}.{tbl}",
+	}},
+	{"TraceStmt", "admin", []string{
+		"TRACE SELECT {col} FROM {tbl}",
+	}},
+	{"ExplainStmt", "admin", []string{
+		"EXPLAIN SELECT * FROM {tbl} WHERE {col} = {int}",
+		"EXPLAIN FORMAT = 'brief' DELETE FROM {tbl}",
+	}},
+	{"ExplainForStmt", "admin", []string{
+		"EXPLAIN FOR CONNECTION {int}",
+	}},
+	{"PlanReplayerStmt", "admin", []string{
+		"PLAN REPLAYER DUMP EXPLAIN SELECT {col} FROM {tbl}",
+	}},
+	{"TrafficStmt", "admin", []string{
+		"TRAFFIC CAPTURE TO '/tmp' DURATION = '1s'",
+	}},
+	{"RecommendIndexStmt", "admin", []string{
+		"RECOMMEND INDEX RUN",
+	}},
+	{"PrepareStmt", "admin", []string{
+		"PREPARE {ident} FROM 'SELECT ?'",
+	}},
+	{"ExecuteStmt", "admin", []string{
+		"EXECUTE {ident} USING @{ident}",
+	}},
+	{"DeallocateStmt", "admin", []string{
+		"DEALLOCATE PREPARE {ident}",
+	}},
+	{"BeginStmt", "admin", []string{
+		"BEGIN",
+		"START TRANSACTION READ ONLY",
+	}},
+	{"CommitStmt", "admin", []string{
+		"COMMIT",
+	}},
+	{"RollbackStmt", "admin", []string{
+		"ROLLBACK",
+		"ROLLBACK TO SAVEPOINT {ident}",
+	}},
+	{"SavepointStmt", "admin", []string{
+		"SAVEPOINT {ident}",
+	}},
+	{"ReleaseSavepointStmt", "admin", []string{
+		"RELEASE SAVEPOINT {ident}",
+	}},
+	{"BinlogStmt", "admin", []string{
+		"BINLOG 'BxSFVw8JAAAA8QAAAPUAAAAAAAQANS41LjQ0'",
+	}},
+	{"ChecksumTableStmt", "admin", []string{
+		"CHECKSUM TABLE {tbl}, {tbl}",
+		"CHECKSUM TABLE {tbl} QUICK",
+	}},
+	{"CacheIndexStmt", "admin", []string{
+		"CACHE INDEX {tbl} INDEX ({ident}) IN {ident}",
+		"CACHE INDEX {tbl} PARTITION (ALL), {tbl} IN {ident}",
+	}},
+	{"LoadIndexIntoCacheStmt", "admin", []string{
+		"LOAD INDEX INTO CACHE {tbl} PARTITION ({ident}) IGNORE LEAVES",
+		"LOAD INDEX INTO CACHE {tbl} INDEX ({ident}, {ident}), {tbl}",
+	}},
+	{"UseStmt", "admin", []string{
+		"USE {ident}",
+	}},
+	{"FlushStmt", "admin", []string{
+		"FLUSH TABLES {tbl} WITH READ LOCK",
+		"FLUSH PRIVILEGES",
+	}},
+	{"KillStmt", "admin", []string{
+		"KILL {int}",
+		"KILL QUERY {int}",
+	}},
+	{"SetStmt", "admin", []string{
+		"SET @{ident} = {expr}",
+		"SET NAMES utf8mb4",
+		"SET SESSION sql_mode = {str}",
+		"SET PERSIST {ident} = {int}",
+		"SET @@PERSIST_ONLY.{ident} = {int}",
+	}},
+	{"SetConfigStmt", "admin", []string{
+		"SET CONFIG TIKV LOG.LEVEL = 'info'",
+	}},
+	{"SetSessionStatesStmt", "admin", []string{
+		"SET SESSION_STATES {str}",
+	}},
+	{"SetPwdStmt", "admin", []string{
+		"SET PASSWORD FOR 'u1'@'localhost' = {str}",
+	}},
+	{"SetRoleStmt", "admin", []string{
+		"SET ROLE DEFAULT",
+		"SET ROLE ALL",
+	}},
+	{"SetDefaultRoleStmt", "admin", []string{
+		"SET DEFAULT ROLE r1 TO 'u1'@'localhost'",
+	}},
+	{"SetResourceGroupStmt", "admin", []string{
+		"SET RESOURCE GROUP {ident}",
+	}},
+	{"SetBindingStmt", "admin", []string{
+		"SET BINDING DISABLED FOR SELECT * FROM {tbl}",
+	}},
+	{"CreateBindingStmt", "admin", []string{
+		"CREATE GLOBAL BINDING FOR SELECT * FROM t USING SELECT * FROM t USE INDEX (idx_a)",
+	}},
+	{"DropBindingStmt", "admin", []string{
+		"DROP SESSION BINDING FOR SELECT * FROM {tbl}",
+	}},
+	{"CreateUserStmt", "admin", []string{
+		"CREATE USER IF NOT EXISTS 'u1'@'localhost' IDENTIFIED BY {str}",
+	}},
+	{"AlterUserStmt", "admin", []string{
+		"ALTER USER 'u1'@'localhost' IDENTIFIED BY {str}",
+	}},
+	{"DropUserStmt", "admin", []string{
+		"DROP USER IF EXISTS 'u1'@'localhost'",
+	}},
+	{"RenameUserStmt", "admin", []string{
+		"RENAME USER 'u1'@'%' TO 'u2'@'%'",
+	}},
+	{"GrantStmt", "admin", []string{
+		"GRANT SELECT, INSERT ON {ident}.* TO 'u1'@'localhost'",
+	}},
+	{"GrantProxyStmt", "admin", []string{
+		"GRANT PROXY ON 'u1'@'%' TO 'u2'@'%'",
+	}},
+	{"GrantRoleStmt", "admin", []string{
+		"GRANT r1, r2 TO 'u1'@'localhost'",
+	}},
+	{"RevokeStmt", "admin", []string{
+		"REVOKE SELECT ON {ident}.{tbl} FROM 'u1'@'localhost'",
+	}},
+	{"RevokeRoleStmt", "admin", []string{
+		"REVOKE r1 FROM 'u1'@'localhost'",
+	}},
+	{"AlterInstanceStmt", "admin", []string{
+		"ALTER INSTANCE RELOAD TLS",
+	}},
+	{"DoStmt", "admin", []string{
+		"DO {expr}",
+	}},
+	{"AdminStmt", "admin", []string{
+		"ADMIN CHECK TABLE {tbl}",
+		"ADMIN SHOW DDL JOBS",
+	}},
+	{"ShutdownStmt", "admin", []string{
+		"SHUTDOWN",
+	}},
+	{"RestartStmt", "admin", []string{
+		"RESTART",
+	}},
+	{"ResetPersistStmt", "admin", []string{
+		"RESET PERSIST",
+		"RESET PERSIST IF EXISTS {ident}",
+	}},
+	{"HelpStmt", "admin", []string{
+		"HELP 'select'",
+	}},
+	{"BRIEStmt", "admin", []string{
+		"BACKUP DATABASE {ident} TO 'local:///tmp/backup/'",
+	}},
+	{"ImportIntoActionStmt", "admin", []string{
+		"CANCEL IMPORT JOB {int}",
+	}},
+	{"CancelDistributionJobStmt", "admin", []string{
+		"CANCEL DISTRIBUTION JOB {int}",
+	}},
+	{"CalibrateResourceStmt", "admin", []string{
+		"CALIBRATE RESOURCE",
+	}},
+	{"DynamicCalibrateResourceOption", "admin", []string{
+		"CALIBRATE RESOURCE START_TIME '2021-04-15 00:00:00'",
+	}},
+	{"AddQueryWatchStmt", "admin", []string{
+		"QUERY WATCH ADD RESOURCE GROUP {ident} SQL TEXT EXACT TO 'select 1'",
+	}},
+	{"QueryWatchOption", "admin", []string{
+		"QUERY WATCH ADD SQL DIGEST b13858789fce00208f9a262c99621b7045f4869807cd4e6568008ae7ca19a377",
+	}},
+	{"DropQueryWatchStmt", "admin", []string{
+		"QUERY WATCH REMOVE {int}",
+	}},
+
+	// procedure.sql
+	{"ProcedureInfo", "procedure", []string{
+		"CREATE PROCEDURE IF NOT EXISTS {ident}(IN id INT, OUT total BIGINT) SELECT {col} FROM {tbl} WHERE {col} = id",
+	}},
+	{"DropProcedureStmt", "procedure", []string{
+		"DROP PROCEDURE IF EXISTS {ident}",
+	}},
+	{"ProcedureBlock", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE v INT DEFAULT {int}; SELECT v; END",
+	}},
+	{"ProcedureIfInfo", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN IF i > {int} THEN SELECT {int}; END IF; END",
+	}},
+	{"ProcedureIfBlock", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN IF i > {int} THEN SELECT 1; ELSE SELECT 2; END IF; END",
+	}},
+	{"ProcedureElseIfBlock", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN IF i > {int} THEN SELECT 1; ELSEIF i = {int} THEN SELECT 2; END IF; END",
+	}},
+	{"ProcedureElseBlock", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN IF i > {int} THEN SELECT 1; ELSEIF i = {int} THEN SELECT 2; ELSE SELECT 3; END IF; END",
+	}},
+	{"SimpleCaseStmt", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) CASE i WHEN {int} THEN SELECT 1; ELSE SELECT 2; END CASE",
+	}},
+	{"SimpleWhenThenStmt", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) CASE i WHEN {int} THEN SELECT 1; WHEN {int} THEN SELECT 2; END CASE",
+	}},
+	{"SearchCaseStmt", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) CASE WHEN i = {int} THEN SELECT 1; ELSE SELECT 2; END CASE",
+	}},
+	{"SearchWhenThenStmt", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) CASE WHEN i = {int} THEN SELECT 1; WHEN i > {int} THEN SELECT 2; END CASE",
+	}},
+	{"ProcedureWhileStmt", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN WHILE i < {int} DO SET i = i + 1; END WHILE; END",
+	}},
+	{"ProcedureRepeatStmt", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN REPEAT SET i = i + 1; UNTIL i > {int} END REPEAT; END",
+	}},
+	{"ProcedureOpenCur", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE cur CURSOR FOR SELECT {col} FROM {tbl}; OPEN cur; END",
+	}},
+	{"ProcedureFetchInto", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE v INT; DECLARE cur CURSOR FOR SELECT {col} FROM {tbl}; OPEN cur; FETCH cur INTO v; END",
+	}},
+	{"ProcedureCloseCur", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE cur CURSOR FOR SELECT {col} FROM {tbl}; OPEN cur; CLOSE cur; END",
+	}},
+	{"ProcedureErrorCondition", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE CONTINUE HANDLER FOR SQLEXCEPTION SELECT {int}; SELECT 1; END",
+	}},
+	{"ProcedureErrorCon", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE EXIT HANDLER FOR SQLWARNING, NOT FOUND SELECT {int}; SELECT 1; END",
+	}},
+	{"ProcedureErrorVal", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE EXIT HANDLER FOR 1062 SELECT {int}; SELECT 1; END",
+	}},
+	{"ProcedureErrorState", "procedure", []string{
+		"CREATE PROCEDURE {ident}() BEGIN DECLARE CONTINUE HANDLER FOR SQLSTATE '23000' SELECT {int}; SELECT 1; END",
+	}},
+	{"ProcedureLabelBlock", "procedure", []string{
+		"CREATE PROCEDURE {ident}() blk: BEGIN SELECT {int}; END blk",
+	}},
+	{"ProcedureLabelLoop", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN lp: WHILE i < {int} DO SET i = i + 1; END WHILE lp; END",
+	}},
+	{"ProcedureJump", "procedure", []string{
+		"CREATE PROCEDURE {ident}(i INT) BEGIN lp: REPEAT SET i = i + 1; IF i = {int} THEN ITERATE lp; END IF; IF i > {int} THEN LEAVE lp; END IF; UNTIL i > 100 END REPEAT lp; END",
+	}},
+}
+
+var (
+	tables      = []string{"t", "t1", "t2", "orders", "users"}
+	columns     = []string{"a", "b", "c", "id", "name"}
+	identifiers = []string{"x", "y", "p1", "v1", "s1", "db1"}
+	strs        = []string{"'abc'", "'foo'", "'bar baz'", "'it''s'", "'%a_'"}
+	exprs       = []string{"{col}", "{int}", "{str}", "{col} + {int}", "{col} * {int}", "ABS({col})", "{col} - {col}"}
+)
+
+var placeholderRe = regexp.MustCompile(`\{(tbl|col|ident|int|str|expr)\}`)
+
+type generator struct {
+	rnd *rand.Rand
+}
+
+func (g *generator) fill(template string) string {
+	return placeholderRe.ReplaceAllStringFunc(template, func(p string) string {
+		switch p {
+		case "{tbl}":
+			return tables[g.rnd.Intn(len(tables))]
+		case "{col}":
+			return columns[g.rnd.Intn(len(columns))]
+		case "{ident}":
+			return identifiers[g.rnd.Intn(len(identifiers))]
+		case "{int}":
+			return fmt.Sprint(1 + g.rnd.Intn(99))
+		case "{str}":
+			return strs[g.rnd.Intn(len(strs))]
+		default:
+			return g.fill(exprs[g.rnd.Intn(len(exprs))])
+		}
+	})
+}
+
+// Nodes returns the names of the nodes which have templates, sorted.
+func Nodes() []string {
+	nodes := make([]string, 0, len(entries))
+	for _, e := range entries {
+		nodes = append(nodes, e.node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// Generate generates the files of the corpus, sorted by name, with the
+// statements in the order of the templates.
+func Generate(seed int64) []File {
+	g := &generator{rnd: rand.New(rand.NewSource(seed))}
+	byName := make(map[string]*File)
+	var files []File
+	for _, e := range entries {
+		f, ok := byName[e.file]
+		if !ok {
+			files = append(files, File{Name: e.file + ".sql"})
+			f = &files[len(files)-1]
+			byName[e.file] = f
+		}
+		seen := make(map[string]struct{})
+		for _, tmpl := range e.templates {
+			for range Variants {
+				sql := g.fill(tmpl)
+				if _, ok := seen[sql]; ok {
+					continue
+				}
+				seen[sql] = struct{}{}
+				f.Statements = append(f.Statements, Statement{Node: e.node, SQL: sql})
+			}
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files
+}
+
+// Content returns the content of the file. Every statement is on its own line,
+// ended by a semicolon, after a comment line with the name of its node.
+func (f *File) Content(seed int64) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "-- Code generated by gencorpus with seed %d. DO NOT EDIT.\n", seed)
+	for _, stmt := range f.Statements {
+		fmt.Fprintf(&b, "\n-- %s\n%s;\n", stmt.Node, stmt.SQL)
+	}
+	return b.Bytes()
+}
+
+// Write generates the corpus with seed into dir.
+func Write(dir string, seed int64) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, f := range Generate(seed) {
+		if err := os.WriteFile(filepath.Join(dir, f.Name), f.Content(seed), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load reads the statements of the corpus in dir, in the order of the files
+// and of the statements in them.
+func Load(dir string) ([]Statement, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var stmts []Statement
+	for _, name := range names {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		node := ""
+		s := bufio.NewScanner(bytes.NewReader(data))
+		s.Buffer(nil, 1<<20)
+		for s.Scan() {
+			line := strings.TrimSpace(s.Text())
+			switch {
+			case line == "":
+			case strings.HasPrefix(line, "-- Code generated"):
+			case strings.HasPrefix(line, "-- "):
+				node = strings.TrimPrefix(line, "-- ")
+			default:
+				if node == "" {
+					return nil, fmt.Errorf("%s: statement without node: %s", name, line)
+				}
+				stmts = append(stmts, Statement{Node: node, SQL: line})
+				node = ""
+			}
+		}
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return stmts, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package corpus
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const corpusDir = "../../testdata/corpus"
+
+// baseNodes are the unexported types of package ast a node embeds to implement
+// StmtNode or ExprNode.
+var baseNodes = []string{"stmtNode", "ddlNode", "dmlNode", "exprNode", "funcNode"}
+
+// astNodes returns the exported struct types of package ast which embed one of
+// baseNodes, directly or through another type.
+func astNodes(t *testing.T) []string {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "../../ast", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+	embeds := make(map[string][]string)
+	for _, f := range pkgs["ast"].Files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					if len(field.Names) != 0 {
+						continue
+					}
+					typ := field.Type
+					if star, ok := typ.(*ast.StarExpr); ok {
+						typ = star.X
+					}
+					if ident, ok := typ.(*ast.Ident); ok {
+						embeds[ts.Name.Name] = append(embeds[ts.Name.Name], ident.Name)
+					}
+				}
+			}
+		}
+	}
+
+	isNode := make(map[string]bool)
+	for _, base := range baseNodes {
+		isNode[base] = true
+	}
+	for changed := true; changed; {
+		changed = false
+		for name, embedded := range embeds {
+			if isNode[name] {
+				continue
+			}
+			for _, e := range embedded {
+				if isNode[e] {
+					isNode[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	var nodes []string
+	for name := range isNode {
+		if ast.IsExported(name) {
+			nodes = append(nodes, name)
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+func TestEveryNodeHasTemplates(t *testing.T) {
+	nodes := astNodes(t)
+	require.NotEmpty(t, nodes)
+	templated := make(map[string]bool)
+	for _, node := range Nodes() {
+		require.False(t, templated[node], "%s has more than one entry", node)
+		templated[node] = true
+	}
+	for _, node := range nodes {
+		_, unreachable := Unreachable[node]
+		require.True(t, templated[node] || unreachable, "%s has no template, add one to internal/corpus and run go generate", node)
+		require.False(t, templated[node] && unreachable, "%s has templates but is unreachable", node)
+	}
+
+	known := make(map[string]bool)
+	for _, node := range nodes {
+		known[node] = true
+	}
+	for node := range templated {
+		require.True(t, known[node], "%s isn't a node of package ast", node)
+	}
+	for node := range Unreachable {
+		require.True(t, known[node], "%s isn't a node of package ast", node)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	for _, e := range entries {
+		require.NotEmpty(t, e.templates, e.node)
+		for _, tmpl := range e.templates {
+			// Every brace must be a placeholder.
+			rest := placeholderRe.ReplaceAllString(tmpl, "")
+			require.NotContains(t, rest, "{", tmpl)
+			require.NotContains(t, rest, "}", tmpl)
+			require.NotContains(t, tmpl, "\n", tmpl)
+			require.False(t, strings.HasSuffix(tmpl, ";"), tmpl)
+		}
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	require.Equal(t, Generate(DefaultSeed), Generate(DefaultSeed))
+	require.NotEqual(t, Generate(DefaultSeed), Generate(DefaultSeed+1))
+
+	nodes := make(map[string]bool)
+	for _, f := range Generate(DefaultSeed) {
+		for _, stmt := range f.Statements {
+			nodes[stmt.Node] = true
+			require.NotContains(t, stmt.SQL, "{", stmt.SQL)
+		}
+	}
+	require.Len(t, nodes, len(entries))
+}
+
+func TestCorpusIsUpToDate(t *testing.T) {
+	files := Generate(DefaultSeed)
+	names, err := filepath.Glob(filepath.Join(corpusDir, "*.sql"))
+	require.NoError(t, err)
+	require.Len(t, names, len(files), "run go generate to regenerate the corpus")
+	for _, f := range files {
+		data, err := os.ReadFile(filepath.Join(corpusDir, f.Name))
+		require.NoError(t, err)
+		require.Equal(t, string(f.Content(DefaultSeed)), string(data), "run go generate to regenerate %s", f.Name)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	var expected []Statement
+	for _, f := range Generate(DefaultSeed) {
+		for _, stmt := range f.Statements {
+			expected = append(expected, Statement{Node: stmt.Node, SQL: stmt.SQL + ";"})
+		}
+	}
+	stmts, err := Load(corpusDir)
+	require.NoError(t, err)
+	require.Equal(t, expected, stmts)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.sql"), []byte("SELECT 1;\n"), 0o644))
+	_, err = Load(dir)
+	require.ErrorContains(t, err, "statement without node")
+}
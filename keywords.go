@@ -269,6 +269,7 @@ var Keywords = []KeywordsType{
 	{"ANY", false, "unreserved"},
 	{"APPLY", false, "unreserved"},
 	{"ASCII", false, "unreserved"},
+	{"AT", false, "unreserved"},
 	{"ATTRIBUTE", false, "unreserved"},
 	{"ATTRIBUTES", false, "unreserved"},
 	{"AUTO_ID_CACHE", false, "unreserved"},
@@ -281,6 +282,7 @@ var Keywords = []KeywordsType{
 	{"BACKUP", false, "unreserved"},
 	{"BACKUPS", false, "unreserved"},
 	{"BDR", false, "unreserved"},
+	{"BEFORE", false, "unreserved"},
 	{"BEGIN", false, "unreserved"},
 	{"BERNOULLI", false, "unreserved"},
 	{"BINDING", false, "unreserved"},
@@ -319,6 +321,7 @@ var Keywords = []KeywordsType{
 	{"COMMIT", false, "unreserved"},
 	{"COMMITTED", false, "unreserved"},
 	{"COMPACT", false, "unreserved"},
+	{"COMPLETION", false, "unreserved"},
 	{"COMPRESSED", false, "unreserved"},
 	{"COMPRESSION", false, "unreserved"},
 	{"COMPRESSION_LEVEL", false, "unreserved"},
@@ -354,14 +357,17 @@ var Keywords = []KeywordsType{
 	{"DISCARD", false, "unreserved"},
 	{"DISK", false, "unreserved"},
 	{"DO", false, "unreserved"},
+	{"DUMPFILE", false, "unreserved"},
 	{"DUPLICATE", false, "unreserved"},
 	{"DYNAMIC", false, "unreserved"},
+	{"EACH", false, "unreserved"},
 	{"ENABLE", false, "unreserved"},
 	{"ENABLED", false, "unreserved"},
 	{"ENCRYPTION", false, "unreserved"},
 	{"ENCRYPTION_KEYFILE", false, "unreserved"},
 	{"ENCRYPTION_METHOD", false, "unreserved"},
 	{"END", false, "unreserved"},
+	{"ENDS", false, "unreserved"},
 	{"ENFORCED", false, "unreserved"},
 	{"ENGINE", false, "unreserved"},
 	{"ENGINES", false, "unreserved"},
@@ -372,6 +378,7 @@ var Keywords = []KeywordsType{
 	{"ESCAPE", false, "unreserved"},
 	{"EVENT", false, "unreserved"},
 	{"EVENTS", false, "unreserved"},
+	{"EVERY", false, "unreserved"},
 	{"EVOLVE", false, "unreserved"},
 	{"EXCHANGE", false, "unreserved"},
 	{"EXCLUSIVE", false, "unreserved"},
@@ -388,11 +395,14 @@ var Keywords = []KeywordsType{
 	{"FIXED", false, "unreserved"},
 	{"FLUSH", false, "unreserved"},
 	{"FOLLOWING", false, "unreserved"},
+	{"FOLLOWS", false, "unreserved"},
 	{"FORMAT", false, "unreserved"},
 	{"FOUND", false, "unreserved"},
 	{"FULL", false, "unreserved"},
 	{"FUNCTION", false, "unreserved"},
 	{"GENERAL", false, "unreserved"},
+	{"GEOMETRY", false, "unreserved"},
+	{"GEOMETRYCOLLECTION", false, "unreserved"},
 	{"GLOBAL", false, "unreserved"},
 	{"GRANTS", false, "unreserved"},
 	{"HANDLER", false, "unreserved"},
@@ -425,8 +435,10 @@ var Keywords = []KeywordsType{
 	{"LAST", false, "unreserved"},
 	{"LASTVAL", false, "unreserved"},
 	{"LAST_BACKUP", false, "unreserved"},
+	{"LEAVES", false, "unreserved"},
 	{"LESS", false, "unreserved"},
 	{"LEVEL", false, "unreserved"},
+	{"LINESTRING", false, "unreserved"},
 	{"LIST", false, "unreserved"},
 	{"LOAD_STATS", false, "unreserved"},
 	{"LOCAL", false, "unreserved"},
@@ -452,6 +464,9 @@ var Keywords = []KeywordsType{
 	{"MODE", false, "unreserved"},
 	{"MODIFY", false, "unreserved"},
 	{"MONTH", false, "unreserved"},
+	{"MULTILINESTRING", false, "unreserved"},
+	{"MULTIPOINT", false, "unreserved"},
+	{"MULTIPOLYGON", false, "unreserved"},
 	{"NAMES", false, "unreserved"},
 	{"NATIONAL", false, "unreserved"},
 	{"NCHAR", false, "unreserved"},
@@ -489,11 +504,15 @@ var Keywords = []KeywordsType{
 	{"PASSWORD_LOCK_TIME", false, "unreserved"},
 	{"PAUSE", false, "unreserved"},
 	{"PERCENT", false, "unreserved"},
+	{"PERSIST", false, "unreserved"},
+	{"PERSIST_ONLY", false, "unreserved"},
 	{"PER_DB", false, "unreserved"},
 	{"PER_TABLE", false, "unreserved"},
 	{"PLUGINS", false, "unreserved"},
 	{"POINT", false, "unreserved"},
 	{"POLICY", false, "unreserved"},
+	{"POLYGON", false, "unreserved"},
+	{"PRECEDES", false, "unreserved"},
 	{"PRECEDING", false, "unreserved"},
 	{"PREPARE", false, "unreserved"},
 	{"PRESERVE", false, "unreserved"},
@@ -535,6 +554,7 @@ var Keywords = []KeywordsType{
 	{"ROLE", false, "unreserved"},
 	{"ROLLBACK", false, "unreserved"},
 	{"ROLLUP", false, "unreserved"},
+	{"ROTATE", false, "unreserved"},
 	{"ROUTINE", false, "unreserved"},
 	{"ROW_COUNT", false, "unreserved"},
 	{"ROW_FORMAT", false, "unreserved"},
@@ -580,7 +600,9 @@ var Keywords = []KeywordsType{
 	{"SQL_TSI_SECOND", false, "unreserved"},
 	{"SQL_TSI_WEEK", false, "unreserved"},
 	{"SQL_TSI_YEAR", false, "unreserved"},
+	{"SRID", false, "unreserved"},
 	{"START", false, "unreserved"},
+	{"STARTS", false, "unreserved"},
 	{"STATS_AUTO_RECALC", false, "unreserved"},
 	{"STATS_COL_CHOICE", false, "unreserved"},
 	{"STATS_COL_LIST", false, "unreserved"},
@@ -616,6 +638,7 @@ var Keywords = []KeywordsType{
 	{"TRACE", false, "unreserved"},
 	{"TRADITIONAL", false, "unreserved"},
 	{"TRANSACTION", false, "unreserved"},
+	{"TREE", false, "unreserved"},
 	{"TRIGGERS", false, "unreserved"},
 	{"TRUNCATE", false, "unreserved"},
 	{"TSO", false, "unreserved"},
@@ -16,12 +16,12 @@ package parser
 import (
 	"bytes"
 	"fmt"
-	"strconv"
 	"strings"
 	"unicode"
 
 	"github.com/abbychau/mysql-parser/charset"
 	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/parser_driver"
 	tidbfeature "github.com/abbychau/mysql-parser/tidb"
 )
 
@@ -29,8 +29,11 @@ var _ = yyLexer(&Scanner{})
 
 // Pos represents the position of a token.
 type Pos struct {
-	Line   int
-	Col    int
+	// Line is from 1.
+	Line int
+	// Col is the column in bytes, from 0 at the start of every line.
+	Col int
+	// Offset is the offset in bytes in the whole SQL text.
 	Offset int
 }
 
@@ -45,6 +48,17 @@ type Scanner struct {
 	errs         []error
 	warns        []error
 	stmtStartPos int
+	// stmtTokenStart is the offset of the first token of the statement being
+	// scanned, -1 before it is scanned.
+	stmtTokenStart int
+	// prevTokenEnd is the offset right after the token before the last one
+	// returned by Lex.
+	prevTokenEnd int
+	// oneStmt, set by ParseOneStmt, ends the scan at the first token of a
+	// statement following a parsed one, whose offset is kept in nextStmtOffset.
+	oneStmt        bool
+	parsedStmt     bool
+	nextStmtOffset int
 
 	// inBangComment is true if we are inside a `/*! ... */` block.
 	// It is used to ignore a stray `*/` when scanning.
@@ -57,6 +71,9 @@ type Scanner struct {
 	// because some application may already use them as identifiers.
 	supportWindowFunc bool
 
+	// dialect is the SQL dialect accepted besides MySQL, see SetDialect.
+	dialect Dialect
+
 	// Whether record the original text keyword position to the AST node.
 	skipPositionRecording bool
 
@@ -83,8 +100,53 @@ type Scanner struct {
 
 	// keepHint, if true, Scanner will keep hint when normalizing .
 	keepHint bool
+
+	// litCheck locates the string literals for ValidationError.
+	litCheck literalCheck
+
+	// identCheck, if true, Scanner records the identifiers in longIdents for
+	// the identifier length check.
+	identCheck bool
+	longIdents []identToken
+
+	// lenientIdentEncoding, if true, Scanner replaces the invalid utf8mb4 of the
+	// identifiers and warns instead of failing.
+	lenientIdentEncoding bool
+
+	// digitSeparators, if true, Scanner scans the underscores between digits
+	// as part of the numeric literals, e.g. 1_000, instead of identifiers.
+	digitSeparators bool
+
+	// funcArgParsers are the FunctionArgParser of the functions registered with
+	// Parser.RegisterFunctionSyntax, by their uppercase names.
+	funcArgParsers map[string]FunctionArgParser
+
+	// inTriggerBody is true after the FOR EACH ROW of a CREATE TRIGGER, until
+	// its body is scanned as a triggerBody token, after the FOLLOWS or
+	// PRECEDES clause, whose tokens left are triggerOrderTokens.
+	inTriggerBody      bool
+	triggerOrderTokens int
+
+	// eventStmt is the state of the scan of a CREATE EVENT or ALTER EVENT up to
+	// the DO of its body, which is scanned like a trigger body if it's a
+	// compound statement.
+	eventStmt eventStmtState
 }
 
+// eventStmtState is the state of the scan of a CREATE EVENT or ALTER EVENT.
+type eventStmtState int
+
+const (
+	// eventStmtNone is out of the header of an event statement.
+	eventStmtNone eventStmtState = iota
+	// eventStmtStart is after the CREATE or ALTER starting a statement.
+	eventStmtStart
+	// eventStmtDefiner is in the DEFINER = user after eventStmtStart.
+	eventStmtDefiner
+	// eventStmtEvent is after the EVENT, up to the DO of the body.
+	eventStmtEvent
+)
+
 // Errors returns the errors and warns during a scan.
 func (s *Scanner) Errors() (warns []error, errs []error) {
 	return s.warns, s.errs
@@ -99,9 +161,18 @@ func (s *Scanner) reset(sql string) {
 	s.errs = s.errs[:0]
 	s.warns = s.warns[:0]
 	s.stmtStartPos = 0
+	s.stmtTokenStart = -1
+	s.prevTokenEnd = 0
+	s.parsedStmt = false
+	s.nextStmtOffset = -1
+	s.litCheck.reset()
+	s.longIdents = s.longIdents[:0]
 	s.inBangComment = false
 	s.lastKeyword = 0
 	s.identifierDot = false
+	s.inTriggerBody = false
+	s.triggerOrderTokens = 0
+	s.eventStmt = eventStmtNone
 }
 
 func (s *Scanner) stmtText() string {
@@ -116,22 +187,49 @@ func (s *Scanner) stmtText() string {
 	text := s.r.s[s.stmtStartPos:endPos]
 
 	s.stmtStartPos = endPos
+	s.stmtTokenStart = -1
+	s.parsedStmt = true
 	return text
 }
 
+// stmtOffsets returns the start and end offsets of the statement being reduced,
+// it must be called before stmtText. The start is the offset of its first token,
+// so the comments before it are left out, and the end is the offset right after
+// its terminating ';', which is the lookahead token, or right after its last
+// token when it ends the text without one.
+func (s *Scanner) stmtOffsets() (start, end int) {
+	if s.lastScanOffset < s.r.l {
+		return s.stmtTokenStart, s.lastScanOffset + 1
+	}
+	return s.stmtTokenStart, s.prevTokenEnd
+}
+
+// lineColumnAt describes the offset of the scanned text as "line %d column %d",
+// with the line counted from 1 and the column in bytes from 0, like the ones of a
+// SyntaxError.
+func (s *Scanner) lineColumnAt(offset int) string {
+	line := strings.Count(s.r.s[:offset], "\n") + 1
+	col := offset - strings.LastIndexByte(s.r.s[:offset], '\n') - 1
+	return fmt.Sprintf("line %d column %d", line, col)
+}
+
 // Errorf tells scanner something is wrong.
 // Scanner satisfies yyLexer interface which need this function.
+// The error is a *SyntaxError.
 func (s *Scanner) Errorf(format string, a ...interface{}) (err error) {
-	str := fmt.Sprintf(format, a...)
 	val := s.r.s[s.lastScanOffset:]
-	var lenStr = ""
-	if len(val) > 2048 {
-		lenStr = "(total length " + strconv.Itoa(len(val)) + ")"
-		val = val[:2048]
-	}
-	err = fmt.Errorf("line %d column %d near \"%s\"%s %s",
-		s.r.p.Line, s.r.p.Col, val, str, lenStr)
-	return
+	e := &SyntaxError{
+		Line:   s.r.p.Line,
+		Col:    s.r.p.Col,
+		Offset: s.lastScanOffset,
+		Msg:    fmt.Sprintf(format, a...),
+	}
+	if len(val) > maxNearLength {
+		e.nearLength = len(val)
+		val = val[:maxNearLength]
+	}
+	e.Near = val
+	return e
 }
 
 // AppendError sets error into scanner.
@@ -162,17 +260,23 @@ func (s *Scanner) convert2System(tok int, lit string) (int, string) {
 }
 
 // convert2Connection convert lit from client encoding to connection encoding.
-func (s *Scanner) convert2Connection(tok int, lit string) (int, string) {
+// In strict SQL mode, an invalid literal is reported as a ValidationError and
+// the scanning goes on, so that all invalid literals of a statement are reported.
+// introducer is the charset of the introducer of lit, if any.
+func (s *Scanner) convert2Connection(tok int, lit string, introducer string) (int, string) {
+	index := s.litCheck.nextLiteral()
+	s.checkLiteralCharset(lit, index, introducer)
 	if mysql.IsUTF8Charset(s.client.Name()) {
 		return tok, lit
 	}
 	utf8Lit, err := s.client.Transform(nil, charset.HackSlice(lit), charset.OpDecodeReplace)
 	if err != nil {
-		s.AppendError(err)
 		if s.sqlMode.HasStrictMode() && s.client.Tp() == s.connection.Tp() {
-			return invalid, lit
+			s.appendValidationError(lit, index, err)
+		} else {
+			s.AppendError(err)
+			s.lastErrorAsWarn()
 		}
-		s.lastErrorAsWarn()
 	}
 
 	// It is definitely valid if `client` is the same with `connection`, so just transform if they are not the same.
@@ -227,22 +331,54 @@ func (s *Scanner) getNextTwoTokens() (tok1 int, tok2 int) {
 // return 0 tells parser that scanner meets EOF,
 // return invalid tells parser that scanner meets illegal character.
 func (s *Scanner) Lex(v *yySymType) int {
+	s.prevTokenEnd = s.r.pos().Offset
+	if s.inTriggerBody {
+		if s.triggerOrderTokens > 0 {
+			s.triggerOrderTokens--
+		} else if tok := s.getNextToken(); tok == follows || tok == precedes {
+			// The trigger name follows FOLLOWS and PRECEDES.
+			s.triggerOrderTokens = 1
+		} else {
+			s.inTriggerBody = false
+			return s.lexTriggerBody(v)
+		}
+	}
+	introducer := s.litCheck.introducer
+	s.litCheck.introducer = ""
 	tok, pos, lit := s.scan()
 	s.lastScanOffset = pos.Offset
+	if s.stmtTokenStart < 0 && tok != ';' && tok != 0 {
+		if s.oneStmt && s.parsedStmt {
+			// The parse ends before the second statement.
+			s.nextStmtOffset = pos.Offset
+			v.offset = pos.Offset
+			return 0
+		}
+		s.stmtTokenStart = pos.Offset
+	}
 	s.lastKeyword3 = s.lastKeyword2
 	s.lastKeyword2 = s.lastKeyword
 	s.lastKeyword = 0
 	v.offset = pos.Offset
 	v.ident = lit
+	s.litCheck.onToken(tok, pos.Offset)
 	if tok == identifier {
 		tok = s.handleIdent(v)
 	}
+	if tok == underscoreCS {
+		s.litCheck.introducer = v.ident
+	}
 	if tok == identifier {
 		if tok1 := s.isTokenIdentifier(lit, pos.Offset); tok1 != 0 {
 			tok = tok1
 			s.lastKeyword = tok1
 		}
 	}
+	if tok == identifier && len(s.funcArgParsers) > 0 {
+		if tok1 := s.lexRegisteredFuncCall(v, pos.Offset); tok1 != 0 {
+			return tok1
+		}
+	}
 	if s.sqlMode.HasANSIQuotesMode() &&
 		tok == stringLit &&
 		s.r.s[v.offset] == '"' {
@@ -253,6 +389,13 @@ func (s *Scanner) Lex(v *yySymType) int {
 		return pipesAsOr
 	}
 
+	if tok == row && s.lastKeyword2 == each && s.lastKeyword3 == forKwd {
+		s.inTriggerBody = true
+	}
+	if s.scanEventStmt(tok, pos.Offset) {
+		s.inTriggerBody = true
+	}
+
 	if tok == not && s.sqlMode.HasHighNotPrecedenceMode() {
 		return not2
 	}
@@ -302,6 +445,12 @@ func (s *Scanner) Lex(v *yySymType) int {
 		}
 	}
 
+	switch tok {
+	case intLit, floatLit, decLit:
+		if s.digitSeparators {
+			lit = parser_driver.StripDigitSeparators(lit)
+		}
+	}
 	switch tok {
 	case intLit:
 		return toInt(s, v, lit)
@@ -314,21 +463,146 @@ func (s *Scanner) Lex(v *yySymType) int {
 	case bitLit:
 		return toBit(s, v, lit)
 	case singleAtIdentifier, doubleAtIdentifier, cast, extract:
+		lit = s.checkIdentEncoding(lit, pos)
 		v.item = lit
+		s.recordIdent(tok, lit, pos)
 		return tok
 	case null:
 		v.item = nil
 	case quotedIdentifier, identifier:
 		tok = identifier
 		s.identifierDot = s.r.peek() == '.'
-		tok, v.ident = s.convert2System(tok, lit)
+		tok, v.ident = s.convert2System(tok, s.checkIdentEncoding(lit, pos))
+		s.recordIdent(tok, v.ident, pos)
 	case stringLit:
-		tok, v.ident = s.convert2Connection(tok, lit)
+		tok, v.ident = s.convert2Connection(tok, lit, introducer)
+		s.recordIdent(tok, v.ident, pos)
 	}
 
 	return tok
 }
 
+// lexTriggerBody scans the body of a CREATE TRIGGER, or the compound body of an
+// event, as a triggerBody token whose ident is its text. The body is a statement, which ends with the ';' or
+// the end of the text, but it may be a compound statement, e.g. BEGIN ... END,
+// whose statements end with ';' too, so the ';' within a compound statement
+// don't end the body. A compound statement starts with BEGIN, IF, LOOP, REPEAT
+// or WHILE at the start of a statement and ends with END, or END IF and so on,
+// and a CASE, whether an expression or a statement, ends with END or END CASE.
+func (s *Scanner) lexTriggerBody(v *yySymType) int {
+	start, end := -1, -1
+	depth := 0
+	stmtStart := true
+	for {
+		// The ';' is scanned again after the body, with the `*/` before it,
+		// e.g. the one of the body of mysqldump in a `/*!50003 ... */`.
+		r, inBangComment := s.r, s.inBangComment
+		tok, pos, lit := s.scan()
+		if tok == 0 || (tok == ';' && depth == 0) {
+			s.r, s.inBangComment = r, inBangComment
+			break
+		}
+		if start < 0 {
+			start = pos.Offset
+			s.lastScanOffset = pos.Offset
+			if s.stmtTokenStart < 0 {
+				s.stmtTokenStart = pos.Offset
+			}
+		}
+		end = s.r.pos().Offset
+		atStmtStart := stmtStart
+		stmtStart = tok == ';' || tok == ':'
+		if tok != identifier {
+			continue
+		}
+		switch {
+		case equalFoldUpper(lit, "CASE"):
+			depth++
+		case equalFoldUpper(lit, "END"):
+			if depth > 0 {
+				depth--
+			}
+			r := s.r
+			if tok, _, lit := s.scan(); tok == identifier && isCompoundStmtKeyword(lit) {
+				end = s.r.pos().Offset
+			} else {
+				s.r = r
+			}
+		case isCompoundStmtKeyword(lit) || equalFoldUpper(lit, "BEGIN"):
+			if atStmtStart {
+				depth++
+			}
+			// The statements of LOOP and REPEAT follow them.
+			stmtStart = !equalFoldUpper(lit, "IF") && !equalFoldUpper(lit, "WHILE")
+		default:
+			stmtStart = equalFoldUpper(lit, "THEN") || equalFoldUpper(lit, "ELSE") || equalFoldUpper(lit, "DO")
+		}
+	}
+	if start < 0 {
+		// There is no body.
+		return invalid
+	}
+	v.offset = start
+	v.ident = s.r.s[start:end]
+	return triggerBody
+}
+
+// scanEventStmt updates the state of the scan of an event statement with tok,
+// the token at offset, and tells whether tok is the DO of the event followed by
+// a compound statement.
+func (s *Scanner) scanEventStmt(tok int, offset int) bool {
+	switch {
+	case offset == s.stmtTokenStart:
+		s.eventStmt = eventStmtNone
+		if tok == create || tok == alter {
+			s.eventStmt = eventStmtStart
+		}
+	case s.eventStmt == eventStmtNone:
+	case s.eventStmt == eventStmtEvent:
+		if tok == do {
+			s.eventStmt = eventStmtNone
+			return s.compoundStmtFollows()
+		}
+		if tok == ';' {
+			s.eventStmt = eventStmtNone
+		}
+	case tok == event:
+		s.eventStmt = eventStmtEvent
+	case tok == definer && s.eventStmt == eventStmtStart:
+		s.eventStmt = eventStmtDefiner
+	case s.eventStmt == eventStmtDefiner && (s.lastKeyword == 0 || tok == currentUser):
+		// The user of the DEFINER.
+	default:
+		s.eventStmt = eventStmtNone
+	}
+	return false
+}
+
+// compoundStmtFollows tells whether a compound statement, e.g. BEGIN ... END,
+// which may have a label, is the next one.
+func (s *Scanner) compoundStmtFollows() bool {
+	r := s.r
+	defer func() {
+		s.r = r
+	}()
+	tok, _, lit := s.scan()
+	if tok == identifier && (equalFoldUpper(lit, "BEGIN") || isCompoundStmtKeyword(lit)) {
+		return true
+	}
+	if tok != identifier && tok != quotedIdentifier {
+		return false
+	}
+	tok, _, _ = s.scan()
+	return tok == ':'
+}
+
+// isCompoundStmtKeyword tells whether lit is a keyword ending a compound
+// statement after END, e.g. the IF of END IF.
+func isCompoundStmtKeyword(lit string) bool {
+	return equalFoldUpper(lit, "IF") || equalFoldUpper(lit, "LOOP") || equalFoldUpper(lit, "REPEAT") ||
+		equalFoldUpper(lit, "WHILE") || equalFoldUpper(lit, "CASE")
+}
+
 // LexLiteral returns the value of the converted literal
 func (s *Scanner) LexLiteral() interface{} {
 	symType := &yySymType{}
@@ -362,10 +636,12 @@ func (s *Scanner) setKeepHint(val bool) {
 // InheritScanner returns a new scanner object which inherits configurations from the parent scanner.
 func (s *Scanner) InheritScanner(sql string) *Scanner {
 	return &Scanner{
-		r:                 reader{s: sql},
-		client:            s.client,
-		sqlMode:           s.sqlMode,
-		supportWindowFunc: s.supportWindowFunc,
+		r:                    reader{s: sql},
+		client:               s.client,
+		sqlMode:              s.sqlMode,
+		supportWindowFunc:    s.supportWindowFunc,
+		dialect:              s.dialect,
+		lenientIdentEncoding: s.lenientIdentEncoding,
 	}
 }
 
@@ -547,7 +823,15 @@ func startWithSlash(s *Scanner) (tok int, pos Pos, lit string) {
 			return s.scan()
 		}
 	case 'M': // '/*M' maybe MariaDB-specific comments
-		// no special treatment for now.
+		// '/*M!' is executed by MariaDB only, MySQL ignores it.
+		if s.dialect != DialectMariaDB || s.r.peek() != '!' {
+			break
+		}
+		s.r.inc()
+		s.scanVersionDigits(5, 6)
+		s.inBangComment = true
+		s.AppendWarn(&DialectWarning{Dialect: DialectMariaDB, Construct: "/*M! executable comment", Offset: pos.Offset})
+		return s.scan()
 
 	case '+': // '/*+' optimizer hints
 		// See https://dev.mysql.com/doc/refman/5.7/en/optimizer-hints.html
@@ -625,7 +909,7 @@ func startWithAt(s *Scanner) (tok int, pos Pos, lit string) {
 		s.r.inc()
 		stream := s.r.s[pos.Offset+2:]
 		var prefix string
-		for _, v := range []string{"global.", "session.", "local."} {
+		for _, v := range []string{"global.", "session.", "local.", "persist.", "persist_only."} {
 			if len(v) > len(stream) {
 				continue
 			}
@@ -860,7 +1144,9 @@ func startWithNumber(s *Scanner) (tok int, pos Pos, lit string) {
 		}
 	}
 
-	s.scanDigits()
+	if tok == intLit {
+		s.scanDigits()
+	}
 	ch0 = s.r.peek()
 	if ch0 == '.' || ch0 == 'e' || ch0 == 'E' {
 		return s.scanFloat(&pos)
@@ -949,9 +1235,21 @@ func (s *Scanner) scanFloat(beg *Pos) (tok int, pos Pos, lit string) {
 func (s *Scanner) scanDigits() string {
 	pos := s.r.pos()
 	s.r.incAsLongAs(isDigit)
+	for s.atDigitSeparator() {
+		s.r.inc()
+		s.r.incAsLongAs(isDigit)
+	}
 	return s.r.data(&pos)
 }
 
+// atDigitSeparator reports whether the reader is at an underscore between two
+// digits which digitSeparators makes part of the literal.
+func (s *Scanner) atDigitSeparator() bool {
+	offset := s.r.pos().Offset
+	return s.digitSeparators && offset > 0 && offset+1 < s.r.l &&
+		s.r.s[offset] == '_' && isDigit(s.r.s[offset-1]) && isDigit(s.r.s[offset+1])
+}
+
 // scanVersionDigits scans for `min` to `max` digits (range inclusive) used in
 // `/*!12345 ... */` comments.
 func (s *Scanner) scanVersionDigits(minv, maxv int) {
@@ -1047,13 +1345,15 @@ func (r *reader) peek() byte {
 
 // inc increase the position offset of the reader.
 // peek must be called before calling inc!
+// The byte after a '\n' is at column 0, like the first byte of the text.
 func (r *reader) inc() {
 	if r.s[r.p.Offset] == '\n' {
 		r.p.Line++
 		r.p.Col = 0
+	} else {
+		r.p.Col++
 	}
 	r.p.Offset++
-	r.p.Col++
 }
 
 func (r *reader) incN(n int) {
@@ -53,7 +53,7 @@ type testLiteralValue struct {
 
 func TestSingleCharOther(t *testing.T) {
 	table := []testCaseItem{
-		{"AT", identifier},
+		{"AT", at},
 		{"?", paramMarker},
 		{"PLACEHOLDER", identifier},
 		{"=", eq},
@@ -78,10 +78,14 @@ func TestAtLeadingIdentifier(t *testing.T) {
 		{"@@global.test", doubleAtIdentifier},
 		{"@@session.test", doubleAtIdentifier},
 		{"@@local.test", doubleAtIdentifier},
+		{"@@persist.test", doubleAtIdentifier},
+		{"@@persist_only.test", doubleAtIdentifier},
 		{"@@test", doubleAtIdentifier},
 		{"@@global.`test`", doubleAtIdentifier},
 		{"@@session.`test`", doubleAtIdentifier},
 		{"@@local.`test`", doubleAtIdentifier},
+		{"@@persist.`test`", doubleAtIdentifier},
+		{"@@persist_only.`test`", doubleAtIdentifier},
 		{"@@`test`", doubleAtIdentifier},
 	}
 	runTest(t, table)
@@ -366,7 +370,8 @@ func TestSpecialComment(t *testing.T) {
 	tok, pos, lit = l.scan()
 	requires.Equal(t, intLit, tok)
 	requires.Equal(t, "5", lit)
-	requires.Equal(t, Pos{2, 1, 16}, pos)
+	// The columns are from 0 on every line.
+	requires.Equal(t, Pos{2, 0, 16}, pos)
 }
 
 func TestFeatureIDsComment(t *testing.T) {
@@ -521,6 +526,45 @@ func TestInt(t *testing.T) {
 	}
 }
 
+func TestDigitSeparators(t *testing.T) {
+	tests := []struct {
+		input string
+		tok   int
+		val   interface{}
+	}{
+		{"1_000_000", intLit, int64(1000000)},
+		{"0_1", intLit, int64(1)},
+		{"1_000.000_5", decLit, "1000.0005"},
+		{".5_5", decLit, "0.55"},
+		{"1_0e1_0", floatLit, float64(1e11)},
+		{"1_000abc", identifier, "1_000abc"},
+		{"1__0", identifier, "1__0"},
+		{"1_", identifier, "1_"},
+		{"0x1_0", identifier, "0x1_0"},
+		{"0b1_0", identifier, "0b1_0"},
+	}
+	for _, tt := range tests {
+		l := NewScanner(tt.input)
+		l.digitSeparators = true
+		var v yySymType
+		requires.Equal(t, tt.tok, l.Lex(&v), tt.input)
+		switch tt.tok {
+		case identifier:
+			requires.Equal(t, tt.val, v.ident, tt.input)
+		case decLit:
+			requires.Equal(t, tt.val, fmt.Sprint(v.item), tt.input)
+		default:
+			requires.Equal(t, tt.val, v.item, tt.input)
+		}
+	}
+
+	// Without digitSeparators, 1_000 is an identifier as in MySQL.
+	l := NewScanner("1_000")
+	var v yySymType
+	requires.Equal(t, identifier, l.Lex(&v))
+	requires.Equal(t, "1_000", v.ident)
+}
+
 func TestSQLModeANSIQuotes(t *testing.T) {
 	tests := []struct {
 		input string
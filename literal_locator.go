@@ -0,0 +1,156 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+)
+
+// maxLiteralLookahead is the number of tokens scanned for a literal from its
+// offset, which is the one of the rule reducing it, e.g. the ELSE of ELSE 1
+// or the ( of DEFAULT (1).
+const maxLiteralLookahead = 3
+
+// locateLiterals locates the literals of stmt found by ast.ExtractLiterals in
+// its text, see ast.LocateLiterals. The text is scanned in the default SQL
+// mode, and the literals are located from the offsets of the parse, so none is
+// located when stmt comes from a parse without the positions, see
+// ParserConfig.SkipPositionRecording.
+func locateLiterals(stmt ast.StmtNode, literals []ast.LiteralInfo) int {
+	text := stmt.OriginalText()
+	if text == "" {
+		return 0
+	}
+	l := &literalLocator{s: NewScanner(text), text: text}
+	// The text starts with the comments before the first token, which is at
+	// the offset of stmt.
+	_, first, _ := l.s.scan()
+	start := stmt.OriginTextPosition()
+	base := start - first.Offset
+	located := make(map[int]struct{}, len(literals))
+	for i := range literals {
+		lit := &literals[i]
+		if lit.Offset <= start {
+			continue
+		}
+		var temporal bool
+		switch lit.Type.GetType() {
+		case mysql.TypeDate, mysql.TypeDuration, mysql.TypeDatetime:
+			temporal = true
+		}
+		begin, end := l.locate(lit.Offset-base, temporal)
+		if begin < 0 {
+			continue
+		}
+		// A literal made up by the grammar may be located at a literal of the
+		// text.
+		if _, ok := located[begin]; ok {
+			continue
+		}
+		located[begin] = struct{}{}
+		lit.Offset, lit.End, lit.Text = base+begin, base+end, text[begin:end]
+	}
+	return base
+}
+
+// literalLocator scans the text of a statement for its literals.
+type literalLocator struct {
+	s    *Scanner
+	text string
+}
+
+// locate returns the start and end of the literal at offset of the text, or
+// at one of the next tokens, -1 if there is none.
+func (l *literalLocator) locate(offset int, temporal bool) (start, end int) {
+	if offset >= len(l.text) {
+		return -1, -1
+	}
+	l.s.r.updatePos(Pos{Offset: offset})
+	for range maxLiteralLookahead {
+		tok, pos, lit := l.s.scan()
+		if tok == 0 {
+			break
+		}
+		if end := l.literalEnd(tok, lit, temporal); end >= 0 {
+			return pos.Offset, end
+		}
+		l.s.r.updatePos(pos)
+		l.s.scan()
+	}
+	return -1, -1
+}
+
+// literalEnd returns the end of the literal starting with the token just
+// scanned, -1 if it doesn't start a literal.
+func (l *literalLocator) literalEnd(tok int, lit string, temporal bool) int {
+	s := l.s
+	if temporal {
+		switch {
+		case tok == '{':
+			// The ODBC syntax, e.g. {d '2025-01-01'}.
+			if tok, _, _ := s.scan(); tok != identifier {
+				return -1
+			}
+			if tok, _, _ := s.scan(); tok != stringLit {
+				return -1
+			}
+			if tok, _, _ := s.scan(); tok != '}' {
+				return -1
+			}
+			return s.r.pos().Offset
+		case tok == identifier:
+			switch strings.ToUpper(lit) {
+			case "DATE", "TIME", "TIMESTAMP":
+				if tok, _, _ := s.scan(); tok == stringLit {
+					return s.r.pos().Offset
+				}
+			}
+		}
+		return -1
+	}
+	switch tok {
+	case stringLit:
+		// The adjacent strings are concatenated, 'a' 'b' is 'ab'.
+		end := s.r.pos().Offset
+		for {
+			if tok, _, _ := s.scan(); tok != stringLit {
+				return end
+			}
+			end = s.r.pos().Offset
+		}
+	case intLit, decLit, floatLit, hexLit, bitLit, null:
+		return s.r.pos().Offset
+	case underscoreCS:
+		// N'a'
+		if tok, _, _ := s.scan(); tok == stringLit {
+			return s.r.pos().Offset
+		}
+	case identifier:
+		switch strings.ToUpper(lit) {
+		case "TRUE", "FALSE", "NULL":
+			return s.r.pos().Offset
+		}
+		// A charset introducer, e.g. _utf8mb4'a'.
+		if strings.HasPrefix(lit, "_") {
+			switch tok, _, _ := s.scan(); tok {
+			case stringLit, hexLit, bitLit:
+				return s.r.pos().Offset
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/parser_driver"
+)
+
+// DefaultMaxValidationErrors is the default number of ValidationError collected
+// for a statement.
+const DefaultMaxValidationErrors = 20
+
+// ValidationError is reported for a string literal which is invalid in the client
+// charset under strict SQL mode, or in its own charset, see
+// Parser.SetStrictCharsetValidation. It locates the invalid byte sequence, so
+// that it can be found among the many literals of a statement.
+type ValidationError struct {
+	// StmtOffset is the offset of the statement in the SQL text.
+	StmtOffset int
+	// LiteralIndex is the index of the literal among the string literals of the
+	// statement, starting from 0.
+	LiteralIndex int
+	// ByteOffset is the offset of the invalid sequence in the literal, the quotes
+	// and escapes excluded.
+	ByteOffset int
+	// Charset is the charset the literal is expected to be in.
+	Charset string
+	// Hex is the invalid sequence in MySQL style, e.g. `\xF0\x9F`.
+	Hex string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("Invalid %s character string: '%s' at byte %d of string literal %d of the statement at offset %d",
+		e.Charset, e.Hex, e.ByteOffset, e.LiteralIndex, e.StmtOffset)
+}
+
+// Unwrap returns the MySQL error of the invalid literal.
+func (e *ValidationError) Unwrap() error {
+	return charset.ErrInvalidCharacterString.FastGenByArgs(e.Charset, strings.ReplaceAll(e.Hex, `\x`, ""))
+}
+
+// literalCheck tracks the string literals of the current statement for their
+// validation.
+type literalCheck struct {
+	stmtOffset int
+	stmtEnded  bool
+	index      int
+	errCount   int
+	maxErrs    int
+
+	// introducer is the charset of the last token if it's a charset introducer,
+	// e.g. the ascii of _ascii'...'.
+	introducer string
+	// names is the charset set by the last SET NAMES or SET CHARACTER SET.
+	names string
+	// strictCharset, if true, reports the literals invalid in their charset as
+	// errors instead of warnings.
+	strictCharset bool
+}
+
+func (c *literalCheck) reset() {
+	c.stmtOffset = 0
+	// The first token starts the first statement.
+	c.stmtEnded = true
+	c.index = 0
+	c.errCount = 0
+	c.introducer = ""
+	c.names = ""
+}
+
+// onToken is called for every token, a ';' starts a new statement.
+func (c *literalCheck) onToken(tok int, offset int) {
+	switch {
+	case tok == ';':
+		c.stmtEnded = true
+	case c.stmtEnded:
+		c.stmtOffset = offset
+		c.stmtEnded = false
+		c.index = 0
+		c.errCount = 0
+	}
+}
+
+// nextLiteral returns the index of the next string literal in the statement.
+func (c *literalCheck) nextLiteral() int {
+	c.index++
+	return c.index - 1
+}
+
+// appendValidationError appends a ValidationError for the invalid literal lit,
+// at most maxErrs for a statement. The other errors of the statement are dropped.
+func (s *Scanner) appendValidationError(lit string, index int, err error) {
+	c := &s.litCheck
+	if !c.countError() {
+		return
+	}
+	offset := 0
+	s.client.Foreach(charset.HackSlice(lit), charset.OpDecode, func(from, _ []byte, ok bool) bool {
+		if ok {
+			offset += len(from)
+			return true
+		}
+		err = &ValidationError{
+			StmtOffset:   c.stmtOffset,
+			LiteralIndex: index,
+			ByteOffset:   offset,
+			Charset:      s.client.Name(),
+			Hex:          hexEscape(from),
+		}
+		return false
+	})
+	s.AppendError(err)
+}
+
+// countError counts an error of the statement, and returns false if maxErrs
+// errors are already collected.
+func (c *literalCheck) countError() bool {
+	maxErrs := c.maxErrs
+	if maxErrs <= 0 {
+		maxErrs = DefaultMaxValidationErrors
+	}
+	if c.errCount >= maxErrs {
+		return false
+	}
+	c.errCount++
+	return true
+}
+
+// hexEscape returns b in MySQL style, e.g. `\xF0\x9F`.
+func hexEscape(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		fmt.Fprintf(&sb, `\x%02X`, c)
+	}
+	return sb.String()
+}
+
+// checkLiteralCharset checks the string literal lit, the index-th one of the
+// statement, in the charset of its introducer, or else of the last SET NAMES,
+// or else of the client if it's utf8: the literals of the other clients are
+// checked by convert2Connection.
+func (s *Scanner) checkLiteralCharset(lit string, index int, introducer string) {
+	c := &s.litCheck
+	cs := introducer
+	if cs == "" {
+		cs = c.names
+	}
+	if cs == "" {
+		if !mysql.IsUTF8Charset(s.client.Name()) {
+			return
+		}
+		cs = s.client.Name()
+	}
+	enc := parser_driver.FindEncoding(cs)
+	if enc.IsValid(charset.HackSlice(lit)) || !c.countError() {
+		return
+	}
+	_, err := enc.Transform(nil, charset.HackSlice(lit), parser_driver.OpDecode)
+	invalid, ok := err.(*parser_driver.InvalidEncodingError)
+	if !ok {
+		return
+	}
+	verr := &ValidationError{
+		StmtOffset:   c.stmtOffset,
+		LiteralIndex: index,
+		ByteOffset:   invalid.Offset,
+		Charset:      invalid.Charset,
+		Hex:          hexEscape(invalid.Bytes),
+	}
+	if c.strictCharset {
+		s.AppendError(verr)
+	} else {
+		s.AppendWarn(verr)
+	}
+}
+
+// setNames records the charset of the SET NAMES or SET CHARACTER SET among vars,
+// in which the string literals of the next statements are checked.
+func (parser *Parser) setNames(vars []*ast.VariableAssignment) {
+	for _, v := range vars {
+		if v.Name != ast.SetNames && v.Name != ast.SetCharset {
+			continue
+		}
+		parser.lexer.litCheck.names = ""
+		if value, ok := v.Value.(ast.ValueExpr); ok {
+			parser.lexer.litCheck.names = strings.ToLower(value.GetString())
+		}
+	}
+}
+
+// SetStrictCharsetValidation sets whether a string literal which is invalid in
+// its charset is an error, or a warning by default. The charset of a literal is
+// the one of its introducer, e.g. the ascii of _ascii'...', or else the one set by
+// a previous SET NAMES or SET CHARACTER SET of the SQL text, or else the client
+// charset. The literals are reported as ValidationError, and they are checked
+// as gbk, gb18030, ascii, latin1, utf8 and utf8mb4, see
+// parser_driver.FindEncoding.
+func (parser *Parser) SetStrictCharsetValidation(val bool) {
+	parser.lexer.litCheck.strictCharset = val
+}
+
+// SetMaxValidationErrors sets the number of ValidationError collected for a
+// statement, the default is DefaultMaxValidationErrors.
+func (parser *Parser) SetMaxValidationErrors(n int) {
+	parser.lexer.litCheck.maxErrs = n
+}
+
+// ValidationErrors returns the ValidationError found by the last parse.
+func (parser *Parser) ValidationErrors() []*ValidationError {
+	var errs []*ValidationError
+	for _, err := range parser.lexer.errs {
+		if e, ok := err.(*ValidationError); ok {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationErrors(t *testing.T) {
+	p := parser.New()
+	p.SetSQLMode(mysql.ModeStrictTransTables)
+	gbk := []parser.ParseParam{parser.CharsetClient("gbk"), parser.CharsetConnection("gbk")}
+
+	sql := "select 'x\x80';\n insert into t values ('ok', '\x80', 1), ('a\x80', 'b'), ('abc\x80\x80')"
+	insertOffset := strings.Index(sql, "insert")
+	_, _, err := p.ParseSQL(sql, gbk...)
+	require.Error(t, err)
+	first, ok := errors.Cause(err).(*parser.ValidationError)
+	require.True(t, ok)
+	require.Equal(t, 0, first.StmtOffset)
+	require.Equal(t, 0, first.LiteralIndex)
+	require.Equal(t, 1, first.ByteOffset)
+	require.True(t, terror.ErrorEqual(charset.ErrInvalidCharacterString, first.Unwrap()))
+
+	errs := p.ValidationErrors()
+	require.Equal(t, []*parser.ValidationError{
+		{StmtOffset: 0, LiteralIndex: 0, ByteOffset: 1, Charset: "gbk", Hex: `\x80`},
+		{StmtOffset: insertOffset, LiteralIndex: 1, ByteOffset: 0, Charset: "gbk", Hex: `\x80`},
+		{StmtOffset: insertOffset, LiteralIndex: 2, ByteOffset: 1, Charset: "gbk", Hex: `\x80`},
+		{StmtOffset: insertOffset, LiteralIndex: 4, ByteOffset: 3, Charset: "gbk", Hex: `\x80\x80`},
+	}, errs)
+	require.Equal(t, `Invalid gbk character string: '\x80\x80' at byte 3 of string literal 4 of the statement at offset 14`, errs[3].Error())
+
+	// The number of errors of a statement is limited.
+	p.SetMaxValidationErrors(2)
+	_, _, err = p.ParseSQL(sql, gbk...)
+	require.Error(t, err)
+	errs = p.ValidationErrors()
+	require.Len(t, errs, 3)
+	require.Equal(t, 0, errs[0].StmtOffset)
+	require.Equal(t, 1, errs[1].LiteralIndex)
+	require.Equal(t, 2, errs[2].LiteralIndex)
+	p.SetMaxValidationErrors(parser.DefaultMaxValidationErrors)
+
+	// The invalid literals are warnings without strict mode.
+	p.SetSQLMode(mysql.ModeNone)
+	_, warns, err := p.ParseSQL(sql, gbk...)
+	require.NoError(t, err)
+	require.Len(t, warns, 4)
+	require.Empty(t, p.ValidationErrors())
+
+	// Valid literals.
+	p.SetSQLMode(mysql.ModeStrictTransTables)
+	_, _, err = p.ParseSQL("insert into t values ('\xc6\x5c', 'a')", gbk...)
+	require.NoError(t, err)
+	require.Empty(t, p.ValidationErrors())
+}
+
+func TestStrictCharsetValidation(t *testing.T) {
+	p := parser.New()
+
+	// The literals are checked in the charset of their introducer or of the SET
+	// NAMES before them: 曉 is E6 9B 89 in utf8, which ends with a truncated gbk
+	// character.
+	for _, sql := range []string{"set names gbk; select '曉'", "set names gbk; select '\x81'", "select _ascii'a\x80'", "select _utf8mb4'\xff'", "select N'\xc0'", "select 'a\xe6\x9b'"} {
+		_, warns, err := p.ParseSQL(sql)
+		require.NoError(t, err, sql)
+		require.Len(t, warns, 1, sql)
+		require.IsType(t, &parser.ValidationError{}, warns[0], sql)
+	}
+	p.SetStrictCharsetValidation(true)
+	_, _, err := p.ParseSQL("set names gbk; select 'ok', '曉'")
+	require.Error(t, err)
+	require.Equal(t, []*parser.ValidationError{
+		{StmtOffset: 15, LiteralIndex: 1, ByteOffset: 2, Charset: "gbk", Hex: `\x89`},
+	}, p.ValidationErrors())
+	_, _, err = p.ParseSQL("select 1; select _ascii'a\x80b'")
+	require.Error(t, err)
+	require.Equal(t, []*parser.ValidationError{
+		{StmtOffset: 10, LiteralIndex: 0, ByteOffset: 1, Charset: "ascii", Hex: `\x80`},
+	}, p.ValidationErrors())
+
+	// Valid literals: 测 is B2 E2 in gbk, and 8139EF30 is ㄊ in gb18030.
+	for _, sql := range []string{"set names gbk; select '\xb2\xe2a'", "set names gb18030; select '\x81\x39\xef\x30'", "select _latin1'\xff'", "select _binary'\xff'", "select _ascii'abc', '曉'"} {
+		_, warns, err := p.ParseSQL(sql)
+		require.NoError(t, err, sql)
+		require.Empty(t, warns, sql)
+	}
+
+	// The literals after SET NAMES are checked in its charset.
+	sql := "select '\xb2\xe2'; SET NAMES gbk; select '\xb2\xe2', 'a\x81'; SET NAMES DEFAULT; select '\xb2'"
+	_, _, err = p.ParseSQL(sql)
+	require.Error(t, err)
+	require.Equal(t, []*parser.ValidationError{
+		{StmtOffset: 0, LiteralIndex: 0, ByteOffset: 0, Charset: "utf8mb4", Hex: `\xB2\xE2`},
+		{StmtOffset: strings.Index(sql, "select '\xb2\xe2', "), LiteralIndex: 1, ByteOffset: 1, Charset: "gbk", Hex: `\x81`},
+		{StmtOffset: strings.LastIndex(sql, "select"), LiteralIndex: 0, ByteOffset: 0, Charset: "utf8mb4", Hex: `\xB2`},
+	}, p.ValidationErrors())
+
+	// The flag is cleared by Reset.
+	p.Reset()
+	_, warns, err := p.ParseSQL("set names gbk; select '\x81'")
+	require.NoError(t, err)
+	require.Len(t, warns, 1)
+}
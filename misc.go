@@ -13,6 +13,10 @@
 
 package parser
 
+import (
+	myformat "github.com/abbychau/mysql-parser/format"
+)
+
 func isLetter(ch byte) bool {
 	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
 }
@@ -40,6 +44,10 @@ func isInCorrectIdentifierName(name string) bool {
 	return false
 }
 
+func init() {
+	myformat.IsReservedKeyword = IsReservedKeyword
+}
+
 // Initialize a lookup table for isUserVarChar
 var isUserVarCharTable [256]bool
 
@@ -155,6 +163,9 @@ func isInTokenMap(target string) bool {
 
 // tokenMap is a map of known identifiers to the parser token ID.
 // Please try to keep the map in alphabetical order.
+// The lexer matches the identifiers with the matchers of token_match.go, which are
+// generated from tokenMap, btFuncTokenMap and windowFuncTokenMap, please run
+// `make generate` after changing them.
 var tokenMap = map[string]int{
 	"ACCOUNT":                        account,
 	"ACTION":                         action,
@@ -179,6 +190,7 @@ var tokenMap = map[string]int{
 	"AS":                             as,
 	"ASC":                            asc,
 	"ASCII":                          ascii,
+	"AT":                             at,
 	"APPLY":                          apply,
 	"ATTRIBUTE":                      attribute,
 	"ATTRIBUTES":                     attributes,
@@ -198,6 +210,7 @@ var tokenMap = map[string]int{
 	"BACKUP":                         backup,
 	"BACKUPS":                        backups,
 	"BDR":                            bdr,
+	"BEFORE":                         before,
 	"BEGIN":                          begin,
 	"BETWEEN":                        between,
 	"BERNOULLI":                      bernoulli,
@@ -264,6 +277,7 @@ var tokenMap = map[string]int{
 	"COMMIT":                         commit,
 	"COMMITTED":                      committed,
 	"COMPACT":                        compact,
+	"COMPLETION":                     completion,
 	"COMPRESS":                       compress,
 	"COMPRESSED":                     compressed,
 	"COMPRESSION":                    compression,
@@ -356,9 +370,11 @@ var tokenMap = map[string]int{
 	"DRYRUN":                         dryRun,
 	"DUAL":                           dual,
 	"DUMP":                           dump,
+	"DUMPFILE":                       dumpfile,
 	"DUPLICATE":                      duplicate,
 	"DURATION":                       timeDuration,
 	"DYNAMIC":                        dynamic,
+	"EACH":                           each,
 	"ELSE":                           elseKwd,
 	"ELSEIF":                         elseIfKwd,
 	"ENABLE":                         enable,
@@ -366,6 +382,7 @@ var tokenMap = map[string]int{
 	"ENCLOSED":                       enclosed,
 	"ENCRYPTION":                     encryption,
 	"END":                            end,
+	"ENDS":                           ends,
 	"END_TIME":                       endTime,
 	"ENFORCED":                       enforced,
 	"ENGINE":                         engine,
@@ -379,6 +396,7 @@ var tokenMap = map[string]int{
 	"ESCAPED":                        escaped,
 	"EVENT":                          event,
 	"EVENTS":                         events,
+	"EVERY":                          every,
 	"EVOLVE":                         evolve,
 	"EXACT":                          exact,
 	"EXEC_ELAPSED":                   execElapsed,
@@ -411,6 +429,7 @@ var tokenMap = map[string]int{
 	"FOLLOWERS":                      followers,
 	"FOLLOWER_CONSTRAINTS":           followerConstraints,
 	"FOLLOWING":                      following,
+	"FOLLOWS":                        follows,
 	"FOR":                            forKwd,
 	"FORCE":                          force,
 	"FOREIGN":                        foreign,
@@ -424,6 +443,8 @@ var tokenMap = map[string]int{
 	"GC_TTL":                         gcTTL,
 	"GENERAL":                        general,
 	"GENERATED":                      generated,
+	"GEOMETRY":                       geometryType,
+	"GEOMETRYCOLLECTION":             geometryCollectionType,
 	"GET_FORMAT":                     getFormat,
 	"GLOBAL":                         global,
 	"GRANT":                          grant,
@@ -512,6 +533,7 @@ var tokenMap = map[string]int{
 	"LEARNER_CONSTRAINTS":            learnerConstraints,
 	"LEARNERS":                       learners,
 	"LEAVE":                          leave,
+	"LEAVES":                         leaves,
 	"LEFT":                           left,
 	"LESS":                           less,
 	"LEVEL":                          level,
@@ -519,6 +541,7 @@ var tokenMap = map[string]int{
 	"LIMIT":                          limit,
 	"LINEAR":                         linear,
 	"LINES":                          lines,
+	"LINESTRING":                     lineStringType,
 	"LIST":                           list,
 	"LOAD":                           load,
 	"LOCAL":                          local,
@@ -564,6 +587,9 @@ var tokenMap = map[string]int{
 	"MODE":                           mode,
 	"MODIFY":                         modify,
 	"MONTH":                          month,
+	"MULTILINESTRING":                multiLineStringType,
+	"MULTIPOINT":                     multiPointType,
+	"MULTIPOLYGON":                   multiPolygonType,
 	"NAMES":                          names,
 	"NATIONAL":                       national,
 	"NATURAL":                        natural,
@@ -623,6 +649,8 @@ var tokenMap = map[string]int{
 	"PASSWORD":                       password,
 	"PAUSE":                          pause,
 	"PERCENT":                        percent,
+	"PERSIST":                        persist,
+	"PERSIST_ONLY":                   persistOnly,
 	"PER_DB":                         per_db,
 	"PER_TABLE":                      per_table,
 	"PESSIMISTIC":                    pessimistic,
@@ -632,8 +660,10 @@ var tokenMap = map[string]int{
 	"PLUGINS":                        plugins,
 	"POINT":                          point,
 	"POLICY":                         policy,
+	"POLYGON":                        polygonType,
 	"POSITION":                       position,
 	"PRE_SPLIT_REGIONS":              preSplitRegions,
+	"PRECEDES":                       precedes,
 	"PRECEDING":                      preceding,
 	"PREDICATE":                      predicate,
 	"PRECISION":                      precisionType,
@@ -704,6 +734,7 @@ var tokenMap = map[string]int{
 	"ROLE":                           role,
 	"ROLLBACK":                       rollback,
 	"ROLLUP":                         rollup,
+	"ROTATE":                         rotate,
 	"ROUTINE":                        routine,
 	"ROW_COUNT":                      rowCount,
 	"ROW_FORMAT":                     rowFormat,
@@ -776,9 +807,11 @@ var tokenMap = map[string]int{
 	"SQLEXCEPTION":                   sqlexception,
 	"SQLSTATE":                       sqlstate,
 	"SQLWARNING":                     sqlwarning,
+	"SRID":                           srid,
 	"SSL":                            ssl,
 	"STALENESS":                      staleness,
 	"START":                          start,
+	"STARTS":                         starts,
 	"START_TIME":                     startTime,
 	"START_TS":                       startTS,
 	"STARTING":                       starting,
@@ -867,6 +900,7 @@ var tokenMap = map[string]int{
 	"TRAFFIC":                        traffic,
 	"TRAILING":                       trailing,
 	"TRANSACTION":                    transaction,
+	"TREE":                           tree,
 	"TRIGGER":                        trigger,
 	"TRIGGERS":                       triggers,
 	"TRIM":                           trim,
@@ -997,6 +1031,12 @@ var windowFuncTokenMap = map[string]int{
 	"WINDOW":       window,
 }
 
+// mariaDBTokenMap are the keywords of the MariaDB syntax, recognized with
+// DialectMariaDB only, so that they remain identifiers in MySQL.
+var mariaDBTokenMap = map[string]int{
+	"RETURNING": returning,
+}
+
 // aliases are strings directly map to another string and use the same token.
 var aliases = map[string]string{
 	"SCHEMA":  "DATABASE",
@@ -1131,34 +1171,43 @@ func (s *Scanner) isTokenIdentifier(lit string, offset int) int {
 		break
 	}
 
-	buf := &s.buf
-	buf.Reset()
-	buf.Grow(len(lit))
-	data := buf.Bytes()[:len(lit)]
-
-	for i := range len(lit) {
-		c := lit[i]
-		if c >= 'a' && c <= 'z' {
-			data[i] = c + 'A' - 'a'
-		} else {
-			data[i] = c
-		}
-	}
-
 	checkBtFuncToken := s.r.peek() == '('
 	if !checkBtFuncToken && s.sqlMode.HasIgnoreSpaceMode() {
 		s.skipWhitespace()
 		checkBtFuncToken = s.r.peek() == '('
 	}
 
+	// The maps are matched by the matchers generated from them, which needn't
+	// upper case lit.
 	if checkBtFuncToken {
-		if tok := btFuncTokenMap[string(data)]; tok != 0 {
+		if tok, _ := matchBtFuncToken(lit); tok != 0 {
 			return tok
 		}
 	}
-	tok, ok := tokenMap[string(data)]
+	tok, ok := matchToken(lit)
 	if !ok && s.supportWindowFunc {
-		tok = windowFuncTokenMap[string(data)]
+		tok, ok = matchWindowFuncToken(lit)
+	}
+	if !ok && s.dialect == DialectMariaDB {
+		tok, _ = matchMariaDBToken(lit)
 	}
 	return tok
 }
+
+// equalFoldUpper reports whether lit equals upper, a word without lower case ASCII
+// letters, once the ASCII letters of lit are upper cased.
+func equalFoldUpper(lit, upper string) bool {
+	if len(lit) != len(upper) {
+		return false
+	}
+	for i := range len(lit) {
+		c := lit[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c != upper[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -974,6 +974,9 @@ const (
 	ErrWarnOptimizerHintParseError      = 8064
 	ErrWarnOptimizerHintInvalidInteger  = 8065
 	ErrWarnOptimizerHintWrongPos        = 8066
+	ErrNotConstantExpr                  = 8067
+	ErrEmptyIdent                       = 8068
+	ErrZeroDateLiteral                  = 8069
 
 	// Stop adding error code here!
 	// They are moved to github.com/pingcap/tidb/pkg/errno
@@ -0,0 +1,150 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/abbychau/mysql-parser/ast"
+)
+
+// ParamContext is the syntactic context of a parameter marker, see
+// ParamMarkerPositions.
+type ParamContext byte
+
+// The contexts of a parameter marker.
+const (
+	// ParamContextValue is a marker standing for a value, e.g. in a WHERE clause,
+	// a field list or the VALUES of an INSERT.
+	ParamContextValue ParamContext = iota
+	// ParamContextInList is a marker of the list of an IN, e.g. a IN (?, ?).
+	ParamContextInList
+	// ParamContextLimit is the row count of a LIMIT, which must be an integer.
+	ParamContextLimit
+	// ParamContextOffset is the offset of a LIMIT, which must be an integer.
+	ParamContextOffset
+	// ParamContextForbidden is a marker the server doesn't prepare, e.g. in a
+	// DDL statement, a column option or a SHOW statement.
+	ParamContextForbidden
+)
+
+// String implements the fmt.Stringer interface.
+func (c ParamContext) String() string {
+	switch c {
+	case ParamContextValue:
+		return "value"
+	case ParamContextInList:
+		return "IN list"
+	case ParamContextLimit:
+		return "LIMIT"
+	case ParamContextOffset:
+		return "OFFSET"
+	case ParamContextForbidden:
+		return "forbidden"
+	}
+	return "unknown"
+}
+
+// ParamPos is a parameter marker of a statement, see ParamMarkerPositions.
+type ParamPos struct {
+	// Offset is the offset of the ? in the SQL text parsed, in bytes.
+	Offset int
+	// Ordinal is the number of the marker, from 0, which is its order by
+	// Offset: the index of the value bound to it by EXECUTE ... USING, and the
+	// order the server sets with ast.ParamMarkerExpr.SetOrder.
+	Ordinal int
+	// Context is the syntactic context of the marker.
+	Context ParamContext
+	// Expr is the marker.
+	Expr ast.ParamMarkerExpr
+}
+
+// CountParamMarkers returns the number of parameter markers of stmt, wherever
+// they are, e.g. in a subquery.
+func CountParamMarkers(stmt ast.StmtNode) int {
+	c := &paramMarkerCollector{countOnly: true}
+	stmt.Accept(c)
+	return c.count
+}
+
+// ParamMarkerPositions returns the parameter markers of stmt in the order of
+// their Ordinal, with their syntactic context, so that a driver preparing stmt
+// can check them, e.g. bind an integer to the markers of a LIMIT.
+func ParamMarkerPositions(stmt ast.StmtNode) []ParamPos {
+	c := &paramMarkerCollector{}
+	stmt.Accept(c)
+	slices.SortStableFunc(c.markers, func(a, b ParamPos) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+	for i := range c.markers {
+		c.markers[i].Ordinal = i
+	}
+	return c.markers
+}
+
+type paramMarkerCollector struct {
+	// countOnly, if true, only counts the markers, without their positions.
+	countOnly bool
+	count     int
+	// path is the ancestors of the node being visited.
+	path    []ast.Node
+	markers []ParamPos
+}
+
+// Enter implements ast.Visitor interface.
+func (c *paramMarkerCollector) Enter(in ast.Node) (ast.Node, bool) {
+	if marker, ok := in.(ast.ParamMarkerExpr); ok {
+		c.count++
+		if !c.countOnly {
+			c.markers = append(c.markers, ParamPos{Offset: marker.OriginTextPosition(), Context: c.context(in), Expr: marker})
+		}
+		return in, true
+	}
+	if !c.countOnly {
+		c.path = append(c.path, in)
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (c *paramMarkerCollector) Leave(in ast.Node) (ast.Node, bool) {
+	if _, ok := in.(ast.ParamMarkerExpr); !ok && !c.countOnly {
+		c.path = c.path[:len(c.path)-1]
+	}
+	return in, true
+}
+
+// context returns the context of the marker, decided by its nearest ancestor
+// having one.
+func (c *paramMarkerCollector) context(marker ast.Node) ParamContext {
+	child := marker
+	for i := len(c.path) - 1; i >= 0; i-- {
+		switch x := c.path[i].(type) {
+		case *ast.Limit:
+			if child == ast.Node(x.Offset) {
+				return ParamContextOffset
+			}
+			return ParamContextLimit
+		case *ast.PatternInExpr:
+			if slices.ContainsFunc(x.List, func(e ast.ExprNode) bool { return ast.Node(e) == child }) {
+				return ParamContextInList
+			}
+		case *ast.ColumnOption, ast.DDLNode, *ast.ShowStmt, *ast.LoadDataStmt, *ast.ImportIntoStmt:
+			return ParamContextForbidden
+		}
+		child = c.path[i]
+	}
+	return ParamContextValue
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamMarkerPositions(t *testing.T) {
+	p := parser.New()
+	const (
+		value     = parser.ParamContextValue
+		inList    = parser.ParamContextInList
+		limit     = parser.ParamContextLimit
+		offset    = parser.ParamContextOffset
+		forbidden = parser.ParamContextForbidden
+	)
+	for _, c := range []struct {
+		sql      string
+		contexts []parser.ParamContext
+	}{
+		{"SELECT * FROM t WHERE a = ? AND b IN (?, ?, ?) LIMIT ? OFFSET ?", []parser.ParamContext{value, inList, inList, inList, limit, offset}},
+		{"SELECT * FROM t LIMIT ?, ?", []parser.ParamContext{offset, limit}},
+		{"INSERT INTO t (a, b) VALUES (?, ?), (?, 1 + ?)", []parser.ParamContext{value, value, value, value}},
+		{"UPDATE t SET a = ? WHERE b IN (?) ORDER BY c LIMIT ?", []parser.ParamContext{value, inList, limit}},
+		{"SELECT ?, (SELECT c FROM t LIMIT ?) FROM t WHERE a IN (? + 1, b) ORDER BY ?", []parser.ParamContext{value, limit, inList, value}},
+		{"DELETE FROM t WHERE ? IN (a, ?)", []parser.ParamContext{value, inList}},
+		{"CREATE VIEW v AS SELECT * FROM t WHERE a = ?", []parser.ParamContext{forbidden}},
+		{"SELECT 1", nil},
+	} {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Equal(t, len(c.contexts), parser.CountParamMarkers(stmt), c.sql)
+		positions := parser.ParamMarkerPositions(stmt)
+		require.Len(t, positions, len(c.contexts), c.sql)
+		offsets := markerOffsets(c.sql, 0)
+		for i, pos := range positions {
+			require.Equal(t, i, pos.Ordinal, c.sql)
+			require.Equal(t, offsets[i], pos.Offset, c.sql)
+			require.Equal(t, c.contexts[i], pos.Context, "%s: %d", c.sql, i)
+			require.Equal(t, pos.Offset, pos.Expr.OriginTextPosition(), c.sql)
+		}
+	}
+
+	// The offsets are in the whole SQL text, the ordinals in each statement.
+	sql := "SELECT ?;\nSELECT * FROM t WHERE a = ? LIMIT ?"
+	stmts, _, err := p.Parse(sql, "", "")
+	require.NoError(t, err)
+	positions := parser.ParamMarkerPositions(stmts[1])
+	require.Len(t, positions, 2)
+	require.Equal(t, parser.ParamPos{Offset: markerOffsets(sql, 1)[0], Ordinal: 0, Context: value, Expr: positions[0].Expr}, positions[0])
+	require.Equal(t, parser.ParamPos{Offset: markerOffsets(sql, 1)[1], Ordinal: 1, Context: limit, Expr: positions[1].Expr}, positions[1])
+
+	require.Equal(t, "value", value.String())
+	require.Equal(t, "IN list", inList.String())
+	require.Equal(t, "OFFSET", offset.String())
+}
+
+// markerOffsets returns the offsets of the ? of sql from its statement of
+// index stmt, which have no ? in strings or comments.
+func markerOffsets(sql string, stmt int) []int {
+	start := 0
+	for range stmt {
+		start += strings.IndexByte(sql[start:], ';') + 1
+	}
+	var offsets []int
+	for i := start; i < len(sql); i++ {
+		if sql[i] == '?' {
+			offsets = append(offsets, i)
+		}
+	}
+	return offsets
+}
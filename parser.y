@@ -29,13 +29,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/pingcap/tidb/pkg/parser/mysql"
-	"github.com/pingcap/tidb/pkg/parser/ast"
-	"github.com/pingcap/tidb/pkg/parser/opcode"
-	"github.com/pingcap/tidb/pkg/parser/auth"
-	"github.com/pingcap/tidb/pkg/parser/charset"
-	"github.com/pingcap/tidb/pkg/parser/types"
-	"github.com/pingcap/tidb/pkg/parser/duration"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/opcode"
+	"github.com/abbychau/mysql-parser/auth"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/types"
+	"github.com/abbychau/mysql-parser/duration"
 )
 %}
 
@@ -56,6 +56,7 @@ import (
 	toTSO                "TO TSO"
 	memberof             "MEMBER OF"
 	optionallyEnclosedBy "OPTIONALLY ENCLOSED BY"
+	triggerBody          "trigger body"
 
 	/*yy:token "_%c"    */
 	underscoreCS "UNDERSCORE_CHARSET"
@@ -321,6 +322,7 @@ import (
 	any                        "ANY"
 	apply                      "APPLY"
 	ascii                      "ASCII"
+	at                         "AT"
 	attribute                  "ATTRIBUTE"
 	attributes                 "ATTRIBUTES"
 	autoIdCache                "AUTO_ID_CACHE"
@@ -333,6 +335,7 @@ import (
 	backup                     "BACKUP"
 	backups                    "BACKUPS"
 	bdr                        "BDR"
+	before                     "BEFORE"
 	begin                      "BEGIN"
 	bernoulli                  "BERNOULLI"
 	binding                    "BINDING"
@@ -371,6 +374,7 @@ import (
 	commit                     "COMMIT"
 	committed                  "COMMITTED"
 	compact                    "COMPACT"
+	completion                 "COMPLETION"
 	compressed                 "COMPRESSED"
 	compression                "COMPRESSION"
 	compressionLevel           "COMPRESSION_LEVEL"
@@ -406,14 +410,17 @@ import (
 	discard                    "DISCARD"
 	disk                       "DISK"
 	do                         "DO"
+	dumpfile                   "DUMPFILE"
 	duplicate                  "DUPLICATE"
 	dynamic                    "DYNAMIC"
+	each                       "EACH"
 	enable                     "ENABLE"
 	enabled                    "ENABLED"
 	encryption                 "ENCRYPTION"
 	encryptionKeyFile          "ENCRYPTION_KEYFILE"
 	encryptionMethod           "ENCRYPTION_METHOD"
 	end                        "END"
+	ends                       "ENDS"
 	enforced                   "ENFORCED"
 	engine                     "ENGINE"
 	engines                    "ENGINES"
@@ -424,6 +431,7 @@ import (
 	escape                     "ESCAPE"
 	event                      "EVENT"
 	events                     "EVENTS"
+	every                      "EVERY"
 	evolve                     "EVOLVE"
 	exchange                   "EXCHANGE"
 	exclusive                  "EXCLUSIVE"
@@ -440,11 +448,14 @@ import (
 	fixed                      "FIXED"
 	flush                      "FLUSH"
 	following                  "FOLLOWING"
+	follows                    "FOLLOWS"
 	format                     "FORMAT"
 	found                      "FOUND"
 	full                       "FULL"
 	function                   "FUNCTION"
 	general                    "GENERAL"
+	geometryType               "GEOMETRY"
+	geometryCollectionType     "GEOMETRYCOLLECTION"
 	global                     "GLOBAL"
 	grants                     "GRANTS"
 	handler                    "HANDLER"
@@ -477,8 +488,10 @@ import (
 	last                       "LAST"
 	lastval                    "LASTVAL"
 	lastBackup                 "LAST_BACKUP"
+	leaves                     "LEAVES"
 	less                       "LESS"
 	level                      "LEVEL"
+	lineStringType             "LINESTRING"
 	list                       "LIST"
 	loadStats                  "LOAD_STATS"
 	local                      "LOCAL"
@@ -504,6 +517,9 @@ import (
 	mode                       "MODE"
 	modify                     "MODIFY"
 	month                      "MONTH"
+	multiLineStringType        "MULTILINESTRING"
+	multiPointType             "MULTIPOINT"
+	multiPolygonType           "MULTIPOLYGON"
 	names                      "NAMES"
 	national                   "NATIONAL"
 	ncharType                  "NCHAR"
@@ -541,12 +557,16 @@ import (
 	passwordLockTime           "PASSWORD_LOCK_TIME"
 	pause                      "PAUSE"
 	percent                    "PERCENT"
+	persist                    "PERSIST"
+	persistOnly                "PERSIST_ONLY"
 	per_db                     "PER_DB"
 	per_table                  "PER_TABLE"
 	pipesAsOr
 	plugins                    "PLUGINS"
 	point                      "POINT"
 	policy                     "POLICY"
+	polygonType                "POLYGON"
+	precedes                   "PRECEDES"
 	preceding                  "PRECEDING"
 	prepare                    "PREPARE"
 	preserve                   "PRESERVE"
@@ -588,6 +608,7 @@ import (
 	role                       "ROLE"
 	rollback                   "ROLLBACK"
 	rollup                     "ROLLUP"
+	rotate                     "ROTATE"
 	routine                    "ROUTINE"
 	rowCount                   "ROW_COUNT"
 	rowFormat                  "ROW_FORMAT"
@@ -633,7 +654,9 @@ import (
 	sqlTsiSecond               "SQL_TSI_SECOND"
 	sqlTsiWeek                 "SQL_TSI_WEEK"
 	sqlTsiYear                 "SQL_TSI_YEAR"
+	srid                       "SRID"
 	start                      "START"
+	starts                     "STARTS"
 	statsAutoRecalc            "STATS_AUTO_RECALC"
 	statsColChoice             "STATS_COL_CHOICE"
 	statsColList               "STATS_COL_LIST"
@@ -669,6 +692,7 @@ import (
 	trace                      "TRACE"
 	traditional                "TRADITIONAL"
 	transaction                "TRANSACTION"
+	tree                       "TREE"
 	triggers                   "TRIGGERS"
 	truncate                   "TRUNCATE"
 	tsoType                    "TSO"
@@ -935,9 +959,17 @@ import (
 	paramMarker  "?"
 	rsh          ">>"
 
+	/* The call of a function registered with RegisterFunctionSyntax. */
+	registeredFuncCall "registered function call"
+
 %token not2
+
+/* MariaDB keywords, recognized with DialectMariaDB only. See mariaDBTokenMap. */
+%token	<ident>
+	returning "RETURNING"
 %type	<expr>
 	Expression                      "expression"
+	FuncArgExpression               "function argument expression"
 	MaxValueOrExpression            "maxvalue or expression"
 	DefaultOrExpression             "default or expression"
 	BoolPri                         "boolean primary expression"
@@ -955,9 +987,12 @@ import (
 	Variable                        "User or system variable"
 	SystemVariable                  "System defined variable name"
 	UserVariable                    "User defined variable name"
+	SelectIntoVariable              "User or local variable in SELECT INTO"
 	SubSelect                       "Sub Select"
 	StringLiteral                   "text literal"
 	ExpressionOpt                   "Optional expression"
+	EventStartsOpt                  "Event optional STARTS clause"
+	EventEndsOpt                    "Event optional ENDS clause"
 	SignedLiteral                   "Literal or NumLiteral with sign"
 	DefaultValueExpr                "DefaultValueExpr(Now or Signed Literal)"
 	NowSymOptionFraction            "NowSym with optional fraction part"
@@ -981,15 +1016,20 @@ import (
 	AlterPolicyStmt            "Alter Placement Policy statement"
 	AlterResourceGroupStmt     "Alter Resource Group statement"
 	AlterSequenceStmt          "Alter sequence statement"
+	AlterEventStmt             "ALTER EVENT statement"
 	AnalyzeTableStmt           "Analyze table statement"
 	BeginTransactionStmt       "BEGIN TRANSACTION statement"
 	BinlogStmt                 "Binlog base64 statement"
+	CacheIndexStmt             "CACHE INDEX statement"
+	ChecksumTableStmt          "CHECKSUM TABLE statement"
 	BRIEStmt                   "BACKUP or RESTORE statement"
 	CalibrateResourceStmt      "CALIBRATE RESOURCE statement"
 	CancelDistributionJobStmt  "CANCEL DISTRIBUTION JOB statement"
 	CommitStmt                 "COMMIT statement"
 	CreateTableStmt            "CREATE TABLE statement"
 	CreateViewStmt             "CREATE VIEW  statement"
+	CreateTriggerStmt          "CREATE TRIGGER statement"
+	CreateEventStmt            "CREATE EVENT statement"
 	CreateUserStmt             "CREATE User statement"
 	CreateRoleStmt             "CREATE Role statement"
 	CreateDatabaseStmt         "Create Database Statement"
@@ -1011,6 +1051,8 @@ import (
 	DropStatsStmt              "DROP STATS statement"
 	DropTableStmt              "DROP TABLE statement"
 	DropSequenceStmt           "DROP SEQUENCE statement"
+	DropTriggerStmt            "DROP TRIGGER statement"
+	DropEventStmt              "DROP EVENT statement"
 	DropUserStmt               "DROP USER"
 	DropRoleStmt               "DROP ROLE"
 	DropViewStmt               "DROP VIEW statement"
@@ -1038,6 +1080,7 @@ import (
 	ImportFromSelectStmt       "SELECT statement of IMPORT INTO"
 	KillStmt                   "Kill statement"
 	LoadDataStmt               "Load data statement"
+	LoadIndexIntoCacheStmt     "LOAD INDEX INTO CACHE statement"
 	LoadStatsStmt              "Load statistic statement"
 	LockStatsStmt              "Lock statistic statement"
 	UnlockStatsStmt            "Unlock statistic statement"
@@ -1078,6 +1121,7 @@ import (
 	UseStmt                    "USE statement"
 	ShutdownStmt               "SHUTDOWN statement"
 	RestartStmt                "RESTART statement"
+	ResetPersistStmt           "RESET PERSIST statement"
 	RecommendIndexStmt         "RECOMMEND INDEX statement"
 	CreateViewSelectOpt        "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
 	BindableStmt               "Statement that can be created binding on"
@@ -1183,9 +1227,9 @@ import (
 	MaxValueOrExpressionList               "maxvalue or expression list"
 	DefaultOrExpressionList                "default or expression list"
 	ExpressionListOpt                      "expression list opt"
+	FuncArgExpressionList                  "function argument expression list"
 	FetchFirstOpt                          "Fetch First/Next Option"
-	FuncDatetimePrecListOpt                "Function datetime precision list opt"
-	FuncDatetimePrecList                   "Function datetime precision list"
+	FuncFspOpt                             "Function fractional seconds precision within parentheses"
 	Field                                  "field expression"
 	Fields                                 "Fields clause"
 	FieldList                              "field expression list"
@@ -1212,6 +1256,7 @@ import (
 	IfExists                               "If Exists"
 	IfNotExists                            "If Not Exists"
 	IgnoreOptional                         "IGNORE or empty"
+	IgnoreLeavesOpt                        "IGNORE LEAVES or empty"
 	IndexHint                              "index hint"
 	IndexHintList                          "index hint list"
 	IndexHintListOpt                       "index hint list opt"
@@ -1228,6 +1273,7 @@ import (
 	IndexName                              "index name"
 	IndexTypeName                          "index type name"
 	IndexTypeOpt                           "optional index type"
+	IndexPartOrderOpt                      "Optional ordering of an index key part: ASC/DESC"
 	IndexPartSpecification                 "Index column name or expression"
 	IndexPartSpecificationList             "List of index column name or expression"
 	IndexPartSpecificationListOpt          "Optional list of index column name or expression"
@@ -1297,6 +1343,7 @@ import (
 	PrepareSQL                             "Prepare statement sql string"
 	Priority                               "Statement priority"
 	PriorityOpt                            "Statement priority option"
+	PriorityList                           "Statement priority list"
 	PrivElem                               "Privilege element"
 	RefreshObject                          "Refresh object"
 	RefreshObjectList                      "Refresh object list"
@@ -1311,10 +1358,12 @@ import (
 	RecommendIndexOptionList               "Recommend index option list"
 	RecommendIndexOption                   "Recommend index option"
 	ReferOpt                               "reference option"
+	RestrictOrCascadeOpt                   "optional RESTRICT or CASCADE of DROP TABLE and DROP VIEW"
 	ReorganizePartitionRuleOpt             "optional reorganize partition partition list and definitions"
 	RequireList                            "require list for tls options"
 	RequireListElement                     "require list element for tls option"
 	ResourceGroupNameOption                "resource group name for user"
+	ReturningOptional                      "optional MariaDB RETURNING clause"
 	Rolename                               "Rolename"
 	RolenameComposed                       "Rolename that composed with more than 1 symbol"
 	RolenameList                           "RolenameList"
@@ -1339,6 +1388,8 @@ import (
 	SelectStmtFromTable                    "SELECT statement from table"
 	SelectStmtGroup                        "SELECT statement optional GROUP BY clause"
 	SelectStmtIntoOption                   "SELECT statement into clause"
+	SelectStmtIntoClause                   "SELECT statement non-empty into clause"
+	SelectIntoVariableList                 "SELECT INTO variable list"
 	SequenceOption                         "Create sequence option"
 	SequenceOptionList                     "Create sequence option list"
 	SetRoleOpt                             "Set role options"
@@ -1382,6 +1433,12 @@ import (
 	TableName                              "Table name"
 	TableNameOptWild                       "Table name with optional wildcard"
 	TableNameList                          "Table name list"
+	TableIndexList                         "Table, partitions and indexes of CACHE INDEX or LOAD INDEX INTO CACHE"
+	TableIndexListList                     "Table index lists of CACHE INDEX"
+	TableIndexNamesOpt                     "{INDEX|KEY} (index_name, ...) or empty"
+	LoadIndexTable                         "Table index list of LOAD INDEX INTO CACHE"
+	LoadIndexTableList                     "Table index lists of LOAD INDEX INTO CACHE"
+	ChecksumTableOptionOpt                 "QUICK, EXTENDED or empty"
 	TableNameListOpt                       "Table name list opt"
 	TableOption                            "create table option"
 	TableOptionList                        "create table option list"
@@ -1403,6 +1460,17 @@ import (
 	LockType                               "Table locks type"
 	TransactionChar                        "Transaction characteristic"
 	TransactionChars                       "Transaction characteristic list"
+	TriggerEvent                           "Trigger event"
+	EventSchedule                          "Event ON SCHEDULE clause"
+	EventCompletionOpt                     "Event optional ON COMPLETION clause"
+	EventStatusOpt                         "Event optional ENABLE or DISABLE"
+	EventCommentOpt                        "Event optional COMMENT"
+	EventBody                              "Event DO body"
+	EventBodyOpt                           "Event optional DO body"
+	AlterEventScheduleOpt                  "ALTER EVENT optional ON SCHEDULE and ON COMPLETION clauses"
+	AlterEventRenameOpt                    "ALTER EVENT optional RENAME TO clause"
+	TriggerOrderOpt                        "Trigger FOLLOWS or PRECEDES clause"
+	TriggerTiming                          "Trigger action time"
 	TrimDirection                          "Trim string direction"
 	SetOprOpt                              "Union/Except/Intersect Option(empty/ALL/DISTINCT)"
 	UpdateIndexElem                        "IndexName {GLOBAL|LOCAL}"
@@ -1482,8 +1550,13 @@ import (
 	BlobType                               "Blob types"
 	TextType                               "Text types"
 	DateAndTimeType                        "Date and Time types"
+	SpatialType                            "Spatial types"
+	SpatialTypeName                        "Spatial type name"
 	OptFieldLen                            "Field length or empty"
 	FieldLen                               "Field length"
+	Fsp                                    "Fractional seconds precision within parentheses"
+	OptFsp                                 "Fractional seconds precision or empty"
+	InvalidFsp                             "Fractional seconds precision which isn't a non-negative integer"
 	FieldOpts                              "Field type definition option list"
 	FieldOpt                               "Field type definition option"
 	FloatOpt                               "Floating-point type option"
@@ -1634,6 +1707,7 @@ import (
 	StringNameOrBRIEOptionKeyword   "string literal or identifier or keyword used for BRIE options"
 	Symbol                          "Constraint Symbol"
 	ProcedurceLabelOpt              "Optional Procedure label name"
+	WildCardAsName                  "Alias name following a wildcard field"
 
 %precedence empty
 %precedence statsExtended
@@ -1655,6 +1729,8 @@ import (
 %precedence set
 %precedence selectKwd
 %precedence lowerThanSelectStmt
+%precedence lowerThanInto
+%precedence into
 %precedence lowerThanInsertValues
 %precedence insertValues
 %precedence lowerThanCreateTableSelect
@@ -2622,7 +2698,8 @@ AlterTableSpec:
 	}
 |	"ALTER" ColumnKeywordOpt ColumnName "SET" "DEFAULT" '(' Expression ')'
 	{
-		option := &ast.ColumnOption{Expr: $7}
+		// Keep the parentheses, they make the default an expression default.
+		option := &ast.ColumnOption{Expr: &ast.ParenthesesExpr{Expr: $7}}
 		colDef := &ast.ColumnDef{
 			Name:    $3.(*ast.ColumnName),
 			Options: []*ast.ColumnOption{option},
@@ -3504,6 +3581,121 @@ BinlogStmt:
 		$$ = &ast.BinlogStmt{Str: $2}
 	}
 
+/*******************************************************************
+ *
+ *  Checksum Table Statement
+ *
+ *  Example:
+ *      CHECKSUM TABLE t1, t2 QUICK
+ *  See https://dev.mysql.com/doc/refman/8.0/en/checksum-table.html
+ *******************************************************************/
+ChecksumTableStmt:
+	"CHECKSUM" "TABLE" TableNameList ChecksumTableOptionOpt
+	{
+		$$ = &ast.ChecksumTableStmt{Tables: $3.([]*ast.TableName), Option: $4.(ast.ChecksumTableOption)}
+	}
+
+ChecksumTableOptionOpt:
+	{
+		$$ = ast.ChecksumTableOptionNone
+	}
+|	"QUICK"
+	{
+		$$ = ast.ChecksumTableOptionQuick
+	}
+|	"EXTENDED"
+	{
+		$$ = ast.ChecksumTableOptionExtended
+	}
+
+/*******************************************************************
+ *
+ *  Cache Index Statement
+ *
+ *  Example:
+ *      CACHE INDEX t1 INDEX (i1), t2 IN hot_cache
+ *  See https://dev.mysql.com/doc/refman/8.0/en/cache-index.html
+ *******************************************************************/
+CacheIndexStmt:
+	"CACHE" "INDEX" TableIndexListList "IN" Identifier
+	{
+		$$ = &ast.CacheIndexStmt{Tables: $3.([]*ast.TableIndexList), KeyCacheName: ast.NewCIStr($5)}
+	}
+
+TableIndexListList:
+	TableIndexList
+	{
+		$$ = []*ast.TableIndexList{$1.(*ast.TableIndexList)}
+	}
+|	TableIndexListList ',' TableIndexList
+	{
+		$$ = append($1.([]*ast.TableIndexList), $3.(*ast.TableIndexList))
+	}
+
+TableIndexList:
+	TableName TableIndexNamesOpt
+	{
+		$$ = &ast.TableIndexList{Table: $1.(*ast.TableName), Indexes: $2.([]ast.CIStr)}
+	}
+|	TableName "PARTITION" '(' PartitionNameList ')' TableIndexNamesOpt
+	{
+		$$ = &ast.TableIndexList{Table: $1.(*ast.TableName), Partitions: $4.([]ast.CIStr), Indexes: $6.([]ast.CIStr)}
+	}
+|	TableName "PARTITION" '(' "ALL" ')' TableIndexNamesOpt
+	{
+		$$ = &ast.TableIndexList{Table: $1.(*ast.TableName), AllPartitions: true, Indexes: $6.([]ast.CIStr)}
+	}
+
+TableIndexNamesOpt:
+	{
+		$$ = []ast.CIStr(nil)
+	}
+|	KeyOrIndex '(' IndexNameList ')'
+	{
+		$$ = $3
+	}
+
+/*******************************************************************
+ *
+ *  Load Index Into Cache Statement
+ *
+ *  Example:
+ *      LOAD INDEX INTO CACHE t1 PARTITION (p0) IGNORE LEAVES
+ *  See https://dev.mysql.com/doc/refman/8.0/en/load-index.html
+ *******************************************************************/
+LoadIndexIntoCacheStmt:
+	"LOAD" "INDEX" "INTO" "CACHE" LoadIndexTableList
+	{
+		$$ = &ast.LoadIndexIntoCacheStmt{Tables: $5.([]*ast.TableIndexList)}
+	}
+
+LoadIndexTableList:
+	LoadIndexTable
+	{
+		$$ = []*ast.TableIndexList{$1.(*ast.TableIndexList)}
+	}
+|	LoadIndexTableList ',' LoadIndexTable
+	{
+		$$ = append($1.([]*ast.TableIndexList), $3.(*ast.TableIndexList))
+	}
+
+LoadIndexTable:
+	TableIndexList IgnoreLeavesOpt
+	{
+		tbl := $1.(*ast.TableIndexList)
+		tbl.IgnoreLeaves = $2.(bool)
+		$$ = tbl
+	}
+
+IgnoreLeavesOpt:
+	{
+		$$ = false
+	}
+|	"IGNORE" "LEAVES"
+	{
+		$$ = true
+	}
+
 ColumnDef:
 	ColumnName Type ColumnOptionListOpt
 	{
@@ -3512,6 +3704,7 @@ ColumnDef:
 			yylex.AppendError(err)
 			return 1
 		}
+		colDef.ResolveBinaryCollation()
 		$$ = colDef
 	}
 |	ColumnName "SERIAL" ColumnOptionListOpt
@@ -3807,6 +4000,24 @@ ColumnOption:
 			StrValue: $3,
 		}
 	}
+|	"SRID" LengthNum
+	{
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionSRID, UintValue: $2.(uint64)}
+	}
+|	"COMPRESSED"
+	{
+		if !parser.dialectConstruct("COMPRESSED column attribute", yyS[yypt].offset) {
+			return 1
+		}
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionCompressed}
+	}
+|	"COMPRESSED" eq Identifier
+	{
+		if !parser.dialectConstruct("COMPRESSED column attribute", yyS[yypt-2].offset) {
+			return 1
+		}
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionCompressed, StrValue: $3}
+	}
 
 AutoRandomOpt:
 	{
@@ -4152,9 +4363,9 @@ NowSymOptionFraction:
 	{
 		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr("CURRENT_TIMESTAMP")}
 	}
-|	NowSymFunc '(' NUM ')'
+|	NowSymFunc Fsp
 	{
-		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr("CURRENT_TIMESTAMP"), Args: []ast.ExprNode{ast.NewValueExpr($3, parser.charset, parser.collation)}}
+		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr("CURRENT_TIMESTAMP"), Args: parser.fspArgs($2, $1)}
 	}
 |	CurdateSym '(' ')'
 	{
@@ -4359,13 +4570,29 @@ IndexPartSpecificationList:
 	}
 
 IndexPartSpecification:
-	ColumnName OptFieldLen OptOrder
+	ColumnName OptFieldLen IndexPartOrderOpt
 	{
-		$$ = &ast.IndexPartSpecification{Column: $1.(*ast.ColumnName), Length: $2.(int), Desc: $3.(bool)}
+		order := $3.(ast.IndexPartOrder)
+		$$ = &ast.IndexPartSpecification{Column: $1.(*ast.ColumnName), Length: $2.(int), Desc: order == ast.IndexPartOrderDesc, Order: order}
 	}
-|	'(' Expression ')' OptOrder
+|	'(' Expression ')' IndexPartOrderOpt
 	{
-		$$ = &ast.IndexPartSpecification{Expr: $2, Desc: $4.(bool)}
+		order := $4.(ast.IndexPartOrder)
+		$$ = &ast.IndexPartSpecification{Expr: $2, Length: types.UnspecifiedLength, Desc: order == ast.IndexPartOrderDesc, Order: order}
+	}
+
+IndexPartOrderOpt:
+	/* EMPTY */
+	{
+		$$ = ast.IndexPartOrderNone
+	}
+|	"ASC"
+	{
+		$$ = ast.IndexPartOrderAsc
+	}
+|	"DESC"
+	{
+		$$ = ast.IndexPartOrderDesc
 	}
 
 IndexLockAndAlgorithmOpt:
@@ -4962,10 +5189,12 @@ PartDefOption:
 	}
 |	"ENGINE" EqOpt StringName
 	{
+		parser.warnDialectEngine($3, yyS[yypt-2].offset)
 		$$ = &ast.TableOption{Tp: ast.TableOptionEngine, StrValue: $3}
 	}
 |	"STORAGE" "ENGINE" EqOpt StringName
 	{
+		parser.warnDialectEngine($4, yyS[yypt-3].offset)
 		$$ = &ast.TableOption{Tp: ast.TableOptionEngine, StrValue: $4}
 	}
 |	"ENGINE_ATTRIBUTE" EqOpt StringName
@@ -5263,6 +5492,296 @@ ViewCheckOption:
 		$$ = ast.CheckOptionLocal
 	}
 
+/*******************************************************************
+ *
+ *  Create Trigger Statement
+ *
+ *  Example:
+ *      CREATE DEFINER = 'root'@'localhost' TRIGGER trg BEFORE INSERT ON t
+ *          FOR EACH ROW FOLLOWS other_trg SET NEW.a = NEW.a + 1
+ *
+ *  The body is the triggerBody token, scanned up to the end of the
+ *  statement, see Scanner.lexTriggerBody.
+ *******************************************************************/
+CreateTriggerStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "TRIGGER" IfNotExists TableName TriggerTiming TriggerEvent "ON" TableName "FOR" "EACH" "ROW" TriggerOrderOpt triggerBody
+	{
+		// OR REPLACE and ALGORITHM are only there to share the prefix with CREATE VIEW.
+		if $2.(bool) || $3.(ast.ViewAlgorithm) != ast.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported by CREATE TRIGGER"))
+			return 1
+		}
+		x := &ast.CreateTriggerStmt{
+			IfNotExists: $6.(bool),
+			Definer:     $4.(*auth.UserIdentity),
+			TriggerName: $7.(*ast.TableName),
+			Timing:      $8.(ast.TriggerTiming),
+			Event:       $9.(ast.TriggerEvent),
+			Table:       $11.(*ast.TableName),
+			Body:        $16,
+		}
+		if $15 != nil {
+			order := $15.([]interface{})
+			x.Order = order[0].(ast.TriggerOrderType)
+			x.OtherTrigger = ast.NewCIStr(order[1].(string))
+		}
+		$$ = x
+	}
+
+TriggerTiming:
+	"BEFORE"
+	{
+		$$ = ast.TriggerBefore
+	}
+|	"AFTER"
+	{
+		$$ = ast.TriggerAfter
+	}
+
+TriggerEvent:
+	"INSERT"
+	{
+		$$ = ast.TriggerInsert
+	}
+|	"UPDATE"
+	{
+		$$ = ast.TriggerUpdate
+	}
+|	"DELETE"
+	{
+		$$ = ast.TriggerDelete
+	}
+
+TriggerOrderOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"FOLLOWS" Identifier
+	{
+		$$ = []interface{}{ast.TriggerFollows, $2}
+	}
+|	"PRECEDES" Identifier
+	{
+		$$ = []interface{}{ast.TriggerPrecedes, $2}
+	}
+
+/*******************************************************************
+ *
+ *  Drop Trigger Statement
+ *
+ *  Example:
+ *      DROP TRIGGER IF EXISTS db.trg
+ *******************************************************************/
+DropTriggerStmt:
+	"DROP" "TRIGGER" IfExists TableName
+	{
+		$$ = &ast.DropTriggerStmt{
+			IfExists:    $3.(bool),
+			TriggerName: $4.(*ast.TableName),
+		}
+	}
+
+/*******************************************************************
+ *
+ *  Create Event Statement
+ *
+ *  Example:
+ *      CREATE EVENT e ON SCHEDULE EVERY 1 DAY STARTS '2024-01-01 00:00:00'
+ *          DO DELETE FROM logs WHERE ts < NOW() - INTERVAL 30 DAY
+ *
+ *  A compound body, e.g. BEGIN ... END, is the triggerBody token, see
+ *  Scanner.lexTriggerBody.
+ *******************************************************************/
+CreateEventStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "EVENT" IfNotExists TableName "ON" "SCHEDULE" EventSchedule EventCompletionOpt EventStatusOpt EventCommentOpt "DO" EventBody
+	{
+		// OR REPLACE and ALGORITHM are only there to share the prefix with CREATE VIEW.
+		if $2.(bool) || $3.(ast.ViewAlgorithm) != ast.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported by CREATE EVENT"))
+			return 1
+		}
+		$$ = &ast.CreateEventStmt{
+			IfNotExists: $6.(bool),
+			Definer:     $4.(*auth.UserIdentity),
+			EventName:   $7.(*ast.TableName),
+			Schedule:    $10.(*ast.EventSchedule),
+			Completion:  $11.(ast.EventCompletion),
+			Status:      $12.(ast.EventStatus),
+			Comment:     $13.(*string),
+			Body:        *$15.(*ast.EventBody),
+		}
+	}
+
+EventSchedule:
+	"AT" Expression
+	{
+		$$ = &ast.EventSchedule{At: $2}
+	}
+|	"EVERY" Expression TimeUnit EventStartsOpt EventEndsOpt
+	{
+		$$ = &ast.EventSchedule{
+			Every:  $2,
+			Unit:   $3.(ast.TimeUnitType),
+			Starts: $4,
+			Ends:   $5,
+		}
+	}
+
+EventStartsOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"STARTS" Expression
+	{
+		$$ = $2
+	}
+
+EventEndsOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"ENDS" Expression
+	{
+		$$ = $2
+	}
+
+EventCompletionOpt:
+	/* EMPTY */
+	{
+		$$ = ast.EventCompletionUnspecified
+	}
+|	"ON" "COMPLETION" "PRESERVE"
+	{
+		$$ = ast.EventCompletionPreserve
+	}
+|	"ON" "COMPLETION" "NOT" "PRESERVE"
+	{
+		$$ = ast.EventCompletionNotPreserve
+	}
+
+EventStatusOpt:
+	/* EMPTY */
+	{
+		$$ = ast.EventStatusUnspecified
+	}
+|	"ENABLE"
+	{
+		$$ = ast.EventStatusEnable
+	}
+|	"DISABLE"
+	{
+		$$ = ast.EventStatusDisable
+	}
+|	"DISABLE" "ON" "SLAVE"
+	{
+		$$ = ast.EventStatusDisableOnReplica
+	}
+|	"DISABLE" "ON" "REPLICA"
+	{
+		$$ = ast.EventStatusDisableOnReplica
+	}
+
+EventCommentOpt:
+	/* EMPTY */
+	{
+		$$ = (*string)(nil)
+	}
+|	"COMMENT" stringLit
+	{
+		comment := $2
+		$$ = &comment
+	}
+
+EventBody:
+	ProcedureStatementStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		$1.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = &ast.EventBody{Stmt: $1}
+	}
+|	triggerBody
+	{
+		$$ = &ast.EventBody{Text: $1}
+	}
+
+EventBodyOpt:
+	/* EMPTY */
+	{
+		$$ = (*ast.EventBody)(nil)
+	}
+|	"DO" EventBody
+	{
+		$$ = $2
+	}
+
+/*******************************************************************
+ *
+ *  Alter Event Statement
+ *
+ *  Example:
+ *      ALTER EVENT e ON SCHEDULE EVERY 7 DAY RENAME TO db.e2 DISABLE
+ *******************************************************************/
+AlterEventStmt:
+	"ALTER" ViewDefiner "EVENT" TableName AlterEventScheduleOpt AlterEventRenameOpt EventStatusOpt EventCommentOpt EventBodyOpt
+	{
+		schedule := $5.([]interface{})
+		x := &ast.AlterEventStmt{
+			Definer:    $2.(*auth.UserIdentity),
+			EventName:  $4.(*ast.TableName),
+			Schedule:   schedule[0].(*ast.EventSchedule),
+			Completion: schedule[1].(ast.EventCompletion),
+			NewName:    $6.(*ast.TableName),
+			Status:     $7.(ast.EventStatus),
+			Comment:    $8.(*string),
+			Body:       $9.(*ast.EventBody),
+		}
+		if x.Schedule == nil && x.Completion == ast.EventCompletionUnspecified && x.NewName == nil &&
+			x.Status == ast.EventStatusUnspecified && x.Comment == nil && x.Body == nil {
+			yylex.AppendError(yylex.Errorf("ALTER EVENT needs a clause to change"))
+			return 1
+		}
+		$$ = x
+	}
+
+AlterEventScheduleOpt:
+	EventCompletionOpt
+	{
+		$$ = []interface{}{(*ast.EventSchedule)(nil), $1}
+	}
+|	"ON" "SCHEDULE" EventSchedule EventCompletionOpt
+	{
+		$$ = []interface{}{$3, $4}
+	}
+
+AlterEventRenameOpt:
+	/* EMPTY */
+	{
+		$$ = (*ast.TableName)(nil)
+	}
+|	"RENAME" "TO" TableName
+	{
+		$$ = $3
+	}
+
+/*******************************************************************
+ *
+ *  Drop Event Statement
+ *
+ *  Example:
+ *      DROP EVENT IF EXISTS db.e
+ *******************************************************************/
+DropEventStmt:
+	"DROP" "EVENT" IfExists TableName
+	{
+		$$ = &ast.DropEventStmt{
+			IfExists:  $3.(bool),
+			EventName: $4.(*ast.TableName),
+		}
+	}
+
 /******************************************************************
  * Do statement
  * See https://dev.mysql.com/doc/refman/5.7/en/do.html
@@ -5281,7 +5800,7 @@ DoStmt:
  *
  *******************************************************************/
 DeleteWithoutUsingStmt:
-	"DELETE" TableOptimizerHintsOpt PriorityOpt QuickOptional IgnoreOptional "FROM" TableName PartitionNameListOpt TableAsNameOpt IndexHintListOpt WhereClauseOptional OrderByOptional LimitClause
+	"DELETE" TableOptimizerHintsOpt PriorityOpt QuickOptional IgnoreOptional "FROM" TableName PartitionNameListOpt TableAsNameOpt IndexHintListOpt WhereClauseOptional OrderByOptional LimitClause ReturningOptional
 	{
 		// Single Table
 		tn := $7.(*ast.TableName)
@@ -5306,6 +5825,9 @@ DeleteWithoutUsingStmt:
 		if $13 != nil {
 			x.Limit = $13.(*ast.Limit)
 		}
+		if $14 != nil {
+			x.Returning = $14.(*ast.FieldList)
+		}
 
 		$$ = x
 	}
@@ -5409,7 +5931,7 @@ DropIndexStmt:
 DropTableStmt:
 	"DROP" OptTemporary TableOrTables IfExists TableNameList RestrictOrCascadeOpt
 	{
-		$$ = &ast.DropTableStmt{IfExists: $4.(bool), Tables: $5.([]*ast.TableName), IsView: false, TemporaryKeyword: $2.(ast.TemporaryKeyword)}
+		$$ = &ast.DropTableStmt{IfExists: $4.(bool), Tables: $5.([]*ast.TableName), IsView: false, TemporaryKeyword: $2.(ast.TemporaryKeyword), Behavior: $6.(ast.DropBehavior)}
 	}
 
 OptTemporary:
@@ -5429,11 +5951,11 @@ OptTemporary:
 DropViewStmt:
 	"DROP" "VIEW" TableNameList RestrictOrCascadeOpt
 	{
-		$$ = &ast.DropTableStmt{Tables: $3.([]*ast.TableName), IsView: true}
+		$$ = &ast.DropTableStmt{Tables: $3.([]*ast.TableName), IsView: true, Behavior: $4.(ast.DropBehavior)}
 	}
 |	"DROP" "VIEW" "IF" "EXISTS" TableNameList RestrictOrCascadeOpt
 	{
-		$$ = &ast.DropTableStmt{IfExists: true, Tables: $5.([]*ast.TableName), IsView: true}
+		$$ = &ast.DropTableStmt{IfExists: true, Tables: $5.([]*ast.TableName), IsView: true, Behavior: $6.(ast.DropBehavior)}
 	}
 
 DropUserStmt:
@@ -5491,9 +6013,17 @@ DropStatsStmt:
 	}
 
 RestrictOrCascadeOpt:
-	{}
+	{
+		$$ = ast.DropBehaviorNone
+	}
 |	"RESTRICT"
+	{
+		$$ = ast.DropBehaviorRestrict
+	}
 |	"CASCADE"
+	{
+		$$ = ast.DropBehaviorCascade
+	}
 
 TableOrTables:
 	"TABLE"
@@ -5636,28 +6166,32 @@ ExplainStmt:
 	{
 		$$ = &ast.ExplainForStmt{
 			Format:       $4,
+			FormatType:   ast.ExplainFormatTypeOf($4),
 			ConnectionID: getUint64FromNUM($7),
 		}
 	}
 |	ExplainSym "FORMAT" "=" stringLit ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
-			Stmt:   $5,
-			Format: $4,
+			Stmt:       $5,
+			Format:     $4,
+			FormatType: ast.ExplainFormatTypeOf($4),
 		}
 	}
 |	ExplainSym "FORMAT" "=" ExplainFormatType "FOR" "CONNECTION" NUM
 	{
 		$$ = &ast.ExplainForStmt{
 			Format:       $4,
+			FormatType:   ast.ExplainFormatTypeOf($4),
 			ConnectionID: getUint64FromNUM($7),
 		}
 	}
 |	ExplainSym "FORMAT" "=" ExplainFormatType ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
-			Stmt:   $5,
-			Format: $4,
+			Stmt:       $5,
+			Format:     $4,
+			FormatType: ast.ExplainFormatTypeOf($4),
 		}
 	}
 |	ExplainSym "FORMAT" "=" ExplainFormatType stringLit
@@ -5665,6 +6199,7 @@ ExplainStmt:
 		$$ = &ast.ExplainStmt{
 			PlanDigest: $5,
 			Format:     $4,
+			FormatType: ast.ExplainFormatTypeOf($4),
 		}
 	}
 |	ExplainSym "FORMAT" "=" stringLit stringLit
@@ -5672,6 +6207,7 @@ ExplainStmt:
 		$$ = &ast.ExplainStmt{
 			PlanDigest: $5,
 			Format:     $4,
+			FormatType: ast.ExplainFormatTypeOf($4),
 		}
 	}
 |	ExplainSym "ANALYZE" ExplainableStmt
@@ -5693,9 +6229,10 @@ ExplainStmt:
 |	ExplainSym "ANALYZE" "FORMAT" "=" ExplainFormatType ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
-			Stmt:    $6,
-			Format:  $5,
-			Analyze: true,
+			Stmt:       $6,
+			Format:     $5,
+			FormatType: ast.ExplainFormatTypeOf($5),
+			Analyze:    true,
 		}
 	}
 |	ExplainSym "ANALYZE" "FORMAT" "=" ExplainFormatType stringLit
@@ -5703,6 +6240,7 @@ ExplainStmt:
 		$$ = &ast.ExplainStmt{
 			PlanDigest: $6,
 			Format:     $5,
+			FormatType: ast.ExplainFormatTypeOf($5),
 			Analyze:    true,
 		}
 	}
@@ -5711,15 +6249,17 @@ ExplainStmt:
 		$$ = &ast.ExplainStmt{
 			PlanDigest: $6,
 			Format:     $5,
+			FormatType: ast.ExplainFormatTypeOf($5),
 			Analyze:    true,
 		}
 	}
 |	ExplainSym "ANALYZE" "FORMAT" "=" stringLit ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
-			Stmt:    $6,
-			Format:  $5,
-			Analyze: true,
+			Stmt:       $6,
+			Format:     $5,
+			FormatType: ast.ExplainFormatTypeOf($5),
+			Analyze:    true,
 		}
 	}
 
@@ -5732,6 +6272,7 @@ ExplainFormatType:
 |	"VERBOSE"
 |	"TRUE_CARD_COST"
 |	"TIDB_JSON"
+|	"TREE"
 
 SavepointStmt:
 	"SAVEPOINT" Identifier
@@ -6281,7 +6822,7 @@ Expression:
 |	BoolPri IsOrNotOp "UNKNOWN" %prec is
 	{
 		/* https://dev.mysql.com/doc/refman/5.7/en/comparison-operators.html#operator_is */
-		$$ = &ast.IsNullExpr{Expr: $1, Not: !$2.(bool)}
+		$$ = &ast.IsTruthExpr{Expr: $1, Not: !$2.(bool), Unknown: true}
 	}
 |	BoolPri
 
@@ -6363,26 +6904,53 @@ ExpressionListOpt:
 	{
 		$$ = []ast.ExprNode{}
 	}
-|	ExpressionList
+|	FuncArgExpressionList
 
-FuncDatetimePrecListOpt:
+FuncArgExpressionList:
+	FuncArgExpression
 	{
-		$$ = []ast.ExprNode{}
+		$$ = []ast.ExprNode{$1}
+	}
+|	FuncArgExpressionList ',' FuncArgExpression
+	{
+		$$ = append($1.([]ast.ExprNode), $3)
 	}
-|	FuncDatetimePrecList
 
-FuncDatetimePrecList:
-	intLit
+FuncArgExpression:
+	Expression
+|	Identifier '.' '*'
 	{
-		expr := ast.NewValueExpr($1, parser.charset, parser.collation)
-		$$ = []ast.ExprNode{expr}
+		yylex.AppendError(ErrParse.GenWithStackByArgs("Qualified wildcard is not allowed in function arguments", yylex.Errorf("").Error()))
+		return 1
+	}
+|	Identifier '.' Identifier '.' '*'
+	{
+		yylex.AppendError(ErrParse.GenWithStackByArgs("Qualified wildcard is not allowed in function arguments", yylex.Errorf("").Error()))
+		return 1
 	}
 
+FuncFspOpt:
+	'(' ')'
+	{
+		$$ = nil
+	}
+|	Fsp
+
 BoolPri:
 	BoolPri IsOrNotOp "NULL" %prec is
 	{
 		$$ = &ast.IsNullExpr{Expr: $1, Not: !$2.(bool)}
 	}
+|	BoolPri IsOrNotOp "DISTINCT" "FROM" PredicateExpr %prec is
+	{
+		// IS [NOT] DISTINCT FROM is the ANSI form of the null-safe comparison.
+		msg := "IS DISTINCT FROM is not supported, use NOT (a <=> b) instead"
+		if !$2.(bool) {
+			msg = "IS NOT DISTINCT FROM is not supported, use a <=> b instead"
+		}
+		yylex.AppendError(ErrParse.GenWithStackByArgs(msg, parser.positionAt(parser.startOffset(&yyS[yypt-3]))))
+		return 1
+	}
 |	BoolPri CompareOp PredicateExpr %prec eq
 	{
 		$$ = &ast.BinaryOperationExpr{Op: $2.(opcode.Op), L: $1, R: $3}
@@ -6608,6 +7176,21 @@ Field:
 		wildCard := &ast.WildCardField{Schema: ast.NewCIStr($1), Table: ast.NewCIStr($3)}
 		$$ = &ast.SelectField{WildCard: wildCard}
 	}
+|	'*' WildCardAsName
+	{
+		yylex.AppendError(ErrParse.GenWithStackByArgs("Alias is not allowed for wildcard field", yylex.Errorf("").Error()))
+		return 1
+	}
+|	Identifier '.' '*' WildCardAsName
+	{
+		yylex.AppendError(ErrParse.GenWithStackByArgs("Alias is not allowed for wildcard field", yylex.Errorf("").Error()))
+		return 1
+	}
+|	Identifier '.' Identifier '.' '*' WildCardAsName
+	{
+		yylex.AppendError(ErrParse.GenWithStackByArgs("Alias is not allowed for wildcard field", yylex.Errorf("").Error()))
+		return 1
+	}
 |	Expression FieldAsNameOpt
 	{
 		expr := $1
@@ -6634,6 +7217,16 @@ FieldAsName:
 		$$ = $2
 	}
 
+WildCardAsName:
+	"AS" Identifier
+	{
+		$$ = $2
+	}
+|	"AS" stringLit
+	{
+		$$ = $2
+	}
+
 FieldList:
 	Field
 	{
@@ -6674,6 +7267,7 @@ GroupByClause:
 	}
 
 HavingClause:
+	%prec lowerThanInto
 	{
 		$$ = nil
 	}
@@ -6750,28 +7344,7 @@ IndexOptionList:
 			$$ = $2
 		} else {
 			opt1 := $1.(*ast.IndexOption)
-			opt2 := $2.(*ast.IndexOption)
-			if len(opt2.Comment) > 0 {
-				opt1.Comment = opt2.Comment
-			} else if opt2.Tp != 0 {
-				opt1.Tp = opt2.Tp
-			} else if opt2.KeyBlockSize > 0 {
-				opt1.KeyBlockSize = opt2.KeyBlockSize
-			} else if len(opt2.ParserName.O) > 0 {
-				opt1.ParserName = opt2.ParserName
-			} else if opt2.Visibility != ast.IndexVisibilityDefault {
-				opt1.Visibility = opt2.Visibility
-			} else if opt2.PrimaryKeyTp != ast.PrimaryKeyTypeDefault {
-				opt1.PrimaryKeyTp = opt2.PrimaryKeyTp
-			} else if opt2.AddColumnarReplicaOnDemand > 0 {
-				opt1.AddColumnarReplicaOnDemand = opt2.AddColumnarReplicaOnDemand
-			} else if opt2.Global {
-				opt1.Global = true
-			} else if opt2.SplitOpt != nil {
-				opt1.SplitOpt = opt2.SplitOpt
-			} else if len(opt2.SecondaryEngineAttr) > 0 {
-				opt1.SecondaryEngineAttr = opt2.SecondaryEngineAttr
-			}
+			opt1.Merge($2.(*ast.IndexOption))
 			$$ = opt1
 		}
 	}
@@ -6779,6 +7352,10 @@ IndexOptionList:
 IndexOption:
 	"KEY_BLOCK_SIZE" EqOpt LengthNum
 	{
+		if parser.storageOptionCheck && !ast.IsValidKeyBlockSize($3.(uint64)) {
+			yylex.AppendError(yylex.Errorf("Invalid KEY_BLOCK_SIZE = %d, the valid sizes are 0, 1, 2, 4, 8 and 16", $3.(uint64)))
+			return 1
+		}
 		$$ = &ast.IndexOption{
 			KeyBlockSize: $3.(uint64),
 		}
@@ -6948,6 +7525,7 @@ UnReservedKeyword:
 |	"ADVISE"
 |	"ASCII"
 |	"APPLY"
+|	"AT"
 |	"ATTRIBUTE"
 |	"ATTRIBUTES"
 |	"BINDING_CACHE"
@@ -6959,6 +7537,10 @@ UnReservedKeyword:
 |	"AUTO_INCREMENT"
 |	"AFTER"
 |	"ALWAYS"
+|	"BEFORE"
+|	"EACH"
+|	"FOLLOWS"
+|	"PRECEDES"
 |	"AVG"
 |	"BDR"
 |	"BEGIN"
@@ -6978,6 +7560,7 @@ UnReservedKeyword:
 |	"SAN"
 |	"COMMIT"
 |	"COMPACT"
+|	"COMPLETION"
 |	"COMPRESSED"
 |	"CONSISTENCY"
 |	"CONSISTENT"
@@ -6988,10 +7571,12 @@ UnReservedKeyword:
 |	"DAY"
 |	"DEALLOCATE"
 |	"DO"
+|	"DUMPFILE"
 |	"DUPLICATE"
 |	"DYNAMIC"
 |	"ENCRYPTION"
 |	"END"
+|	"ENDS"
 |	"ENFORCED"
 |	"ENGINE"
 |	"ENGINES"
@@ -7001,6 +7586,7 @@ UnReservedKeyword:
 |	"ERROR"
 |	"ERRORS"
 |	"ESCAPE"
+|	"EVERY"
 |	"EVOLVE"
 |	"EXECUTE"
 |	"EXPLORE"
@@ -7042,6 +7628,7 @@ UnReservedKeyword:
 |	"ROLE"
 |	"ROLLBACK"
 |	"ROLLUP"
+|	"ROTATE"
 |	"RULE"
 |	"SESSION"
 |	"SIGNED"
@@ -7049,6 +7636,7 @@ UnReservedKeyword:
 |	"SHUTDOWN"
 |	"SNAPSHOT"
 |	"START"
+|	"STARTS"
 |	"STATUS"
 |	"OPEN"
 |	"POINT"
@@ -7140,6 +7728,8 @@ UnReservedKeyword:
 |	"MICROSECOND"
 |	"MINUTE"
 |	"PLUGINS"
+|	"PERSIST"
+|	"PERSIST_ONLY"
 |	"PRECEDING"
 |	"QUERY"
 |	"QUERIES"
@@ -7190,6 +7780,7 @@ UnReservedKeyword:
 |	"SWAPS"
 |	"SOURCE"
 |	"TRADITIONAL"
+|	"TREE"
 |	"SQL_BUFFER_RESULT"
 |	"DIRECTORY"
 |	"HISTOGRAM"
@@ -7271,6 +7862,7 @@ UnReservedKeyword:
 |	"RESTORES"
 |	"SEND_CREDENTIALS_TO_TIKV"
 |	"LAST_BACKUP"
+|	"LEAVES"
 |	"CHECKPOINT"
 |	"SKIP_SCHEMA_FILES"
 |	"STRICT_FORMAT"
@@ -7332,6 +7924,14 @@ UnReservedKeyword:
 |	"COMPRESSION_TYPE"
 |	"ENCRYPTION_METHOD"
 |	"ENCRYPTION_KEYFILE"
+|	"GEOMETRY"
+|	"GEOMETRYCOLLECTION"
+|	"LINESTRING"
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
+|	"POLYGON"
+|	"SRID"
 
 TiDBKeyword:
 	"ADMIN"
@@ -7566,7 +8166,7 @@ ProcedureCall:
  *
  **********************************************************************************/
 InsertIntoStmt:
-	"INSERT" TableOptimizerHintsOpt PriorityOpt IgnoreOptional IntoOpt TableName PartitionNameListOpt InsertValues OnDuplicateKeyUpdate
+	"INSERT" TableOptimizerHintsOpt PriorityOpt IgnoreOptional IntoOpt TableName PartitionNameListOpt InsertValues OnDuplicateKeyUpdate ReturningOptional
 	{
 		x := $8.(*ast.InsertStmt)
 		x.Priority = $3.(mysql.PriorityEnum)
@@ -7576,14 +8176,28 @@ InsertIntoStmt:
 		x.Table = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
 		if $9 != nil {
 			x.OnDuplicate = $9.([]*ast.Assignment)
+			markDeprecatedValues(x.OnDuplicate)
 		}
 		if $2 != nil {
 			x.TableHints = $2.([]*ast.TableOptimizerHint)
 		}
 		x.PartitionNames = $7.([]ast.CIStr)
+		if $10 != nil {
+			x.Returning = $10.(*ast.FieldList)
+		}
 		$$ = x
 	}
 
+ReturningOptional:
+	{
+		$$ = nil
+	}
+|	"RETURNING" FieldList
+	{
+		parser.dialectConstruct("RETURNING", yyS[yypt-1].offset)
+		$$ = &ast.FieldList{Fields: $2.([]*ast.SelectField)}
+	}
+
 IntoOpt:
 	{}
 |	"INTO"
@@ -7596,6 +8210,15 @@ InsertValues:
 			Lists:   $5.([][]ast.ExprNode),
 		}
 	}
+|	'(' ColumnNameListOpt ')' ValueSym ValuesList "AS" Identifier IdentListWithParenOpt
+	{
+		$$ = &ast.InsertStmt{
+			Columns:         $2.([]*ast.ColumnName),
+			Lists:           $5.([][]ast.ExprNode),
+			RowAlias:        ast.NewCIStr($7),
+			RowAliasColumns: $8.([]ast.CIStr),
+		}
+	}
 |	'(' ColumnNameListOpt ')' SetOprStmt
 	{
 		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(ast.ResultSetNode)}
@@ -7625,6 +8248,10 @@ InsertValues:
 	{
 		$$ = &ast.InsertStmt{Lists: $2.([][]ast.ExprNode)}
 	}
+|	ValueSym ValuesList "AS" Identifier IdentListWithParenOpt
+	{
+		$$ = &ast.InsertStmt{Lists: $2.([][]ast.ExprNode), RowAlias: ast.NewCIStr($4), RowAliasColumns: $5.([]ast.CIStr)}
+	}
 |	SetOprStmt
 	{
 		$$ = &ast.InsertStmt{Select: $1.(ast.ResultSetNode)}
@@ -7654,6 +8281,13 @@ InsertValues:
 	{
 		$$ = $2.(*ast.InsertStmt)
 	}
+|	"SET" ColumnSetValueList "AS" Identifier IdentListWithParenOpt
+	{
+		x := $2.(*ast.InsertStmt)
+		x.RowAlias = ast.NewCIStr($4)
+		x.RowAliasColumns = $5.([]ast.CIStr)
+		$$ = x
+	}
 
 ValueSym:
 	"VALUE"
@@ -7734,9 +8368,13 @@ OnDuplicateKeyUpdate:
  *
  **********************************************************************************/
 ReplaceIntoStmt:
-	"REPLACE" TableOptimizerHintsOpt PriorityOpt IntoOpt TableName PartitionNameListOpt InsertValues
+	"REPLACE" TableOptimizerHintsOpt PriorityOpt IntoOpt TableName PartitionNameListOpt InsertValues ReturningOptional
 	{
 		x := $7.(*ast.InsertStmt)
+		if x.RowAlias.L != "" {
+			yylex.AppendError(yylex.Errorf("The row alias is only supported by INSERT"))
+			return 1
+		}
 		if $2 != nil {
 			x.TableHints = $2.([]*ast.TableOptimizerHint)
 		}
@@ -7745,6 +8383,9 @@ ReplaceIntoStmt:
 		ts := &ast.TableSource{Source: $5.(*ast.TableName)}
 		x.Table = &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}}
 		x.PartitionNames = $6.([]ast.CIStr)
+		if $8 != nil {
+			x.Returning = $8.(*ast.FieldList)
+		}
 		$$ = x
 	}
 
@@ -8143,6 +8784,8 @@ SimpleExpr:
 |	"BINARY" SimpleExpr %prec neg
 	{
 		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#operator_binary
+		yylex.AppendError(ErrWarnDeprecatedSyntax.FastGenByArgs("BINARY expr", "CAST(expr AS BINARY)"))
+		parser.lastErrorAsWarn()
 		tp := types.NewFieldType(mysql.TypeString)
 		tp.SetCharset(charset.CharsetBin)
 		tp.SetCollate(charset.CharsetBin)
@@ -8153,7 +8796,7 @@ SimpleExpr:
 			FunctionType: ast.CastBinaryOperator,
 		}
 	}
-|	builtinCast '(' Expression "AS" CastType ArrayKwdOpt ')'
+|	builtinCast '(' FuncArgExpression "AS" CastType ArrayKwdOpt ')'
 	{
 		/* See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_cast */
 		tp := $5.(*types.FieldType)
@@ -8179,7 +8822,7 @@ SimpleExpr:
 			ExplicitCharSet: explicitCharset,
 		}
 	}
-|	jsonSumCrc32 '(' Expression "AS" CastType "ARRAY" ')'
+|	jsonSumCrc32 '(' FuncArgExpression "AS" CastType "ARRAY" ')'
 	{
 		/* Copied from CAST function, except that ARRAY is enforced to be true */
 		tp := $5.(*types.FieldType)
@@ -8214,7 +8857,7 @@ SimpleExpr:
 		}
 		$$ = x
 	}
-|	"CONVERT" '(' Expression ',' CastType ')'
+|	"CONVERT" '(' FuncArgExpression ',' CastType ')'
 	{
 		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_convert
 		tp := $5.(*types.FieldType)
@@ -8234,7 +8877,7 @@ SimpleExpr:
 			ExplicitCharSet: explicitCharset,
 		}
 	}
-|	"CONVERT" '(' Expression "USING" CharsetName ')'
+|	"CONVERT" '(' FuncArgExpression "USING" CharsetName ')'
 	{
 		// See https://dev.mysql.com/doc/refman/5.7/en/cast-functions.html#function_convert
 		charset1 := ast.NewValueExpr($5, "", "")
@@ -8313,17 +8956,22 @@ FunctionNameConflict:
 |	"DATE"
 |	"DATABASE"
 |	"DAY"
+|	"GEOMETRYCOLLECTION"
 |	"HOUR"
 |	"IF"
 |	"INTERVAL"
+|	"LINESTRING"
 |	"LOG"
 |	"FORMAT"
 |	"LEFT"
 |	"MICROSECOND"
 |	"MINUTE"
 |	"MONTH"
-|	builtinNow
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
 |	"POINT"
+|	"POLYGON"
 |	"QUARTER"
 |	"REPEAT"
 |	"REPLACE"
@@ -8377,13 +9025,9 @@ FunctionCallKeyword:
 	}
 |	FunctionNameDatetimePrecision FuncDatetimePrec
 	{
-		args := []ast.ExprNode{}
-		if $2 != nil {
-			args = append(args, $2.(ast.ExprNode))
-		}
-		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: args}
+		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: parser.fspArgs($2, $1)}
 	}
-|	"CHAR" '(' ExpressionList ')'
+|	"CHAR" '(' FuncArgExpressionList ')'
 	{
 		nilVal := ast.NewValueExpr(nil, parser.charset, parser.collation)
 		args := $3.([]ast.ExprNode)
@@ -8392,7 +9036,7 @@ FunctionCallKeyword:
 			Args:   append(args, nilVal),
 		}
 	}
-|	"CHAR" '(' ExpressionList "USING" CharsetName ')'
+|	"CHAR" '(' FuncArgExpressionList "USING" CharsetName ')'
 	{
 		charset1 := ast.NewValueExpr($5, "", "")
 		args := $3.([]ast.ExprNode)
@@ -8420,7 +9064,7 @@ FunctionCallKeyword:
 	{
 		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr(ast.InsertFunc), Args: $3.([]ast.ExprNode)}
 	}
-|	"MOD" '(' Expression ',' Expression ')'
+|	"MOD" '(' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.BinaryOperationExpr{Op: opcode.Mod, L: $3, R: $5}
 	}
@@ -8430,15 +9074,19 @@ FunctionCallKeyword:
 	}
 
 FunctionCallNonKeyword:
-	builtinCurTime '(' FuncDatetimePrecListOpt ')'
+	builtinCurTime FuncFspOpt
 	{
-		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: parser.fspArgs($2, $1)}
 	}
-|	builtinSysDate '(' FuncDatetimePrecListOpt ')'
+|	builtinSysDate FuncFspOpt
 	{
-		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: parser.fspArgs($2, $1)}
+	}
+|	builtinNow FuncFspOpt
+	{
+		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: parser.fspArgs($2, $1)}
 	}
-|	FunctionNameDateArithMultiForms '(' Expression ',' Expression ')'
+|	FunctionNameDateArithMultiForms '(' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
@@ -8449,7 +9097,7 @@ FunctionCallNonKeyword:
 			},
 		}
 	}
-|	FunctionNameDateArithMultiForms '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+|	FunctionNameDateArithMultiForms '(' FuncArgExpression ',' "INTERVAL" Expression TimeUnit ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
@@ -8460,7 +9108,7 @@ FunctionCallNonKeyword:
 			},
 		}
 	}
-|	FunctionNameDateArith '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+|	FunctionNameDateArith '(' FuncArgExpression ',' "INTERVAL" Expression TimeUnit ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
@@ -8471,7 +9119,7 @@ FunctionCallNonKeyword:
 			},
 		}
 	}
-|	builtinExtract '(' TimeUnit "FROM" Expression ')'
+|	builtinExtract '(' TimeUnit "FROM" FuncArgExpression ')'
 	{
 		timeUnit := &ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}
 		$$ = &ast.FuncCallExpr{
@@ -8479,7 +9127,7 @@ FunctionCallNonKeyword:
 			Args:   []ast.ExprNode{timeUnit, $5},
 		}
 	}
-|	"GET_FORMAT" '(' GetFormatSelector ',' Expression ')'
+|	"GET_FORMAT" '(' GetFormatSelector ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
@@ -8489,67 +9137,67 @@ FunctionCallNonKeyword:
 			},
 		}
 	}
-|	builtinPosition '(' BitExpr "IN" Expression ')'
+|	builtinPosition '(' BitExpr "IN" FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{FnName: ast.NewCIStr($1), Args: []ast.ExprNode{$3, $5}}
 	}
-|	builtinSubstring '(' Expression ',' Expression ')'
+|	builtinSubstring '(' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3, $5},
 		}
 	}
-|	builtinSubstring '(' Expression "FROM" Expression ')'
+|	builtinSubstring '(' FuncArgExpression "FROM" FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3, $5},
 		}
 	}
-|	builtinSubstring '(' Expression ',' Expression ',' Expression ')'
+|	builtinSubstring '(' FuncArgExpression ',' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3, $5, $7},
 		}
 	}
-|	builtinSubstring '(' Expression "FROM" Expression "FOR" Expression ')'
+|	builtinSubstring '(' FuncArgExpression "FROM" FuncArgExpression "FOR" FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3, $5, $7},
 		}
 	}
-|	"TIMESTAMPADD" '(' TimestampUnit ',' Expression ',' Expression ')'
+|	"TIMESTAMPADD" '(' TimestampUnit ',' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{&ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}, $5, $7},
 		}
 	}
-|	"TIMESTAMPDIFF" '(' TimestampUnit ',' Expression ',' Expression ')'
+|	"TIMESTAMPDIFF" '(' TimestampUnit ',' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{&ast.TimeUnitExpr{Unit: $3.(ast.TimeUnitType)}, $5, $7},
 		}
 	}
-|	builtinTrim '(' Expression ')'
+|	builtinTrim '(' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3},
 		}
 	}
-|	builtinTrim '(' Expression "FROM" Expression ')'
+|	builtinTrim '(' FuncArgExpression "FROM" FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$5, $3},
 		}
 	}
-|	builtinTrim '(' TrimDirection "FROM" Expression ')'
+|	builtinTrim '(' TrimDirection "FROM" FuncArgExpression ')'
 	{
 		spaceVal := ast.NewValueExpr(" ", parser.charset, parser.collation)
 		direction := &ast.TrimDirectionExpr{Direction: $3.(ast.TrimDirectionType)}
@@ -8558,7 +9206,7 @@ FunctionCallNonKeyword:
 			Args:   []ast.ExprNode{$5, spaceVal, direction},
 		}
 	}
-|	builtinTrim '(' TrimDirection Expression "FROM" Expression ')'
+|	builtinTrim '(' TrimDirection FuncArgExpression "FROM" FuncArgExpression ')'
 	{
 		direction := &ast.TrimDirectionExpr{Direction: $3.(ast.TrimDirectionType)}
 		$$ = &ast.FuncCallExpr{
@@ -8566,21 +9214,21 @@ FunctionCallNonKeyword:
 			Args:   []ast.ExprNode{$6, $4, direction},
 		}
 	}
-|	weightString '(' Expression ')'
+|	weightString '(' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3},
 		}
 	}
-|	weightString '(' Expression "AS" Char FieldLen ')'
+|	weightString '(' FuncArgExpression "AS" Char FieldLen ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
 			Args:   []ast.ExprNode{$3, ast.NewValueExpr("CHAR", parser.charset, parser.collation), ast.NewValueExpr($6, parser.charset, parser.collation)},
 		}
 	}
-|	weightString '(' Expression "AS" "BINARY" FieldLen ')'
+|	weightString '(' FuncArgExpression "AS" "BINARY" FieldLen ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
@@ -8588,7 +9236,7 @@ FunctionCallNonKeyword:
 		}
 	}
 |	FunctionNameSequence
-|	builtinTranslate '(' Expression ',' Expression ',' Expression ')'
+|	builtinTranslate '(' FuncArgExpression ',' FuncArgExpression ',' FuncArgExpression ')'
 	{
 		$$ = &ast.FuncCallExpr{
 			FnName: ast.NewCIStr($1),
@@ -8665,7 +9313,7 @@ FunctionNameSequence:
 |	NextValueForSequence
 
 SumExpr:
-	"AVG" '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+	"AVG" '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8673,15 +9321,15 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinApproxCountDistinct '(' ExpressionList ')'
+|	builtinApproxCountDistinct '(' FuncArgExpressionList ')'
 	{
 		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode), Distinct: false}
 	}
-|	builtinApproxPercentile '(' ExpressionList ')'
+|	builtinApproxPercentile '(' FuncArgExpressionList ')'
 	{
 		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode)}
 	}
-|	builtinBitAnd '(' Expression ')' OptWindowingClause
+|	builtinBitAnd '(' FuncArgExpression ')' OptWindowingClause
 	{
 		if $5 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
@@ -8689,7 +9337,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
 		}
 	}
-|	builtinBitAnd '(' "ALL" Expression ')' OptWindowingClause
+|	builtinBitAnd '(' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
@@ -8697,7 +9345,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
 		}
 	}
-|	builtinBitOr '(' Expression ')' OptWindowingClause
+|	builtinBitOr '(' FuncArgExpression ')' OptWindowingClause
 	{
 		if $5 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
@@ -8705,7 +9353,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
 		}
 	}
-|	builtinBitOr '(' "ALL" Expression ')' OptWindowingClause
+|	builtinBitOr '(' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
@@ -8713,7 +9361,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
 		}
 	}
-|	builtinBitXor '(' Expression ')' OptWindowingClause
+|	builtinBitXor '(' FuncArgExpression ')' OptWindowingClause
 	{
 		if $5 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
@@ -8721,7 +9369,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
 		}
 	}
-|	builtinBitXor '(' "ALL" Expression ')' OptWindowingClause
+|	builtinBitXor '(' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
@@ -8729,11 +9377,11 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
 		}
 	}
-|	builtinCount '(' DistinctKwd ExpressionList ')'
+|	builtinCount '(' DistinctKwd FuncArgExpressionList ')'
 	{
 		$$ = &ast.AggregateFuncExpr{F: $1, Args: $4.([]ast.ExprNode), Distinct: true}
 	}
-|	builtinCount '(' "ALL" Expression ')' OptWindowingClause
+|	builtinCount '(' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
@@ -8741,7 +9389,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
 		}
 	}
-|	builtinCount '(' Expression ')' OptWindowingClause
+|	builtinCount '(' FuncArgExpression ')' OptWindowingClause
 	{
 		if $5 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
@@ -8758,7 +9406,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: args}
 		}
 	}
-|	builtinGroupConcat '(' BuggyDefaultFalseDistinctOpt ExpressionList OrderByOptional OptGConcatSeparator ')' OptWindowingClause
+|	builtinGroupConcat '(' BuggyDefaultFalseDistinctOpt FuncArgExpressionList OrderByOptional OptGConcatSeparator ')' OptWindowingClause
 	{
 		args := $4.([]ast.ExprNode)
 		args = append(args, $6.(ast.ExprNode))
@@ -8772,7 +9420,7 @@ SumExpr:
 			$$ = agg
 		}
 	}
-|	builtinMax '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinMax '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8780,7 +9428,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinMin '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinMin '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8788,7 +9436,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinSum '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinSum '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8796,7 +9444,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinStddevPop '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinStddevPop '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: ast.AggFuncStddevPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8804,7 +9452,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: ast.AggFuncStddevPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinStddevSamp '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinStddevSamp '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8812,7 +9460,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinVarPop '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinVarPop '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: ast.AggFuncVarPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8820,7 +9468,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: ast.AggFuncVarPop, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	builtinVarSamp '(' BuggyDefaultFalseDistinctOpt Expression ')' OptWindowingClause
+|	builtinVarSamp '(' BuggyDefaultFalseDistinctOpt FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool), Spec: *($6.(*ast.WindowSpec))}
@@ -8828,7 +9476,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}, Distinct: $3.(bool)}
 		}
 	}
-|	"JSON_ARRAYAGG" '(' Expression ')' OptWindowingClause
+|	"JSON_ARRAYAGG" '(' FuncArgExpression ')' OptWindowingClause
 	{
 		if $5 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, Spec: *($5.(*ast.WindowSpec))}
@@ -8836,7 +9484,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3}}
 		}
 	}
-|	"JSON_ARRAYAGG" '(' "ALL" Expression ')' OptWindowingClause
+|	"JSON_ARRAYAGG" '(' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $6 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4}, Spec: *($6.(*ast.WindowSpec))}
@@ -8844,7 +9492,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4}}
 		}
 	}
-|	"JSON_OBJECTAGG" '(' Expression ',' Expression ')' OptWindowingClause
+|	"JSON_OBJECTAGG" '(' FuncArgExpression ',' FuncArgExpression ')' OptWindowingClause
 	{
 		if $7 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3, $5}, Spec: *($7.(*ast.WindowSpec))}
@@ -8852,7 +9500,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3, $5}}
 		}
 	}
-|	"JSON_OBJECTAGG" '(' "ALL" Expression ',' Expression ')' OptWindowingClause
+|	"JSON_OBJECTAGG" '(' "ALL" FuncArgExpression ',' FuncArgExpression ')' OptWindowingClause
 	{
 		if $8 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4, $6}, Spec: *($8.(*ast.WindowSpec))}
@@ -8860,7 +9508,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$4, $6}}
 		}
 	}
-|	"JSON_OBJECTAGG" '(' Expression ',' "ALL" Expression ')' OptWindowingClause
+|	"JSON_OBJECTAGG" '(' FuncArgExpression ',' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $8 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3, $6}, Spec: *($8.(*ast.WindowSpec))}
@@ -8868,7 +9516,7 @@ SumExpr:
 			$$ = &ast.AggregateFuncExpr{F: $1, Args: []ast.ExprNode{$3, $6}}
 		}
 	}
-|	"JSON_OBJECTAGG" '(' "ALL" Expression ',' "ALL" Expression ')' OptWindowingClause
+|	"JSON_OBJECTAGG" '(' "ALL" FuncArgExpression ',' "ALL" FuncArgExpression ')' OptWindowingClause
 	{
 		if $9 != nil {
 			$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$4, $7}, Spec: *($9.(*ast.WindowSpec))}
@@ -8909,6 +9557,10 @@ FunctionCallGeneric:
 			Args:   $5.([]ast.ExprNode),
 		}
 	}
+|	registeredFuncCall
+	{
+		$$ = $1.(*ast.FuncCallExpr)
+	}
 
 FuncDatetimePrec:
 	{
@@ -8918,11 +9570,7 @@ FuncDatetimePrec:
 	{
 		$$ = nil
 	}
-|	'(' intLit ')'
-	{
-		expr := ast.NewValueExpr($2, parser.charset, parser.collation)
-		$$ = expr
-	}
+|	Fsp
 
 TimeUnit:
 	TimestampUnit
@@ -9128,12 +9776,12 @@ CastType:
 		tp.AddFlag(mysql.BinaryFlag)
 		$$ = tp
 	}
-|	"DATETIME" OptFieldLen
+|	"DATETIME" OptFsp
 	{
 		tp := types.NewFieldType(mysql.TypeDatetime)
 		flen, _ := mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDatetime)
 		tp.SetFlen(flen)
-		tp.SetDecimal($2.(int))
+		tp.SetDecimal(parser.checkFsp($2, "CAST"))
 		if tp.GetDecimal() > 0 {
 			tp.SetFlen(tp.GetFlen() + 1 + tp.GetDecimal())
 		}
@@ -9153,12 +9801,12 @@ CastType:
 		tp.AddFlag(mysql.BinaryFlag)
 		$$ = tp
 	}
-|	"TIME" OptFieldLen
+|	"TIME" OptFsp
 	{
 		tp := types.NewFieldType(mysql.TypeDuration)
 		flen, _ := mysql.GetDefaultFieldLengthAndDecimalForCast(mysql.TypeDuration)
 		tp.SetFlen(flen)
-		tp.SetDecimal($2.(int))
+		tp.SetDecimal(parser.checkFsp($2, "CAST"))
 		if tp.GetDecimal() > 0 {
 			tp.SetFlen(tp.GetFlen() + 1 + tp.GetDecimal())
 		}
@@ -9267,12 +9915,36 @@ PriorityOpt:
 	{
 		$$ = mysql.NoPriority
 	}
-|	Priority
+|	PriorityList
+	{
+		if $1.(mysql.PriorityEnum) == mysql.DelayedPriority {
+			yylex.AppendError(ErrWarnDeprecatedSyntaxNoReplacement.FastGenByArgs("'DELAYED'", ""))
+			parser.lastErrorAsWarn()
+		}
+		$$ = $1
+	}
+
+PriorityList:
+	Priority
+|	PriorityList Priority
+	{
+		// A statement has only one priority, so a second one either repeats or
+		// contradicts the first. The error is at the second one.
+		first, second := mysql.Priority2Str[$1.(mysql.PriorityEnum)], mysql.Priority2Str[$2.(mysql.PriorityEnum)]
+		msg := first + " and " + second + " can't be used together"
+		if first == second {
+			msg = "Duplicate " + second
+		}
+		yylex.AppendError(ErrParse.GenWithStackByArgs(msg, parser.positionAt(parser.startOffset(&yyS[yypt]))))
+		return 1
+	}
 
 TableName:
 	Identifier
 	{
-		$$ = &ast.TableName{Name: ast.NewCIStr($1)}
+		tn := &ast.TableName{Name: ast.NewCIStr($1)}
+		tn.SetOriginTextPosition(yyS[yypt].offset)
+		$$ = tn
 	}
 |	Identifier '.' Identifier
 	{
@@ -9281,11 +9953,15 @@ TableName:
 			yylex.AppendError(ErrWrongDBName.GenWithStackByArgs(schema))
 			return 1
 		}
-		$$ = &ast.TableName{Schema: ast.NewCIStr(schema), Name: ast.NewCIStr($3)}
+		tn := &ast.TableName{Schema: ast.NewCIStr(schema), Name: ast.NewCIStr($3)}
+		tn.SetOriginTextPosition(yyS[yypt-2].offset)
+		$$ = tn
 	}
 |	'*' '.' Identifier
 	{
-		$$ = &ast.TableName{Schema: ast.NewCIStr("*"), Name: ast.NewCIStr($3)}
+		tn := &ast.TableName{Schema: ast.NewCIStr("*"), Name: ast.NewCIStr($3)}
+		tn.SetOriginTextPosition(yyS[yypt-2].offset)
+		$$ = tn
 	}
 
 TableNameList:
@@ -9302,11 +9978,15 @@ TableNameList:
 TableNameOptWild:
 	Identifier OptWild
 	{
-		$$ = &ast.TableName{Name: ast.NewCIStr($1)}
+		tn := &ast.TableName{Name: ast.NewCIStr($1)}
+		tn.SetOriginTextPosition(yyS[yypt-1].offset)
+		$$ = tn
 	}
 |	Identifier '.' Identifier OptWild
 	{
-		$$ = &ast.TableName{Schema: ast.NewCIStr($1), Name: ast.NewCIStr($3)}
+		tn := &ast.TableName{Schema: ast.NewCIStr($1), Name: ast.NewCIStr($3)}
+		tn.SetOriginTextPosition(yyS[yypt-3].offset)
+		$$ = tn
 	}
 
 TableAliasRefList:
@@ -9427,7 +10107,7 @@ RollbackStmt:
 	}
 |	"ROLLBACK" "TO" "SAVEPOINT" Identifier
 	{
-		$$ = &ast.RollbackStmt{SavepointName: $4}
+		$$ = &ast.RollbackStmt{SavepointName: $4, SavepointKeyword: true}
 	}
 
 CompletionTypeWithinTransaction:
@@ -9472,6 +10152,23 @@ RestartStmt:
 		$$ = &ast.RestartStmt{}
 	}
 
+/*******************************************************************
+ *
+ *  RESET PERSIST Statement
+ *
+ *  RESET PERSIST [[IF EXISTS] system_var_name]
+ *  See https://dev.mysql.com/doc/refman/8.0/en/reset-persist.html
+ *******************************************************************/
+ResetPersistStmt:
+	"RESET" "PERSIST"
+	{
+		$$ = &ast.ResetPersistStmt{}
+	}
+|	"RESET" "PERSIST" IfExists VariableName
+	{
+		$$ = &ast.ResetPersistStmt{IfExists: $3.(bool), Name: $4}
+	}
+
 HelpStmt:
 	"HELP" stringLit
 	{
@@ -9495,13 +10192,30 @@ SelectStmtBasic:
 		}
 		$$ = st
 	}
+|	"SELECT" SelectStmtOpts SelectStmtFieldList SelectStmtIntoClause HavingClause
+	{
+		st := &ast.SelectStmt{
+			SelectStmtOpts: $2.(*ast.SelectStmtOpts),
+			Distinct:       $2.(*ast.SelectStmtOpts).Distinct,
+			Fields:         $3.(*ast.FieldList),
+			Kind:           ast.SelectStmtKindSelect,
+			SelectIntoOpt:  $4.(*ast.SelectIntoOption),
+		}
+		if st.SelectStmtOpts.TableHints != nil {
+			st.TableHints = st.SelectStmtOpts.TableHints
+		}
+		if $5 != nil {
+			st.Having = $5.(*ast.HavingClause)
+		}
+		$$ = st
+	}
 
 SelectStmtFromDualTable:
 	SelectStmtBasic FromDual WhereClauseOptional
 	{
 		st := $1.(*ast.SelectStmt)
 		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
-		if lastField.Expr != nil && lastField.AsName.O == "" {
+		if lastField.Expr != nil && lastField.AsName.O == "" && st.SelectIntoOpt == nil {
 			lastEnd := yyS[yypt-1].offset - 1
 			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
 		}
@@ -9516,7 +10230,7 @@ SelectStmtFromTable:
 		st := $1.(*ast.SelectStmt)
 		st.From = $3.(*ast.TableRefsClause)
 		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
-		if lastField.Expr != nil && lastField.AsName.O == "" {
+		if lastField.Expr != nil && lastField.AsName.O == "" && st.SelectIntoOpt == nil {
 			lastEnd := parser.endOffset(&yyS[yypt-5])
 			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
 		}
@@ -9627,6 +10341,10 @@ SelectStmt:
 			st.Limit = $5.(*ast.Limit)
 		}
 		if $7 != nil {
+			if st.SelectIntoOpt != nil {
+				yylex.AppendError(yylex.Errorf("Multiple INTO clauses in one query block"))
+				return 1
+			}
 			st.SelectIntoOpt = $7.(*ast.SelectIntoOption)
 		}
 		$$ = st
@@ -9647,6 +10365,10 @@ SelectStmt:
 			st.LockInfo = $5.(*ast.SelectLockInfo)
 		}
 		if $6 != nil {
+			if st.SelectIntoOpt != nil {
+				yylex.AppendError(yylex.Errorf("Multiple INTO clauses in one query block"))
+				return 1
+			}
 			st.SelectIntoOpt = $6.(*ast.SelectIntoOption)
 		}
 		$$ = st
@@ -9664,6 +10386,10 @@ SelectStmt:
 			st.Limit = $3.(*ast.Limit)
 		}
 		if $5 != nil {
+			if st.SelectIntoOpt != nil {
+				yylex.AppendError(yylex.Errorf("Multiple INTO clauses in one query block"))
+				return 1
+			}
 			st.SelectIntoOpt = $5.(*ast.SelectIntoOption)
 		}
 		$$ = st
@@ -9989,7 +10715,7 @@ WindowFuncCall:
 	{
 		$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, Spec: $5.(ast.WindowSpec)}
 	}
-|	"LEAD" '(' Expression OptLeadLagInfo ')' OptNullTreatment WindowingClause
+|	"LEAD" '(' FuncArgExpression OptLeadLagInfo ')' OptNullTreatment WindowingClause
 	{
 		args := []ast.ExprNode{$3}
 		if $4 != nil {
@@ -9997,7 +10723,7 @@ WindowFuncCall:
 		}
 		$$ = &ast.WindowFuncExpr{Name: $1, Args: args, IgnoreNull: $6.(bool), Spec: $7.(ast.WindowSpec)}
 	}
-|	"LAG" '(' Expression OptLeadLagInfo ')' OptNullTreatment WindowingClause
+|	"LAG" '(' FuncArgExpression OptLeadLagInfo ')' OptNullTreatment WindowingClause
 	{
 		args := []ast.ExprNode{$3}
 		if $4 != nil {
@@ -10005,15 +10731,15 @@ WindowFuncCall:
 		}
 		$$ = &ast.WindowFuncExpr{Name: $1, Args: args, IgnoreNull: $6.(bool), Spec: $7.(ast.WindowSpec)}
 	}
-|	"FIRST_VALUE" '(' Expression ')' OptNullTreatment WindowingClause
+|	"FIRST_VALUE" '(' FuncArgExpression ')' OptNullTreatment WindowingClause
 	{
 		$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, IgnoreNull: $5.(bool), Spec: $6.(ast.WindowSpec)}
 	}
-|	"LAST_VALUE" '(' Expression ')' OptNullTreatment WindowingClause
+|	"LAST_VALUE" '(' FuncArgExpression ')' OptNullTreatment WindowingClause
 	{
 		$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3}, IgnoreNull: $5.(bool), Spec: $6.(ast.WindowSpec)}
 	}
-|	"NTH_VALUE" '(' Expression ',' SimpleExpr ')' OptFromFirstLast OptNullTreatment WindowingClause
+|	"NTH_VALUE" '(' FuncArgExpression ',' SimpleExpr ')' OptFromFirstLast OptNullTreatment WindowingClause
 	{
 		$$ = &ast.WindowFuncExpr{Name: $1, Args: []ast.ExprNode{$3, $5}, FromLast: $7.(bool), IgnoreNull: $8.(bool), Spec: $9.(ast.WindowSpec)}
 	}
@@ -10320,7 +11046,9 @@ LimitClause:
 LimitOption:
 	LengthNum
 	{
-		$$ = ast.NewValueExpr($1, parser.charset, parser.collation)
+		v := ast.NewValueExpr($1, parser.charset, parser.collation)
+		v.SetOriginTextPosition(yyS[yypt].offset)
+		$$ = v
 	}
 |	paramMarker
 	{
@@ -10536,7 +11264,10 @@ SelectStmtIntoOption:
 	{
 		$$ = nil
 	}
-|	"INTO" "OUTFILE" stringLit Fields Lines
+|	SelectStmtIntoClause
+
+SelectStmtIntoClause:
+	"INTO" "OUTFILE" stringLit Fields Lines
 	{
 		x := &ast.SelectIntoOption{
 			Tp:       ast.SelectIntoOutfile,
@@ -10551,6 +11282,37 @@ SelectStmtIntoOption:
 
 		$$ = x
 	}
+|	"INTO" "DUMPFILE" stringLit
+	{
+		$$ = &ast.SelectIntoOption{
+			Tp:       ast.SelectIntoDumpfile,
+			FileName: $3,
+		}
+	}
+|	"INTO" SelectIntoVariableList
+	{
+		$$ = &ast.SelectIntoOption{
+			Tp:        ast.SelectIntoVars,
+			Variables: $2.([]*ast.VariableExpr),
+		}
+	}
+
+SelectIntoVariableList:
+	SelectIntoVariable
+	{
+		$$ = []*ast.VariableExpr{$1.(*ast.VariableExpr)}
+	}
+|	SelectIntoVariableList ',' SelectIntoVariable
+	{
+		$$ = append($1.([]*ast.VariableExpr), $3.(*ast.VariableExpr))
+	}
+
+SelectIntoVariable:
+	UserVariable
+|	Identifier
+	{
+		$$ = &ast.VariableExpr{Name: $1, IsLocal: true}
+	}
 
 // See https://dev.mysql.com/doc/refman/5.7/en/subqueries.html
 SubSelect:
@@ -10951,7 +11713,9 @@ SetOprOpt:
 SetStmt:
 	"SET" VariableAssignmentList
 	{
-		$$ = &ast.SetStmt{Variables: $2.([]*ast.VariableAssignment)}
+		vars := $2.([]*ast.VariableAssignment)
+		parser.setNames(vars)
+		$$ = &ast.SetStmt{Variables: vars}
 	}
 |	"SET" "PASSWORD" EqOrAssignmentEq PasswordOpt
 	{
@@ -11162,10 +11926,18 @@ VariableAssignment:
 	{
 		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsSystem: true}
 	}
+|	"PERSIST" VariableName EqOrAssignmentEq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsGlobal: true, IsSystem: true, IsPersist: true, IsScopeKeyword: true}
+	}
+|	"PERSIST_ONLY" VariableName EqOrAssignmentEq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: $2, Value: $4, IsGlobal: true, IsSystem: true, IsPersistOnly: true, IsScopeKeyword: true}
+	}
 |	doubleAtIdentifier EqOrAssignmentEq SetExpr
 	{
 		v := strings.ToLower($1)
-		var isGlobal bool
+		var isGlobal, isPersist, isPersistOnly bool
 		if strings.HasPrefix(v, "@@global.") {
 			isGlobal = true
 			v = strings.TrimPrefix(v, "@@global.")
@@ -11173,10 +11945,16 @@ VariableAssignment:
 			v = strings.TrimPrefix(v, "@@session.")
 		} else if strings.HasPrefix(v, "@@local.") {
 			v = strings.TrimPrefix(v, "@@local.")
+		} else if strings.HasPrefix(v, "@@persist.") {
+			isGlobal, isPersist = true, true
+			v = strings.TrimPrefix(v, "@@persist.")
+		} else if strings.HasPrefix(v, "@@persist_only.") {
+			isGlobal, isPersistOnly = true, true
+			v = strings.TrimPrefix(v, "@@persist_only.")
 		} else if strings.HasPrefix(v, "@@") {
 			v = strings.TrimPrefix(v, "@@")
 		}
-		$$ = &ast.VariableAssignment{Name: v, Value: $3, IsGlobal: isGlobal, IsSystem: true}
+		$$ = &ast.VariableAssignment{Name: v, Value: $3, IsGlobal: isGlobal, IsSystem: true, IsPersist: isPersist, IsPersistOnly: isPersistOnly}
 	}
 |	singleAtIdentifier EqOrAssignmentEq Expression
 	{
@@ -12485,12 +13263,15 @@ Statement:
 |	AlterInstanceStmt
 |	AlterRangeStmt
 |	AlterSequenceStmt
+|	AlterEventStmt
 |	AlterPolicyStmt
 |	AlterResourceGroupStmt
 |	AnalyzeTableStmt
 |	BeginTransactionStmt
 |	BinlogStmt
 |	BRIEStmt
+|	CacheIndexStmt
+|	ChecksumTableStmt
 |	CommitStmt
 |	DeallocateStmt
 |	DeleteFromStmt
@@ -12502,6 +13283,8 @@ Statement:
 |	CreateIndexStmt
 |	CreateTableStmt
 |	CreateViewStmt
+|	CreateTriggerStmt
+|	CreateEventStmt
 |	CreateUserStmt
 |	CreateRoleStmt
 |	CreateBindingStmt
@@ -12519,6 +13302,8 @@ Statement:
 |	DropProcedureStmt
 |	DropPolicyStmt
 |	DropSequenceStmt
+|	DropTriggerStmt
+|	DropEventStmt
 |	DropViewStmt
 |	DropUserStmt
 |	DropResourceGroupStmt
@@ -12539,6 +13324,7 @@ Statement:
 |	InsertIntoStmt
 |	KillStmt
 |	LoadDataStmt
+|	LoadIndexIntoCacheStmt
 |	LoadStatsStmt
 |	LockStatsStmt
 |	UnlockStatsStmt
@@ -12584,6 +13370,7 @@ Statement:
 |	LockTablesStmt
 |	ShutdownStmt
 |	RestartStmt
+|	ResetPersistStmt
 |	RecommendIndexStmt
 |	HelpStmt
 |	NonTransactionalDMLStmt
@@ -12651,9 +13438,15 @@ StatementList:
 		if $1 != nil {
 			s := $1
 			if lexer, ok := yylex.(stmtTexter); ok {
+				if !parser.lexer.skipPositionRecording {
+					start, end := lexer.stmtOffsets()
+					s.SetOriginTextPosition(start)
+					s.SetEndPosition(end)
+				}
 				s.SetText(parser.lexer.client, lexer.stmtText())
 			}
 			parser.result = append(parser.result, s)
+			parser.stmtParsed()
 		}
 	}
 |	StatementList ';' Statement
@@ -12661,9 +13454,15 @@ StatementList:
 		if $3 != nil {
 			s := $3
 			if lexer, ok := yylex.(stmtTexter); ok {
+				if !parser.lexer.skipPositionRecording {
+					start, end := lexer.stmtOffsets()
+					s.SetOriginTextPosition(start)
+					s.SetEndPosition(end)
+				}
 				s.SetText(parser.lexer.client, lexer.stmtText())
 			}
 			parser.result = append(parser.result, s)
+			parser.stmtParsed()
 		}
 	}
 
@@ -12831,10 +13630,18 @@ TableOption:
 	}
 |	"COMPRESSION" EqOpt stringLit
 	{
+		if parser.storageOptionCheck && !ast.IsValidCompression($3) {
+			yylex.AppendError(yylex.Errorf("Invalid compression algorithm '%s', the valid ones are 'zlib', 'lz4' and 'none'", $3))
+			return 1
+		}
 		$$ = &ast.TableOption{Tp: ast.TableOptionCompression, StrValue: $3}
 	}
 |	"KEY_BLOCK_SIZE" EqOpt LengthNum
 	{
+		if parser.storageOptionCheck && !ast.IsValidKeyBlockSize($3.(uint64)) {
+			yylex.AppendError(yylex.Errorf("Invalid KEY_BLOCK_SIZE = %d, the valid sizes are 0, 1, 2, 4, 8 and 16", $3.(uint64)))
+			return 1
+		}
 		$$ = &ast.TableOption{Tp: ast.TableOptionKeyBlockSize, UintValue: $3.(uint64)}
 	}
 |	"DELAY_KEY_WRITE" EqOpt LengthNum
@@ -13104,6 +13911,7 @@ Type:
 	NumericType
 |	StringType
 |	DateAndTimeType
+|	SpatialType
 
 NumericType:
 	IntegerType OptFieldLen FieldOpts
@@ -13601,37 +14409,81 @@ OptCharsetWithOptBinary:
 		}
 	}
 
+SpatialType:
+	SpatialTypeName
+	{
+		tp := types.NewFieldType(mysql.TypeGeometry)
+		tp.SetGeometryType($1.(types.GeometryType))
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		$$ = tp
+	}
+
+SpatialTypeName:
+	"GEOMETRY"
+	{
+		$$ = types.GeometryTypeGeometry
+	}
+|	"POINT"
+	{
+		$$ = types.GeometryTypePoint
+	}
+|	"LINESTRING"
+	{
+		$$ = types.GeometryTypeLineString
+	}
+|	"POLYGON"
+	{
+		$$ = types.GeometryTypePolygon
+	}
+|	"MULTIPOINT"
+	{
+		$$ = types.GeometryTypeMultiPoint
+	}
+|	"MULTILINESTRING"
+	{
+		$$ = types.GeometryTypeMultiLineString
+	}
+|	"MULTIPOLYGON"
+	{
+		$$ = types.GeometryTypeMultiPolygon
+	}
+|	"GEOMETRYCOLLECTION"
+	{
+		$$ = types.GeometryTypeGeometryCollection
+	}
+
 DateAndTimeType:
 	"DATE"
 	{
 		tp := types.NewFieldType(mysql.TypeDate)
 		$$ = tp
 	}
-|	"DATETIME" OptFieldLen
+|	"DATETIME" OptFsp
 	{
 		tp := types.NewFieldType(mysql.TypeDatetime)
 		tp.SetFlen(mysql.MaxDatetimeWidthNoFsp)
-		tp.SetDecimal($2.(int))
+		tp.SetDecimal(parser.checkFsp($2, "DATETIME"))
 		if tp.GetDecimal() > 0 {
 			tp.SetFlen(tp.GetFlen() + 1 + tp.GetDecimal())
 		}
 		$$ = tp
 	}
-|	"TIMESTAMP" OptFieldLen
+|	"TIMESTAMP" OptFsp
 	{
 		tp := types.NewFieldType(mysql.TypeTimestamp)
 		tp.SetFlen(mysql.MaxDatetimeWidthNoFsp)
-		tp.SetDecimal($2.(int))
+		tp.SetDecimal(parser.checkFsp($2, "TIMESTAMP"))
 		if tp.GetDecimal() > 0 {
 			tp.SetFlen(tp.GetFlen() + 1 + tp.GetDecimal())
 		}
 		$$ = tp
 	}
-|	"TIME" OptFieldLen
+|	"TIME" OptFsp
 	{
 		tp := types.NewFieldType(mysql.TypeDuration)
 		tp.SetFlen(mysql.MaxDurationWidthNoFsp)
-		tp.SetDecimal($2.(int))
+		tp.SetDecimal(parser.checkFsp($2, "TIME"))
 		if tp.GetDecimal() > 0 {
 			tp.SetFlen(tp.GetFlen() + 1 + tp.GetDecimal())
 		}
@@ -13660,6 +14512,34 @@ OptFieldLen:
 	}
 |	FieldLen
 
+Fsp:
+	'(' NUM ')'
+	{
+		$$ = parser.newFspArg($2, parser.startOffset(&yyS[yypt-1]), parser.endOffset(&yyS[yypt]))
+	}
+|	'(' InvalidFsp ')'
+	{
+		$$ = parser.newFspArg(nil, parser.startOffset(&yyS[yypt-1]), parser.endOffset(&yyS[yypt]))
+	}
+
+OptFsp:
+	{
+		$$ = nil
+	}
+|	Fsp
+
+InvalidFsp:
+	'-' NUM
+	{}
+|	decLit
+	{}
+|	floatLit
+	{}
+|	stringLit
+	{}
+|	"NULL"
+	{}
+
 FieldOpt:
 	"UNSIGNED"
 	{
@@ -13829,7 +14709,7 @@ UpdateStmt:
 	}
 
 UpdateStmtNoWith:
-	"UPDATE" TableOptimizerHintsOpt PriorityOpt IgnoreOptional TableRef "SET" AssignmentList WhereClauseOptional OrderByOptional LimitClause
+	"UPDATE" TableOptimizerHintsOpt PriorityOpt IgnoreOptional TableRef "SET" AssignmentList WhereClauseOptional OrderByOptional LimitClause ReturningOptional
 	{
 		var refs *ast.Join
 		if x, ok := $5.(*ast.Join); ok {
@@ -13855,6 +14735,9 @@ UpdateStmtNoWith:
 		if $10 != nil {
 			st.Limit = $10.(*ast.Limit)
 		}
+		if $11 != nil {
+			st.Returning = $11.(*ast.FieldList)
+		}
 		$$ = st
 	}
 |	"UPDATE" TableOptimizerHintsOpt PriorityOpt IgnoreOptional TableRefs "SET" AssignmentList WhereClauseOptional
@@ -13958,6 +14841,18 @@ AlterUserStmt:
 			CurrentAuth: auth,
 		}
 	}
+|	"ALTER" "USER" IfExists Username "DEFAULT" "ROLE" SetDefaultRoleOpt
+	{
+		tmp := $7.(*ast.SetRoleStmt)
+		$$ = &ast.AlterUserStmt{
+			IfExists: $3.(bool),
+			Specs:    []*ast.UserSpec{{User: $4.(*auth.UserIdentity)}},
+			DefaultRole: &ast.DefaultRoleOption{
+				SetRoleOpt: tmp.SetRoleOpt,
+				RoleList:   tmp.RoleList,
+			},
+		}
+	}
 
 /* See https://dev.mysql.com/doc/refman/8.0/en/alter-instance.html */
 AlterInstanceStmt:
@@ -13977,16 +14872,42 @@ InstanceOption:
 	"RELOAD" "TLS"
 	{
 		$$ = &ast.AlterInstanceStmt{
+			Action:    ast.AlterInstanceReloadTLS,
 			ReloadTLS: true,
 		}
 	}
 |	"RELOAD" "TLS" "NO" "ROLLBACK" "ON" "ERROR"
 	{
 		$$ = &ast.AlterInstanceStmt{
+			Action:            ast.AlterInstanceReloadTLS,
 			ReloadTLS:         true,
 			NoRollbackOnError: true,
 		}
 	}
+|	"ROTATE" Identifier "MASTER" "KEY"
+	{
+		if !strings.EqualFold($2, "INNODB") {
+			yylex.AppendError(ErrSyntax)
+			return 1
+		}
+		$$ = &ast.AlterInstanceStmt{Action: ast.AlterInstanceRotateInnodbMasterKey}
+	}
+|	"ENABLE" Identifier Identifier
+	{
+		if !strings.EqualFold($2, "INNODB") || !strings.EqualFold($3, "REDO_LOG") {
+			yylex.AppendError(ErrSyntax)
+			return 1
+		}
+		$$ = &ast.AlterInstanceStmt{Action: ast.AlterInstanceEnableInnodbRedoLog}
+	}
+|	"DISABLE" Identifier Identifier
+	{
+		if !strings.EqualFold($2, "INNODB") || !strings.EqualFold($3, "REDO_LOG") {
+			yylex.AppendError(ErrSyntax)
+			return 1
+		}
+		$$ = &ast.AlterInstanceStmt{Action: ast.AlterInstanceDisableInnodbRedoLog}
+	}
 
 UserSpec:
 	Username AuthOption
@@ -15035,7 +15956,7 @@ RevokeRoleStmt:
  * for load stmt with format see https://github.com/pingcap/tidb/issues/40499
  *******************************************************************************************/
 LoadDataStmt:
-	"LOAD" "DATA" LowPriorityOpt LocalOpt "INFILE" stringLit FormatOpt DuplicateOpt "INTO" "TABLE" TableName CharsetOpt Fields Lines IgnoreLines ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt LoadDataOptionListOpt
+	"LOAD" "DATA" LowPriorityOpt LocalOpt "INFILE" stringLit FormatOpt DuplicateOpt "INTO" "TABLE" TableName PartitionNameListOpt CharsetOpt Fields Lines IgnoreLines ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt LoadDataOptionListOpt
 	{
 		x := &ast.LoadDataStmt{
 			LowPriority:        $3.(bool),
@@ -15044,13 +15965,14 @@ LoadDataStmt:
 			Format:             $7.(*string),
 			OnDuplicate:        $8.(ast.OnDuplicateKeyHandlingType),
 			Table:              $11.(*ast.TableName),
-			Charset:            $12.(*string),
-			FieldsInfo:         $13.(*ast.FieldsClause),
-			LinesInfo:          $14.(*ast.LinesClause),
-			IgnoreLines:        $15.(*uint64),
-			ColumnsAndUserVars: $16.([]*ast.ColumnNameOrUserVar),
-			ColumnAssignments:  $17.([]*ast.Assignment),
-			Options:            $18.([]*ast.LoadDataOpt),
+			PartitionNames:     $12.([]ast.CIStr),
+			Charset:            $13.(*string),
+			FieldsInfo:         $14.(*ast.FieldsClause),
+			LinesInfo:          $15.(*ast.LinesClause),
+			IgnoreLines:        $16.(*uint64),
+			ColumnsAndUserVars: $17.([]*ast.ColumnNameOrUserVar),
+			ColumnAssignments:  $18.([]*ast.Assignment),
+			Options:            $19.([]*ast.LoadDataOpt),
 		}
 		if $4 != nil {
 			x.FileLocRef = ast.FileLocClient
@@ -15099,6 +16021,11 @@ IgnoreLines:
 		v := getUint64FromNUM($2)
 		$$ = &v
 	}
+|	"IGNORE" NUM "ROWS"
+	{
+		v := getUint64FromNUM($2)
+		$$ = &v
+	}
 
 CharsetOpt:
 	{
@@ -14,6 +14,16 @@
 
 package parser_driver
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
 // Basic charset constants needed for self-contained operation
 const (
 	// CharsetBin is used for marking binary charset.
@@ -22,6 +32,14 @@ const (
 	CharsetUTF8 = "utf8"
 	// CharsetUTF8MB4 represents 4 bytes utf8, which works the same way as utf8 in Go.
 	CharsetUTF8MB4 = "utf8mb4"
+	// CharsetASCII is a subset of UTF8.
+	CharsetASCII = "ascii"
+	// CharsetLatin1 is a single byte charset.
+	CharsetLatin1 = "latin1"
+	// CharsetGBK is a chinese character set.
+	CharsetGBK = "gbk"
+	// CharsetGB18030 is a chinese character set.
+	CharsetGB18030 = "gb18030"
 )
 
 const (
@@ -44,6 +62,9 @@ const (
 const (
 	EncodingTpUTF8 = iota
 	EncodingTpASCII
+	EncodingTpLatin1
+	EncodingTpGBK
+	EncodingTpGB18030
 )
 
 // Encoding provides a minimal interface for character encoding operations
@@ -74,13 +95,22 @@ func GetCollationByName(name string) (*Collation, error) {
 	return &Collation{CharsetName: CharsetUTF8MB4}, nil
 }
 
-// FindEncodingTakeUTF8AsNoop returns a basic encoding handler
+// FindEncodingTakeUTF8AsNoop returns the encoding of charset like FindEncoding,
+// but the one of utf8 and utf8mb4 doesn't check the strings.
 func FindEncodingTakeUTF8AsNoop(charset string) Encoding {
-	return &basicEncoding{charset: charset}
+	if charset == CharsetUTF8 || charset == CharsetUTF8MB4 {
+		return &basicEncoding{charset: charset}
+	}
+	return FindEncoding(charset)
 }
 
-// FindEncoding returns a basic encoding handler
+// FindEncoding returns the encoding of charset. The strings of utf8, utf8mb4,
+// ascii, latin1, gbk and gb18030 are checked, the ones of the other charsets are
+// taken as valid.
 func FindEncoding(charset string) Encoding {
+	if enc, ok := encodings[strings.ToLower(charset)]; ok {
+		return enc
+	}
 	return &basicEncoding{charset: charset}
 }
 
@@ -113,8 +143,194 @@ func (e *basicEncoding) IsValid(s []byte) bool {
 	return true
 }
 
-// EncodingUTF8MB3StrictImpl provides a basic UTF8 encoding for compatibility
-var EncodingUTF8MB3StrictImpl = &basicEncoding{charset: CharsetUTF8}
+// EncodingUTF8MB3StrictImpl is the encoding of utf8 which rejects the 4 bytes
+// characters.
+var EncodingUTF8MB3StrictImpl Encoding = &checkedEncoding{charset: CharsetUTF8, tp: EncodingTpUTF8, next: nextUTF8MB3}
+
+var encodings = map[string]Encoding{
+	CharsetUTF8:    &checkedEncoding{charset: CharsetUTF8, tp: EncodingTpUTF8, next: nextUTF8},
+	CharsetUTF8MB4: &checkedEncoding{charset: CharsetUTF8MB4, tp: EncodingTpUTF8, next: nextUTF8},
+	CharsetASCII:   &checkedEncoding{charset: CharsetASCII, tp: EncodingTpASCII, next: nextASCII},
+	// latin1 is stored as utf8 for compatibility, like TiDB does.
+	CharsetLatin1:  &checkedEncoding{charset: CharsetLatin1, tp: EncodingTpLatin1, next: nextLatin1},
+	CharsetGBK:     &checkedEncoding{charset: CharsetGBK, tp: EncodingTpGBK, next: nextGBK, codec: simplifiedchinese.GBK},
+	CharsetGB18030: &checkedEncoding{charset: CharsetGB18030, tp: EncodingTpGB18030, next: nextGB18030, codec: simplifiedchinese.GB18030},
+}
+
+// InvalidEncodingError is returned by Transform with OpDecode for a string which
+// isn't valid in its charset.
+type InvalidEncodingError struct {
+	Charset string
+	// Offset is the offset of the first invalid byte sequence in the string.
+	Offset int
+	// Bytes is the invalid byte sequence.
+	Bytes []byte
+}
+
+// Error implements the error interface.
+func (e *InvalidEncodingError) Error() string {
+	return fmt.Sprintf("Invalid %s character string: '%X' at offset %d", e.Charset, e.Bytes, e.Offset)
+}
+
+// checkedEncoding is the Encoding of a charset whose strings are checked one
+// character at a time, like MySQL does for well-formed strings.
+type checkedEncoding struct {
+	charset string
+	tp      int
+	// next returns the length of the character at the start of src, or 0 if it
+	// isn't valid.
+	next func(src []byte) int
+	// codec converts the characters from and to UTF-8, nil for the charsets
+	// stored as UTF-8.
+	codec encoding.Encoding
+}
+
+// Tp implements Encoding interface.
+func (e *checkedEncoding) Tp() int {
+	return e.tp
+}
+
+// IsValid implements Encoding interface, s is valid if all its characters are.
+func (e *checkedEncoding) IsValid(s []byte) bool {
+	offset, _ := e.firstInvalid(s)
+	return offset < 0
+}
+
+// firstInvalid returns the offset and the length of the first invalid sequence
+// of src, or -1 if src is valid. The invalid sequence runs up to the next byte
+// starting a valid character.
+func (e *checkedEncoding) firstInvalid(src []byte) (offset, n int) {
+	for i := 0; i < len(src); {
+		if l := e.next(src[i:]); l > 0 {
+			i += l
+			continue
+		}
+		end := i + 1
+		for end < len(src) && e.next(src[end:]) == 0 {
+			end++
+		}
+		return i, end - i
+	}
+	return -1, 0
+}
+
+// Transform implements Encoding interface. OpDecode converts src to UTF-8 up to
+// its first invalid sequence, and returns an *InvalidEncodingError locating it.
+// OpReplace returns src with '?' in place of the invalid sequences. OpEncodeNoErr
+// converts the UTF-8 src to the charset, with '?' in place of the characters the
+// charset doesn't have.
+func (e *checkedEncoding) Transform(dst, src []byte, op int) ([]byte, error) {
+	dst = dst[:0]
+	switch op {
+	case OpDecode:
+		offset, n := e.firstInvalid(src)
+		valid := src
+		var err error
+		if offset >= 0 {
+			valid = src[:offset]
+			err = &InvalidEncodingError{Charset: e.charset, Offset: offset, Bytes: slices.Clone(src[offset : offset+n])}
+		}
+		if e.codec == nil {
+			return append(dst, valid...), err
+		}
+		decoded, _ := e.codec.NewDecoder().Bytes(valid)
+		return append(dst, decoded...), err
+	case OpReplace:
+		for len(src) > 0 {
+			offset, n := e.firstInvalid(src)
+			if offset < 0 {
+				return append(dst, src...), nil
+			}
+			dst = append(append(dst, src[:offset]...), '?')
+			src = src[offset+n:]
+		}
+		return dst, nil
+	case OpEncodeNoErr:
+		if e.codec == nil && e.tp != EncodingTpASCII {
+			return append(dst, src...), nil
+		}
+		var encoder *encoding.Encoder
+		if e.codec != nil {
+			encoder = e.codec.NewEncoder()
+		}
+		for len(src) > 0 {
+			r, size := utf8.DecodeRune(src)
+			switch {
+			case r < utf8.RuneSelf && size == 1:
+				dst = append(dst, src[0])
+			case encoder != nil && r != utf8.RuneError:
+				encoded, err := encoder.Bytes(src[:size])
+				if err != nil {
+					encoded = []byte{'?'}
+				}
+				dst = append(dst, encoded...)
+			default:
+				dst = append(dst, '?')
+			}
+			src = src[size:]
+		}
+		return dst, nil
+	}
+	return append(dst, src...), nil
+}
+
+func nextUTF8(src []byte) int {
+	r, n := utf8.DecodeRune(src)
+	if r == utf8.RuneError && n <= 1 {
+		return 0
+	}
+	return n
+}
+
+func nextUTF8MB3(src []byte) int {
+	if n := nextUTF8(src); n < 4 {
+		return n
+	}
+	return 0
+}
+
+func nextASCII(src []byte) int {
+	if src[0] < utf8.RuneSelf {
+		return 1
+	}
+	return 0
+}
+
+func nextLatin1([]byte) int {
+	return 1
+}
+
+// isGBKHead reports whether c starts a multi-byte character of gbk and gb18030.
+func isGBKHead(c byte) bool {
+	return c >= 0x81 && c <= 0xfe
+}
+
+// isGBKTail reports whether c ends a 2 bytes character of gbk and gb18030.
+func isGBKTail(c byte) bool {
+	return c >= 0x40 && c <= 0xfe && c != 0x7f
+}
+
+func nextGBK(src []byte) int {
+	switch {
+	case src[0] < utf8.RuneSelf:
+		return 1
+	case len(src) >= 2 && isGBKHead(src[0]) && isGBKTail(src[1]):
+		return 2
+	}
+	return 0
+}
+
+func nextGB18030(src []byte) int {
+	if n := nextGBK(src); n > 0 {
+		return n
+	}
+	// The 4 bytes characters are head, digit, head, digit.
+	isDigit := func(c byte) bool { return c >= 0x30 && c <= 0x39 }
+	if len(src) >= 4 && isGBKHead(src[0]) && isDigit(src[1]) && isGBKHead(src[2]) && isDigit(src[3]) {
+		return 4
+	}
+	return 0
+}
 
 // NewCollationEnabled returns whether new collation is enabled
 func NewCollationEnabled() bool {
@@ -137,6 +353,10 @@ func GetCharsetInfo(charset string) (*CharsetInfo, error) {
 		maxlen = 3
 	case CharsetUTF8MB4:
 		maxlen = 4
+	case CharsetGBK:
+		maxlen = 2
+	case CharsetGB18030:
+		maxlen = 4
 	case CharsetBin:
 		maxlen = 1
 	}
@@ -0,0 +1,82 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodingValidation(t *testing.T) {
+	for _, c := range []struct {
+		charset string
+		str     string
+		offset  int
+		invalid string
+	}{
+		{CharsetGBK, "a\xb2\xe2b", -1, ""},
+		// A truncated multi-byte character.
+		{CharsetGBK, "\xb2\xe2\xb2", 2, "\xb2"},
+		{CharsetGBK, "a\x81\x7fb", 1, "\x81"},
+		{CharsetGBK, "\x80\xffa", 0, "\x80\xff"},
+		{CharsetGB18030, "\x81\x39\xef\x30\xb2\xe2", -1, ""},
+		{CharsetGB18030, "a\x81\x39\xef", 1, "\x81"},
+		{CharsetASCII, "abc", -1, ""},
+		{CharsetASCII, "ab\x80c", 2, "\x80"},
+		{CharsetLatin1, "\x80\xff", -1, ""},
+		{CharsetUTF8MB4, "a曉😀", -1, ""},
+		{CharsetUTF8MB4, "a\xe6\x9b", 1, "\xe6\x9b"},
+		{"UTF8MB4", "\xff曉", 0, "\xff"},
+		// The charsets which aren't checked.
+		{CharsetBin, "\xff", -1, ""},
+		{"cp1250", "\xff", -1, ""},
+	} {
+		enc := FindEncoding(c.charset)
+		require.Equal(t, c.offset < 0, enc.IsValid([]byte(c.str)), "%s %q", c.charset, c.str)
+		decoded, err := enc.Transform(nil, []byte(c.str), OpDecode)
+		if c.offset < 0 {
+			require.NoError(t, err)
+			continue
+		}
+		invalid, ok := err.(*InvalidEncodingError)
+		require.True(t, ok, "%s %q: %v", c.charset, c.str, err)
+		require.Equal(t, c.offset, invalid.Offset)
+		require.Equal(t, []byte(c.invalid), invalid.Bytes)
+		// The valid prefix is decoded.
+		valid, err := enc.Transform(nil, []byte(c.str[:c.offset]), OpDecode)
+		require.NoError(t, err)
+		require.Equal(t, valid, decoded)
+	}
+
+	gbk := FindEncoding(CharsetGBK)
+	decoded, err := gbk.Transform(nil, []byte("a\xb2\xe2"), OpDecode)
+	require.NoError(t, err)
+	require.Equal(t, "a测", string(decoded))
+	replaced, err := gbk.Transform(nil, []byte("\xb2\xe2\x80\xffa\x81"), OpReplace)
+	require.NoError(t, err)
+	require.Equal(t, "\xb2\xe2?a?", string(replaced))
+	encoded, err := gbk.Transform(nil, []byte("a测😀"), OpEncodeNoErr)
+	require.NoError(t, err)
+	require.Equal(t, "a\xb2\xe2?", string(encoded))
+	encoded, err = FindEncoding(CharsetASCII).Transform(nil, []byte("a测"), OpEncodeNoErr)
+	require.NoError(t, err)
+	require.Equal(t, "a?", string(encoded))
+
+	// utf8 accepts the 4 bytes characters unless it's strict.
+	require.True(t, FindEncoding(CharsetUTF8).IsValid([]byte("😀")))
+	require.False(t, EncodingUTF8MB3StrictImpl.IsValid([]byte("😀")))
+	require.True(t, FindEncodingTakeUTF8AsNoop(CharsetUTF8MB4).IsValid([]byte("\xff")))
+	require.False(t, FindEncodingTakeUTF8AsNoop(CharsetGBK).IsValid([]byte("\xff")))
+}
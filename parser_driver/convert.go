@@ -577,13 +577,16 @@ func ConvertJSONToInt64(ctx Context, j BinaryJSON, unsigned bool) (int64, error)
 	return ConvertJSONToInt(ctx, j, unsigned, mysql.TypeLonglong)
 }
 
-// ConvertJSONToInt casts JSON into int by type.
+// ConvertJSONToInt casts JSON into int by type. A float is rounded, or
+// truncated toward zero if the TruncateJSONFloatToInt flag of ctx is set. The
+// truncation of a string, e.g. "123abc", is handled by ctx as an error
+// quoting the JSON text.
 func ConvertJSONToInt(ctx Context, j BinaryJSON, unsigned bool, tp byte) (int64, error) {
 	switch j.TypeCode {
 	case JSONTypeCodeObject, JSONTypeCodeArray, JSONTypeCodeOpaque, JSONTypeCodeDate, JSONTypeCodeDatetime, JSONTypeCodeTimestamp, JSONTypeCodeDuration:
 		return 0, ctx.HandleTruncate(ErrTruncatedWrongVal.GenWithStackByArgs("INTEGER", j.String()))
 	case JSONTypeCodeLiteral:
-		switch j.Value[0] {
+		switch j.literal() {
 		case JSONLiteralFalse:
 			return 0, nil
 		case JSONLiteralNil:
@@ -614,6 +617,9 @@ func ConvertJSONToInt(ctx Context, j BinaryJSON, unsigned bool, tp byte) (int64,
 		return ConvertUintToInt(u, uBound, tp)
 	case JSONTypeCodeFloat64:
 		f := j.GetFloat64()
+		if ctx.Flags().TruncateJSONFloatToInt {
+			f = math.Trunc(f)
+		}
 		if !unsigned {
 			lBound := IntegerSignedLowerBound(tp)
 			uBound := IntegerSignedUpperBound(tp)
@@ -624,28 +630,39 @@ func ConvertJSONToInt(ctx Context, j BinaryJSON, unsigned bool, tp byte) (int64,
 		u, err := ConvertFloatToUint(ctx.Flags(), f, bound, tp)
 		return int64(u), err
 	case JSONTypeCodeString:
-		str := j.GetString()
 		// The behavior of casting json string as an integer is consistent with casting a string as an integer.
-		// See the `builtinCastStringAsIntSig` in `expression` pkg. The only difference is that this function
-		// doesn't append any warning. This behavior is compatible with MySQL.
-		isNegative := len(str) > 1 && str[0] == '-'
-		if !isNegative {
-			r, err := StrToUint(ctx, str, false)
-			return int64(r), err
+		// See the `builtinCastStringAsIntSig` in `expression` pkg. The only differences are that the error of
+		// a truncation quotes the JSON text, and that a negative string cast as unsigned gives its complement
+		// without a warning. This behavior is compatible with MySQL.
+		str := j.GetString()
+		rec := &truncationRecorder{Context: ctx}
+		var (
+			i   int64
+			err error
+		)
+		if isNegative := len(str) > 1 && str[0] == '-'; isNegative {
+			i, err = StrToInt(rec, str, false)
+		} else {
+			var u uint64
+			u, err = StrToUint(rec, str, false)
+			i = int64(u)
 		}
-
-		return StrToInt(ctx, str, false)
+		if err == nil && rec.truncated {
+			err = ctx.HandleTruncate(ErrTruncatedWrongVal.GenWithStackByArgs("INTEGER", j.String()))
+		}
+		return i, err
 	}
 	return 0, errors.New("Unknown type code in JSON")
 }
 
-// ConvertJSONToFloat casts JSON into float64.
+// ConvertJSONToFloat casts JSON into float64. The truncation of a string, e.g.
+// "1.5abc", is handled by ctx as an error quoting the JSON text.
 func ConvertJSONToFloat(ctx Context, j BinaryJSON) (float64, error) {
 	switch j.TypeCode {
 	case JSONTypeCodeObject, JSONTypeCodeArray, JSONTypeCodeOpaque, JSONTypeCodeDate, JSONTypeCodeDatetime, JSONTypeCodeTimestamp, JSONTypeCodeDuration:
 		return 0, ctx.HandleTruncate(ErrTruncatedWrongVal.GenWithStackByArgs("FLOAT", j.String()))
 	case JSONTypeCodeLiteral:
-		switch j.Value[0] {
+		switch j.literal() {
 		case JSONLiteralFalse:
 			return 0, nil
 		case JSONLiteralNil:
@@ -660,12 +677,31 @@ func ConvertJSONToFloat(ctx Context, j BinaryJSON) (float64, error) {
 	case JSONTypeCodeFloat64:
 		return j.GetFloat64(), nil
 	case JSONTypeCodeString:
-		str := j.GetString()
-		return StrToFloat(ctx, str, false)
+		rec := &truncationRecorder{Context: ctx}
+		f, err := StrToFloat(rec, j.GetString(), false)
+		if err == nil && rec.truncated {
+			err = ctx.HandleTruncate(ErrTruncatedWrongVal.GenWithStackByArgs("FLOAT", j.String()))
+		}
+		return f, err
 	}
 	return 0, errors.New("Unknown type code in JSON")
 }
 
+// truncationRecorder is a Context which records the truncations instead of
+// handling them, so that the caller handles them with its own error.
+type truncationRecorder struct {
+	Context
+	truncated bool
+}
+
+// HandleTruncate implements Context interface.
+func (r *truncationRecorder) HandleTruncate(err error) error {
+	if err != nil {
+		r.truncated = true
+	}
+	return nil
+}
+
 // ConvertJSONToDecimal casts JSON into decimal.
 func ConvertJSONToDecimal(ctx Context, j BinaryJSON) (*MyDecimal, error) {
 	var err error = nil
@@ -0,0 +1,153 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_driver
+
+import (
+	"testing"
+
+	"github.com/abbychau/mysql-parser/parser_driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertJSONToInt(t *testing.T) {
+	ctx := &StmtContext{}
+	for _, tt := range []struct {
+		j        BinaryJSON
+		unsigned bool
+		expected int64
+	}{
+		{CreateBinaryJSON("123"), false, 123},
+		{CreateBinaryJSON("-123"), false, -123},
+		// The strings are cast as integers without the precision loss of a
+		// float.
+		{CreateBinaryJSON("9007199254740993"), false, 9007199254740993},
+		{CreateBinaryJSON("9007199254740993"), true, 9007199254740993},
+		// A negative string cast as unsigned gives its complement.
+		{CreateBinaryJSON("-5"), true, -5},
+		{CreateBinaryJSON(true), false, 1},
+		{CreateBinaryJSON(true), true, 1},
+		{CreateBinaryJSON(false), false, 0},
+		{CreateBinaryJSON(int64(-7)), false, -7},
+		{CreateBinaryJSON(uint64(7)), true, 7},
+		{CreateBinaryJSON(2.5), false, 2},
+		{CreateBinaryJSON(3.5), false, 4},
+		{CreateBinaryJSON(-2.7), false, -3},
+		{CreateBinaryJSON(2.7), true, 3},
+	} {
+		i, err := ConvertJSONToInt64(ctx, tt.j, tt.unsigned)
+		require.NoError(t, err, tt.j.String())
+		require.Equal(t, tt.expected, i, tt.j.String())
+	}
+	require.Equal(t, uint64(18446744073709551611), func() uint64 {
+		i, _ := ConvertJSONToInt64(ctx, CreateBinaryJSON("-5"), true)
+		return uint64(i)
+	}())
+
+	// The floats are truncated toward zero if the flag is set.
+	flags := ctx.Flags()
+	flags.TruncateJSONFloatToInt = true
+	ctx.SetFlags(flags)
+	for f, expected := range map[float64]int64{2.7: 2, 3.5: 3, -2.7: -2, 0.9: 0} {
+		i, err := ConvertJSONToInt(ctx, CreateBinaryJSON(f), false, mysql.TypeLonglong)
+		require.NoError(t, err)
+		require.Equal(t, expected, i, f)
+	}
+	i, err := ConvertJSONToInt(ctx, CreateBinaryJSON(2.7), true, mysql.TypeLonglong)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), i)
+}
+
+func TestConvertJSONTruncation(t *testing.T) {
+	ctx := &StmtContext{}
+
+	// The error quotes the JSON text.
+	i, err := ConvertJSONToInt64(ctx, CreateBinaryJSON("123abc"), false)
+	require.True(t, ErrTruncatedWrongVal.Equal(err))
+	require.ErrorContains(t, err, `"123abc"`)
+	require.Equal(t, int64(123), i)
+	i, err = ConvertJSONToInt64(ctx, CreateBinaryJSON("-12x"), true)
+	require.ErrorContains(t, err, `"-12x"`)
+	require.Equal(t, int64(-12), i)
+	_, err = ConvertJSONToInt64(ctx, CreateBinaryJSON(nil), false)
+	require.ErrorContains(t, err, "null")
+	f, err := ConvertJSONToFloat(ctx, CreateBinaryJSON("1.5abc"))
+	require.True(t, ErrTruncatedWrongVal.Equal(err))
+	require.ErrorContains(t, err, `"1.5abc"`)
+	require.Equal(t, 1.5, f)
+
+	// A truncation is a single warning if the context treats them as
+	// warnings.
+	ctx.SetFlags(ContextFlags{truncateAsWarning: true})
+	i, err = ConvertJSONToInt64(ctx, CreateBinaryJSON("123abc"), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(123), i)
+	require.Len(t, ctx.warnings, 1)
+	require.ErrorContains(t, ctx.warnings[0], `"123abc"`)
+	f, err = ConvertJSONToFloat(ctx, CreateBinaryJSON("1e400"))
+	require.NoError(t, err)
+	require.Greater(t, f, 1e300)
+	require.Len(t, ctx.warnings, 2)
+	require.ErrorContains(t, ctx.warnings[1], `"1e400"`)
+}
+
+func TestConvertJSONToFloat(t *testing.T) {
+	ctx := &StmtContext{}
+	for _, tt := range []struct {
+		j        BinaryJSON
+		expected float64
+	}{
+		// The float has the precision loss of a float64.
+		{CreateBinaryJSON("9007199254740993"), 9007199254740992},
+		{CreateBinaryJSON("-1.25"), -1.25},
+		{CreateBinaryJSON(true), 1},
+		{CreateBinaryJSON(false), 0},
+		{CreateBinaryJSON(uint64(18446744073709551615)), 18446744073709551615},
+	} {
+		f, err := ConvertJSONToFloat(ctx, tt.j)
+		require.NoError(t, err, tt.j.String())
+		require.Equal(t, tt.expected, f, tt.j.String())
+	}
+}
+
+func TestStmtContextWarnings(t *testing.T) {
+	ctx := &StmtContext{}
+	ctx.SetFlags(ContextFlags{truncateAsWarning: true})
+	_, err := ParseTime(ctx, "2020-01-01 10:00:00 x", mysql.TypeDatetime, 0)
+	require.NoError(t, err)
+	i, err := StrToInt(ctx, "12abc", false)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), i)
+	warnings := ctx.GetWarnings()
+	require.Len(t, warnings, 2)
+	require.True(t, ErrTruncatedWrongVal.Equal(warnings[0]))
+	require.True(t, ErrTruncatedWrongVal.Equal(warnings[1]))
+	require.ErrorContains(t, warnings[1], "12abc")
+
+	// The warnings returned are a copy.
+	warnings[0] = nil
+	require.NotNil(t, ctx.GetWarnings()[0])
+
+	require.Nil(t, ctx.TruncateWarnings(2))
+	truncated := ctx.TruncateWarnings(1)
+	require.Len(t, truncated, 1)
+	require.ErrorContains(t, truncated[0], "12abc")
+	require.Len(t, ctx.GetWarnings(), 1)
+	require.Len(t, ctx.TruncateWarnings(-1), 1)
+	require.Empty(t, ctx.GetWarnings())
+
+	// DefaultStmtNoWarningContext discards the warnings.
+	_, err = ParseTime(DefaultStmtNoWarningContext, "2020-01-01 10:00:00 x", mysql.TypeDatetime, 0)
+	require.NoError(t, err)
+	require.Empty(t, DefaultStmtNoWarningContext.(*StmtContext).GetWarnings())
+}
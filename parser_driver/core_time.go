@@ -423,6 +423,14 @@ func weekMode(mode int) weekBehaviour {
 	return weekFormat
 }
 
+// WeekMode checks that mode is one of the modes 0 to 7 of WEEK() and YEARWEEK().
+func WeekMode(mode int) error {
+	if mode < 0 || mode > 7 {
+		return ErrInvalidWeekModeFormat.GenWithStackByArgs(mode)
+	}
+	return nil
+}
+
 // calcWeek calculates week and year for the time.
 func calcWeek(t CoreTime, wb weekBehaviour) (year int, week int) {
 	var days int
@@ -80,6 +80,9 @@ var (
 	ErrSyntax = NewStd(mysql.ErrParse)
 	// ErrWrongValue is returned when the input value is in wrong format.
 	ErrWrongValue = NewStd(mysql.ErrTruncatedWrongValue)
+	// ErrInvalidTimeZoneOffset is returned when the timezone offset of a datetime literal is out of [-14:00, +14:00],
+	// has a minute out of [0, 59], or is -00:00.
+	ErrInvalidTimeZoneOffset = NewStd(mysql.ErrUnknownTimeZone)
 	// ErrWrongValue2 is returned when the input value is in wrong format.
 	ErrWrongValue2 = NewStd(mysql.ErrWrongValue)
 	// ErrWrongValueForType is returned when the input value is in wrong format for function.
@@ -19,7 +19,13 @@
 package parser_driver
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
 
 	"github.com/pingcap/errors"
 	"github.com/abbychau/mysql-parser/parser_driver/mysql"
@@ -57,34 +63,71 @@ const (
 	JSONLiteralNil
 )
 
-// String returns a string representation of the JSON value
+// String returns the JSON text of the value. Only the scalars are rendered,
+// e.g. 1.5, "a" or true.
 func (bj BinaryJSON) String() string {
+	switch bj.TypeCode {
+	case JSONTypeCodeLiteral:
+		switch bj.literal() {
+		case JSONLiteralFalse:
+			return "false"
+		case JSONLiteralTrue:
+			return "true"
+		default:
+			return "null"
+		}
+	case JSONTypeCodeInt64:
+		return strconv.FormatInt(bj.GetInt64(), 10)
+	case JSONTypeCodeUint64:
+		return strconv.FormatUint(bj.GetUint64(), 10)
+	case JSONTypeCodeFloat64:
+		return strconv.FormatFloat(bj.GetFloat64(), 'g', -1, 64)
+	case JSONTypeCodeString:
+		var sb strings.Builder
+		enc := json.NewEncoder(&sb)
+		enc.SetEscapeHTML(false)
+		_ = enc.Encode(bj.GetString())
+		return strings.TrimSuffix(sb.String(), "\n")
+	}
 	// Stub implementation
 	return "json_value"
 }
 
+// literal returns the literal of a JSONTypeCodeLiteral value, one of the
+// JSONLiteral constants.
+func (bj BinaryJSON) literal() byte {
+	if len(bj.Value) == 0 {
+		return JSONLiteralNil
+	}
+	return bj.Value[0]
+}
+
+// scalarBits returns the 8 little-endian bytes of a number.
+func (bj BinaryJSON) scalarBits() uint64 {
+	if len(bj.Value) < 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(bj.Value)
+}
+
 // GetInt64 returns int64 value from JSON
 func (bj BinaryJSON) GetInt64() int64 {
-	// Stub implementation
-	return 0
+	return int64(bj.scalarBits())
 }
 
 // GetUint64 returns uint64 value from JSON
 func (bj BinaryJSON) GetUint64() uint64 {
-	// Stub implementation
-	return 0
+	return bj.scalarBits()
 }
 
 // GetFloat64 returns float64 value from JSON
 func (bj BinaryJSON) GetFloat64() float64 {
-	// Stub implementation
-	return 0.0
+	return math.Float64frombits(bj.scalarBits())
 }
 
 // GetString returns string value from JSON
 func (bj BinaryJSON) GetString() string {
-	// Stub implementation
-	return ""
+	return string(bj.Value)
 }
 
 // Unquote returns unquoted string value from JSON
@@ -156,8 +199,30 @@ func ParseBinaryJSONFromString(s string) (BinaryJSON, error) {
 	return BinaryJSON{TypeCode: JSONTypeCodeString, Value: []byte(s)}, nil
 }
 
-// CreateBinaryJSON creates a binary JSON from interface value
+// CreateBinaryJSON creates a binary JSON from interface value. Only the
+// scalars are encoded: nil, bool, int64, uint64, float64 and string.
 func CreateBinaryJSON(val interface{}) BinaryJSON {
+	bits := func(tp int, u uint64) BinaryJSON {
+		return BinaryJSON{TypeCode: tp, Value: binary.LittleEndian.AppendUint64(nil, u)}
+	}
+	switch x := val.(type) {
+	case nil:
+		return BinaryJSON{TypeCode: JSONTypeCodeLiteral, Value: []byte{JSONLiteralNil}}
+	case bool:
+		lit := byte(JSONLiteralFalse)
+		if x {
+			lit = JSONLiteralTrue
+		}
+		return BinaryJSON{TypeCode: JSONTypeCodeLiteral, Value: []byte{lit}}
+	case int64:
+		return bits(JSONTypeCodeInt64, uint64(x))
+	case uint64:
+		return bits(JSONTypeCodeUint64, x)
+	case float64:
+		return bits(JSONTypeCodeFloat64, math.Float64bits(x))
+	case string:
+		return BinaryJSON{TypeCode: JSONTypeCodeString, Value: []byte(x)}
+	}
 	// Stub implementation
 	return BinaryJSON{TypeCode: JSONTypeCodeObject, Value: []byte{}}
 }
@@ -177,9 +242,13 @@ func (bj BinaryJSON) IsZero() bool {
 // hack package replacement - provides string/byte conversion utilities
 type hackPkg struct{}
 
-// String converts byte slice to string
+// String converts byte slice to string without copying it, like the hack
+// package of TiDB, so the string changes if b is modified.
 func (hackPkg) String(b []byte) string {
-	return string(b)
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(unsafe.SliceData(b), len(b))
 }
 
 // Slice converts string to byte slice
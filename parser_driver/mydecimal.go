@@ -16,6 +16,7 @@ package parser_driver
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -390,24 +391,128 @@ func (d *MyDecimal) ToString() (str []byte) {
 	return
 }
 
-// FromString parses decimal from string.
+// FromString parses decimal from string. It stops at an underscore between
+// digits like MySQL does, e.g. '1_000' is 1 and ErrTruncated, see
+// FromStringMode for the other ways.
 func (d *MyDecimal) FromString(str []byte) error {
-	for i := range str {
-		if !isSpace(str[i]) {
-			str = str[i:]
-			break
-		}
+	return d.FromStringMode(str, UnderscoreTruncate)
+}
+
+// UnderscoreMode is how MyDecimal.FromStringMode treats an underscore between
+// two digits, the digit separator of the numbers written like 1_000_000.
+type UnderscoreMode int
+
+// UnderscoreMode types.
+const (
+	// UnderscoreTruncate ignores the underscore and the characters after it,
+	// with ErrTruncated.
+	UnderscoreTruncate UnderscoreMode = iota
+	// UnderscoreReject rejects the string with ErrBadNumber.
+	UnderscoreReject
+	// UnderscoreStrip removes the underscores before the conversion, e.g.
+	// '1_000.000_5' is 1000.0005.
+	UnderscoreStrip
+)
+
+// FromStringMode parses decimal from string like FromString, treating the
+// underscores between digits, in the integer, fraction and exponent digits
+// alike, as mode says.
+func (d *MyDecimal) FromStringMode(str []byte, mode UnderscoreMode) error {
+	if mode == UnderscoreStrip {
+		str = StripDigitSeparators(str)
 	}
-	if len(str) == 0 {
-		*d = zeroMyDecimal
+	consumed, err := d.fromString(str, mode == UnderscoreReject)
+	if consumed == 0 && err != nil {
 		return ErrTruncatedWrongVal.GenWithStackByArgs("DECIMAL", str)
 	}
-	switch str[0] {
-	case '-':
-		d.negative = true
-		fallthrough
-	case '+':
-		str = str[1:]
+	if numErr, ok := err.(*NumberParseError); ok {
+		return numErr.Err
+	}
+	return err
+}
+
+// StripDigitSeparators returns str without the underscores between two
+// digits, str itself if it has none. The other underscores are kept, e.g. the
+// ones of '1__0' and '_1'.
+func StripDigitSeparators[T string | []byte](str T) T {
+	i := 1
+	for i+1 < len(str) && !isDigitSeparator(str, i) {
+		i++
+	}
+	if i+1 >= len(str) {
+		return str
+	}
+	b := make([]byte, 0, len(str)-1)
+	b = append(b, str[:i]...)
+	for i++; i < len(str); i++ {
+		if str[i] != '_' || i+1 >= len(str) || !isDigitSeparator(str, i) {
+			b = append(b, str[i])
+		}
+	}
+	return T(b)
+}
+
+// isDigitSeparator reports whether str[i] is an underscore between two digits,
+// for 0 < i < len(str)-1.
+func isDigitSeparator[T string | []byte](str T, i int) bool {
+	return str[i] == '_' && isDigit(str[i-1]) && isDigit(str[i+1])
+}
+
+// NumberParseError is ErrTruncated or ErrBadNumber located in the string parsed
+// by MyDecimal.FromStringWithPos.
+type NumberParseError struct {
+	// Err is ErrTruncated when the characters from Offset are ignored, or
+	// ErrBadNumber when the string isn't a number.
+	Err    error
+	Offset int
+}
+
+// Error implements the error interface.
+func (e *NumberParseError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Err.Error(), e.Offset)
+}
+
+// Unwrap returns ErrTruncated or ErrBadNumber.
+func (e *NumberParseError) Unwrap() error {
+	return e.Err
+}
+
+// FromStringWithPos parses decimal from string like FromString, and returns the
+// number of bytes of str making up the decimal, leading spaces and exponent
+// included. The errors about the characters of str are *NumberParseError:
+//   - ErrTruncated at the first non-space character after the decimal, which is
+//     kept, e.g. at 5 for '1.5e3x';
+//   - ErrBadNumber where the digits are expected for a string without digits,
+//     e.g. at 1 for a solitary '-' and at 0 for '...', d is set to zero and 0
+//     bytes are consumed;
+//   - ErrBadNumber at an underscore between digits, e.g. at 1 for '1_000' and
+//     at 4 for '1e10_0', d is set to zero and the digits before the underscore
+//     are consumed.
+//
+// The other errors, such as ErrOverflow, are returned as FromString does.
+func (d *MyDecimal) FromStringWithPos(str []byte) (consumed int, err error) {
+	return d.fromString(str, true)
+}
+
+// fromString parses str into d and returns the number of bytes consumed, the
+// underscores between digits are rejected with rejectUnderscores.
+func (d *MyDecimal) fromString(str []byte, rejectUnderscores bool) (consumed int, err error) {
+	origLen := len(str)
+	// base is the offset of str in the input.
+	base := 0
+	for base < len(str) && isSpace(str[base]) {
+		base++
+	}
+	str = str[base:]
+	if len(str) > 0 {
+		switch str[0] {
+		case '-':
+			d.negative = true
+			fallthrough
+		case '+':
+			str = str[1:]
+			base++
+		}
 	}
 	var strIdx int
 	for strIdx < len(str) && isDigit(str[strIdx]) {
@@ -428,11 +533,16 @@ func (d *MyDecimal) FromString(str []byte) error {
 	}
 	if digitsInt+digitsFrac == 0 {
 		*d = zeroMyDecimal
-		return ErrTruncatedWrongVal.GenWithStackByArgs("DECIMAL", str)
+		return 0, &NumberParseError{Err: ErrBadNumber, Offset: base}
 	}
+	if rejectUnderscores && endIdx > 0 && endIdx+1 < len(str) && isDigitSeparator(str, endIdx) {
+		*d = zeroMyDecimal
+		return base + endIdx, &NumberParseError{Err: ErrBadNumber, Offset: base + endIdx}
+	}
+	consumed = base + endIdx
 	wordsInt := digitsToWords(digitsInt)
 	wordsFrac := digitsToWords(digitsFrac)
-	wordsInt, wordsFrac, err := fixWordCntError(wordsInt, wordsFrac)
+	wordsInt, wordsFrac, err = fixWordCntError(wordsInt, wordsFrac)
 	if err != nil {
 		digitsFrac = wordsFrac * digitsPerWord
 		if err == ErrOverflow {
@@ -483,6 +593,13 @@ func (d *MyDecimal) FromString(str []byte) error {
 	}
 	if endIdx+1 <= len(str) {
 		if str[endIdx] == 'e' || str[endIdx] == 'E' {
+			if expEnd := exponentEnd(str, endIdx+1); expEnd > 0 {
+				consumed = base + expEnd
+				if rejectUnderscores && expEnd+1 < len(str) && isDigitSeparator(str, expEnd) {
+					*d = zeroMyDecimal
+					return consumed, &NumberParseError{Err: ErrBadNumber, Offset: consumed}
+				}
+			}
 			exponent, err1 := strToInt(string(str[endIdx+1:]))
 			if err1 != nil {
 				err = errors.Cause(err1)
@@ -529,7 +646,45 @@ func (d *MyDecimal) FromString(str []byte) error {
 		d.negative = false
 	}
 	d.resultFrac = d.digitsFrac
-	return err
+	if err == ErrTruncated {
+		// The characters after the decimal are ignored, or the decimal is rounded.
+		if offset := base + skipSpaces(str, consumed-base); offset < origLen {
+			return consumed, &NumberParseError{Err: ErrTruncated, Offset: offset}
+		}
+	}
+	return consumed, err
+}
+
+// exponentEnd returns the end of the exponent digits of str starting from i, the
+// spaces and the sign before the digits are skipped as strToInt does. It returns
+// 0 if there is no digit.
+func exponentEnd(str []byte, i int) int {
+	i = skipSpaces(str, i)
+	if i < len(str) && (str[i] == '+' || str[i] == '-') {
+		i++
+	}
+	start := i
+	for i < len(str) && isDigit(str[i]) {
+		i++
+	}
+	if i == start {
+		return 0
+	}
+	return i
+}
+
+// skipSpaces returns the offset of the first byte of str from i which isn't a
+// space for strings.TrimSpace.
+func skipSpaces(str []byte, i int) int {
+	for i < len(str) {
+		switch str[i] {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
 }
 
 // Shift shifts decimal digits in given number (with rounding if it need), shift > 0 means shift to left shift,
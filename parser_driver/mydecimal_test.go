@@ -0,0 +1,109 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_driver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromStringWithPos(t *testing.T) {
+	tests := []struct {
+		input    string
+		consumed int
+		value    string
+		// err is ErrTruncated or ErrBadNumber located at offset, nil if the
+		// input is a decimal.
+		err    error
+		offset int
+	}{
+		{"123", 3, "123", nil, 0},
+		{"  -1.5  ", 6, "-1.5", nil, 0},
+		{"1.5E-1", 6, "0.15", nil, 0},
+		{"1.5e3", 5, "1500", nil, 0},
+		{"1.5e3x", 5, "1500", ErrTruncated, 5},
+		{"1.5e", 3, "1.5", ErrTruncated, 3},
+		{"12abc", 2, "12", ErrTruncated, 2},
+		{"12 x", 2, "12", ErrTruncated, 3},
+		{"1..", 2, "1", ErrTruncated, 2},
+		{"+", 0, "0", ErrBadNumber, 1},
+		{" -", 0, "0", ErrBadNumber, 2},
+		{"...", 0, "0", ErrBadNumber, 0},
+		{".", 0, "0", ErrBadNumber, 0},
+		{"", 0, "0", ErrBadNumber, 0},
+		{"1_000", 1, "0", ErrBadNumber, 1},
+		{"1._5", 2, "1", ErrTruncated, 2},
+	}
+	for _, tt := range tests {
+		var d MyDecimal
+		consumed, err := d.FromStringWithPos([]byte(tt.input))
+		require.Equal(t, tt.consumed, consumed, tt.input)
+		require.Equal(t, tt.value, d.String(), tt.input)
+		if tt.err == nil {
+			require.NoError(t, err, tt.input)
+			continue
+		}
+		require.True(t, errors.Is(err, tt.err), "%s: %v", tt.input, err)
+		var numErr *NumberParseError
+		require.ErrorAs(t, err, &numErr, tt.input)
+		require.Equal(t, tt.offset, numErr.Offset, tt.input)
+	}
+
+	// FromString keeps returning the bare errors, and accepts the digits before
+	// an underscore.
+	var d MyDecimal
+	require.Equal(t, ErrTruncated, d.FromString([]byte("1.5e3x")))
+	require.Equal(t, "1500", d.String())
+	require.Equal(t, ErrTruncated, d.FromString([]byte("1_000")))
+	require.Equal(t, "1", d.String())
+	require.Error(t, d.FromString([]byte("-")))
+	require.Equal(t, "0", d.String())
+}
+
+func TestFromStringMode(t *testing.T) {
+	tests := []struct {
+		input string
+		mode  UnderscoreMode
+		value string
+		err   error
+	}{
+		{"1_000", UnderscoreTruncate, "1", ErrTruncated},
+		{"1_000", UnderscoreReject, "0", ErrBadNumber},
+		{"1_000", UnderscoreStrip, "1000", nil},
+		{"1.000_5", UnderscoreTruncate, "1.000", ErrTruncated},
+		{"1.000_5", UnderscoreReject, "0", ErrBadNumber},
+		{"1.000_5", UnderscoreStrip, "1.0005", nil},
+		{"1e1_0", UnderscoreTruncate, "10", ErrTruncated},
+		{"1e1_0", UnderscoreReject, "0", ErrBadNumber},
+		{"1e1_0", UnderscoreStrip, "10000000000", nil},
+		{"-1_000_000.5", UnderscoreStrip, "-1000000.5", nil},
+		{"1__0", UnderscoreReject, "1", ErrTruncated},
+		{"1__0", UnderscoreStrip, "1", ErrTruncated},
+		{"1_", UnderscoreReject, "1", ErrTruncated},
+		{"1_", UnderscoreStrip, "1", ErrTruncated},
+		{"12", UnderscoreReject, "12", nil},
+	}
+	for _, tt := range tests {
+		var d MyDecimal
+		err := d.FromStringMode([]byte(tt.input), tt.mode)
+		require.Equal(t, tt.err, err, "%s %d", tt.input, tt.mode)
+		require.Equal(t, tt.value, d.String(), "%s %d", tt.input, tt.mode)
+	}
+
+	require.Equal(t, "1000000", StripDigitSeparators("1_000_000"))
+	require.Equal(t, []byte("1.05e10"), StripDigitSeparators([]byte("1.0_5e1_0")))
+	require.Equal(t, "_1__0_", StripDigitSeparators("_1__0_"))
+}
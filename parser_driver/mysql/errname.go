@@ -982,4 +982,7 @@ var MySQLErrName = map[uint16]*ErrMessage{
 	ErrWarnMemoryQuotaOverflow:          Message("Max value of MEMORY_QUOTA is %d bytes, ignore this invalid limit", nil),
 	ErrWarnOptimizerHintParseError:      Message("Optimizer hint syntax error at %v", nil),
 	ErrWarnOptimizerHintWrongPos:        Message("Optimizer hint can only be followed by certain keywords like SELECT, INSERT, etc.", nil),
+	ErrNotConstantExpr:                  Message("%s is not a constant expression", nil),
+	ErrEmptyIdent:                       Message("Empty identifier is not allowed at %s", nil),
+	ErrZeroDateLiteral:                  Message("Zero date literal '%s' is not allowed at %s", nil),
 }
@@ -17,6 +17,8 @@ package parser_driver
 import (
 	"fmt"
 	"log"
+
+	"github.com/pingcap/errors"
 )
 
 // Log provides basic logging functionality for error tracing
@@ -63,30 +65,41 @@ func (e *TError) Error() string {
 
 // FastGenByArgs generates error with arguments
 func (e *TError) FastGenByArgs(args ...interface{}) error {
-	return fmt.Errorf("mysql error %d: %v", e.code, args)
+	return e.withMessage(fmt.Sprintf("%v", args))
 }
 
 // GenWithStackByArgs generates error with stack and arguments
 func (e *TError) GenWithStackByArgs(args ...interface{}) error {
-	return fmt.Errorf("mysql error %d: %v", e.code, args)
+	return e.withMessage(fmt.Sprintf("%v", args))
 }
 
-// Equal checks if errors are equal
+// Equal checks if errors are equal, i.e. have the same code. The errors
+// generated from e are equal to e.
 func (e *TError) Equal(other error) bool {
-	if otherT, ok := other.(*TError); ok {
+	if otherT, ok := errors.Cause(other).(*TError); ok {
 		return e.code == otherT.code
 	}
 	return false
 }
 
+// Code returns the MySQL error code of e.
+func (e *TError) Code() uint16 {
+	return e.code
+}
+
 // GenWithStack generates error with stack
 func (e *TError) GenWithStack(format string, args ...interface{}) error {
-	return fmt.Errorf("mysql error %d: "+format, append([]interface{}{e.code}, args...)...)
+	return e.withMessage(fmt.Sprintf(format, args...))
 }
 
 // FastGen generates error quickly
 func (e *TError) FastGen(args ...interface{}) error {
-	return fmt.Errorf("mysql error %d: %v", e.code, args)
+	return e.withMessage(fmt.Sprintf("%v", args))
+}
+
+// withMessage returns an error with the code of e and message.
+func (e *TError) withMessage(message string) error {
+	return &TError{code: e.code, message: message}
 }
 
 // Basic error functions that return TError for compatibility
@@ -377,8 +377,11 @@ func (t Time) String() string {
 	Log(errors.Trace(err))
 	fsp := t.Fsp()
 	if fsp > 0 {
-		tmp := fmt.Sprintf(".%06d", t.Microsecond())
-		str = str + tmp[:1+fsp]
+		b := make([]byte, 0, len(str)+7)
+		b = append(b, str...)
+		b = append(b, '.')
+		b = appendPaddedInt(b, t.Microsecond(), 6)
+		str = string(b[:len(str)+1+fsp])
 	}
 
 	return str
@@ -434,13 +437,14 @@ func (t Time) FillNumber(dec *MyDecimal) {
 		BgLogger.Error("never happen because we've control the format!", "fatal")
 	}
 
+	b := []byte(s)
 	fsp := t.Fsp()
 	if fsp > 0 {
-		s1 := fmt.Sprintf("%s.%06d", s, t.Microsecond())
-		s = s1[:len(s)+fsp+1]
+		b = append(b, '.')
+		b = appendPaddedInt(b, t.Microsecond(), 6)[:len(s)+fsp+1]
 	}
 	// We skip checking error here because time formatted string can be parsed certainly.
-	err = dec.FromString([]byte(s))
+	err = dec.FromString(b)
 	Log(errors.Trace(err))
 }
 
@@ -465,19 +469,25 @@ func (t Time) Convert(ctx Context, tp uint8) (Time, error) {
 }
 
 // ConvertToDuration converts mysql datetime, timestamp and date to mysql time type.
+// The fsp of t is kept on the result. A date has no time part, so it always
+// converts to a zero duration.
 // e.g,
 // 2012-12-12T10:10:10 -> 10:10:10
 // 2012-12-12 -> 0
 func (t Time) ConvertToDuration() (Duration, error) {
-	if t.IsZero() {
-		return ZeroDuration, nil
+	if t.IsZero() || t.Type() == mysql.TypeDate {
+		return Duration{Duration: 0, Fsp: t.Fsp()}, nil
 	}
 
 	hour, minute, second := t.Clock()
+	// The clock part of a valid datetime is always below 24 hours, anything
+	// else comes from a corrupted core time and can't be represented as a time of day.
+	if hour >= 24 {
+		return ZeroDuration, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, t.String()))
+	}
 	frac := t.Microsecond() * 1000
 
 	d := gotime.Duration(hour*3600+minute*60+second)*gotime.Second + gotime.Duration(frac) //nolint:durationcheck
-	// TODO: check convert validation
 	return Duration{Duration: d, Fsp: t.Fsp()}, nil
 }
 
@@ -819,6 +829,7 @@ var validIdxCombinations = map[int]struct {
 	100: {0, 0}, // 23:59:59Z
 	30:  {2, 0}, // 23:59:59+08
 	50:  {4, 2}, // 23:59:59+0800
+	53:  {4, 2}, // 23:59:59+8:00
 	63:  {5, 2}, // 23:59:59+08:00
 	// postgres supports the following additional syntax that deviates from ISO8601, although we won't support it
 	// currently, it will be fairly easy to add in the current parsing framework
@@ -833,10 +844,11 @@ var validIdxCombinations = map[int]struct {
 //
 // Supported syntax:
 //
-//	MySQL compatible: ((?P<tz_sign>[-+])(?P<tz_hour>[0-9]{2}):(?P<tz_minute>[0-9]{2})){0,1}$, see
+//	MySQL compatible: ((?P<tz_sign>[-+])(?P<tz_hour>[0-9]{1,2}):(?P<tz_minute>[0-9]{2})){0,1}$, see
 //	  https://dev.mysql.com/doc/refman/8.0/en/time-zone-support.html and https://dev.mysql.com/doc/refman/8.0/en/datetime.html
 //	  the first link specified that timezone information should be in "[H]H:MM, prefixed with a + or -" while the
 //	  second link specified that for string literal, "hour values less than than 10, a leading zero is required.".
+//	  The server accepts the single digit hour, e.g. +8:00, so does GetTimezone.
 //	ISO-8601: Z|((((?P<tz_sign>[-+])(?P<tz_hour>[0-9]{2})(:(?P<tz_minute>[0-9]{2}){0,1}){0,1})|((?P<tz_minute>[0-9]{2}){0,1}){0,1}))$
 //	  see https://www.cl.cam.ac.uk/~mgk25/iso-time.html
 func GetTimezone(lit string) (idx int, tzSign, tzHour, tzSep, tzMinute string) {
@@ -876,7 +888,12 @@ func GetTimezone(lit string) (idx int, tzSign, tzHour, tzSep, tzMinute string) {
 	if v, ok := validIdxCombinations[k]; ok {
 		hidx, midx := l-v.h, l-v.m
 		valid := func(v string) bool {
-			return '0' <= v[0] && v[0] <= '9' && '0' <= v[1] && v[1] <= '9'
+			for i := 0; i < len(v); i++ {
+				if !isDigit(v[i]) {
+					return false
+				}
+			}
+			return true
 		}
 		if sidx != -1 {
 			tzSign = lit[sidx : sidx+1]
@@ -889,7 +906,8 @@ func GetTimezone(lit string) (idx int, tzSign, tzHour, tzSep, tzMinute string) {
 			tzSep = lit[spidx : spidx+1]
 		}
 		if v.h != 0 {
-			tzHour = lit[hidx : hidx+2]
+			// The hour ends at the minute or its separator.
+			tzHour = lit[hidx : l-v.m-len(tzSep)]
 			if !valid(tzHour) {
 				return -1, "", "", "", ""
 			}
@@ -1018,6 +1036,10 @@ func parseDatetime(ctx Context, str string, fsp int, isFloat bool) (Time, error)
 		// therefore we could safely skip this branch.
 		if !noAbsorb(seps) && !(tzMinute != "" && tzSep == "") {
 			// we can't absorb timezone if there is no separate between tzHour and tzMinute
+			if tzSep != "" && tzMinute > "59" {
+				// neither a minute or second nor the minute of a timezone
+				return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(DateTimeStr, str))
+			}
 			if len(tzHour) != 0 {
 				seps = append(seps, tzHour)
 			}
@@ -1186,17 +1208,14 @@ func parseDatetime(ctx Context, str string, fsp int, isFloat bool) (Time, error)
 	if hasTZ {
 		// without hhmmss, timezone is also meaningless
 		if !hhmmss {
-			return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStack(DateTimeStr, str))
-		}
-		if len(tzHour) != 0 {
-			deltaHour = int((tzHour[0]-'0')*10 + (tzHour[1] - '0'))
-		}
-		if len(tzMinute) != 0 {
-			deltaMinute = int((tzMinute[0]-'0')*10 + (tzMinute[1] - '0'))
+			return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(DateTimeStr, str))
 		}
+		// GetTimezone returns the hour and the minute as digits
+		deltaHour, _ = strconv.Atoi(tzHour)
+		deltaMinute, _ = strconv.Atoi(tzMinute)
 		// allowed delta range is [-14:00, 14:00], and we will intentionally reject -00:00
 		if deltaHour > 14 || deltaMinute > 59 || (deltaHour == 14 && deltaMinute != 0) || (tzSign == "-" && deltaHour == 0 && deltaMinute == 0) {
-			return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(DateTimeStr, str))
+			return ZeroDatetime, errors.Trace(ErrInvalidTimeZoneOffset.GenWithStackByArgs(tzSign + tzHour + tzSep + tzMinute))
 		}
 		// by default, if the temporal string literal does not contain timezone information, it will be in the timezone
 		// specified by the time_zone system variable. However, if the timezone is specified in the string literal, we
@@ -1321,12 +1340,13 @@ func (d Duration) Add(v Duration) (Duration, error) {
 	}
 	dsum, err := AddInt64(int64(d.Duration), int64(v.Duration))
 	if err != nil {
-		return Duration{}, errors.Trace(err)
-	}
-	if d.Fsp >= v.Fsp {
-		return Duration{Duration: gotime.Duration(dsum), Fsp: d.Fsp}, nil
+		// d and v have the sign of the overflow.
+		dsum = math.MaxInt64
+		if v.Duration < 0 {
+			dsum = math.MinInt64
+		}
 	}
-	return Duration{Duration: gotime.Duration(dsum), Fsp: v.Fsp}, nil
+	return clampDuration(gotime.Duration(dsum), max(d.Fsp, v.Fsp))
 }
 
 // Sub subtracts d to d, returns a duration value.
@@ -1336,12 +1356,25 @@ func (d Duration) Sub(v Duration) (Duration, error) {
 	}
 	dsum, err := SubInt64(int64(d.Duration), int64(v.Duration))
 	if err != nil {
-		return Duration{}, errors.Trace(err)
+		// d and -v have the sign of the overflow.
+		dsum = math.MaxInt64
+		if d.Duration < 0 {
+			dsum = math.MinInt64
+		}
 	}
-	if d.Fsp >= v.Fsp {
-		return Duration{Duration: gotime.Duration(dsum), Fsp: d.Fsp}, nil
+	return clampDuration(gotime.Duration(dsum), max(d.Fsp, v.Fsp))
+}
+
+// clampDuration returns d with fsp. If d is out of the range of the TIME type,
+// it returns the max TIME value of the sign of d with ErrTruncatedWrongVal, as
+// MySQL clamps the results of the TIME arithmetic.
+func clampDuration(d gotime.Duration, fsp int) (Duration, error) {
+	if d > MaxTime {
+		return MaxMySQLDuration(fsp), ErrTruncatedWrongVal.GenWithStackByArgs("time", Duration{Duration: d}.String())
+	} else if d < MinTime {
+		return MaxMySQLDuration(fsp).Neg(), ErrTruncatedWrongVal.GenWithStackByArgs("time", Duration{Duration: d}.String())
 	}
-	return Duration{Duration: gotime.Duration(dsum), Fsp: v.Fsp}, nil
+	return Duration{Duration: d, Fsp: fsp}, nil
 }
 
 // DurationFormat returns a textual representation of the duration value formatted
@@ -1372,7 +1405,7 @@ func (d Duration) DurationFormat(layout string) (string, error) {
 func (d Duration) convertDateFormat(b rune, buf *bytes.Buffer) error {
 	switch b {
 	case 'H':
-		buf.WriteString(FormatIntWidthN(d.Hour(), 2))
+		writeIntWidthN(buf, d.Hour(), 2)
 	case 'k':
 		buf.WriteString(strconv.FormatInt(int64(d.Hour()), 10))
 	case 'h', 'I':
@@ -1380,7 +1413,7 @@ func (d Duration) convertDateFormat(b rune, buf *bytes.Buffer) error {
 		if t%12 == 0 {
 			buf.WriteString("12")
 		} else {
-			buf.WriteString(FormatIntWidthN(t%12, 2))
+			writeIntWidthN(buf, t%12, 2)
 		}
 	case 'l':
 		t := d.Hour()
@@ -1390,7 +1423,7 @@ func (d Duration) convertDateFormat(b rune, buf *bytes.Buffer) error {
 			buf.WriteString(strconv.FormatInt(int64(t%12), 10))
 		}
 	case 'i':
-		buf.WriteString(FormatIntWidthN(d.Minute(), 2))
+		writeIntWidthN(buf, d.Minute(), 2)
 	case 'p':
 		hour := d.Hour()
 		if hour/12%2 == 0 {
@@ -1401,22 +1434,13 @@ func (d Duration) convertDateFormat(b rune, buf *bytes.Buffer) error {
 	case 'r':
 		h := d.Hour()
 		h %= 24
-		switch {
-		case h == 0:
-			fmt.Fprintf(buf, "%02d:%02d:%02d AM", 12, d.Minute(), d.Second())
-		case h == 12:
-			fmt.Fprintf(buf, "%02d:%02d:%02d PM", 12, d.Minute(), d.Second())
-		case h < 12:
-			fmt.Fprintf(buf, "%02d:%02d:%02d AM", h, d.Minute(), d.Second())
-		default:
-			fmt.Fprintf(buf, "%02d:%02d:%02d PM", h-12, d.Minute(), d.Second())
-		}
+		writeClock12(buf, h, d.Minute(), d.Second())
 	case 'T':
-		fmt.Fprintf(buf, "%02d:%02d:%02d", d.Hour(), d.Minute(), d.Second())
+		buf.Write(appendClock(buf.AvailableBuffer(), d.Hour(), d.Minute(), d.Second()))
 	case 'S', 's':
-		buf.WriteString(FormatIntWidthN(d.Second(), 2))
+		writeIntWidthN(buf, d.Second(), 2)
 	case 'f':
-		fmt.Fprintf(buf, "%06d", d.MicroSecond())
+		buf.Write(appendPaddedInt(buf.AvailableBuffer(), d.MicroSecond(), 6))
 	default:
 		buf.WriteRune(b)
 	}
@@ -1426,27 +1450,28 @@ func (d Duration) convertDateFormat(b rune, buf *bytes.Buffer) error {
 
 // String returns the time formatted using default TimeFormat and fsp.
 func (d Duration) String() string {
-	var buf bytes.Buffer
+	var buf [32]byte
+	b := buf[:0]
 
 	sign, hours, minutes, seconds, fraction := splitDuration(d.Duration)
 	if sign < 0 {
-		buf.WriteByte('-')
+		b = append(b, '-')
 	}
 
-	fmt.Fprintf(&buf, "%02d:%02d:%02d", hours, minutes, seconds)
+	b = appendClock(b, hours, minutes, seconds)
 	if d.Fsp > 0 {
-		buf.WriteString(".")
-		buf.WriteString(d.formatFrac(fraction))
+		b = append(b, '.')
+		b = d.appendFrac(b, fraction)
 	}
 
-	p := buf.String()
-
-	return p
+	return string(b)
 }
 
-func (d Duration) formatFrac(frac int) string {
-	s := fmt.Sprintf("%06d", frac)
-	return s[0:d.Fsp]
+// appendFrac appends the first Fsp digits of the 6 digits of frac to b.
+func (d Duration) appendFrac(b []byte, frac int) []byte {
+	start := len(b)
+	b = appendPaddedInt(b, frac, 6)
+	return b[:start+d.Fsp]
 }
 
 // ToNumber changes duration to number format.
@@ -1454,53 +1479,59 @@ func (d Duration) formatFrac(frac int) string {
 // 10:10:10 -> 101010
 func (d Duration) ToNumber() *MyDecimal {
 	sign, hours, minutes, seconds, fraction := splitDuration(d.Duration)
-	var (
-		s       string
-		signStr string
-	)
-
+	var buf [32]byte
+	s := buf[:0]
 	if sign < 0 {
-		signStr = "-"
+		s = append(s, '-')
 	}
-
-	if d.Fsp == 0 {
-		s = fmt.Sprintf("%s%02d%02d%02d", signStr, hours, minutes, seconds)
-	} else {
-		s = fmt.Sprintf("%s%02d%02d%02d.%s", signStr, hours, minutes, seconds, d.formatFrac(fraction))
+	s = appendPaddedInt(s, hours, 2)
+	s = appendPaddedInt(s, minutes, 2)
+	s = appendPaddedInt(s, seconds, 2)
+	if d.Fsp != 0 {
+		s = append(s, '.')
+		s = d.appendFrac(s, fraction)
 	}
 
 	// We skip checking error here because time formatted string can be parsed certainly.
 	dec := new(MyDecimal)
-	err := dec.FromString([]byte(s))
+	err := dec.FromString(s)
 	Log(errors.Trace(err))
 	return dec
 }
 
-// ConvertToTime converts duration to Time.
+// ConvertToTime converts duration to Time, using the current date of ctx as
+// the date part. See ContextNow for how a Context can pin the current time.
 // Tp is TypeDatetime, TypeTimestamp and TypeDate.
 func (d Duration) ConvertToTime(ctx Context, tp uint8) (Time, error) {
-	year, month, day := gotime.Now().In(ctx.Location()).Date()
-	datePart := FromDate(year, int(month), day, 0, 0, 0, 0)
-	mixDateAndDuration(&datePart, d)
-
-	t := NewTime(datePart, mysql.TypeDatetime, d.Fsp)
-	return t.Convert(ctx, tp)
+	return d.ConvertToTimeWithTimestamp(ctx, tp, ContextNow(ctx))
 }
 
 // ConvertToTimeWithTimestamp converts duration to Time by system timestamp.
+// Like MySQL, a duration outside of [00:00:00, 24:00:00) wraps into the
+// following or preceding days, e.g. 25:00:00 is 01:00:00 of the next day.
+// A duration beyond the TIME range is rejected.
 // Tp is TypeDatetime, TypeTimestamp and TypeDate.
 func (d Duration) ConvertToTimeWithTimestamp(ctx Context, tp uint8, ts gotime.Time) (Time, error) {
+	if d.Duration > MaxTime || d.Duration < -MaxTime {
+		return NewTime(ZeroCoreTime, tp, d.Fsp), errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, d.String()))
+	}
+
 	year, month, day := ts.In(ctx.Location()).Date()
 	datePart := FromDate(year, int(month), day, 0, 0, 0, 0)
 	mixDateAndDuration(&datePart, d)
 
 	t := NewTime(datePart, mysql.TypeDatetime, d.Fsp)
-	return t.Convert(ctx, tp)
+	t, err := t.Convert(ctx, tp)
+	if tp == mysql.TypeDate {
+		// Truncate hh:mm:ss part if the type is Date.
+		t.SetCoreTime(FromDate(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0))
+	}
+	return t, err
 }
 
 // ConvertToYear converts duration to Year.
 func (d Duration) ConvertToYear(ctx Context) (int64, error) {
-	return d.ConvertToYearFromNow(ctx, gotime.Now())
+	return d.ConvertToYearFromNow(ctx, ContextNow(ctx))
 }
 
 // ConvertToYearFromNow converts duration to Year, with the `now` specified by the argument.
@@ -1726,6 +1757,7 @@ func matchDuration(str string, fsp int) (Duration, bool, error) {
 	charsLen := len(rest)
 
 	hhmmss := [3]int{}
+	compact := false
 
 	if day, hms, remain, err := matchDayHHMMSS(rest); err == nil {
 		hms[0] += 24 * day
@@ -1733,7 +1765,7 @@ func matchDuration(str string, fsp int) (Duration, bool, error) {
 	} else if hms, remain, err := matchHHMMSSDelimited(rest, true); err == nil {
 		rest, hhmmss = remain, hms
 	} else if hms, remain, err := matchHHMMSSCompact(rest); err == nil {
-		rest, hhmmss = remain, hms
+		rest, hhmmss, compact = remain, hms, true
 	} else {
 		return ZeroDuration, true, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
 	}
@@ -1749,11 +1781,15 @@ func matchDuration(str string, fsp int) (Duration, bool, error) {
 		frac = 0
 	}
 
-	if !checkHHMMSS(hhmmss) {
+	// As in MySQL, a compact number above the max, e.g. 8385960, is clamped
+	// before its minutes and seconds are checked.
+	outOfRange := hhmmss[0] > TimeMaxHour ||
+		(compact && hhmmss[0]*10000+hhmmss[1]*100+hhmmss[2] > TimeMaxValue)
+	if !outOfRange && !checkHHMMSS(hhmmss) {
 		return ZeroDuration, true, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
 	}
 
-	if hhmmss[0] > TimeMaxHour {
+	if outOfRange {
 		var t gotime.Duration
 		if negative {
 			t = MinTime
@@ -1837,6 +1873,108 @@ func ParseDuration(ctx Context, str string, fsp int) (Duration, bool, error) {
 	return d, false, err
 }
 
+// ParseISO8601Duration parses an ISO 8601 duration of the form
+// `[-]P[nD][T[nH][nM][n[.n]S]]`, e.g. `PT1H30M`, `P2DT3H` or `-PT0.5S`, into a
+// TIME value with fsp. Only the seconds may have a fractional part, which is
+// rounded to fsp. The days are mapped to 24 hours, as in the `D HH:MM:SS` TIME
+// literals, and the components may exceed their usual range, e.g. `PT90M`. The
+// years, months and weeks aren't accepted as their length isn't fixed. A
+// malformed string returns ErrTruncatedWrongVal, and a duration out of the range
+// of TIME is clamped to it with ErrTruncatedWrongVal too.
+func ParseISO8601Duration(str string, fsp int) (Duration, error) {
+	fsp, err := CheckFsp(fsp)
+	if err != nil {
+		return ZeroDuration, errors.Trace(err)
+	}
+	rest := strings.TrimSpace(str)
+	negative := false
+	if len(rest) > 0 && rest[0] == '-' {
+		negative, rest = true, rest[1:]
+	}
+	if len(rest) == 0 || (rest[0] != 'P' && rest[0] != 'p') {
+		return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+	}
+	rest = rest[1:]
+
+	// The designators of the date part, then of the time part, in their order.
+	const dateDesignators, timeDesignators = "D", "HMS"
+	designators, inTime := dateDesignators, false
+	var seconds int64
+	frac, components, overflow := 0, 0, false
+	for len(rest) > 0 {
+		if rest[0] == 'T' || rest[0] == 't' {
+			if inTime || len(rest) == 1 {
+				return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+			}
+			designators, inTime, rest = timeDesignators, true, rest[1:]
+		}
+		digits, remain := leadingDigits(rest)
+		fracDigits := ""
+		if len(remain) > 0 && remain[0] == '.' {
+			if fracDigits, remain = leadingDigits(remain[1:]); fracDigits == "" {
+				return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+			}
+		}
+		if digits == "" || len(remain) == 0 {
+			return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+		}
+		idx := strings.IndexByte(designators, remain[0]&^0x20)
+		if idx < 0 || (fracDigits != "" && remain[0]&^0x20 != 'S') {
+			return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+		}
+		var unit int64
+		switch designators[idx] {
+		case 'D':
+			unit = 24 * 3600
+		case 'H':
+			unit = 3600
+		case 'M':
+			unit = 60
+		default:
+			unit = 1
+			if frac, overflow, err = ParseFrac(fracDigits, fsp); err != nil {
+				return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+			}
+		}
+		// The values beyond the range of TIME are clamped below.
+		v, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil || v > int64(MaxTime/gotime.Second) {
+			v = int64(MaxTime/gotime.Second) + 1
+		}
+		seconds = min(seconds+v*unit, int64(MaxTime/gotime.Second)+1)
+		// The next designators must follow this one.
+		designators = designators[idx+1:]
+		rest = remain[1:]
+		components++
+	}
+	if components == 0 {
+		return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+	}
+	if overflow {
+		seconds++
+		frac = 0
+	}
+
+	d := gotime.Duration(seconds)*gotime.Second + gotime.Duration(frac)*gotime.Microsecond
+	if negative {
+		d = -d
+	}
+	if d > MaxTime || d < MinTime {
+		d, _ = TruncateOverflowMySQLTime(d)
+		return Duration{Duration: d, Fsp: fsp}, ErrTruncatedWrongVal.GenWithStackByArgs("time", str)
+	}
+	return Duration{Duration: d, Fsp: fsp}, nil
+}
+
+// leadingDigits splits str after its leading decimal digits.
+func leadingDigits(str string) (string, string) {
+	i := 0
+	for i < len(str) && isDigit(str[i]) {
+		i++
+	}
+	return str[:i], str[i:]
+}
+
 // TruncateOverflowMySQLTime truncates d when it overflows, and returns ErrTruncatedWrongVal.
 func TruncateOverflowMySQLTime(d gotime.Duration) (gotime.Duration, error) {
 	if d > MaxTime {
@@ -2128,6 +2266,40 @@ func TimeFromDays(num int64) Time {
 	return NewTime(ct, mysql.TypeDate, 0)
 }
 
+// ToDays returns the day number of t, the number of days since year 0, as the
+// TO_DAYS function does. It's the inverse of TimeFromDays. An error is returned
+// for a date with a zero month or day, for which MySQL returns NULL.
+func ToDays(t Time) (int64, error) {
+	if t.InvalidZero() {
+		return 0, errors.Trace(ErrWrongValue.GenWithStackByArgs(DateTimeStr, t.String()))
+	}
+	return int64(calcDaynr(t.Year(), t.Month(), t.Day())), nil
+}
+
+// ToSeconds returns the number of seconds of t since year 0, as the TO_SECONDS
+// function does. An error is returned for a date with a zero month or day.
+func ToSeconds(t Time) (int64, error) {
+	days, err := ToDays(t)
+	if err != nil {
+		return 0, err
+	}
+	return days*86400 + int64(t.Hour())*3600 + int64(t.Minute())*60 + int64(t.Second()), nil
+}
+
+// UnixTimestamp returns the number of seconds of t since 1970-01-01 00:00:00 UTC,
+// t being in UTC, as UNIX_TIMESTAMP does for a TIMESTAMP column. Like MySQL, it
+// returns 0 for a time before the epoch.
+func UnixTimestamp(t Time) (int64, error) {
+	seconds, err := ToSeconds(t)
+	if err != nil {
+		return 0, err
+	}
+	return max(seconds-unixEpochSeconds, 0), nil
+}
+
+// unixEpochSeconds is TO_SECONDS('1970-01-01').
+const unixEpochSeconds = 62167219200
+
 func checkDateType(t CoreTime, allowZeroInDate, allowInvalidDate bool) error {
 	year, month, day := t.Year(), t.Month(), t.Day()
 	if year == 0 && month == 0 && day == 0 {
@@ -2183,31 +2355,8 @@ func checkMonthDay(year, month, day int, allowInvalidDate bool) error {
 }
 
 func checkTimestampType(t CoreTime, tz *gotime.Location) error {
-	if compareTime(t, ZeroCoreTime) == 0 {
-		return nil
-	}
-
-	var checkTime CoreTime
-	if tz != BoundTimezone {
-		convertTime := NewTime(t, mysql.TypeTimestamp, DefaultFsp)
-		err := convertTime.ConvertTimeZone(tz, BoundTimezone)
-		if err != nil {
-			_, err2 := adjustTimestampErrForDST(tz, t.String(), mysql.TypeTimestamp, Time{t}, err)
-			return err2
-		}
-		checkTime = convertTime.coreTime
-	} else {
-		checkTime = t
-	}
-	if compareTime(checkTime, MaxTimestamp.coreTime) > 0 || compareTime(checkTime, MinTimestamp.coreTime) < 0 {
-		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, t))
-	}
-
-	if _, err := t.GoTime(tz); err != nil {
-		return errors.Trace(err)
-	}
-
-	return nil
+	c := TimeZoneConverter{from: tz, to: BoundTimezone}
+	return c.checkTimestamp(t)
 }
 
 func checkDatetimeType(t CoreTime, allowZeroInDate, allowInvalidDate bool) error {
@@ -2746,10 +2895,33 @@ func ParseTimeFromDecimal(ctx Context, dec *MyDecimal) (t Time, err error) {
 // according to layout.
 // See http://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_date-format
 func (t Time) DateFormat(layout string) (string, error) {
+	return t.dateFormat(layout, false)
+}
+
+// DateFormatStrict is like DateFormat, but it returns an error for the
+// specifiers DateFormat writes as they are, e.g. %q, naming the first of them.
+// It also returns an error for %j of a date with a zero month or day, and for
+// the year of a week and a week of different modes, e.g. %x with %V, which
+// pairs with %X.
+func (t Time) DateFormatStrict(layout string) (string, error) {
+	if err := checkYearWeekSpecifiers(layout); err != nil {
+		return "", err
+	}
+	return t.dateFormat(layout, true)
+}
+
+func (t Time) dateFormat(layout string, strict bool) (string, error) {
 	var buf bytes.Buffer
+	// Most of the specifiers are formatted in 2 to 4 bytes.
+	buf.Grow(2 * len(layout))
 	inPatternMatch := false
 	for _, b := range layout {
 		if inPatternMatch {
+			if strict {
+				if err := t.checkDateFormatSpecifier(b); err != nil {
+					return "", err
+				}
+			}
 			if err := t.convertDateFormat(b, &buf); err != nil {
 				return "", errors.Trace(err)
 			}
@@ -2764,9 +2936,58 @@ func (t Time) DateFormat(layout string) (string, error) {
 			buf.WriteRune(b)
 		}
 	}
+	if strict && inPatternMatch {
+		return "", errors.Trace(ErrWrongValue2.GenWithStackByArgs(dateFormatSpecifierStr, "%"))
+	}
 	return buf.String(), nil
 }
 
+// dateFormatSpecifiers is the specifiers convertDateFormat formats, and % for
+// %%.
+const dateFormatSpecifiers = "abcDdefHhIijklMmprSsTUuVvWwXxYy%"
+
+// dateFormatSpecifierStr is the kind of the value of the errors of
+// DateFormatStrict.
+const dateFormatSpecifierStr = "format specifier"
+
+// checkDateFormatSpecifier returns an error if DateFormatStrict can't format
+// the specifier b of t.
+func (t Time) checkDateFormatSpecifier(b rune) error {
+	if !strings.ContainsRune(dateFormatSpecifiers, b) {
+		return errors.Trace(ErrWrongValue2.GenWithStackByArgs(dateFormatSpecifierStr, "%"+string(b)))
+	}
+	// YearDay is 0 for a zero month or day.
+	if b == 'j' && (t.Month() == 0 || t.Day() == 0) {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(DateStr, t.String()))
+	}
+	return nil
+}
+
+// checkYearWeekSpecifiers returns an error if layout has a week with only the
+// year of a week of the other mode: %X is the year of %V, where a week starts
+// on Sunday, and %x the one of %v, where a week starts on Monday.
+func checkYearWeekSpecifiers(layout string) error {
+	var specifiers [128]bool
+	inPatternMatch := false
+	for _, b := range layout {
+		if inPatternMatch {
+			if b < rune(len(specifiers)) {
+				specifiers[b] = true
+			}
+			inPatternMatch = false
+		} else if b == '%' {
+			inPatternMatch = true
+		}
+	}
+	switch {
+	case specifiers['V'] && specifiers['x'] && !specifiers['X']:
+		return errors.Trace(ErrWrongValue2.GenWithStackByArgs(dateFormatSpecifierStr, "%x with %V"))
+	case specifiers['v'] && specifiers['X'] && !specifiers['x']:
+		return errors.Trace(ErrWrongValue2.GenWithStackByArgs(dateFormatSpecifierStr, "%X with %v"))
+	}
+	return nil
+}
+
 var abbrevWeekdayName = []string{
 	"Sun", "Mon", "Tue",
 	"Wed", "Thu", "Fri", "Sat",
@@ -2787,20 +3008,20 @@ func (t Time) convertDateFormat(b rune, buf *bytes.Buffer) error {
 		}
 		buf.WriteString(MonthNames[m-1])
 	case 'm':
-		buf.WriteString(FormatIntWidthN(t.Month(), 2))
+		writeIntWidthN(buf, t.Month(), 2)
 	case 'c':
 		buf.WriteString(strconv.FormatInt(int64(t.Month()), 10))
 	case 'D':
 		buf.WriteString(strconv.FormatInt(int64(t.Day()), 10))
 		buf.WriteString(abbrDayOfMonth(t.Day()))
 	case 'd':
-		buf.WriteString(FormatIntWidthN(t.Day(), 2))
+		writeIntWidthN(buf, t.Day(), 2)
 	case 'e':
 		buf.WriteString(strconv.FormatInt(int64(t.Day()), 10))
 	case 'j':
-		fmt.Fprintf(buf, "%03d", t.YearDay())
+		buf.Write(appendPaddedInt(buf.AvailableBuffer(), t.YearDay(), 3))
 	case 'H':
-		buf.WriteString(FormatIntWidthN(t.Hour(), 2))
+		writeIntWidthN(buf, t.Hour(), 2)
 	case 'k':
 		buf.WriteString(strconv.FormatInt(int64(t.Hour()), 10))
 	case 'h', 'I':
@@ -2808,7 +3029,7 @@ func (t Time) convertDateFormat(b rune, buf *bytes.Buffer) error {
 		if tt%12 == 0 {
 			buf.WriteString("12")
 		} else {
-			buf.WriteString(FormatIntWidthN(tt%12, 2))
+			writeIntWidthN(buf, tt%12, 2)
 		}
 	case 'l':
 		tt := t.Hour()
@@ -2818,7 +3039,7 @@ func (t Time) convertDateFormat(b rune, buf *bytes.Buffer) error {
 			buf.WriteString(strconv.FormatInt(int64(tt%12), 10))
 		}
 	case 'i':
-		buf.WriteString(FormatIntWidthN(t.Minute(), 2))
+		writeIntWidthN(buf, t.Minute(), 2)
 	case 'p':
 		hour := t.Hour()
 		if hour/12%2 == 0 {
@@ -2829,34 +3050,25 @@ func (t Time) convertDateFormat(b rune, buf *bytes.Buffer) error {
 	case 'r':
 		h := t.Hour()
 		h %= 24
-		switch {
-		case h == 0:
-			fmt.Fprintf(buf, "%02d:%02d:%02d AM", 12, t.Minute(), t.Second())
-		case h == 12:
-			fmt.Fprintf(buf, "%02d:%02d:%02d PM", 12, t.Minute(), t.Second())
-		case h < 12:
-			fmt.Fprintf(buf, "%02d:%02d:%02d AM", h, t.Minute(), t.Second())
-		default:
-			fmt.Fprintf(buf, "%02d:%02d:%02d PM", h-12, t.Minute(), t.Second())
-		}
+		writeClock12(buf, h, t.Minute(), t.Second())
 	case 'T':
-		fmt.Fprintf(buf, "%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+		buf.Write(appendClock(buf.AvailableBuffer(), t.Hour(), t.Minute(), t.Second()))
 	case 'S', 's':
-		buf.WriteString(FormatIntWidthN(t.Second(), 2))
+		writeIntWidthN(buf, t.Second(), 2)
 	case 'f':
-		fmt.Fprintf(buf, "%06d", t.Microsecond())
+		buf.Write(appendPaddedInt(buf.AvailableBuffer(), t.Microsecond(), 6))
 	case 'U':
 		w := t.Week(0)
-		buf.WriteString(FormatIntWidthN(w, 2))
+		writeIntWidthN(buf, w, 2)
 	case 'u':
 		w := t.Week(1)
-		buf.WriteString(FormatIntWidthN(w, 2))
+		writeIntWidthN(buf, w, 2)
 	case 'V':
 		w := t.Week(2)
-		buf.WriteString(FormatIntWidthN(w, 2))
+		writeIntWidthN(buf, w, 2)
 	case 'v':
 		_, w := t.YearWeek(3)
-		buf.WriteString(FormatIntWidthN(w, 2))
+		writeIntWidthN(buf, w, 2)
 	case 'a':
 		weekday := t.Weekday()
 		buf.WriteString(abbrevWeekdayName[weekday])
@@ -2869,20 +3081,20 @@ func (t Time) convertDateFormat(b rune, buf *bytes.Buffer) error {
 		if year < 0 {
 			buf.WriteString(strconv.FormatUint(uint64(math.MaxUint32), 10))
 		} else {
-			buf.WriteString(FormatIntWidthN(year, 4))
+			writeIntWidthN(buf, year, 4)
 		}
 	case 'x':
 		year, _ := t.YearWeek(3)
 		if year < 0 {
 			buf.WriteString(strconv.FormatUint(uint64(math.MaxUint32), 10))
 		} else {
-			buf.WriteString(FormatIntWidthN(year, 4))
+			writeIntWidthN(buf, year, 4)
 		}
 	case 'Y':
-		buf.WriteString(FormatIntWidthN(t.Year(), 4))
+		writeIntWidthN(buf, t.Year(), 4)
 	case 'y':
-		str := FormatIntWidthN(t.Year(), 4)
-		buf.WriteString(str[2:])
+		b := appendIntWidthN(buf.AvailableBuffer(), t.Year(), 4)
+		buf.Write(b[2:])
 	default:
 		buf.WriteRune(b)
 	}
@@ -2890,17 +3102,78 @@ func (t Time) convertDateFormat(b rune, buf *bytes.Buffer) error {
 	return nil
 }
 
+// twoDigits holds the numbers from 00 to 99.
+const twoDigits = "00010203040506070809101112131415161718192021222324252627282930313233343536373839404142434445464748495051525354555657585960616263646566676869707172737475767778798081828384858687888990919293949596979899"
+
 // FormatIntWidthN uses to format int with width. Insufficient digits are filled by 0.
+// It doesn't allocate for the numbers from 0 to 99 of width 2 or less.
 func FormatIntWidthN(num, n int) string {
-	numString := strconv.FormatInt(int64(num), 10)
-	if len(numString) >= n {
-		return numString
+	if num >= 0 && num < 100 {
+		switch {
+		case n == 2:
+			return twoDigits[num*2 : num*2+2]
+		case n < 2:
+			return strconv.Itoa(num)
+		}
+	}
+	var buf [24]byte
+	return string(appendIntWidthN(buf[:0], num, n))
+}
+
+// appendIntWidthN appends num formatted as FormatIntWidthN does to b.
+func appendIntWidthN(b []byte, num, n int) []byte {
+	start := len(b)
+	b = strconv.AppendInt(b, int64(num), 10)
+	digits := len(b) - start
+	if digits >= n {
+		return b
+	}
+	for range n - digits {
+		b = append(b, '0')
+	}
+	copy(b[start+n-digits:], b[start:start+digits])
+	for i := start; i < start+n-digits; i++ {
+		b[i] = '0'
+	}
+	return b
+}
+
+// appendPaddedInt appends num formatted as fmt does with "%0Nd" to b, where N
+// is n, the sign of a negative number comes before the zeros.
+func appendPaddedInt(b []byte, num, n int) []byte {
+	if num < 0 {
+		b = append(b, '-')
+		return appendIntWidthN(b, -num, n-1)
 	}
-	padBytes := make([]byte, n-len(numString))
-	for i := range padBytes {
-		padBytes[i] = '0'
+	return appendIntWidthN(b, num, n)
+}
+
+// writeIntWidthN writes num formatted as FormatIntWidthN does to buf.
+func writeIntWidthN(buf *bytes.Buffer, num, n int) {
+	buf.Write(appendIntWidthN(buf.AvailableBuffer(), num, n))
+}
+
+// appendClock appends the clock h:m:s formatted as "%02d:%02d:%02d" to b.
+func appendClock(b []byte, h, m, s int) []byte {
+	b = appendPaddedInt(b, h, 2)
+	b = append(b, ':')
+	b = appendPaddedInt(b, m, 2)
+	b = append(b, ':')
+	return appendPaddedInt(b, s, 2)
+}
+
+// writeClock12 writes the 12-hour clock of the hour h of a day, e.g. 01:02:03 PM.
+func writeClock12(buf *bytes.Buffer, h, m, s int) {
+	suffix := " AM"
+	if h >= 12 {
+		suffix = " PM"
+		h -= 12
+	}
+	if h == 0 {
+		h = 12
 	}
-	return string(padBytes) + numString
+	buf.Write(appendClock(buf.AvailableBuffer(), h, m, s))
+	buf.WriteString(suffix)
 }
 
 func abbrDayOfMonth(day int) string {
@@ -2920,6 +3193,13 @@ func abbrDayOfMonth(day int) string {
 
 // StrToDate converts date string according to format.
 // See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_date-format
+//
+// The day of year of %j is meant to be used with %Y, e.g. '%Y %j', and gives
+// the month and the day. It fails when the day of year is past the end of the
+// year, or when it disagrees with a month or a day given too, e.g. by %m or %d.
+//
+// The weekday of %a, %W or %w gives the date with a week, e.g. '%Y %U %W' or
+// '%x %v %a', and otherwise must be the weekday of the date.
 func (t *Time) StrToDate(typeCtx Context, date, format string) bool {
 	ctx := make(map[string]int)
 	var tm CoreTime
@@ -2950,9 +3230,18 @@ func (t *Time) StrToDate(typeCtx Context, date, format string) bool {
 // mysqlTimeFix fixes the Time use the values in the context.
 func mysqlTimeFix(t *CoreTime, ctx map[string]int) error {
 	// Key of the ctx is the format char, such as `%j` `%p` and so on.
-	if yearOfDay, ok := ctx["%j"]; ok {
-		// TODO: Implement the function that converts day of year to yy:mm:dd.
-		_ = yearOfDay
+	if dayOfYear, ok := ctx["%j"]; ok {
+		// The day of year is resolved with the year, given by %Y. A month or a day
+		// given too, e.g. by %m or %d, must be the one of the day of year.
+		month, day, ok := monthDayOfYear(t.Year(), dayOfYear)
+		if !ok || (t.Month() != 0 && t.Month() != month) || (t.Day() != 0 && t.Day() != day) {
+			return ErrWrongValue.GenWithStackByArgs(DateTimeStr, t)
+		}
+		t.setMonth(uint8(month))
+		t.setDay(uint8(day))
+	}
+	if err := weekFix(t, ctx); err != nil {
+		return err
 	}
 	if valueAMorPm, ok := ctx["%p"]; ok {
 		if _, ok := ctx["%H"]; ok {
@@ -2982,6 +3271,92 @@ func mysqlTimeFix(t *CoreTime, ctx map[string]int) error {
 	return nil
 }
 
+// monthDayOfYear returns the month and the day of the day of year dayOfYear, ok
+// is false when the year doesn't have that many days, e.g. 366 for 2023.
+func monthDayOfYear(year, dayOfYear int) (month, day int, ok bool) {
+	if dayOfYear < 1 || dayOfYear > calcDaysInYear(year) {
+		return 0, 0, false
+	}
+	day = dayOfYear
+	for month = 1; ; month++ {
+		days := daysByMonth[month-1]
+		if month == 2 && calcDaysInYear(year) == 366 {
+			days = 29
+		}
+		if day <= days {
+			return month, day, true
+		}
+		day -= days
+	}
+}
+
+// weekFix resolves the weekday of %a, %W or %w, kept in ctx["%w"] from 1 for
+// Monday to 7 for Sunday. With a week, of %U, %u, %V or %v, it gives the date
+// like in MySQL: %U and %u count the weeks of the year of %Y, %V and %v the weeks
+// of the year of %X and %x respectively. Without a week, it must be the weekday
+// of the date.
+func weekFix(t *CoreTime, ctx map[string]int) error {
+	weekday := ctx["%w"]
+	if weekday == 0 {
+		return nil
+	}
+	for _, token := range []string{"%U", "%u", "%V", "%v"} {
+		week, ok := ctx[token]
+		if !ok {
+			continue
+		}
+		sundayFirst := token == "%U" || token == "%V"
+		year := t.Year()
+		yearOfWeek, sundayFirstYear := -1, false
+		if y, ok := ctx["%X"]; ok {
+			yearOfWeek, sundayFirstYear = y, true
+		}
+		if y, ok := ctx["%x"]; ok {
+			yearOfWeek, sundayFirstYear = y, false
+		}
+		if token == "%V" || token == "%v" {
+			if yearOfWeek < 0 || sundayFirstYear != sundayFirst {
+				return ErrWrongValue.GenWithStackByArgs(DateTimeStr, t)
+			}
+			year = yearOfWeek
+		} else if yearOfWeek >= 0 {
+			return ErrWrongValue.GenWithStackByArgs(DateTimeStr, t)
+		}
+
+		// The days till the first day of the first week, then till the week and
+		// the day in it.
+		days := calcDaynr(year, 1, 1)
+		firstWeekday := calcWeekday(days, sundayFirst)
+		if sundayFirst {
+			if firstWeekday != 0 {
+				days += 7 - firstWeekday
+			}
+			days += (week-1)*7 + weekday%7
+		} else {
+			if firstWeekday > 3 {
+				days += 7
+			}
+			days += (week-1)*7 - firstWeekday + weekday - 1
+		}
+		if days <= 0 {
+			return ErrWrongValue.GenWithStackByArgs(DateTimeStr, t)
+		}
+		y, m, d := getDateFromDaynr(uint(days))
+		if y == 0 || (t.Month() != 0 && t.Month() != int(m)) || (t.Day() != 0 && t.Day() != int(d)) {
+			return ErrWrongValue.GenWithStackByArgs(DateTimeStr, t)
+		}
+		t.setYear(uint16(y))
+		t.setMonth(uint8(m))
+		t.setDay(uint8(d))
+		return nil
+	}
+	if t.Year() != 0 && t.Month() != 0 && t.Day() != 0 &&
+		calcWeekday(calcDaynr(t.Year(), t.Month(), t.Day()), false)+1 != weekday {
+		return ErrWrongValue.GenWithStackByArgs(DateTimeStr, t)
+	}
+	return nil
+}
+
 // strToDate converts date string according to format,
 // the value will be stored in argument t or ctx.
 // The second return value is true when success but still need to append a warning.
@@ -3089,19 +3464,19 @@ var dateFormatParserTable = map[string]dateFormatParser{
 	"%#": skipAllNums,           // Skip all numbers
 	"%.": skipAllPunct,          // Skip all punctation characters
 	"%@": skipAllAlpha,          // Skip all alpha characters
+	"%a": abbreviatedWeekday,    // Abbreviated weekday name (Sun..Sat)
+	"%W": weekdayName,           // Weekday name (Sunday..Saturday)
+	"%w": dayOfWeek,             // Day of the week (0=Sunday..6=Saturday)
+	"%U": weekMode0,             // Week (00..53), where Sunday is the first day of the week; WEEK() mode 0
+	"%u": weekMode1,             // Week (00..53), where Monday is the first day of the week; WEEK() mode 1
+	"%V": weekMode2,             // Week (01..53), where Sunday is the first day of the week; WEEK() mode 2; used with %X
+	"%v": weekMode3,             // Week (01..53), where Monday is the first day of the week; WEEK() mode 3; used with %x
+	"%X": yearOfWeekSundayFirst, // Year for the week where Sunday is the first day of the week, numeric, four digits; used with %V
+	"%x": yearOfWeekMondayFirst, // Year for the week, where Monday is the first day of the week, numeric, four digits; used with %v
 	// Deprecated since MySQL 5.7.5
 	"%y": yearNumericTwoDigits, // Year, numeric (two digits)
 	// TODO: Add the following...
-	// "%a": abbreviatedWeekday,         // Abbreviated weekday name (Sun..Sat)
 	// "%D": dayOfMonthWithSuffix,       // Day of the month with English suffix (0th, 1st, 2nd, 3rd)
-	// "%U": weekMode0,                  // Week (00..53), where Sunday is the first day of the week; WEEK() mode 0
-	// "%u": weekMode1,                  // Week (00..53), where Monday is the first day of the week; WEEK() mode 1
-	// "%V": weekMode2,                  // Week (01..53), where Sunday is the first day of the week; WEEK() mode 2; used with %X
-	// "%v": weekMode3,                  // Week (01..53), where Monday is the first day of the week; WEEK() mode 3; used with %x
-	// "%W": weekdayName,                // Weekday name (Sunday..Saturday)
-	// "%w": dayOfWeek,                  // Day of the week (0=Sunday..6=Saturday)
-	// "%X": yearOfWeek,                 // Year for the week where Sunday is the first day of the week, numeric, four digits; used with %V
-	// "%x": yearOfWeek,                 // Year for the week, where Monday is the first day of the week, numeric, four digits; used with %v
 }
 
 // GetFormatType checks the type(Duration, Date or Datetime) of a format string.
@@ -3122,7 +3497,7 @@ func GetFormatType(format string) (isDuration, isDate bool) {
 			switch token[1] {
 			case 'h', 'H', 'i', 'I', 's', 'S', 'k', 'l', 'f', 'r', 'T':
 				isDuration = true
-			case 'y', 'Y', 'm', 'M', 'c', 'b', 'D', 'd', 'e':
+			case 'y', 'Y', 'm', 'M', 'c', 'b', 'D', 'd', 'e', 'a', 'W', 'w', 'U', 'u', 'V', 'v', 'X', 'x':
 				isDate = true
 			}
 		}
@@ -3412,6 +3787,87 @@ func dayOfYearNumeric(_ *CoreTime, input string, ctx map[string]int) (string, bo
 	return input[step:], true
 }
 
+// setWeekday keeps the weekday, from 1 for Monday to 7 for Sunday, in ctx. It
+// fails when another weekday was given, e.g. with both %a and %w.
+func setWeekday(ctx map[string]int, weekday int) bool {
+	if v, ok := ctx["%w"]; ok && v != 0 && v != weekday {
+		return false
+	}
+	ctx["%w"] = weekday
+	return true
+}
+
+func abbreviatedWeekday(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	for i, weekday := range WeekdayNames {
+		if hasCaseInsensitivePrefix(input, weekday[:3]) {
+			return input[3:], setWeekday(ctx, i+1)
+		}
+	}
+	return input, false
+}
+
+func weekdayName(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	for i, weekday := range WeekdayNames {
+		if hasCaseInsensitivePrefix(input, weekday) {
+			return input[len(weekday):], setWeekday(ctx, i+1)
+		}
+	}
+	return input, false
+}
+
+func dayOfWeek(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	v, step := parseNDigits(input, 1) // 0..6
+	if step <= 0 || v > 6 {
+		return input, false
+	}
+	if v == 0 {
+		v = 7
+	}
+	return input[step:], setWeekday(ctx, v)
+}
+
+func weekMode0(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	return weekNumeric(input, ctx, "%U", 0)
+}
+
+func weekMode1(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	return weekNumeric(input, ctx, "%u", 0)
+}
+
+func weekMode2(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	return weekNumeric(input, ctx, "%V", 1)
+}
+
+func weekMode3(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	return weekNumeric(input, ctx, "%v", 1)
+}
+
+func weekNumeric(input string, ctx map[string]int, token string, minWeek int) (string, bool) {
+	v, step := parseNDigits(input, 2)
+	if step <= 0 || v < minWeek || v > 53 {
+		return input, false
+	}
+	ctx[token] = v
+	return input[step:], true
+}
+
+func yearOfWeekSundayFirst(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	return yearOfWeek(input, ctx, "%X")
+}
+
+func yearOfWeekMondayFirst(_ *CoreTime, input string, ctx map[string]int) (string, bool) {
+	return yearOfWeek(input, ctx, "%x")
+}
+
+func yearOfWeek(input string, ctx map[string]int, token string) (string, bool) {
+	v, step := parseNDigits(input, 4)
+	if step <= 0 {
+		return input, false
+	}
+	ctx[token] = v
+	return input[step:], true
+}
+
 func abbreviatedMonth(t *CoreTime, input string, _ map[string]int) (string, bool) {
 	if len(input) >= 3 {
 		monthName := strings.ToLower(input[:3])
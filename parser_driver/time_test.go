@@ -0,0 +1,682 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_driver
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	gotime "time"
+	// The timezones of the tests don't depend on the system.
+	_ "time/tzdata"
+
+	"github.com/abbychau/mysql-parser/parser_driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationConvertToTime(t *testing.T) {
+	ctx := &StmtContext{}
+	ctx.SetNow(gotime.Date(2024, 3, 15, 8, 0, 0, 0, gotime.UTC))
+
+	tests := []struct {
+		dur    gotime.Duration
+		fsp    int
+		tp     uint8
+		expect string
+	}{
+		{10*gotime.Hour + 10*gotime.Minute + 10*gotime.Second, 0, mysql.TypeDatetime, "2024-03-15 10:10:10"},
+		{10*gotime.Hour + 123456*gotime.Microsecond, 6, mysql.TypeDatetime, "2024-03-15 10:00:00.123456"},
+		{25*gotime.Hour + 30*gotime.Minute, 3, mysql.TypeDatetime, "2024-03-16 01:30:00.000"},
+		{MaxTime, 0, mysql.TypeTimestamp, "2024-04-18 22:59:59"},
+		{-gotime.Hour, 0, mysql.TypeDatetime, "2024-03-14 23:00:00"},
+		{-49 * gotime.Hour, 0, mysql.TypeDatetime, "2024-03-12 23:00:00"},
+		{25 * gotime.Hour, 0, mysql.TypeDate, "2024-03-16"},
+	}
+	for _, tt := range tests {
+		d := Duration{Duration: tt.dur, Fsp: tt.fsp}
+		res, err := d.ConvertToTime(ctx, tt.tp)
+		require.NoError(t, err)
+		require.Equal(t, tt.tp, res.Type())
+		require.Equal(t, tt.expect, res.String())
+		if tt.tp != mysql.TypeDate {
+			require.Equal(t, tt.fsp, res.Fsp())
+		}
+	}
+
+	// The result only depends on the pinned time.
+	d := Duration{Duration: gotime.Hour}
+	res1, err := d.ConvertToTime(ctx, mysql.TypeDatetime)
+	require.NoError(t, err)
+	res2, err := d.ConvertToTimeWithTimestamp(ctx, mysql.TypeDatetime, ctx.Now())
+	require.NoError(t, err)
+	require.Equal(t, 0, res1.Compare(res2))
+
+	for _, dur := range []gotime.Duration{MaxTime + gotime.Second, -MaxTime - gotime.Microsecond} {
+		_, err = Duration{Duration: dur}.ConvertToTime(ctx, mysql.TypeDatetime)
+		require.ErrorContains(t, err, "mysql error 1292")
+	}
+}
+
+func TestTimeConvertToDuration(t *testing.T) {
+	tests := []struct {
+		t      Time
+		expect string
+		fsp    int
+	}{
+		{NewTime(FromDate(2012, 12, 12, 10, 10, 10, 0), mysql.TypeDatetime, 0), "10:10:10", 0},
+		{NewTime(FromDate(2012, 12, 12, 10, 10, 10, 123456), mysql.TypeDatetime, 6), "10:10:10.123456", 6},
+		{NewTime(FromDate(2012, 12, 12, 23, 59, 59, 500000), mysql.TypeTimestamp, 1), "23:59:59.5", 1},
+		{NewTime(FromDate(2012, 12, 12, 0, 0, 0, 0), mysql.TypeDate, 0), "00:00:00", 0},
+		{NewTime(ZeroCoreTime, mysql.TypeDatetime, 3), "00:00:00.000", 3},
+	}
+	for _, tt := range tests {
+		d, err := tt.t.ConvertToDuration()
+		require.NoError(t, err)
+		require.Equal(t, tt.expect, d.String())
+		require.Equal(t, tt.fsp, d.Fsp)
+	}
+
+	// A date converts to a zero duration whatever its stored clock is.
+	date := NewTime(FromDate(2012, 12, 12, 10, 10, 10, 0), mysql.TypeDate, 0)
+	d, err := date.ConvertToDuration()
+	require.NoError(t, err)
+	require.Equal(t, gotime.Duration(0), d.Duration)
+
+	// Round trip keeps the clock and fsp.
+	ctx := &StmtContext{}
+	ctx.SetNow(gotime.Date(2012, 12, 12, 0, 0, 0, 0, gotime.UTC))
+	orig := NewTime(FromDate(2012, 12, 12, 10, 10, 10, 123000), mysql.TypeDatetime, 3)
+	d, err = orig.ConvertToDuration()
+	require.NoError(t, err)
+	back, err := d.ConvertToTime(ctx, mysql.TypeDatetime)
+	require.NoError(t, err)
+	require.Equal(t, orig.String(), back.String())
+	require.Equal(t, 3, back.Fsp())
+}
+
+func TestDurationAddSub(t *testing.T) {
+	hours := func(h int, fsp int) Duration {
+		return Duration{Duration: gotime.Duration(h) * gotime.Hour, Fsp: fsp}
+	}
+	maxDur := MaxMySQLDuration(0)
+
+	tests := []struct {
+		res      func() (Duration, error)
+		expect   string
+		fsp      int
+		truncate bool
+	}{
+		{func() (Duration, error) { return hours(1, 0).Add(hours(2, 3)) }, "03:00:00.000", 3, false},
+		{func() (Duration, error) { return hours(1, 2).Sub(hours(2, 1)) }, "-01:00:00.00", 2, false},
+		{func() (Duration, error) { return maxDur.Add(hours(0, 0)) }, "838:59:59", 0, false},
+		{func() (Duration, error) { return maxDur.Neg().Sub(hours(0, 0)) }, "-838:59:59", 0, false},
+		// Positive saturation.
+		{func() (Duration, error) { return maxDur.Add(hours(100, 0)) }, "838:59:59", 0, true},
+		{func() (Duration, error) { return maxDur.Add(hours(100, 4)) }, "838:59:59.0000", 4, true},
+		{func() (Duration, error) { return maxDur.Sub(hours(-1, 0)) }, "838:59:59", 0, true},
+		{func() (Duration, error) {
+			return Duration{Duration: math.MaxInt64 - 1}.Add(Duration{Duration: gotime.Hour, Fsp: 6})
+		}, "838:59:59.000000", 6, true},
+		// Negative saturation.
+		{func() (Duration, error) { return maxDur.Neg().Add(hours(-100, 0)) }, "-838:59:59", 0, true},
+		{func() (Duration, error) { return maxDur.Neg().Sub(hours(100, 2)) }, "-838:59:59.00", 2, true},
+		{func() (Duration, error) {
+			return Duration{Duration: math.MinInt64 + 1, Fsp: 1}.Sub(Duration{Duration: gotime.Hour})
+		}, "-838:59:59.0", 1, true},
+	}
+	for i, tt := range tests {
+		d, err := tt.res()
+		if tt.truncate {
+			require.True(t, ErrTruncatedWrongVal.Equal(err), "%d: %v", i, err)
+		} else {
+			require.NoError(t, err, i)
+		}
+		require.Equal(t, tt.expect, d.String(), i)
+		require.Equal(t, tt.fsp, d.Fsp, i)
+	}
+
+	// The error has the TIME value before the saturation.
+	_, err := maxDur.Add(hours(100, 0))
+	require.EqualError(t, err, "mysql error 1292: [time 938:59:59]")
+	_, err = maxDur.Neg().Sub(hours(100, 2))
+	require.EqualError(t, err, "mysql error 1292: [time -938:59:59]")
+}
+
+func TestParseISO8601Duration(t *testing.T) {
+	tests := []struct {
+		str      string
+		fsp      int
+		expect   string
+		truncate bool
+	}{
+		{"PT1H30M", 0, "01:30:00", false},
+		{"P2DT3H", 0, "51:00:00", false},
+		{"P1D", 0, "24:00:00", false},
+		{"PT45S", 0, "00:00:45", false},
+		{"PT90M", 0, "01:30:00", false},
+		{"pt1h2m3s", 0, "01:02:03", false},
+		{" PT1H ", 0, "01:00:00", false},
+		{"PT1.5S", 1, "00:00:01.5", false},
+		{"PT0.123456S", 6, "00:00:00.123456", false},
+		{"PT1.25S", 1, "00:00:01.3", false},
+		{"PT59.99S", 1, "00:01:00.0", false},
+		{"PT1.5S", 0, "00:00:02", false},
+		{"-PT1H30M", 0, "-01:30:00", false},
+		{"-P1DT0.5S", 2, "-24:00:00.50", false},
+		{"PT838H59M59S", 0, "838:59:59", false},
+		{"-PT838H59M59S", 0, "-838:59:59", false},
+		// Clamped.
+		{"P35D", 0, "838:59:59", true},
+		{"PT839H", 3, "838:59:59.000", true},
+		{"PT838H59M59.5S", 1, "838:59:59.0", true},
+		{"-P100DT1H", 0, "-838:59:59", true},
+		{"PT99999999999999999999999S", 0, "838:59:59", true},
+	}
+	for _, tt := range tests {
+		d, err := ParseISO8601Duration(tt.str, tt.fsp)
+		if tt.truncate {
+			require.ErrorContains(t, err, "time", tt.str)
+		} else {
+			require.NoError(t, err, tt.str)
+		}
+		require.Equal(t, tt.expect, d.String(), tt.str)
+		require.Equal(t, tt.fsp, d.Fsp, tt.str)
+	}
+
+	for _, str := range []string{
+		"", "P", "PT", "P1DT", "P1H", "PT1D", "P1Y", "P1W", "1H", "T1H", "PT1M2H", "PT1H1H",
+		"PT1.5M", "PT.5S", "PT1.S", "PTS", "P-1D", "--PT1H", "PT1H 2M", "PT1HT2M", "PT1H junk",
+	} {
+		d, err := ParseISO8601Duration(str, 0)
+		require.ErrorContains(t, err, "time", str)
+		require.Equal(t, ZeroDuration, d, str)
+	}
+}
+
+func TestParseDurationBoundaries(t *testing.T) {
+	for fsp := 0; fsp <= 6; fsp++ {
+		maxTime := "838:59:59"
+		if fsp > 0 {
+			maxTime += "." + strings.Repeat("0", fsp)
+		}
+		for _, tt := range []struct {
+			str      string
+			clamped  bool
+			truncate bool
+		}{
+			{"838:59:59", false, false},
+			{"8385959", false, false},
+			{"34 22:59:59", false, false},
+			// The fraction is rounded to fsp first.
+			{"838:59:59.000001", fsp == 6, fsp == 6},
+			{"838:59:59.4", fsp > 0, fsp > 0},
+			{"838:59:59.9", true, true},
+			{"8385959.9", true, true},
+			{"34 22:59:59.9", true, true},
+			{"839:00:00", true, true},
+			{"8390000", true, true},
+			// The compact numbers above the max are clamped even if their
+			// seconds are out of range.
+			{"8385960", true, true},
+			{"9999999", true, true},
+			{"35 00:00:00", true, true},
+		} {
+			for _, sign := range []string{"", "-"} {
+				str := sign + tt.str
+				d, isNull, err := ParseDuration(DefaultStmtNoWarningContext, str, fsp)
+				comment := fmt.Sprintf("%s fsp %d", str, fsp)
+				require.False(t, isNull, comment)
+				if tt.truncate {
+					require.True(t, ErrTruncatedWrongVal.Equal(err), comment)
+				} else {
+					require.NoError(t, err, comment)
+				}
+				if tt.clamped {
+					require.Equal(t, sign+maxTime, d.String(), comment)
+				} else {
+					require.True(t, strings.HasPrefix(d.String(), sign+"838:59:59"), comment)
+				}
+				require.Equal(t, fsp, d.Fsp, comment)
+			}
+		}
+	}
+
+	// The minutes and the seconds must be in range otherwise.
+	for _, str := range []string{"838:59:60", "-838:60:00", "1000060", "-1006000"} {
+		_, isNull, err := ParseDuration(DefaultStmtNoWarningContext, str, 0)
+		require.True(t, isNull, str)
+		require.True(t, ErrTruncatedWrongVal.Equal(err), str)
+	}
+}
+
+func TestFormatIntWidthN(t *testing.T) {
+	for num := -120; num < 12000; num += 7 {
+		for n := 0; n < 8; n++ {
+			want := strconv.Itoa(num)
+			if len(want) < n {
+				want = strings.Repeat("0", n-len(want)) + want
+			}
+			require.Equal(t, want, FormatIntWidthN(num, n))
+			require.Equal(t, fmt.Sprintf("%0*d", n, num), string(appendPaddedInt(nil, num, n)))
+		}
+	}
+	require.Zero(t, testing.AllocsPerRun(100, func() {
+		_ = FormatIntWidthN(7, 2)
+		_ = FormatIntWidthN(59, 2)
+	}))
+}
+
+func TestTimeFormatting(t *testing.T) {
+	tm := NewTime(FromDate(2012, 3, 4, 15, 6, 7, 89000), mysql.TypeDatetime, 6)
+	require.Equal(t, "2012-03-04 15:06:07.089000", tm.String())
+	s, err := tm.DateFormat("%j %r %T %f %y %h %I")
+	require.NoError(t, err)
+	require.Equal(t, "064 03:06:07 PM 15:06:07 089000 12 03 03", s)
+	tm = NewTime(FromDate(2012, 3, 4, 0, 6, 7, 0), mysql.TypeDatetime, 0)
+	s, err = tm.DateFormat("%r")
+	require.NoError(t, err)
+	require.Equal(t, "12:06:07 AM", s)
+
+	d := Duration{Duration: -(gotime.Hour + 2*gotime.Minute + 3*gotime.Second + 450*gotime.Millisecond), Fsp: 2}
+	require.Equal(t, "-01:02:03.45", d.String())
+	require.Equal(t, "-10203.45", d.ToNumber().String())
+	d.Fsp = 0
+	require.Equal(t, "-01:02:03", d.String())
+}
+
+func TestDateFormatStrict(t *testing.T) {
+	tm := NewTime(FromDate(2012, 3, 4, 15, 6, 7, 89000), mysql.TypeDatetime, 6)
+	zero := NewTime(ZeroCoreTime, mysql.TypeDate, 0)
+	tests := []struct {
+		tm      Time
+		layout  string
+		lenient string
+		strict  string
+		err     string
+	}{
+		{tm, "%Y-%m-%d %H:%i:%s.%f", "2012-03-04 15:06:07.089000", "2012-03-04 15:06:07.089000", ""},
+		{tm, "%D %j 100%%", "4th 064 100%", "4th 064 100%", ""},
+		{tm, "%X-%V %x-%v", "2012-10 2012-09", "2012-10 2012-09", ""},
+		{tm, "%Y-%q-%z", "2012-q-z", "", "[format specifier %q]"},
+		{tm, "%Y%", "2012", "", "[format specifier %]"},
+		{tm, "%x-%V", "2012-10", "", "[format specifier %x with %V]"},
+		{tm, "%X-%v", "2012-09", "", "[format specifier %X with %v]"},
+		{zero, "%Y %j", "0000 000", "", "[date 0000-00-00]"},
+		{zero, "%Y-%m-%d", "0000-00-00", "0000-00-00", ""},
+	}
+	for _, tt := range tests {
+		s, err := tt.tm.DateFormat(tt.layout)
+		require.NoError(t, err, tt.layout)
+		require.Equal(t, tt.lenient, s, tt.layout)
+		s, err = tt.tm.DateFormatStrict(tt.layout)
+		if tt.err != "" {
+			require.ErrorContains(t, err, tt.err, tt.layout)
+			continue
+		}
+		require.NoError(t, err, tt.layout)
+		require.Equal(t, tt.strict, s, tt.layout)
+	}
+}
+
+func TestToDaysToSeconds(t *testing.T) {
+	tests := []struct {
+		date    string
+		days    int64
+		seconds int64
+	}{
+		{"1970-01-01", 719528, 62167219200},
+		{"0001-01-01", 366, 31622400},
+		{"2000-02-29 12:34:56", 730544, 63119046896},
+		{"9999-12-31 23:59:59", 3652424, 315569519999},
+	}
+	for _, tt := range tests {
+		tm, err := ParseDatetime(DefaultStmtNoWarningContext, tt.date)
+		require.NoError(t, err, tt.date)
+		days, err := ToDays(tm)
+		require.NoError(t, err, tt.date)
+		require.Equal(t, tt.days, days, tt.date)
+		seconds, err := ToSeconds(tm)
+		require.NoError(t, err, tt.date)
+		require.Equal(t, tt.seconds, seconds, tt.date)
+		unix, err := UnixTimestamp(tm)
+		require.NoError(t, err, tt.date)
+		require.Equal(t, max(tt.seconds-62167219200, 0), unix, tt.date)
+	}
+
+	// ToDays is the inverse of TimeFromDays.
+	for days := int64(366); days < 3652425; days += 997 {
+		back, err := ToDays(TimeFromDays(days))
+		require.NoError(t, err)
+		require.Equal(t, days, back)
+	}
+
+	for _, tm := range []Time{
+		ZeroDate,
+		NewTime(FromDate(2020, 0, 1, 0, 0, 0, 0), mysql.TypeDate, 0),
+		NewTime(FromDate(2020, 1, 0, 0, 0, 0, 0), mysql.TypeDate, 0),
+	} {
+		_, err := ToDays(tm)
+		require.Error(t, err, tm.String())
+		_, err = ToSeconds(tm)
+		require.Error(t, err, tm.String())
+		_, err = UnixTimestamp(tm)
+		require.Error(t, err, tm.String())
+	}
+}
+
+func TestStrToDateDayOfYear(t *testing.T) {
+	tests := []struct {
+		date   string
+		format string
+		// expect is the date, empty if StrToDate fails.
+		expect string
+	}{
+		{"2023 001", "%Y %j", "2023-01-01 00:00:00"},
+		{"2023 120", "%Y %j", "2023-04-30 00:00:00"},
+		{"2023 059", "%Y %j", "2023-02-28 00:00:00"},
+		{"2023 060", "%Y %j", "2023-03-01 00:00:00"},
+		{"2024 059", "%Y %j", "2024-02-28 00:00:00"},
+		{"2024 060", "%Y %j", "2024-02-29 00:00:00"},
+		{"2024 061", "%Y %j", "2024-03-01 00:00:00"},
+		{"2023 365", "%Y %j", "2023-12-31 00:00:00"},
+		{"2023 366", "%Y %j", ""},
+		{"2024 366", "%Y %j", "2024-12-31 00:00:00"},
+		{"2024 367", "%Y %j", ""},
+		{"2000 366", "%Y %j", "2000-12-31 00:00:00"},
+		{"1900 366", "%Y %j", ""},
+		{"2023 000", "%Y %j", ""},
+		{"2023 120 13:14", "%Y %j %H:%i", "2023-04-30 13:14:00"},
+		// The month and the day must agree with the day of year.
+		{"2023 04 120", "%Y %m %j", "2023-04-30 00:00:00"},
+		{"2023 05 120", "%Y %m %j", ""},
+		{"2023 30 120", "%Y %d %j", "2023-04-30 00:00:00"},
+		{"2023 29 120", "%Y %d %j", ""},
+		{"2023 Apr 120", "%Y %b %j", "2023-04-30 00:00:00"},
+	}
+	for _, tt := range tests {
+		var tm Time
+		ok := tm.StrToDate(DefaultStmtNoWarningContext, tt.date, tt.format)
+		if tt.expect == "" {
+			require.False(t, ok, tt.date)
+			continue
+		}
+		require.True(t, ok, tt.date)
+		require.Equal(t, tt.expect, tm.String(), tt.date)
+	}
+}
+
+func TestStrToDateWeek(t *testing.T) {
+	tests := []struct {
+		date   string
+		format string
+		// expect is the date, empty if StrToDate fails.
+		expect string
+	}{
+		// The weekday must be the one of the date.
+		{"Tuesday, 05 March 2024", "%W, %d %M %Y", "2024-03-05 00:00:00"},
+		{"tuesday, 05 march 2024", "%W, %d %M %Y", "2024-03-05 00:00:00"},
+		{"Monday, 05 March 2024", "%W, %d %M %Y", ""},
+		{"Tue 2024-03-05 10:11:12", "%a %Y-%m-%d %H:%i:%s", "2024-03-05 10:11:12"},
+		{"Wed 2024-03-05", "%a %Y-%m-%d", ""},
+		{"Tux 2024-03-05", "%a %Y-%m-%d", ""},
+		{"2 2024-03-05", "%w %Y-%m-%d", "2024-03-05 00:00:00"},
+		{"0 2024-03-10", "%w %Y-%m-%d", "2024-03-10 00:00:00"},
+		{"1 2024-03-10", "%w %Y-%m-%d", ""},
+		{"7 2024-03-10", "%w %Y-%m-%d", ""},
+		{"Tue 2 2024-03-05", "%a %w %Y-%m-%d", "2024-03-05 00:00:00"},
+		{"Tue 3 2024-03-05", "%a %w %Y-%m-%d", ""},
+		{"Tue 2024 065", "%a %Y %j", "2024-03-05 00:00:00"},
+		{"Sun 2024 065", "%a %Y %j", ""},
+
+		// The week and the weekday give the date.
+		{"2024 10 Tuesday", "%Y %U %W", "2024-03-12 00:00:00"},
+		{"2024 10 2", "%Y %U %w", "2024-03-12 00:00:00"},
+		{"2024 10 Tue", "%Y %u %a", "2024-03-05 00:00:00"},
+		{"2024 00 Sat", "%Y %U %a", "2024-01-06 00:00:00"},
+		{"2024 10 Tuesday", "%X %V %W", "2024-03-12 00:00:00"},
+		{"2024 10 Tuesday", "%x %v %W", "2024-03-05 00:00:00"},
+		{"2021 01 Fri", "%x %v %a", "2021-01-08 00:00:00"},
+		{"2020 53 Fri", "%x %v %a", "2021-01-01 00:00:00"},
+		{"2024 03 10 Tue", "%Y %m %u %a", "2024-03-05 00:00:00"},
+		{"2024 04 10 Tue", "%Y %m %u %a", ""},
+		// %V and %v need %X and %x respectively, %U and %u need %Y.
+		{"2024 10 Tuesday", "%Y %V %W", ""},
+		{"2024 10 Tuesday", "%x %V %W", ""},
+		{"2024 10 Tuesday", "%X %v %W", ""},
+		{"2024 10 Tuesday", "%X %U %W", ""},
+		{"2024 00 Tuesday", "%X %V %W", ""},
+		{"2024 54 Tuesday", "%Y %U %W", ""},
+	}
+	for _, tt := range tests {
+		var tm Time
+		ok := tm.StrToDate(DefaultStmtNoWarningContext, tt.date, tt.format)
+		if tt.expect == "" {
+			require.False(t, ok, tt.date+" "+tt.format)
+			continue
+		}
+		require.True(t, ok, tt.date+" "+tt.format)
+		require.Equal(t, tt.expect, tm.String(), tt.date+" "+tt.format)
+	}
+}
+
+func TestWeekModes(t *testing.T) {
+	// The expected values are the ones of WEEK(date, mode) and YEARWEEK(date, mode)
+	// of MySQL for the modes 0 to 7.
+	tests := []struct {
+		date     string
+		week     [8]int
+		yearWeek [8]int
+	}{
+		{"2019-01-01", [8]int{0, 1, 52, 1, 1, 0, 1, 53}, [8]int{201852, 201901, 201852, 201901, 201901, 201853, 201901, 201853}},
+		{"2019-01-04", [8]int{0, 1, 52, 1, 1, 0, 1, 53}, [8]int{201852, 201901, 201852, 201901, 201901, 201853, 201901, 201853}},
+		{"2019-01-07", [8]int{1, 2, 1, 2, 2, 1, 2, 1}, [8]int{201901, 201902, 201901, 201902, 201902, 201901, 201902, 201901}},
+		{"2019-12-29", [8]int{52, 52, 52, 52, 53, 51, 1, 51}, [8]int{201952, 201952, 201952, 201952, 202001, 201951, 202001, 201951}},
+		{"2019-12-31", [8]int{52, 53, 52, 1, 53, 52, 1, 52}, [8]int{201952, 202001, 201952, 202001, 202001, 201952, 202001, 201952}},
+		{"2020-01-01", [8]int{0, 1, 52, 1, 1, 0, 1, 52}, [8]int{201952, 202001, 201952, 202001, 202001, 201952, 202001, 201952}},
+		{"2020-01-04", [8]int{0, 1, 52, 1, 1, 0, 1, 52}, [8]int{201952, 202001, 201952, 202001, 202001, 201952, 202001, 201952}},
+		{"2020-01-07", [8]int{1, 2, 1, 2, 2, 1, 2, 1}, [8]int{202001, 202002, 202001, 202002, 202002, 202001, 202002, 202001}},
+		{"2020-12-29", [8]int{52, 53, 52, 53, 53, 52, 53, 52}, [8]int{202052, 202053, 202052, 202053, 202053, 202052, 202053, 202052}},
+		{"2020-12-31", [8]int{52, 53, 52, 53, 53, 52, 53, 52}, [8]int{202052, 202053, 202052, 202053, 202053, 202052, 202053, 202052}},
+		{"2021-01-01", [8]int{0, 0, 52, 53, 0, 0, 53, 52}, [8]int{202052, 202053, 202052, 202053, 202053, 202052, 202053, 202052}},
+		{"2021-01-04", [8]int{1, 1, 1, 1, 1, 1, 1, 1}, [8]int{202101, 202101, 202101, 202101, 202101, 202101, 202101, 202101}},
+		{"2021-01-07", [8]int{1, 1, 1, 1, 1, 1, 1, 1}, [8]int{202101, 202101, 202101, 202101, 202101, 202101, 202101, 202101}},
+		{"2021-12-29", [8]int{52, 52, 52, 52, 52, 52, 52, 52}, [8]int{202152, 202152, 202152, 202152, 202152, 202152, 202152, 202152}},
+		{"2021-12-31", [8]int{52, 52, 52, 52, 52, 52, 52, 52}, [8]int{202152, 202152, 202152, 202152, 202152, 202152, 202152, 202152}},
+		{"2022-01-01", [8]int{0, 0, 52, 52, 0, 0, 52, 52}, [8]int{202152, 202152, 202152, 202152, 202152, 202152, 202152, 202152}},
+		{"2022-01-04", [8]int{1, 1, 1, 1, 1, 1, 1, 1}, [8]int{202201, 202201, 202201, 202201, 202201, 202201, 202201, 202201}},
+		{"2022-01-07", [8]int{1, 1, 1, 1, 1, 1, 1, 1}, [8]int{202201, 202201, 202201, 202201, 202201, 202201, 202201, 202201}},
+		{"2022-12-29", [8]int{52, 52, 52, 52, 52, 52, 52, 52}, [8]int{202252, 202252, 202252, 202252, 202252, 202252, 202252, 202252}},
+		{"2022-12-31", [8]int{52, 52, 52, 52, 52, 52, 52, 52}, [8]int{202252, 202252, 202252, 202252, 202252, 202252, 202252, 202252}},
+		{"2023-01-01", [8]int{1, 0, 1, 52, 1, 0, 1, 52}, [8]int{202301, 202252, 202301, 202252, 202301, 202252, 202301, 202252}},
+		{"2023-01-04", [8]int{1, 1, 1, 1, 1, 1, 1, 1}, [8]int{202301, 202301, 202301, 202301, 202301, 202301, 202301, 202301}},
+		{"2023-01-07", [8]int{1, 1, 1, 1, 1, 1, 1, 1}, [8]int{202301, 202301, 202301, 202301, 202301, 202301, 202301, 202301}},
+		{"2023-12-29", [8]int{52, 52, 52, 52, 52, 52, 52, 52}, [8]int{202352, 202352, 202352, 202352, 202352, 202352, 202352, 202352}},
+		{"2023-12-31", [8]int{53, 52, 53, 52, 53, 52, 1, 52}, [8]int{202353, 202352, 202353, 202352, 202401, 202352, 202401, 202352}},
+		{"2024-01-01", [8]int{0, 1, 53, 1, 1, 1, 1, 1}, [8]int{202353, 202401, 202353, 202401, 202401, 202401, 202401, 202401}},
+		{"2024-01-04", [8]int{0, 1, 53, 1, 1, 1, 1, 1}, [8]int{202353, 202401, 202353, 202401, 202401, 202401, 202401, 202401}},
+		{"2024-01-07", [8]int{1, 1, 1, 1, 2, 1, 2, 1}, [8]int{202401, 202401, 202401, 202401, 202402, 202401, 202402, 202401}},
+		{"2024-12-29", [8]int{52, 52, 52, 52, 53, 52, 1, 52}, [8]int{202452, 202452, 202452, 202452, 202501, 202452, 202501, 202452}},
+		{"2024-12-31", [8]int{52, 53, 52, 1, 53, 53, 1, 53}, [8]int{202452, 202501, 202452, 202501, 202501, 202453, 202501, 202453}},
+		{"2025-01-01", [8]int{0, 1, 52, 1, 1, 0, 1, 53}, [8]int{202452, 202501, 202452, 202501, 202501, 202453, 202501, 202453}},
+		{"2025-01-04", [8]int{0, 1, 52, 1, 1, 0, 1, 53}, [8]int{202452, 202501, 202452, 202501, 202501, 202453, 202501, 202453}},
+		{"2025-01-07", [8]int{1, 2, 1, 2, 2, 1, 2, 1}, [8]int{202501, 202502, 202501, 202502, 202502, 202501, 202502, 202501}},
+		{"2025-12-29", [8]int{52, 53, 52, 1, 53, 52, 53, 52}, [8]int{202552, 202601, 202552, 202601, 202553, 202552, 202553, 202552}},
+		{"2025-12-31", [8]int{52, 53, 52, 1, 53, 52, 53, 52}, [8]int{202552, 202601, 202552, 202601, 202553, 202552, 202553, 202552}},
+	}
+	for _, tt := range tests {
+		var y, m, d int
+		_, err := fmt.Sscanf(tt.date, "%d-%d-%d", &y, &m, &d)
+		require.NoError(t, err)
+		ct := FromDate(y, m, d, 0, 0, 0, 0)
+		for mode := range 8 {
+			require.NoError(t, WeekMode(mode))
+			require.Equal(t, tt.week[mode], ct.Week(mode), "WEEK(%s, %d)", tt.date, mode)
+			year, week := ct.YearWeek(mode)
+			require.Equal(t, tt.yearWeek[mode], year*100+week, "YEARWEEK(%s, %d)", tt.date, mode)
+		}
+	}
+
+	require.Error(t, WeekMode(-1))
+	require.Error(t, WeekMode(8))
+}
+
+func TestParseDatetimeTimezone(t *testing.T) {
+	// The times are converted to the UTC of the context.
+	ctx := &StmtContext{}
+
+	for _, tt := range []struct {
+		str    string
+		expect string
+	}{
+		{"2020-01-01 10:00:00+14:00", "2019-12-31 20:00:00"},
+		{"2020-01-01 10:00:00-14:00", "2020-01-02 00:00:00"},
+		{"2020-01-01 10:00:00+08:00", "2020-01-01 02:00:00"},
+		{"2020-01-01 10:00:00+8:00", "2020-01-01 02:00:00"},
+		{"2020-01-01 10:00:00-5:30", "2020-01-01 15:30:00"},
+		{"2020-01-01 10:00:00.123+8:30", "2020-01-01 01:30:00.123"},
+		{"2020-01-01 10:00:00+0800", "2020-01-01 02:00:00"},
+		{"2020-01-01 10:00:00+08", "2020-01-01 02:00:00"},
+		{"2020-01-01 10:00:00+00:00", "2020-01-01 10:00:00"},
+		{"2020-01-01T10:00:00Z", "2020-01-01 10:00:00"},
+	} {
+		tm, err := ParseTime(ctx, tt.str, mysql.TypeDatetime, 3)
+		require.NoError(t, err, tt.str)
+		require.Equal(t, tt.expect, strings.TrimSuffix(tm.String(), ".000"), tt.str)
+	}
+
+	// The offsets out of range are reported as such.
+	for _, tt := range []struct {
+		str    string
+		offset string
+	}{
+		{"2020-01-01 10:00:00+14:01", "+14:01"},
+		{"2020-01-01 10:00:00-14:30", "-14:30"},
+		{"2020-01-01 10:00:00+15:00", "+15:00"},
+		{"2020-01-01 10:00:00-00:00", "-00:00"},
+		{"2020-01-01 10:00:00+00:60", "+00:60"},
+		{"2020-01-01 10:00:00.5+9:60", "+9:60"},
+		{"20200101100000+00:60", "+00:60"},
+		{"2020-01-01 10:00:00+1500", "+1500"},
+		{"2020-01-01 10:00:00+15", "+15"},
+	} {
+		_, err := ParseTime(ctx, tt.str, mysql.TypeDatetime, 0)
+		require.True(t, ErrInvalidTimeZoneOffset.Equal(err), "%s: %v", tt.str, err)
+		require.ErrorContains(t, err, tt.offset, tt.str)
+	}
+
+	// A minute out of range is rejected when the offset is read as time fields.
+	for _, str := range []string{"2020-01-01 10:00+00:60", "2020-01-01 10+00:60", "2020-01-01 10:00+8:60"} {
+		_, err := ParseTime(ctx, str, mysql.TypeDatetime, 0)
+		require.True(t, ErrWrongValue.Equal(err), "%s: %v", str, err)
+	}
+}
+
+func TestTimeZoneConverter(t *testing.T) {
+	newYork, err := gotime.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tokyo, err := gotime.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// The conversions around the DST transitions of New York in 2024, spring
+	// forward on March 10 at 2:00 and fall back on November 3 at 2:00, are the
+	// ones of ConvertTimeZone.
+	for _, zones := range [][2]*gotime.Location{{newYork, gotime.UTC}, {gotime.UTC, newYork}, {newYork, tokyo}, {tokyo, newYork}} {
+		c := NewTimeZoneConverter(zones[0], zones[1])
+		for _, day := range []int{gotime.Date(2024, 3, 9, 0, 0, 0, 0, gotime.UTC).YearDay(), gotime.Date(2024, 11, 2, 0, 0, 0, 0, gotime.UTC).YearDay()} {
+			start := gotime.Date(2024, 1, day, 0, 0, 0, 0, gotime.UTC)
+			for d := gotime.Duration(0); d < 72*gotime.Hour; d += 7*gotime.Minute + 13*gotime.Second + 1001*gotime.Microsecond {
+				tm := NewTime(FromGoTime(start.Add(d)), mysql.TypeTimestamp, 6)
+				expected := tm
+				expectedErr := expected.ConvertTimeZone(zones[0], zones[1])
+				converted, err := c.Convert(tm)
+				require.Equal(t, expected, converted, "%s from %s to %s", tm, zones[0], zones[1])
+				if expectedErr == nil {
+					require.NoError(t, err, tm)
+				} else {
+					require.EqualError(t, err, expectedErr.Error(), tm)
+				}
+			}
+		}
+	}
+
+	c := NewTimeZoneConverter(newYork, gotime.UTC)
+	for _, tt := range []struct {
+		str    string
+		expect string
+	}{
+		{"2024-03-10 01:59:59.999999", "2024-03-10 06:59:59.999999"},
+		{"2024-03-10 03:00:00", "2024-03-10 07:00:00"},
+		{"2024-03-10 01:00:00", "2024-03-10 06:00:00"},
+		{"2024-11-03 00:59:59", "2024-11-03 04:59:59"},
+		{"2024-11-03 02:00:00", "2024-11-03 07:00:00"},
+		{"0000-00-00 00:00:00", "0000-00-00 00:00:00"},
+	} {
+		tm, err := ParseTime(DefaultStmtNoWarningContext, tt.str, mysql.TypeDatetime, 6)
+		require.NoError(t, err)
+		converted, err := c.Convert(tm)
+		require.NoError(t, err, tt.str)
+		require.Equal(t, tt.expect, strings.TrimSuffix(converted.String(), ".000000"), tt.str)
+		require.Equal(t, mysql.TypeDatetime, converted.Type())
+		require.Equal(t, 6, converted.Fsp())
+	}
+
+	// The times in the gap of spring forward, and the invalid dates, aren't
+	// converted.
+	for _, ct := range []CoreTime{
+		FromDate(2024, 3, 10, 2, 0, 0, 0),
+		FromDate(2024, 3, 10, 2, 59, 59, 0),
+		FromDate(2024, 2, 30, 10, 0, 0, 0),
+		FromDate(2024, 0, 10, 10, 0, 0, 0),
+	} {
+		tm := NewTime(ct, mysql.TypeDatetime, 0)
+		converted, err := c.Convert(tm)
+		require.True(t, ErrWrongValue.Equal(err), "%s: %v", tm, err)
+		require.Equal(t, tm, converted)
+	}
+
+	// A timestamp in the gap of spring forward is moved after it.
+	tm, err := ParseTime(DefaultStmtNoWarningContext, "2024-03-10 02:30:00", mysql.TypeDatetime, 0)
+	require.NoError(t, err)
+	err = checkTimestampType(tm.CoreTime(), newYork)
+	require.True(t, ErrTimestampInDSTTransition.Equal(err), "%v", err)
+	require.NoError(t, checkTimestampType(tm.CoreTime(), gotime.UTC))
+	tm, err = ParseTime(DefaultStmtNoWarningContext, "2038-01-19 03:14:08", mysql.TypeDatetime, 0)
+	require.NoError(t, err)
+	require.True(t, ErrWrongValue.Equal(checkTimestampType(tm.CoreTime(), gotime.UTC)))
+	require.NoError(t, checkTimestampType(tm.CoreTime(), tokyo))
+}
+
+func BenchmarkTimeZoneConverter(b *testing.B) {
+	newYork, err := gotime.LoadLocation("America/New_York")
+	require.NoError(b, err)
+	times := make([]Time, 1024)
+	start := gotime.Date(2024, 3, 1, 0, 0, 0, 0, gotime.UTC)
+	for i := range times {
+		times[i] = NewTime(FromGoTime(start.Add(gotime.Duration(i)*gotime.Minute)), mysql.TypeTimestamp, 0)
+	}
+	b.Run("ConvertTimeZone", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tm := times[i%len(times)]
+			_ = tm.ConvertTimeZone(newYork, gotime.UTC)
+		}
+	})
+	b.Run("TimeZoneConverter", func(b *testing.B) {
+		c := NewTimeZoneConverter(newYork, gotime.UTC)
+		for i := 0; i < b.N; i++ {
+			_, _ = c.Convert(times[i%len(times)])
+		}
+	})
+}
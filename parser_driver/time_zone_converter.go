@@ -0,0 +1,152 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_driver
+
+import (
+	"math"
+	gotime "time"
+
+	"github.com/abbychau/mysql-parser/parser_driver/mysql"
+	"github.com/pingcap/errors"
+)
+
+// TimeZoneConverter converts times from a timezone to another like
+// Time.ConvertTimeZone, with the same results and errors, for many times. It
+// keeps the period of each timezone, between two of its transitions, of the last
+// time converted, so that the times of the same periods are converted without
+// looking the timezones up. It isn't safe for concurrent use.
+type TimeZoneConverter struct {
+	from, to *gotime.Location
+	fromZone zonePeriod
+	toZone   zonePeriod
+}
+
+// zonePeriod is a period of a timezone with a constant offset, from start to
+// end in Unix seconds, end excluded. The zero zonePeriod is empty.
+type zonePeriod struct {
+	start, end int64
+	offset     int64
+}
+
+func (p *zonePeriod) contains(sec int64) bool {
+	return p.start <= sec && sec < p.end
+}
+
+// set sets p to the period of the timezone of t containing t.
+func (p *zonePeriod) set(t gotime.Time) {
+	start, end := t.ZoneBounds()
+	_, offset := t.Zone()
+	p.start, p.end, p.offset = math.MinInt64, math.MaxInt64, int64(offset)
+	// A timezone without transitions has no bounds.
+	if !start.IsZero() {
+		p.start = start.Unix()
+	}
+	if !end.IsZero() {
+		p.end = end.Unix()
+	}
+}
+
+// NewTimeZoneConverter returns a TimeZoneConverter from the timezone from to
+// the timezone to.
+func NewTimeZoneConverter(from, to *gotime.Location) *TimeZoneConverter {
+	return &TimeZoneConverter{from: from, to: to}
+}
+
+// Convert returns t converted, see Time.ConvertTimeZone. On error, t is
+// returned unchanged, e.g. for a time in the gap of a DST transition of the
+// timezone from.
+func (c *TimeZoneConverter) Convert(t Time) (Time, error) {
+	if t.IsZero() {
+		return t, nil
+	}
+	if ct, ok := c.convertInPeriods(t.CoreTime()); ok {
+		t.SetCoreTime(ct)
+		return t, nil
+	}
+	raw, err := t.GoTime(c.from)
+	if err != nil {
+		return t, errors.Trace(err)
+	}
+	converted := raw.In(c.to)
+	c.fromZone.set(raw)
+	c.toZone.set(converted)
+	t.SetCoreTime(FromGoTime(converted))
+	return t, nil
+}
+
+// unixEpochDaynr is the daynr of 1970-01-01, see calcDaynr.
+const unixEpochDaynr = 719528
+
+// convertInPeriods converts t with the offsets of the periods kept, it returns
+// false when t isn't in them, or isn't a valid date of the years 1 to 9999.
+func (c *TimeZoneConverter) convertInPeriods(t CoreTime) (CoreTime, bool) {
+	year, month, day := t.Year(), t.Month(), t.Day()
+	hour, minute, second, microsecond := t.Hour(), t.Minute(), t.Second(), t.Microsecond()
+	// An invalid date, e.g. 2025-02-30, is reported by GoTime.
+	if year < 1 || month < 1 || month > 12 || day < 1 || day > GetLastDay(year, month) ||
+		hour > 23 || minute > 59 || second > 59 || microsecond > 999999 {
+		return 0, false
+	}
+	sec := int64(calcDaynr(year, month, day)-unixEpochDaynr)*secondsIn24Hour + int64(hour*3600+minute*60+second)
+	// gotime.Date takes the offset of the period containing the wall clock read
+	// as UTC, if the time it gives is in the period too, see its code.
+	if !c.fromZone.contains(sec) || !c.fromZone.contains(sec-c.fromZone.offset) {
+		return 0, false
+	}
+	sec -= c.fromZone.offset
+	if !c.toZone.contains(sec) {
+		return 0, false
+	}
+	local := sec + c.toZone.offset
+	days, secOfDay := local/secondsIn24Hour, local%secondsIn24Hour
+	if secOfDay < 0 {
+		days, secOfDay = days-1, secOfDay+secondsIn24Hour
+	}
+	daynr := days + unixEpochDaynr
+	if daynr <= 365 || daynr >= 3652500 {
+		return 0, false
+	}
+	year2, month2, day2 := getDateFromDaynr(uint(daynr))
+	return FromDate(int(year2), int(month2), int(day2), int(secOfDay/3600), int(secOfDay%3600/60), int(secOfDay%60), microsecond), true
+}
+
+// checkTimestamp checks that t, a time of the timezone from, is a valid
+// timestamp. The timezone to must be BoundTimezone.
+func (c *TimeZoneConverter) checkTimestamp(t CoreTime) error {
+	if compareTime(t, ZeroCoreTime) == 0 {
+		return nil
+	}
+
+	checkTime := t
+	if c.from != BoundTimezone {
+		convertTime, err := c.Convert(NewTime(t, mysql.TypeTimestamp, DefaultFsp))
+		if err != nil {
+			_, err2 := adjustTimestampErrForDST(c.from, t.String(), mysql.TypeTimestamp, Time{t}, err)
+			return err2
+		}
+		checkTime = convertTime.coreTime
+	}
+	if compareTime(checkTime, MaxTimestamp.coreTime) > 0 || compareTime(checkTime, MinTimestamp.coreTime) < 0 {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, t))
+	}
+
+	// The conversion has checked t is a time of the timezone from.
+	if c.from == BoundTimezone {
+		if _, err := t.GoTime(c.from); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	return nil
+}
@@ -28,17 +28,25 @@ func (c *StmtContext) HandleTruncate(err error) error {
 	}
 
 	err = errors.Cause(err)
-	if e, ok := err.(*errors.Error); !ok ||
-		(e.Code() != mysql.ErrTruncatedWrongValue &&
-			e.Code() != mysql.ErrDataTooLong &&
-			e.Code() != mysql.ErrTruncatedWrongValueForField &&
-			e.Code() != mysql.ErrWarnDataOutOfRange &&
-			e.Code() != mysql.ErrDataOutOfRange &&
-			e.Code() != 1367 && // ErrBadNumber
-			e.Code() != mysql.ErrWrongValueForType &&
-			e.Code() != mysql.ErrDatetimeFunctionOverflow &&
-			e.Code() != mysql.WarnDataTruncated &&
-			e.Code() != 1292) { // ErrIncorrectDatetimeValue
+	var code int
+	switch e := err.(type) {
+	case *errors.Error:
+		code = int(e.Code())
+	case *TError:
+		code = int(e.Code())
+	default:
+		return err
+	}
+	if code != mysql.ErrTruncatedWrongValue &&
+		code != mysql.ErrDataTooLong &&
+		code != mysql.ErrTruncatedWrongValueForField &&
+		code != mysql.ErrWarnDataOutOfRange &&
+		code != mysql.ErrDataOutOfRange &&
+		code != 1367 && // ErrBadNumber
+		code != mysql.ErrWrongValueForType &&
+		code != mysql.ErrDatetimeFunctionOverflow &&
+		code != mysql.WarnDataTruncated &&
+		code != 1292 { // ErrIncorrectDatetimeValue
 		return err
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -305,19 +306,16 @@ func Number(s string) (int, string, error) {
 	return num, s[i:], err
 }
 
-// Digit parses a specific number of digits
+// Digit parses the leading digits of s, at least n of them
 func Digit(s string, n int) (string, string, error) {
-	if len(s) < n {
-		return "", s, fmt.Errorf("not enough digits")
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
 	}
-	
-	for i := 0; i < n; i++ {
-		if s[i] < '0' || s[i] > '9' {
-			return "", s, fmt.Errorf("expected digit")
-		}
+	if i < n {
+		return "", s, fmt.Errorf("expected at least %d digits", n)
 	}
-	
-	return s[:n], s[n:], nil
+	return s[:i], s[i:], nil
 }
 
 // AnyPunct parses any punctuation character
@@ -344,12 +342,32 @@ type Context interface {
 	Location() *time.Location
 }
 
+// NowProvider is an optional interface of Context. A Context implementing it
+// decides the current time used by conversions that need today's date, e.g.
+// casting a TIME value to DATETIME, so that their result is deterministic.
+type NowProvider interface {
+	Now() time.Time
+}
+
+// ContextNow returns the current time seen by ctx. It falls back to the wall
+// clock if ctx doesn't implement NowProvider.
+func ContextNow(ctx Context) time.Time {
+	if p, ok := ctx.(NowProvider); ok {
+		return p.Now()
+	}
+	return time.Now()
+}
+
 // StmtContext is a concrete implementation of Context
 type StmtContext struct {
 	IgnoreTruncate    bool
 	TruncateAsWarning bool
 	flags             ContextFlags
 	warnings          []error
+	// discardWarnings, if true, drops the warnings appended instead of keeping
+	// them, see DefaultStmtNoWarningContext.
+	discardWarnings bool
+	now             time.Time
 }
 
 // ContextFlags represents context flags
@@ -360,6 +378,10 @@ type ContextFlags struct {
 	InSelectStmt           bool
 	ignoreZeroInDate       bool
 	DividedByZeroAsWarning bool
+	// TruncateJSONFloatToInt makes the casts of the JSON floats to integers
+	// truncate the floats toward zero instead of rounding them, see
+	// ConvertJSONToInt.
+	TruncateJSONFloatToInt bool
 	ignoreTruncateErr      bool
 	truncateAsWarning      bool
 }
@@ -369,6 +391,12 @@ func (c *StmtContext) Flags() ContextFlags {
 	return c.flags
 }
 
+// SetFlags sets the context flags, e.g. to the ones of Flags with a field
+// changed.
+func (c *StmtContext) SetFlags(flags ContextFlags) {
+	c.flags = flags
+}
+
 // IgnoreTruncateErr returns whether to ignore truncate errors
 func (cf ContextFlags) IgnoreTruncateErr() bool {
 	return cf.ignoreTruncateErr
@@ -422,22 +450,60 @@ func (cf ContextFlags) IgnoreZeroDateErr() bool {
 
 // AppendWarning adds a warning to the context
 func (c *StmtContext) AppendWarning(err error) {
+	if c.discardWarnings {
+		return
+	}
 	if c.warnings == nil {
 		c.warnings = make([]error, 0)
 	}
 	c.warnings = append(c.warnings, err)
 }
 
+// GetWarnings returns the warnings appended to the context, e.g. by ParseTime
+// or StrToInt, in the order they were appended.
+func (c *StmtContext) GetWarnings() []error {
+	return slices.Clone(c.warnings)
+}
+
+// TruncateWarnings keeps the first n warnings of the context, e.g. to drop the
+// ones of a conversion retried another way, and returns the ones dropped.
+func (c *StmtContext) TruncateWarnings(n int) []error {
+	n = max(n, 0)
+	if n >= len(c.warnings) {
+		return nil
+	}
+	truncated := slices.Clone(c.warnings[n:])
+	c.warnings = c.warnings[:n]
+	return truncated
+}
+
+// SetNow pins the current time of the context. A zero t unpins it.
+func (c *StmtContext) SetNow(t time.Time) {
+	c.now = t
+}
+
+// Now returns the pinned current time, or the wall clock if none is pinned.
+func (c *StmtContext) Now() time.Time {
+	if c.now.IsZero() {
+		return time.Now()
+	}
+	return c.now
+}
+
 // Location returns the timezone location for time conversions
 func (c *StmtContext) Location() *time.Location {
 	// Return UTC by default
 	return time.UTC
 }
 
-// DefaultStmtNoWarningContext is a default context with no warnings
+// DefaultStmtNoWarningContext is a default context with no warnings: it
+// discards the warnings appended, so that it can be shared, and GetWarnings
+// always returns none. A conversion whose warnings matter takes its own
+// StmtContext.
 var DefaultStmtNoWarningContext Context = &StmtContext{
 	IgnoreTruncate:    false,
 	TruncateAsWarning: false,
+	discardWarnings:   true,
 }
 
 // HandleTruncate method is implemented in truncate.go
\ No newline at end of file
@@ -19,6 +19,8 @@ import (
 	"io"
 	"strconv"
 	"strings"
+
+	"github.com/abbychau/mysql-parser/parser_driver/mysql"
 )
 
 // Initialize the parser driver functions for AST integration
@@ -34,8 +36,13 @@ type ValueExpr struct {
 	projectionOffset int
 }
 
-// SetValue implements interface of ValueExpr
+// SetValue implements interface of ValueExpr. Setting a value other than a
+// bool clears IsBooleanFlag, so that a value folded from a TRUE or FALSE literal
+// is restored as a number rather than as the keyword.
 func (n *ValueExpr) SetValue(res interface{}) {
+	if _, ok := res.(bool); !ok {
+		n.Type.DelFlag(mysql.IsBooleanFlag)
+	}
 	n.Datum.SetValueWithDefaultCollation(res)
 }
 
@@ -2,49 +2,80 @@
 package parser
 
 import (
-	"fmt"
-	
 	"github.com/abbychau/mysql-parser/ast"
 	"github.com/abbychau/mysql-parser/charset"
 	myformat "github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/parser_driver"
+	"github.com/abbychau/mysql-parser/parser_driver/mysql"
 	"github.com/abbychau/mysql-parser/types"
 )
 
 func init() {
 	// Register the parser driver functions with the AST
-	ast.NewValueExpr = func(value interface{}, charset string, collate string) ast.ValueExpr {
-		// Create using the parser_driver but return as ast.ValueExpr interface
-		ve := parser_driver.NewValueExpr(value, charset, collate)
-		if pvExpr, ok := ve.(*parser_driver.ValueExpr); ok {
-			return &astValueExpr{pvExpr}
-		}
-		return nil
-	}
-	
-	ast.NewParamMarkerExpr = func(offset int) ast.ParamMarkerExpr {
-		// Create using the parser_driver but return as ast.ParamMarkerExpr interface
-		pme := parser_driver.NewParamMarkerExpr(offset)
-		if ppExpr, ok := pme.(*parser_driver.ParamMarkerExpr); ok {
-			return &astParamMarkerExpr{ppExpr}
-		}
-		return nil
-	}
-	
-	ast.NewDecimal = func(str string) (interface{}, error) {
-		return parser_driver.NewDecimal(str)
-	}
-	ast.NewHexLiteral = func(str string) (interface{}, error) {
-		return parser_driver.NewHexLiteral(str)
-	}
-	ast.NewBitLiteral = func(str string) (interface{}, error) {
-		return parser_driver.NewBitLiteral(str)
-	}
+	ast.RegisterDriver(ast.DriverHooks{
+		NewValueExpr: func(value interface{}, charset string, collate string) ast.ValueExpr {
+			// A value which is already a ValueExpr is returned as it is, like
+			// parser_driver.NewValueExpr does.
+			if v, ok := value.(*astValueExpr); ok {
+				return v
+			}
+			// Create using the parser_driver but return as ast.ValueExpr interface
+			ve := parser_driver.NewValueExpr(value, charset, collate)
+			if pvExpr, ok := ve.(*parser_driver.ValueExpr); ok {
+				return newASTValueExpr(pvExpr)
+			}
+			return nil
+		},
+		NewParamMarkerExpr: func(offset int) ast.ParamMarkerExpr {
+			// Create using the parser_driver but return as ast.ParamMarkerExpr interface
+			pme := parser_driver.NewParamMarkerExpr(offset)
+			if ppExpr, ok := pme.(*parser_driver.ParamMarkerExpr); ok {
+				return &astParamMarkerExpr{ppExpr}
+			}
+			return nil
+		},
+		NewDecimal: func(str string) (interface{}, error) {
+			return parser_driver.NewDecimal(str)
+		},
+		NewHexLiteral: func(str string) (interface{}, error) {
+			return parser_driver.NewHexLiteral(str)
+		},
+		NewBitLiteral: func(str string) (interface{}, error) {
+			return parser_driver.NewBitLiteral(str)
+		},
+		LocateLiterals: locateLiterals,
+	})
 }
 
 // astValueExpr wraps parser_driver.ValueExpr to implement ast.ValueExpr
 type astValueExpr struct {
 	*parser_driver.ValueExpr
+	// tp is the type of the value as a types.FieldType, which the grammar
+	// changes, e.g. for the charset of _latin1'a'.
+	tp          types.FieldType
+	offset      int
+	annotations ast.Annotation
+}
+
+func newASTValueExpr(ve *parser_driver.ValueExpr) *astValueExpr {
+	v := &astValueExpr{ValueExpr: ve}
+	v.tp.SetType(ve.Type.GetType())
+	v.tp.SetFlag(ve.Type.GetFlag())
+	v.tp.SetFlen(ve.Type.GetFlen())
+	v.tp.SetDecimal(ve.Type.GetDecimal())
+	v.tp.SetCharset(ve.Type.GetCharset())
+	v.tp.SetCollate(ve.Type.GetCollate())
+	return v
+}
+
+// Annotate implements ast.AnnotatedNode interface.
+func (v *astValueExpr) Annotate(a ast.Annotation) {
+	v.annotations |= a
+}
+
+// HasAnnotation implements ast.AnnotatedNode interface.
+func (v *astValueExpr) HasAnnotation(a ast.Annotation) bool {
+	return v.annotations&a != 0
 }
 
 // Implement ast.ValueExpr interface methods that aren't in parser_driver.ValueExpr
@@ -63,13 +94,21 @@ func (v *astValueExpr) Restore(ctx *myformat.RestoreCtx) error {
 	case parser_driver.KindNull:
 		ctx.WriteKeyWord("NULL")
 	case parser_driver.KindInt64:
-		ctx.WritePlain(fmt.Sprintf("%d", v.GetInt64()))
+		if v.Type.GetFlag()&mysql.IsBooleanFlag != 0 {
+			if v.GetInt64() > 0 {
+				ctx.WriteKeyWord("TRUE")
+			} else {
+				ctx.WriteKeyWord("FALSE")
+			}
+		} else {
+			ctx.WritePlainInt(v.GetInt64())
+		}
 	case parser_driver.KindUint64:
-		ctx.WritePlain(fmt.Sprintf("%d", v.GetUint64()))
+		ctx.WritePlainUint(v.GetUint64())
 	case parser_driver.KindFloat32, parser_driver.KindFloat64:
-		ctx.WritePlain(fmt.Sprintf("%g", v.GetFloat64()))
+		ctx.WritePlainFloat(v.GetFloat64(), 'g', -1, 64)
 	case parser_driver.KindString:
-		ctx.WriteString(v.GetString())
+		return ast.RestoreStringLiteral(ctx, v.GetString(), "", v.OriginTextPosition())
 	default:
 		ctx.WritePlain(v.GetString())
 	}
@@ -89,11 +128,11 @@ func (v *astValueExpr) SetText(enc charset.Encoding, text string) {
 }
 
 func (v *astValueExpr) SetOriginTextPosition(offset int) {
-	// No-op for simplicity
+	v.offset = offset
 }
 
 func (v *astValueExpr) OriginTextPosition() int {
-	return 0
+	return v.offset
 }
 
 func (v *astValueExpr) GetFlag() uint64 {
@@ -105,13 +144,11 @@ func (v *astValueExpr) SetFlag(flag uint64) {
 }
 
 func (v *astValueExpr) GetType() *types.FieldType {
-	// Convert from parser_driver.FieldType to types.FieldType
-	// For simplicity, create a basic FieldType
-	return &types.FieldType{}
+	return &v.tp
 }
 
 func (v *astValueExpr) SetType(tp *types.FieldType) {
-	// No-op for simplicity
+	v.tp = *tp
 }
 
 // astParamMarkerExpr wraps parser_driver.ParamMarkerExpr to implement ast.ParamMarkerExpr
@@ -148,8 +185,10 @@ func (p *astParamMarkerExpr) SetOriginTextPosition(offset int) {
 	// No-op
 }
 
+// OriginTextPosition returns the offset of the ? given by the grammar, which
+// SetOriginTextPosition doesn't change to the one of an enclosing rule.
 func (p *astParamMarkerExpr) OriginTextPosition() int {
-	return 0
+	return p.Offset
 }
 
 func (p *astParamMarkerExpr) GetFlag() uint64 {
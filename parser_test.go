@@ -376,6 +376,28 @@ func RunTest(t *testing.T, table []testCase, enableWindowFunc bool) {
 	}
 }
 
+// hasDoubleDash returns whether sql has a "--" outside quoted strings and identifiers,
+// which starts a comment for MySQL when followed by a space and for other tools always.
+func hasDoubleDash(sql string) bool {
+	var quote byte
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			return true
+		}
+	}
+	return false
+}
+
 func RunRestoreTest(t *testing.T, sourceSQLs, expectSQLs string, enableWindowFunc bool) {
 	var sb strings.Builder
 	p := parser.New()
@@ -390,6 +412,7 @@ func RunRestoreTest(t *testing.T, sourceSQLs, expectSQLs string, enableWindowFun
 		require.NoError(t, err, comment)
 		restoreSQL := sb.String()
 		comment = fmt.Sprintf("source %v; restore %v", sourceSQLs, restoreSQL)
+		require.False(t, hasDoubleDash(restoreSQL), comment)
 		restoreStmt, err := p.ParseOneStmt(restoreSQL, "", "")
 		require.NoError(t, err, comment)
 		CleanNodeText(stmt)
@@ -437,6 +460,7 @@ func RunRestoreTestInRealAsFloatMode(t *testing.T, sourceSQLs, expectSQLs string
 		require.NoError(t, err, comment)
 		restoreSQL := sb.String()
 		comment = fmt.Sprintf("source %v; restore %v", sourceSQLs, restoreSQL)
+		require.False(t, hasDoubleDash(restoreSQL), comment)
 		restoreStmt, err := p.ParseOneStmt(restoreSQL, "", "")
 		require.NoError(t, err, comment)
 		CleanNodeText(stmt)
@@ -640,11 +664,18 @@ func TestDMLStmt(t *testing.T) {
 			INSERT INTO tmp SELECT * from bar;
 			SELECT * from tmp;
 		ROLLBACK;`, true, "START TRANSACTION; INSERT INTO `tmp` SELECT * FROM `bar`; SELECT * FROM `tmp`; ROLLBACK"},
-		{"SAVEPOINT x", true, "SAVEPOINT x"},
-		{"RELEASE SAVEPOINT x", true, "RELEASE SAVEPOINT x"},
-		{"ROLLBACK TO x", true, "ROLLBACK TO x"},
-		{"ROLLBACK TO X", true, "ROLLBACK TO X"},
-		{"ROLLBACK TO SAVEPOINT x", true, "ROLLBACK TO x"},
+		{"SAVEPOINT x", true, "SAVEPOINT `x`"},
+		{"SAVEPOINT `my sp`", true, "SAVEPOINT `my sp`"},
+		{"SAVEPOINT `select`", true, "SAVEPOINT `select`"},
+		{"SAVEPOINT select", false, ""},
+		{"RELEASE SAVEPOINT x", true, "RELEASE SAVEPOINT `x`"},
+		{"RELEASE SAVEPOINT `my sp`", true, "RELEASE SAVEPOINT `my sp`"},
+		{"RELEASE x", false, ""},
+		{"ROLLBACK TO x", true, "ROLLBACK TO `x`"},
+		{"ROLLBACK TO X", true, "ROLLBACK TO `X`"},
+		{"ROLLBACK TO SAVEPOINT x", true, "ROLLBACK TO SAVEPOINT `x`"},
+		{"ROLLBACK TO SAVEPOINT `my sp`", true, "ROLLBACK TO SAVEPOINT `my sp`"},
+		{"ROLLBACK TO `my sp`", true, "ROLLBACK TO `my sp`"},
 
 		// table statement
 		{"TABLE t", true, "TABLE `t`"},
@@ -691,6 +722,19 @@ func TestDMLStmt(t *testing.T) {
 		{"SELECT a.b.* FROM t", true, "SELECT `a`.`b`.* FROM `t`"},
 		{"SELECT a FROM t", true, "SELECT `a` FROM `t`"},
 		{"SELECT a.b.c.d FROM t", false, ""},
+		{"SELECT *, a FROM t", true, "SELECT *,`a` FROM `t`"},
+		{"SELECT a, t.*, b FROM t", true, "SELECT `a`,`t`.*,`b` FROM `t`"},
+		{"SELECT db.t.*, a, t2.*, * FROM db.t, t2", true, "SELECT `db`.`t`.*,`a`,`t2`.*,* FROM (`db`.`t`) JOIN `t2`"},
+		{"SELECT `my db`.`my t`.* FROM `my db`.`my t`", true, "SELECT `my db`.`my t`.* FROM `my db`.`my t`"},
+		{"SELECT * AS a FROM t", false, ""},
+		{"SELECT t.* AS a FROM t", false, ""},
+		{"SELECT db.t.* AS 'a' FROM t", false, ""},
+		{"SELECT COUNT(t.*) FROM t", false, ""},
+		{"SELECT COUNT(db.t.*) FROM t", false, ""},
+		{"SELECT SUM(t.*), AVG(DISTINCT t.*) FROM t", false, ""},
+		{"SELECT GROUP_CONCAT(a, t.*) FROM t", false, ""},
+		{"SELECT IFNULL(a, db.t.*) FROM t", false, ""},
+		{"SELECT CAST(t.* AS CHAR) FROM t", false, ""},
 
 		// do statement
 		{"DO 1", true, "DO 1"},
@@ -718,6 +762,10 @@ func TestDMLStmt(t *testing.T) {
 		{"load data local infile '/tmp/t.csv' into table t lines starting by 'ab'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` LINES STARTING BY 'ab'"},
 		{"load data local infile '/tmp/t.csv' into table t lines starting by 'ab' terminated by 'xy'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` LINES STARTING BY 'ab' TERMINATED BY 'xy'"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' lines terminated by 'xy'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' LINES TERMINATED BY 'xy'"},
+		{"load data infile '/x.csv' replace into table t partition (p0) (a, @v) set b = @v + 1", true, "LOAD DATA INFILE '/x.csv' REPLACE INTO TABLE `t` PARTITION(`p0`) (`a`,@`v`) SET `b`=@`v`+1"},
+		{"load data infile '/x.csv' ignore into table t partition (p0, p1) character set utf8 ignore 2 rows (@v, a, @w) set b = @v, c = default", true, "LOAD DATA INFILE '/x.csv' IGNORE INTO TABLE `t` PARTITION(`p0`, `p1`) CHARACTER SET utf8 IGNORE 2 LINES (@`v`,`a`,@`w`) SET `b`=@`v`, `c`=DEFAULT"},
+		{"load data infile '/x.csv' into table t partition () (a)", false, ""},
+		{"load data infile '/x.csv' into table t character set utf8 partition (p0)", false, ""},
 		{"load data local infile '/tmp/t.csv' into table t terminated by 'xy' fields terminated by 'ab'", false, ""},
 		{"load data infile '/tmp/t.csv' into table t (a,b)", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` (`a`,`b`)"},
 		{"load data local infile '/tmp/t.csv' into table t (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` (`a`,`b`)"},
@@ -815,6 +863,8 @@ func TestDMLStmt(t *testing.T) {
 		{"select a from t order by a into outfile '/tmp/abc'", true, "SELECT `a` FROM `t` ORDER BY `a` INTO OUTFILE '/tmp/abc'"},
 		{"select 1 into outfile '/tmp/1.csv'", true, "SELECT 1 INTO OUTFILE '/tmp/1.csv'"},
 		{"select 1 for update into outfile '/tmp/1.csv'", true, "SELECT 1 FOR UPDATE INTO OUTFILE '/tmp/1.csv'"},
+		{"select a, b from t into @x, @y", true, "SELECT `a`,`b` FROM `t` INTO @`x`,@`y`"},
+		{"select 1 into @x", true, "SELECT 1 INTO @`x`"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ','", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ','"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' enclosed BY '\"'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' ENCLOSED BY '\"'"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' optionally enclosed BY '\"'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"'"},
@@ -823,6 +873,17 @@ func TestDMLStmt(t *testing.T) {
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' enclosed BY '\"' lines terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' ENCLOSED BY '\"' LINES TERMINATED BY '\r'"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' optionally enclosed BY '\"' lines starting by 'xy' terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' LINES STARTING BY 'xy' TERMINATED BY '\r'"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' enclosed BY '\"' lines starting by 'xy' terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' ENCLOSED BY '\"' LINES STARTING BY 'xy' TERMINATED BY '\r'"},
+		{"select a from t into outfile '/tmp/result.txt' fields enclosed by '' escaped by ''", true, "SELECT `a` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS ENCLOSED BY '' ESCAPED BY ''"},
+		{"select a from t into outfile '/tmp/result.txt' fields terminated by ',' optionally enclosed by '' escaped by '#'", true, "SELECT `a` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '' ESCAPED BY '#'"},
+		{"select a, b into outfile '/tmp/x.csv' fields terminated by ',' from t", true, "SELECT `a`,`b` FROM `t` INTO OUTFILE '/tmp/x.csv' FIELDS TERMINATED BY ','"},
+		{"select a, b into @x, @y from t where a > 1", true, "SELECT `a`,`b` FROM `t` WHERE `a`>1 INTO @`x`,@`y`"},
+		{"select a into x, y from t", true, "SELECT `a` FROM `t` INTO `x`,`y`"},
+		{"select a from t into @x, y", true, "SELECT `a` FROM `t` INTO @`x`,`y`"},
+		{"select a into dumpfile '/tmp/blob' from t limit 1", true, "SELECT `a` FROM `t` LIMIT 1 INTO DUMPFILE '/tmp/blob'"},
+		{"select a from t limit 1 into dumpfile '/tmp/blob'", true, "SELECT `a` FROM `t` LIMIT 1 INTO DUMPFILE '/tmp/blob'"},
+		{"select 1 into dumpfile", true, "SELECT 1 INTO `dumpfile`"},
+		{"select a into @x from t into @y", false, ""},
+		{"select a into @x from t into outfile '/tmp/x.csv'", false, ""},
 
 		// from join
 		{"SELECT * from t1, t2, t3", true, "SELECT * FROM ((`t1`) JOIN `t2`) JOIN `t3`"},
@@ -914,6 +975,15 @@ func TestDMLStmt(t *testing.T) {
 		{"INSERT IGNORE INTO t (a,b,c) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE c=VALUES(a)+VALUES(b);", true, "INSERT IGNORE INTO `t` (`a`,`b`,`c`) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE `c`=VALUES(`a`)+VALUES(`b`)"},
 		{"INSERT IGNORE INTO t (a,b,c) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE c:=VALUES(a)+VALUES(b);", true, "INSERT IGNORE INTO `t` (`a`,`b`,`c`) VALUES (1,2,3),(4,5,6) ON DUPLICATE KEY UPDATE `c`=VALUES(`a`)+VALUES(`b`)"},
 
+		// for insert ... as row_alias
+		{"INSERT INTO t VALUES (1,2) AS new", true, "INSERT INTO `t` VALUES (1,2) AS `new`"},
+		{"INSERT INTO t (a,b) VALUES (1,2),(3,4) AS new(x,y) ON DUPLICATE KEY UPDATE b=new.x+y", true, "INSERT INTO `t` (`a`,`b`) VALUES (1,2),(3,4) AS `new`(`x`,`y`) ON DUPLICATE KEY UPDATE `b`=`new`.`x`+`y`"},
+		{"INSERT INTO t VALUES (1,2) AS new ON DUPLICATE KEY UPDATE b=new.b+VALUES(b)", true, "INSERT INTO `t` VALUES (1,2) AS `new` ON DUPLICATE KEY UPDATE `b`=`new`.`b`+VALUES(`b`)"},
+		{"INSERT INTO t SET a=1,b=2 AS new ON DUPLICATE KEY UPDATE b=new.a", true, "INSERT INTO `t` SET `a`=1,`b`=2 AS `new` ON DUPLICATE KEY UPDATE `b`=`new`.`a`"},
+		{"INSERT INTO t VALUES (1) AS new()", false, ""},
+		{"INSERT INTO t SELECT 1 AS a", true, "INSERT INTO `t` SELECT 1 AS `a`"},
+		{"REPLACE INTO t VALUES (1) AS new", false, ""},
+
 		// for insert ... set
 		{"INSERT INTO t SET a=1,b=2", true, "INSERT INTO `t` SET `a`=1,`b`=2"},
 		{"INSERT INTO t (a) SET a=1", false, ""},
@@ -1116,6 +1186,13 @@ AAAAAAAAAAAA5gm5Mg==
 		// for alter instance.
 		{"ALTER INSTANCE RELOAD TLS", true, "ALTER INSTANCE RELOAD TLS"},
 		{"ALTER INSTANCE RELOAD TLS NO ROLLBACK ON ERROR", true, "ALTER INSTANCE RELOAD TLS NO ROLLBACK ON ERROR"},
+		{"ALTER INSTANCE ROTATE INNODB MASTER KEY", true, "ALTER INSTANCE ROTATE INNODB MASTER KEY"},
+		{"alter instance rotate innodb master key", true, "ALTER INSTANCE ROTATE INNODB MASTER KEY"},
+		{"ALTER INSTANCE ROTATE FOO MASTER KEY", false, ""},
+		{"ALTER INSTANCE ENABLE INNODB REDO_LOG", true, "ALTER INSTANCE ENABLE INNODB REDO_LOG"},
+		{"ALTER INSTANCE DISABLE INNODB REDO_LOG", true, "ALTER INSTANCE DISABLE INNODB REDO_LOG"},
+		{"ALTER INSTANCE DISABLE INNODB UNDO_LOG", false, ""},
+		{"ALTER INSTANCE ROTATE INNODB MASTER KEY NO ROLLBACK ON ERROR", false, ""},
 
 		// for alter range
 		{"ALTER RANGE global PLACEMENT POLICY mypolicy", true, "ALTER RANGE `global` PLACEMENT POLICY = `mypolicy`"},
@@ -1377,6 +1454,20 @@ func TestDBAStmt(t *testing.T) {
 		// global system variables
 		{"SET GLOBAL autocommit = 1", true, "SET @@GLOBAL.`autocommit`=1"},
 		{"SET @@global.autocommit = 1", true, "SET @@GLOBAL.`autocommit`=1"},
+		// persisted system variables
+		{"SET PERSIST max_connections = 500", true, "SET PERSIST `max_connections`=500"},
+		{"SET @@persist.max_connections = 500", true, "SET @@PERSIST.`max_connections`=500"},
+		{"SET PERSIST_ONLY back_log = 100", true, "SET PERSIST_ONLY `back_log`=100"},
+		{"SET @@PERSIST_ONLY.back_log := 100", true, "SET @@PERSIST_ONLY.`back_log`=100"},
+		{"SET @@persist.`max_connections` = default", true, "SET @@PERSIST.`max_connections`=DEFAULT"},
+		{"SET PERSIST a = 1, @@persist_only.b = 2, c = 3", true, "SET PERSIST `a`=1, @@PERSIST_ONLY.`b`=2, @@SESSION.`c`=3"},
+		{"SET persist = 1", true, "SET @@SESSION.`persist`=1"},
+		// Only SET reads the scope, a variable expression keeps the name.
+		{"SELECT @@persist.max_connections", true, "SELECT @@`persist.max_connections`"},
+		{"RESET PERSIST", true, "RESET PERSIST"},
+		{"RESET PERSIST max_connections", true, "RESET PERSIST `max_connections`"},
+		{"reset persist if exists max_connections", true, "RESET PERSIST IF EXISTS `max_connections`"},
+		{"RESET PERSIST IF EXISTS", false, ""},
 		// set through mysql extension assignment syntax
 		{"SET autocommit := 1", true, "SET @@SESSION.`autocommit`=1"},
 		{"SET @@session.autocommit := 1", true, "SET @@SESSION.`autocommit`=1"},
@@ -1521,6 +1612,56 @@ func TestSetVariable(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSetPersist(t *testing.T) {
+	table := []struct {
+		Input          string
+		Name           string
+		IsPersist      bool
+		IsPersistOnly  bool
+		IsScopeKeyword bool
+	}{
+		{"set persist xx = 1", "xx", true, false, true},
+		{"set persist_only xx = 1", "xx", false, true, true},
+		{"set @@persist.xx = 1", "xx", true, false, false},
+		{"set @@persist_only.xx = 1", "xx", false, true, false},
+		{"set @@persist.`xx` = 1", "xx", true, false, false},
+		{"set global xx = 1", "xx", false, false, false},
+	}
+
+	p := parser.New()
+	for _, tbl := range table {
+		stmt, err := p.ParseOneStmt(tbl.Input, "", "")
+		require.NoError(t, err)
+
+		setStmt, ok := stmt.(*ast.SetStmt)
+		require.True(t, ok)
+		require.Len(t, setStmt.Variables, 1)
+
+		v := setStmt.Variables[0]
+		require.Equal(t, tbl.Name, v.Name, tbl.Input)
+		require.True(t, v.IsGlobal, tbl.Input)
+		require.True(t, v.IsSystem, tbl.Input)
+		require.Equal(t, tbl.IsPersist, v.IsPersist, tbl.Input)
+		require.Equal(t, tbl.IsPersistOnly, v.IsPersistOnly, tbl.Input)
+		require.Equal(t, tbl.IsScopeKeyword, v.IsScopeKeyword, tbl.Input)
+	}
+
+	// Out of SET, @@persist. is a part of the name.
+	stmt, err := p.ParseOneStmt("select @@persist.xx", "", "")
+	require.NoError(t, err)
+	v := stmt.(*ast.SelectStmt).Fields.Fields[0].Expr.(*ast.VariableExpr)
+	require.Equal(t, "persist.xx", v.Name)
+	require.False(t, v.IsGlobal)
+	require.False(t, v.ExplicitScope)
+
+	stmt, err = p.ParseOneStmt("reset persist if exists xx", "", "")
+	require.NoError(t, err)
+	resetStmt, ok := stmt.(*ast.ResetPersistStmt)
+	require.True(t, ok)
+	require.True(t, resetStmt.IfExists)
+	require.Equal(t, "xx", resetStmt.Name)
+}
+
 func TestFlushTable(t *testing.T) {
 	p := parser.New()
 	stmt, _, err := p.Parse("flush local tables tbl1,tbl2 with read lock", "", "")
@@ -1548,7 +1689,9 @@ func TestExpression(t *testing.T) {
 		{"SELECT -*1", false, "SELECT -*1"},
 		{"SELECT -+1", true, "SELECT -+1"},
 		{"SELECT -1", true, "SELECT -1"},
-		{"SELECT --1", true, "SELECT --1"},
+		{"SELECT --1", true, "SELECT - -1"},
+		{"SELECT - - -1, -(-1), - -a, 1 - -1, 1-(-1)", true, "SELECT - - -1,-(-1),- -`a`,1- -1,1-(-1)"},
+		{"SELECT NOT NOT a, !!a, ~~a, !-a, -~a, NOT a IS NULL, !(a IS NULL)", true, "SELECT NOT NOT `a`,!!`a`,~~`a`,!-`a`,-~`a`,NOT `a` IS NULL,!(`a` IS NULL)"},
 
 		// for string literal
 		{`select '''a''', """a"""`, true, "SELECT _UTF8MB4'''a''',_UTF8MB4'\"a\"'"},
@@ -2313,6 +2456,16 @@ func TestBuiltin(t *testing.T) {
 		{`SELECT a->3 FROM t`, false, ""},
 		{`SELECT a->>3 FROM t`, false, ""},
 
+		// JSON paths keep their quoting and escapes on restore.
+		{"SELECT a->'$.\"k y\"' FROM t", true, "SELECT JSON_EXTRACT(`a`, _UTF8MB4'$.\"k y\"') FROM `t`"},
+		{"SELECT a->>'$.\"a\\\\\"b\"' FROM t", true, "SELECT JSON_UNQUOTE(JSON_EXTRACT(`a`, _UTF8MB4'$.\"a\\\\\"b\"')) FROM `t`"},
+		{"SELECT a->'$.\"\\\\u00e9\"' FROM t", true, "SELECT JSON_EXTRACT(`a`, _UTF8MB4'$.\"\\\\u00e9\"') FROM `t`"},
+		{"SELECT a->'$[*]', a->>'$.a[*]' FROM t", true, "SELECT JSON_EXTRACT(`a`, _UTF8MB4'$[*]'),JSON_UNQUOTE(JSON_EXTRACT(`a`, _UTF8MB4'$.a[*]')) FROM `t`"},
+		{"SELECT JSON_EXTRACT(a, '$.\"\\\\u00e9\"', '$.\"k y\"')", true, "SELECT JSON_EXTRACT(`a`, _UTF8MB4'$.\"\\\\u00e9\"', _UTF8MB4'$.\"k y\"')"},
+		{"UPDATE t SET b = a->>'$.\"a\\\\\"b\"', c = JSON_SET(c, '$.\"\\\\u00e9\"', 'x\\\\y')", true, "UPDATE `t` SET `b`=JSON_UNQUOTE(JSON_EXTRACT(`a`, _UTF8MB4'$.\"a\\\\\"b\"')), `c`=JSON_SET(`c`, _UTF8MB4'$.\"\\\\u00e9\"', _UTF8MB4'x\\\\y')"},
+		{"UPDATE t SET b = a->'$[*]'", true, "UPDATE `t` SET `b`=JSON_EXTRACT(`a`, _UTF8MB4'$[*]')"},
+		{"CREATE TABLE t (a json, c json GENERATED ALWAYS AS (a->>'$.\"k y\"') VIRTUAL, d json AS (a->'$.\"\\\\u00e9\"[*]') STORED)", true, "CREATE TABLE `t` (`a` JSON,`c` JSON GENERATED ALWAYS AS(JSON_UNQUOTE(JSON_EXTRACT(`a`, _UTF8MB4'$.\"k y\"'))) VIRTUAL,`d` JSON GENERATED ALWAYS AS(JSON_EXTRACT(`a`, _UTF8MB4'$.\"\\\\u00e9\"[*]')) STORED)"},
+
 		{`SELECT 1 member of (a)`, true, "SELECT 1 MEMBER OF (`a`)"},
 		{`SELECT 1 member of a`, false, ""},
 		{`SELECT 1 member a`, false, ""},
@@ -2395,7 +2548,7 @@ func TestIdentifier(t *testing.T) {
 		{`select .78+.21`, true, "SELECT 0.78+0.21"},
 		{`select .78-123`, true, "SELECT 0.78-123"},
 		{`select .78-.21`, true, "SELECT 0.78-0.21"},
-		{`select .78--123`, true, "SELECT 0.78--123"},
+		{`select .78--123`, true, "SELECT 0.78- -123"},
 		{`select .78*123`, true, "SELECT 0.78*123"},
 		{`select .78*.21`, true, "SELECT 0.78*0.21"},
 		{`select .78/123`, true, "SELECT 0.78/123"},
@@ -2414,6 +2567,39 @@ func TestIdentifier(t *testing.T) {
 	RunTest(t, table, false)
 }
 
+func TestRestoreLowercaseUnquoted(t *testing.T) {
+	cases := []struct {
+		src    string
+		expect string
+	}{
+		{"SELECT a, b FROM t WHERE id = 1", "select a,b from t where id = 1"},
+		{"select `select`, `a b`, `Key`, `1a`, `x.y` from `from` where `order` > 1",
+			"select `select`,`a b`,`Key`,`1a`,`x.y` from `from` where `order` > 1"},
+		{"select T.Value, $c, c_1 from Db.T order by c_1 desc limit 2",
+			"select T.Value,$c,c_1 from Db.T order by c_1 desc limit 2"},
+		{"insert into t (a, `int`) values (1, 2)", "insert into t (a,`int`) values (1,2)"},
+		{"update t set count = count + 1 where id in (1, 2)", "update t set count=count + 1 where id in (1,2)"},
+		{"create table `table` (id int primary key, `desc` varchar(10))",
+			"create table `table` (id int primary key,`desc` varchar(10))"},
+	}
+	flags := RestoreKeyWordLowercase | RestoreNameBacktickOnlyWhenNeeded | RestoreStringSingleQuotes | RestoreSpacesAroundBinaryOperation
+	p := parser.New()
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.src, "", "")
+		require.NoError(t, err, c.src)
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(NewRestoreCtx(flags, &sb)), c.src)
+		require.Equal(t, c.expect, sb.String(), c.src)
+
+		// The lowercase and unquoted text is parsed back as the same statement.
+		restored, err := p.ParseOneStmt(sb.String(), "", "")
+		require.NoError(t, err, c.expect)
+		CleanNodeText(stmt)
+		CleanNodeText(restored)
+		require.Equal(t, stmt, restored, c.expect)
+	}
+}
+
 func TestBuiltinFuncAsIdentifier(t *testing.T) {
 	whitespaceFuncs := []struct {
 		funcName string
@@ -2543,9 +2729,15 @@ func TestDDL(t *testing.T) {
 		{"CREATE TABLE foo (name CHAR(50) COLLATE utf8_bin)", true, "CREATE TABLE `foo` (`name` CHAR(50) COLLATE utf8_bin)"},
 		{"CREATE TABLE foo (id varchar(50) collate utf8_bin);", true, "CREATE TABLE `foo` (`id` VARCHAR(50) COLLATE utf8_bin)"},
 		{"CREATE TABLE foo (name CHAR(50) CHARACTER SET UTF8)", true, "CREATE TABLE `foo` (`name` CHAR(50) CHARACTER SET UTF8)"},
-		{"CREATE TABLE foo (name CHAR(50) CHARACTER SET utf8 BINARY)", true, "CREATE TABLE `foo` (`name` CHAR(50) BINARY CHARACTER SET UTF8)"},
+		{"CREATE TABLE foo (name CHAR(50) CHARACTER SET utf8 BINARY)", true, "CREATE TABLE `foo` (`name` CHAR(50) CHARACTER SET UTF8 COLLATE utf8_bin)"},
 		{"CREATE TABLE foo (name CHAR(50) CHARACTER SET utf8 BINARY CHARACTER set utf8)", false, ""},
-		{"CREATE TABLE foo (name CHAR(50) BINARY CHARACTER SET utf8 COLLATE utf8_bin)", true, "CREATE TABLE `foo` (`name` CHAR(50) BINARY CHARACTER SET UTF8 COLLATE utf8_bin)"},
+		{"CREATE TABLE foo (name CHAR(50) BINARY CHARACTER SET utf8 COLLATE utf8_bin)", true, "CREATE TABLE `foo` (`name` CHAR(50) CHARACTER SET UTF8 COLLATE utf8_bin)"},
+		{"CREATE TABLE foo (name VARCHAR(20) BINARY CHARACTER SET utf8mb4)", true, "CREATE TABLE `foo` (`name` VARCHAR(20) CHARACTER SET UTF8MB4 COLLATE utf8mb4_bin)"},
+		{"CREATE TABLE foo (name VARCHAR(20) BINARY CHARACTER SET latin1 NOT NULL)", true, "CREATE TABLE `foo` (`name` VARCHAR(20) CHARACTER SET LATIN1 COLLATE latin1_bin NOT NULL)"},
+		{"CREATE TABLE foo (name VARCHAR(20) BINARY CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci)", true, "CREATE TABLE `foo` (`name` VARCHAR(20) CHARACTER SET UTF8MB4 COLLATE utf8mb4_general_ci)"},
+		{"CREATE TABLE foo (name VARCHAR(20) BINARY COLLATE utf8mb4_general_ci)", true, "CREATE TABLE `foo` (`name` VARCHAR(20) BINARY COLLATE utf8mb4_general_ci)"},
+		{"CREATE TABLE foo (name TEXT BINARY CHARACTER SET utf8mb4, other MEDIUMTEXT CHARACTER SET latin1 BINARY)", true, "CREATE TABLE `foo` (`name` TEXT CHARACTER SET UTF8MB4 COLLATE utf8mb4_bin,`other` MEDIUMTEXT CHARACTER SET LATIN1 COLLATE latin1_bin)"},
+		{"CREATE TABLE foo (name TEXT BINARY)", true, "CREATE TABLE `foo` (`name` TEXT BINARY)"},
 		{"CREATE TABLE foo (name CHAR(50) CHARACTER SET utf8 COLLATE utf8_bin COLLATE ascii_bin)", false, ""},
 		{"CREATE TABLE foo (name CHAR(50) COLLATE ascii_bin COLLATE latin1_bin)", false, ""},
 		{"CREATE TABLE foo (name CHAR(50) COLLATE ascii_bin PRIMARY KEY COLLATE latin1_bin)", false, ""},
@@ -2837,14 +3029,16 @@ func TestDDL(t *testing.T) {
 		{"drop table if exists xxx", true, "DROP TABLE IF EXISTS `xxx`"},
 		{"drop table if exists xxx, yyy", true, "DROP TABLE IF EXISTS `xxx`, `yyy`"},
 		{"drop table if not exists xxx", false, ""},
-		{"drop table xxx restrict", true, "DROP TABLE `xxx`"},
-		{"drop table xxx, yyy cascade", true, "DROP TABLE `xxx`, `yyy`"},
-		{"drop table if exists xxx restrict", true, "DROP TABLE IF EXISTS `xxx`"},
+		{"drop table xxx restrict", true, "DROP TABLE `xxx` RESTRICT"},
+		{"drop table xxx, yyy cascade", true, "DROP TABLE `xxx`, `yyy` CASCADE"},
+		{"drop table if exists xxx restrict", true, "DROP TABLE IF EXISTS `xxx` RESTRICT"},
 		{"drop view", false, "DROP VIEW"},
 		{"drop view xxx", true, "DROP VIEW `xxx`"},
 		{"drop view xxx, yyy", true, "DROP VIEW `xxx`, `yyy`"},
 		{"drop view if exists xxx", true, "DROP VIEW IF EXISTS `xxx`"},
 		{"drop view if exists xxx, yyy", true, "DROP VIEW IF EXISTS `xxx`, `yyy`"},
+		{"drop view xxx restrict", true, "DROP VIEW `xxx` RESTRICT"},
+		{"drop view if exists xxx, yyy cascade", true, "DROP VIEW IF EXISTS `xxx`, `yyy` CASCADE"},
 		{"drop stats t", true, "DROP STATS `t`"},
 		{"drop stats t1, t2, t3", true, "DROP STATS `t1`, `t2`, `t3`"},
 		{"drop stats t global", true, "DROP STATS `t` GLOBAL"},
@@ -3154,7 +3348,7 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t MODIFY COLUMN IF EXISTS a varchar(255)", true, "ALTER TABLE `t` MODIFY COLUMN IF EXISTS `a` VARCHAR(255)"},
 		{"ALTER TABLE t CHANGE COLUMN a b varchar(255)", true, "ALTER TABLE `t` CHANGE COLUMN `a` `b` VARCHAR(255)"},
 		{"ALTER TABLE t CHANGE COLUMN IF EXISTS a b varchar(255)", true, "ALTER TABLE `t` CHANGE COLUMN IF EXISTS `a` `b` VARCHAR(255)"},
-		{"ALTER TABLE t CHANGE COLUMN a b varchar(255) CHARACTER SET UTF8 BINARY", true, "ALTER TABLE `t` CHANGE COLUMN `a` `b` VARCHAR(255) BINARY CHARACTER SET UTF8"},
+		{"ALTER TABLE t CHANGE COLUMN a b varchar(255) CHARACTER SET UTF8 BINARY", true, "ALTER TABLE `t` CHANGE COLUMN `a` `b` VARCHAR(255) CHARACTER SET UTF8 COLLATE utf8_bin"},
 		{"ALTER TABLE t CHANGE COLUMN a b varchar(255) FIRST", true, "ALTER TABLE `t` CHANGE COLUMN `a` `b` VARCHAR(255) FIRST"},
 
 		// For alter table rename statement.
@@ -3198,7 +3392,11 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (CURRENT_TIMESTAMP())", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT (CURRENT_TIMESTAMP())"},
 		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (NOW())", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT (NOW())"},
 		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (1+1)", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT (1+1)"},
-		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (1)", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT 1"},
+		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (1)", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT (1)"},
+		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT 'x'", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT _UTF8MB4'x'"},
+		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT -1", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT -1"},
+		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (RAND() * 10)", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT (RAND()*10)"},
+		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT (1), FORCE", true, "ALTER TABLE `t` ALTER COLUMN `a` SET DEFAULT (1), FORCE"},
 		{"ALTER TABLE t ALTER COLUMN a DROP DEFAULT", true, "ALTER TABLE `t` ALTER COLUMN `a` DROP DEFAULT"},
 		{"ALTER TABLE t ALTER a DROP DEFAULT", true, "ALTER TABLE `t` ALTER COLUMN `a` DROP DEFAULT"},
 		{"ALTER TABLE t ADD COLUMN a SMALLINT UNSIGNED, lock=none", true, "ALTER TABLE `t` ADD COLUMN `a` SMALLINT UNSIGNED, LOCK = NONE"},
@@ -3213,9 +3411,9 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t ADD COLUMN a SMALLINT UNSIGNED, LOCK=DEFAULT", true, "ALTER TABLE `t` ADD COLUMN `a` SMALLINT UNSIGNED, LOCK = DEFAULT"},
 		{"ALTER TABLE t ADD COLUMN a SMALLINT UNSIGNED, LOCK=SHARED", true, "ALTER TABLE `t` ADD COLUMN `a` SMALLINT UNSIGNED, LOCK = SHARED"},
 		{"ALTER TABLE t ADD COLUMN a SMALLINT UNSIGNED, LOCK=EXCLUSIVE", true, "ALTER TABLE `t` ADD COLUMN `a` SMALLINT UNSIGNED, LOCK = EXCLUSIVE"},
-		{"ALTER TABLE t ADD FULLTEXT KEY `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name`)"},
-		{"ALTER TABLE t ADD FULLTEXT `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name`)"},
-		{"ALTER TABLE t ADD FULLTEXT INDEX `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name`)"},
+		{"ALTER TABLE t ADD FULLTEXT KEY `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name` ASC)"},
+		{"ALTER TABLE t ADD FULLTEXT `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name` ASC)"},
+		{"ALTER TABLE t ADD FULLTEXT INDEX `FullText` (`name` ASC)", true, "ALTER TABLE `t` ADD FULLTEXT `FullText`(`name` ASC)"},
 		{"ALTER TABLE t ADD INDEX (a) USING BTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX(`a`) USING BTREE COMMENT 'a'"},
 		{"ALTER TABLE t ADD INDEX IF NOT EXISTS (a) USING BTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS(`a`) USING BTREE COMMENT 'a'"},
 		{"ALTER TABLE t ADD INDEX (a) USING RTREE COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX(`a`) USING RTREE COMMENT 'a'"},
@@ -3223,7 +3421,7 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS 4", true, "ALTER TABLE `t` ADD INDEX(`a`) PRE_SPLIT_REGIONS = 4"},
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS = 'a'", false, ""},
 		{"ALTER TABLE t ADD PRIMARY KEY (a) CLUSTERED PRE_SPLIT_REGIONS = 4", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) CLUSTERED PRE_SPLIT_REGIONS = 4"},
-		{"ALTER TABLE t ADD PRIMARY KEY (a) PRE_SPLIT_REGIONS = 4 NONCLUSTERED", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) NONCLUSTERED PRE_SPLIT_REGIONS = 4"},
+		{"ALTER TABLE t ADD PRIMARY KEY (a) PRE_SPLIT_REGIONS = 4 NONCLUSTERED", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) PRE_SPLIT_REGIONS = 4 NONCLUSTERED"},
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS = (between (1, 'a') and (2, 'b') regions 4);", true, "ALTER TABLE `t` ADD INDEX(`a`) PRE_SPLIT_REGIONS = (BETWEEN (1,_UTF8MB4'a') AND (2,_UTF8MB4'b') REGIONS 4)"},
 		{"ALTER TABLE t ADD INDEX (a) PRE_SPLIT_REGIONS = (by (1, 'a'), (2, 'b'), (3, 'c'));", true, "ALTER TABLE `t` ADD INDEX(`a`) PRE_SPLIT_REGIONS = (BY (1,_UTF8MB4'a'),(2,_UTF8MB4'b'),(3,_UTF8MB4'c'))"},
 		{"ALTER TABLE t ADD INDEX (a) comment 'a' PRE_SPLIT_REGIONS = (between (1, 'a') and (2, 'b') regions 4);", true, "ALTER TABLE `t` ADD INDEX(`a`) COMMENT 'a' PRE_SPLIT_REGIONS = (BETWEEN (1,_UTF8MB4'a') AND (2,_UTF8MB4'b') REGIONS 4)"},
@@ -3237,7 +3435,7 @@ func TestDDL(t *testing.T) {
 		{"ALTER TABLE t ADD KEY IF NOT EXISTS (a) USING HASH COMMENT 'a'", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS(`a`) USING HASH COMMENT 'a'"},
 		{"ALTER TABLE t ADD PRIMARY KEY ident USING RTREE ( a DESC , b   )", true, "ALTER TABLE `t` ADD PRIMARY KEY `ident`(`a` DESC, `b`) USING RTREE"},
 		{"ALTER TABLE t ADD KEY USING RTREE   ( a ) ", true, "ALTER TABLE `t` ADD INDEX(`a`) USING RTREE"},
-		{"ALTER TABLE t ADD KEY USING RTREE ( ident ASC , ident ( 123 ) )", true, "ALTER TABLE `t` ADD INDEX(`ident`, `ident`(123)) USING RTREE"},
+		{"ALTER TABLE t ADD KEY USING RTREE ( ident ASC , ident ( 123 ) )", true, "ALTER TABLE `t` ADD INDEX(`ident` ASC, `ident`(123)) USING RTREE"},
 		{"ALTER TABLE t ADD PRIMARY KEY (a) COMMENT 'a'", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`) COMMENT 'a'"},
 		{"ALTER TABLE t ADD UNIQUE (a) COMMENT 'a'", true, "ALTER TABLE `t` ADD UNIQUE(`a`) COMMENT 'a'"},
 		{"ALTER TABLE t ADD UNIQUE KEY (a) COMMENT 'a'", true, "ALTER TABLE `t` ADD UNIQUE(`a`) COMMENT 'a'"},
@@ -3303,7 +3501,14 @@ func TestDDL(t *testing.T) {
 		{"alter table d_n.t_n convert to char set default", true, "ALTER TABLE `d_n`.`t_n` CONVERT TO CHARACTER SET DEFAULT"},
 		{"alter table d_n.t_n convert to character set default collate utf8mb4_0900_ai_ci", true, "ALTER TABLE `d_n`.`t_n` CONVERT TO CHARACTER SET DEFAULT COLLATE UTF8MB4_0900_AI_CI"},
 
-		{"ALTER TABLE t FORCE", true, "ALTER TABLE `t` FORCE /* AlterTableForce is not supported */ "},
+		{"ALTER TABLE t FORCE", true, "ALTER TABLE `t` FORCE"},
+		{"ALTER TABLE t FORCE, ADD COLUMN c INT", true, "ALTER TABLE `t` FORCE, ADD COLUMN `c` INT"},
+		{"ALTER TABLE t ADD COLUMN c INT, FORCE", true, "ALTER TABLE `t` ADD COLUMN `c` INT, FORCE"},
+		{"ALTER TABLE t ENGINE = InnoDB", true, "ALTER TABLE `t` ENGINE = InnoDB"},
+		{"ALTER TABLE t FORCE, ALGORITHM = INPLACE, LOCK = NONE", true, "ALTER TABLE `t` FORCE, ALGORITHM = INPLACE, LOCK = NONE"},
+		{"OPTIMIZE TABLE t", true, "OPTIMIZE TABLE `t`"},
+		{"OPTIMIZE LOCAL TABLE t1, t2", true, "OPTIMIZE NO_WRITE_TO_BINLOG TABLE `t1`, `t2`"},
+		{"OPTIMIZE NO_WRITE_TO_BINLOG TABLES t", true, "OPTIMIZE NO_WRITE_TO_BINLOG TABLE `t`"},
 		{"ALTER TABLE t DROP INDEX;", false, "ALTER TABLE `t` DROP INDEX"},
 		{"ALTER TABLE t DROP INDEX a", true, "ALTER TABLE `t` DROP INDEX `a`"},
 		{"ALTER TABLE t DROP INDEX IF EXISTS a", true, "ALTER TABLE `t` DROP INDEX IF EXISTS `a`"},
@@ -3411,18 +3616,18 @@ func TestDDL(t *testing.T) {
 		{"CREATE INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
 		{"CREATE UNIQUE INDEX idx ON t (a)", true, "CREATE UNIQUE INDEX `idx` ON `t` (`a`)"},
 		{"CREATE UNIQUE INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE UNIQUE INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
-		{"CREATE UNIQUE INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE BTREE", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING BTREE"},
-		{"CREATE UNIQUE INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE HASH", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING HASH"},
-		{"CREATE UNIQUE INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE RTREE", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING RTREE"},
-		{"CREATE UNIQUE INDEX ident TYPE BTREE ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING BTREE"},
-		{"CREATE UNIQUE INDEX ident USING BTREE ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING BTREE"},
+		{"CREATE UNIQUE INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE BTREE", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING BTREE"},
+		{"CREATE UNIQUE INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE HASH", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING HASH"},
+		{"CREATE UNIQUE INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE RTREE", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING RTREE"},
+		{"CREATE UNIQUE INDEX ident TYPE BTREE ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING BTREE"},
+		{"CREATE UNIQUE INDEX ident USING BTREE ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING BTREE"},
 		{"CREATE SPATIAL INDEX idx ON t (a)", true, "CREATE SPATIAL INDEX `idx` ON `t` (`a`)"},
 		{"CREATE SPATIAL INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE SPATIAL INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
 		{"CREATE FULLTEXT INDEX idx ON t (a)", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`)"},
 		{"CREATE FULLTEXT INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE FULLTEXT INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
 		{"CREATE FULLTEXT INDEX idx ON t (a) WITH PARSER ident", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident`"},
 		{"CREATE FULLTEXT INDEX idx ON t (a) WITH PARSER ident comment 'string'", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident` COMMENT 'string'"},
-		{"CREATE FULLTEXT INDEX idx ON t (a) comment 'string' with parser ident", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident` COMMENT 'string'"},
+		{"CREATE FULLTEXT INDEX idx ON t (a) comment 'string' with parser ident", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) COMMENT 'string' WITH PARSER `ident`"},
 		{"CREATE FULLTEXT INDEX idx ON t (a) WITH PARSER ident comment 'string' lock default", true, "CREATE FULLTEXT INDEX `idx` ON `t` (`a`) WITH PARSER `ident` COMMENT 'string'"},
 		{"CREATE INDEX idx ON t (a) USING HASH", true, "CREATE INDEX `idx` ON `t` (`a`) USING HASH"},
 		{"CREATE INDEX idx ON t (a) COMMENT 'foo'", true, "CREATE INDEX `idx` ON `t` (`a`) COMMENT 'foo'"},
@@ -3452,8 +3657,8 @@ func TestDDL(t *testing.T) {
 		{"CREATE VECTOR INDEX IF NOT EXISTS idx ON t ((VEC_COSINE_DISTANCE(a))) TYPE HNSW", true, "CREATE VECTOR INDEX IF NOT EXISTS `idx` ON `t` ((VEC_COSINE_DISTANCE(`a`))) USING HNSW"},
 		{"CREATE VECTOR INDEX ident TYPE HNSW ON d_n.t_n ((VEC_COSINE_DISTANCE(a)))", true, "CREATE VECTOR INDEX `ident` ON `d_n`.`t_n` ((VEC_COSINE_DISTANCE(`a`))) USING HNSW"},
 		{"CREATE VECTOR INDEX idx USING HNSW ON t ((VEC_COSINE_DISTANCE(a)))", true, "CREATE VECTOR INDEX `idx` ON `t` ((VEC_COSINE_DISTANCE(`a`))) USING HNSW"},
-		{"CREATE VECTOR INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE HNSW", true, "CREATE VECTOR INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING HNSW"},
-		{"CREATE UNIQUE INDEX ident USING HNSW ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident`) USING HNSW"},
+		{"CREATE VECTOR INDEX ident ON d_n.t_n ( ident , ident ASC ) TYPE HNSW", true, "CREATE VECTOR INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING HNSW"},
+		{"CREATE UNIQUE INDEX ident USING HNSW ON d_n.t_n ( ident , ident ASC )", true, "CREATE UNIQUE INDEX `ident` ON `d_n`.`t_n` (`ident`, `ident` ASC) USING HNSW"},
 
 		// For create index with algorithm
 		{"CREATE INDEX idx ON t ( a ) ALGORITHM = DEFAULT", true, "CREATE INDEX `idx` ON `t` (`a`)"},
@@ -3610,8 +3815,8 @@ func TestDDL(t *testing.T) {
 		{"alter table t add column a double (4,2) zerofill references b match full on update set null first", true, "ALTER TABLE `t` ADD COLUMN `a` DOUBLE(4,2) UNSIGNED ZEROFILL REFERENCES `b` MATCH FULL ON UPDATE SET NULL FIRST"},
 		{"alter table d_n.t_n add constraint foreign key ident (ident(1)) references d_n.t_n match full on delete set null", true, "ALTER TABLE `d_n`.`t_n` ADD CONSTRAINT `ident` FOREIGN KEY (`ident`(1)) REFERENCES `d_n`.`t_n` MATCH FULL ON DELETE SET NULL"},
 		{"alter table t_n add constraint ident foreign key (ident,ident(1)) references t_n match full on update set null on delete restrict", true, "ALTER TABLE `t_n` ADD CONSTRAINT `ident` FOREIGN KEY (`ident`, `ident`(1)) REFERENCES `t_n` MATCH FULL ON DELETE RESTRICT ON UPDATE SET NULL"},
-		{"alter table d_n.t_n add foreign key ident (ident, ident(1) asc) references t_n match partial on delete cascade remove partitioning", true, "ALTER TABLE `d_n`.`t_n` ADD CONSTRAINT `ident` FOREIGN KEY (`ident`, `ident`(1)) REFERENCES `t_n` MATCH PARTIAL ON DELETE CASCADE REMOVE PARTITIONING"},
-		{"alter table d_n.t_n add constraint foreign key (ident asc) references d_n.t_n match simple on update cascade on delete cascade", true, "ALTER TABLE `d_n`.`t_n` ADD CONSTRAINT FOREIGN KEY (`ident`) REFERENCES `d_n`.`t_n` MATCH SIMPLE ON DELETE CASCADE ON UPDATE CASCADE"},
+		{"alter table d_n.t_n add foreign key ident (ident, ident(1) asc) references t_n match partial on delete cascade remove partitioning", true, "ALTER TABLE `d_n`.`t_n` ADD CONSTRAINT `ident` FOREIGN KEY (`ident`, `ident`(1) ASC) REFERENCES `t_n` MATCH PARTIAL ON DELETE CASCADE REMOVE PARTITIONING"},
+		{"alter table d_n.t_n add constraint foreign key (ident asc) references d_n.t_n match simple on update cascade on delete cascade", true, "ALTER TABLE `d_n`.`t_n` ADD CONSTRAINT FOREIGN KEY (`ident` ASC) REFERENCES `d_n`.`t_n` MATCH SIMPLE ON DELETE CASCADE ON UPDATE CASCADE"},
 
 		// for character vary syntax
 		{"create table t (a character varying(1));", true, "CREATE TABLE `t` (`a` VARCHAR(1))"},
@@ -3668,8 +3873,8 @@ func TestDDL(t *testing.T) {
 		{"alter table d_n.t_n modify column ident long character varying after ident remove partitioning", true, "ALTER TABLE `d_n`.`t_n` MODIFY COLUMN `ident` MEDIUMTEXT AFTER `ident` REMOVE PARTITIONING"},
 		{"alter table d_n.t_n modify column ident long varchar after ident remove partitioning", true, "ALTER TABLE `d_n`.`t_n` MODIFY COLUMN `ident` MEDIUMTEXT AFTER `ident` REMOVE PARTITIONING"},
 		{"alter table d_n.t_n modify column ident long varcharacter after ident remove partitioning", true, "ALTER TABLE `d_n`.`t_n` MODIFY COLUMN `ident` MEDIUMTEXT AFTER `ident` REMOVE PARTITIONING"},
-		{"alter table t_n change column ident ident long char varying binary charset utf8 first , tablespace ident", true, "ALTER TABLE `t_n` CHANGE COLUMN `ident` `ident` MEDIUMTEXT BINARY CHARACTER SET UTF8 FIRST, TABLESPACE = `ident`"},
-		{"alter table t_n change column ident ident long character varying binary charset utf8 first , tablespace ident", true, "ALTER TABLE `t_n` CHANGE COLUMN `ident` `ident` MEDIUMTEXT BINARY CHARACTER SET UTF8 FIRST, TABLESPACE = `ident`"},
+		{"alter table t_n change column ident ident long char varying binary charset utf8 first , tablespace ident", true, "ALTER TABLE `t_n` CHANGE COLUMN `ident` `ident` MEDIUMTEXT CHARACTER SET UTF8 COLLATE utf8_bin FIRST, TABLESPACE = `ident`"},
+		{"alter table t_n change column ident ident long character varying binary charset utf8 first , tablespace ident", true, "ALTER TABLE `t_n` CHANGE COLUMN `ident` `ident` MEDIUMTEXT CHARACTER SET UTF8 COLLATE utf8_bin FIRST, TABLESPACE = `ident`"},
 
 		// for STATS_AUTO_RECALC syntax
 		{"create table t (a int) stats_auto_recalc 2;", false, ""},
@@ -3902,9 +4107,9 @@ func TestDDL(t *testing.T) {
 		{"create table t (a int, b varchar(255) primary key clustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255) PRIMARY KEY CLUSTERED)"},
 		{"create table t (a int, b varchar(255) default 'a' primary key clustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255) DEFAULT _UTF8MB4'a' PRIMARY KEY CLUSTERED)"},
 		{"create table t (a int, b varchar(255) primary key nonclustered, primary key(b, a) nonclustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255) PRIMARY KEY NONCLUSTERED,PRIMARY KEY(`b`, `a`) NONCLUSTERED)"},
-		{"create table t (a int, b varchar(255), primary key(b, a) using RTREE nonclustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255),PRIMARY KEY(`b`, `a`) NONCLUSTERED USING RTREE)"},
-		{"create table t (a int, b varchar(255), primary key(b, a) using RTREE clustered nonclustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255),PRIMARY KEY(`b`, `a`) NONCLUSTERED USING RTREE)"},
-		{"create table t (a int, b varchar(255), primary key(b, a) using RTREE nonclustered clustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255),PRIMARY KEY(`b`, `a`) CLUSTERED USING RTREE)"},
+		{"create table t (a int, b varchar(255), primary key(b, a) using RTREE nonclustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255),PRIMARY KEY(`b`, `a`) USING RTREE NONCLUSTERED)"},
+		{"create table t (a int, b varchar(255), primary key(b, a) using RTREE clustered nonclustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255),PRIMARY KEY(`b`, `a`) USING RTREE NONCLUSTERED)"},
+		{"create table t (a int, b varchar(255), primary key(b, a) using RTREE nonclustered clustered)", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(255),PRIMARY KEY(`b`, `a`) USING RTREE CLUSTERED)"},
 		{"create table t (a int, b varchar(255) clustered primary key)", false, ""},
 		{"create table t (a int, b varchar(255) primary key nonclustered clustered)", false, ""},
 		{"alter table t add primary key (`a`, `b`) clustered", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`, `b`) CLUSTERED"},
@@ -4222,6 +4427,28 @@ func TestErrorMsg(t *testing.T) {
 	p := parser.New()
 	_, _, err := p.Parse("select1 1", "", "")
 	require.EqualError(t, err, "line 1 column 7 near \"select1 1\" ")
+	for _, sql := range []string{"select * as a from t", "select t.* as a from t", "select db.t.* as 'a' from t"} {
+		_, _, err = p.Parse(sql, "", "")
+		require.True(t, terror.ErrorEqual(err, parser.ErrParse), sql)
+		require.ErrorContains(t, err, "Alias is not allowed for wildcard field line 1 column", sql)
+	}
+	for _, sql := range []string{
+		"select count(t.*) from t",
+		"select count(db.t.*) from t",
+		"select count(distinct a, t.*) from t",
+		"select sum(t.*) from t",
+		"select max(distinct db.t.*) from t",
+		"select group_concat(t.* separator ',') from t",
+		"select concat(a, t.*) from t",
+		"select db.f(t.*) from t",
+		"select substring(t.*, 1) from t",
+		"select convert(t.* using utf8mb4) from t",
+		"select lag(t.*) over w from t",
+	} {
+		_, _, err = p.Parse(sql, "", "")
+		require.True(t, terror.ErrorEqual(err, parser.ErrParse), sql)
+		require.ErrorContains(t, err, "Qualified wildcard is not allowed in function arguments line 1 column", sql)
+	}
 	_, _, err = p.Parse("select 1 from1 dual", "", "")
 	require.EqualError(t, err, "line 1 column 19 near \"dual\" ")
 	_, _, err = p.Parse("select * from t1 join t2 from t1.a = t2.a;", "", "")
@@ -4776,8 +5003,10 @@ func TestOptimizerHints(t *testing.T) {
 	require.Len(t, hints, 2)
 	require.Equal(t, "memory_quota", hints[0].HintName.L)
 	require.Equal(t, int64(1024*1024), hints[0].HintData.(int64))
+	require.Equal(t, ast.HintMemoryQuotaMB, hints[0].HintDataUnit)
 	require.Equal(t, "memory_quota", hints[1].HintName.L)
 	require.Equal(t, int64(1024*1024*1024), hints[1].HintData.(int64))
+	require.Equal(t, ast.HintMemoryQuotaGB, hints[1].HintDataUnit)
 
 	_, _, err = p.Parse("select /*+ MEMORY_QUOTA(18446744073709551612 MB), memory_quota(8689934592 GB) */ 1", "", "")
 	require.NoError(t, err)
@@ -5071,6 +5300,31 @@ func TestType(t *testing.T) {
 
 		// for json type
 		{`create table t (a JSON);`, true, "CREATE TABLE `t` (`a` JSON)"},
+
+		// for spatial types
+		{"create table t (a geometry, b point, c linestring, d polygon, e multipoint, f multilinestring, g multipolygon, h geometrycollection)", true, "CREATE TABLE `t` (`a` GEOMETRY,`b` POINT,`c` LINESTRING,`d` POLYGON,`e` MULTIPOINT,`f` MULTILINESTRING,`g` MULTIPOLYGON,`h` GEOMETRYCOLLECTION)"},
+		{"create table t (a geometry not null srid 4326, b point not null srid 0, c linestring srid 4326 not null, d polygon not null srid 3857, e multipoint srid 4326, f multilinestring not null srid 4326, g multipolygon not null srid 4326, h geometrycollection not null srid 4326)", true, "CREATE TABLE `t` (`a` GEOMETRY NOT NULL SRID 4326,`b` POINT NOT NULL SRID 0,`c` LINESTRING SRID 4326 NOT NULL,`d` POLYGON NOT NULL SRID 3857,`e` MULTIPOINT SRID 4326,`f` MULTILINESTRING NOT NULL SRID 4326,`g` MULTIPOLYGON NOT NULL SRID 4326,`h` GEOMETRYCOLLECTION NOT NULL SRID 4326)"},
+		{"alter table t add column p point not null srid 4326 after a, modify column g polygon", true, "ALTER TABLE `t` ADD COLUMN `p` POINT NOT NULL SRID 4326 AFTER `a`, MODIFY COLUMN `g` POLYGON"},
+		{"create table t (a int srid 4326)", false, ""},
+		{"create table t (a point srid -1)", false, ""},
+		{"create table point (point point, polygon polygon, srid int)", true, "CREATE TABLE `point` (`point` POINT,`polygon` POLYGON,`srid` INT)"},
+		{"select ST_GeomFromText('POINT(1 1)', 4326), point(1, 1), linestring from t", true, "SELECT ST_GEOMFROMTEXT(_UTF8MB4'POINT(1 1)', 4326),POINT(1, 1),`linestring` FROM `t`"},
+	}
+	RunTest(t, table, false)
+}
+
+func TestSpatialConstructors(t *testing.T) {
+	// The spatial type keywords are the names of the spatial constructors too.
+	table := []testCase{
+		{"select point(0, 0)", true, "SELECT POINT(0, 0)"},
+		{"select linestring(point(0, 0), point(1, 1))", true, "SELECT LINESTRING(POINT(0, 0), POINT(1, 1))"},
+		{"SELECT POLYGON(LINESTRING(POINT(0,0),POINT(1,1),POINT(0,0)))", true, "SELECT POLYGON(LINESTRING(POINT(0, 0), POINT(1, 1), POINT(0, 0)))"},
+		{"select MultiPoint(Point(0, 0), Point(1, 1))", true, "SELECT MULTIPOINT(POINT(0, 0), POINT(1, 1))"},
+		{"select multilinestring(linestring(point(0, 0), point(1, 1)))", true, "SELECT MULTILINESTRING(LINESTRING(POINT(0, 0), POINT(1, 1)))"},
+		{"select multipolygon(polygon(linestring(point(0, 0), point(1, 1), point(0, 0))))", true, "SELECT MULTIPOLYGON(POLYGON(LINESTRING(POINT(0, 0), POINT(1, 1), POINT(0, 0))))"},
+		{"select geometrycollection(point(0, 0), linestring(point(0, 0), point(1, 1)))", true, "SELECT GEOMETRYCOLLECTION(POINT(0, 0), LINESTRING(POINT(0, 0), POINT(1, 1)))"},
+		{"insert into t (g) values (polygon(a)), (multipoint(b))", true, "INSERT INTO `t` (`g`) VALUES (POLYGON(`a`)),(MULTIPOINT(`b`))"},
+		{"select polygon, multipoint from t where linestring = 1", true, "SELECT `polygon`,`multipoint` FROM `t` WHERE `linestring`=1"},
 	}
 	RunTest(t, table, false)
 }
@@ -5136,6 +5390,12 @@ func TestPrivilege(t *testing.T) {
 		{`ALTER USER IF EXISTS 'root'@'localhost' IDENTIFIED BY 'new-password'`, true, "ALTER USER IF EXISTS `root`@`localhost` IDENTIFIED BY 'new-password'"},
 		{`ALTER USER 'root'@'localhost' IDENTIFIED BY 'new-password'`, true, "ALTER USER `root`@`localhost` IDENTIFIED BY 'new-password'"},
 		{`ALTER USER 'root'@'localhost' RESOURCE GROUP rg2`, true, "ALTER USER `root`@`localhost` RESOURCE GROUP `rg2`"},
+		{`ALTER USER 'joe'@'%' DEFAULT ROLE ALL`, true, "ALTER USER `joe`@`%` DEFAULT ROLE ALL"},
+		{`ALTER USER IF EXISTS joe DEFAULT ROLE NONE`, true, "ALTER USER IF EXISTS `joe`@`%` DEFAULT ROLE NONE"},
+		{`ALTER USER joe DEFAULT ROLE administrator`, true, "ALTER USER `joe`@`%` DEFAULT ROLE `administrator`@`%`"},
+		{`ALTER USER joe DEFAULT ROLE administrator, 'developer'@'localhost'`, true, "ALTER USER `joe`@`%` DEFAULT ROLE `administrator`@`%`, `developer`@`localhost`"},
+		{`ALTER USER joe, jane DEFAULT ROLE ALL`, false, ""},
+		{`ALTER USER joe DEFAULT ROLE`, false, ""},
 		{`ALTER USER 'root'@'localhost' IDENTIFIED BY PASSWORD 'hashstring'`, true, "ALTER USER `root`@`localhost` IDENTIFIED WITH 'mysql_native_password' AS 'hashstring'"},
 		{`ALTER USER 'root'@'localhost' IDENTIFIED BY 'new-password', 'root'@'127.0.0.1' IDENTIFIED BY PASSWORD 'hashstring'`, true, "ALTER USER `root`@`localhost` IDENTIFIED BY 'new-password', `root`@`127.0.0.1` IDENTIFIED WITH 'mysql_native_password' AS 'hashstring'"},
 		{`ALTER USER USER() IDENTIFIED BY 'new-password'`, true, "ALTER USER USER() IDENTIFIED BY 'new-password'"},
@@ -5193,7 +5453,18 @@ func TestPrivilege(t *testing.T) {
 		{"GRANT CREATE TABLESPACE ON *.* TO 'dev1'@'localhost';", true, "GRANT CREATE TABLESPACE ON *.* TO `dev1`@`localhost`"},
 		{"GRANT EXECUTE ON FUNCTION db1.anomaly_score TO 'user1'@'domain-or-ip-address1'", true, "GRANT EXECUTE ON FUNCTION `db1`.`anomaly_score` TO `user1`@`domain-or-ip-address1`"},
 		{"GRANT EXECUTE ON PROCEDURE mydb.myproc TO 'someuser'@'somehost'", true, "GRANT EXECUTE ON PROCEDURE `mydb`.`myproc` TO `someuser`@`somehost`"},
+		{"GRANT EXECUTE, ALTER ROUTINE ON FUNCTION f TO u", true, "GRANT EXECUTE, ALTER ROUTINE ON FUNCTION `f` TO `u`@`%`"},
+		{"GRANT EXECUTE ON PROCEDURE p TO u WITH GRANT OPTION", true, "GRANT EXECUTE ON PROCEDURE `p` TO `u`@`%` WITH GRANT OPTION"},
 		{"GRANT APPLICATION_PASSWORD_ADMIN,AUDIT_ADMIN ON *.* TO 'root'@'localhost'", true, "GRANT APPLICATION_PASSWORD_ADMIN, AUDIT_ADMIN ON *.* TO `root`@`localhost`"},
+		{"GRANT BACKUP_ADMIN, ROLE_ADMIN ON *.* TO u", true, "GRANT BACKUP_ADMIN, ROLE_ADMIN ON *.* TO `u`@`%`"},
+		{"grant backup_admin, Role_Admin on *.* to u with grant option", true, "GRANT backup_admin, Role_Admin ON *.* TO `u`@`%` WITH GRANT OPTION"},
+		{"GRANT SELECT, backup_admin, INSERT (c) ON *.* TO u", true, "GRANT SELECT, backup_admin, INSERT (`c`) ON *.* TO `u`@`%`"},
+		{"GRANT SELECT (col1, col2), INSERT (col1) ON db.t TO u", true, "GRANT SELECT (`col1`,`col2`), INSERT (`col1`) ON `db`.`t` TO `u`@`%`"},
+		{"GRANT SELECT (col1), UPDATE (col1, col2), REFERENCES (col2) ON TABLE db.t TO u WITH GRANT OPTION", true, "GRANT SELECT (`col1`), UPDATE (`col1`,`col2`), REFERENCES (`col2`) ON TABLE `db`.`t` TO `u`@`%` WITH GRANT OPTION"},
+		{"GRANT ALL PRIVILEGES ON db.* TO u WITH GRANT OPTION", true, "GRANT ALL ON `db`.* TO `u`@`%` WITH GRANT OPTION"},
+		{"GRANT ALL PRIVILEGES ON *.* TO u REQUIRE SSL WITH GRANT OPTION", true, "GRANT ALL ON *.* TO `u`@`%` REQUIRE SSL WITH GRANT OPTION"},
+		{"GRANT PROXY ON 'a'@'%' TO 'b'@'%'", true, "GRANT PROXY ON `a`@`%` TO `b`@`%`"},
+		{"GRANT PROXY ON 'a'@'%' TO 'b'@'%' WITH GRANT OPTION", true, "GRANT PROXY ON `a`@`%` TO `b`@`%` WITH GRANT OPTION"},
 		{"GRANT LOAD FROM S3, SELECT INTO S3, INVOKE LAMBDA, INVOKE SAGEMAKER, INVOKE COMPREHEND ON *.* TO 'root'@'localhost'", true, "GRANT LOAD FROM S3, SELECT INTO S3, INVOKE LAMBDA, INVOKE SAGEMAKER, INVOKE COMPREHEND ON *.* TO `root`@`localhost`"},
 		{"GRANT PROXY ON 'localuser'@'localhost' TO 'externaluser'@'somehost'", true, "GRANT PROXY ON `localuser`@`localhost` TO `externaluser`@`somehost`"},
 		{"GRANT PROXY ON ''@'' TO 'root'@'localhost' WITH GRANT OPTION", true, "GRANT PROXY ON ``@`` TO `root`@`localhost` WITH GRANT OPTION"},
@@ -5217,6 +5488,7 @@ func TestPrivilege(t *testing.T) {
 		{"REVOKE EXECUTE ON FUNCTION db.func FROM 'user'@'localhost'", true, "REVOKE EXECUTE ON FUNCTION `db`.`func` FROM `user`@`localhost`"},
 		{"REVOKE EXECUTE ON PROCEDURE db.func FROM 'user'@'localhost'", true, "REVOKE EXECUTE ON PROCEDURE `db`.`func` FROM `user`@`localhost`"},
 		{"REVOKE APPLICATION_PASSWORD_ADMIN,AUDIT_ADMIN ON *.* FROM 'root'@'localhost'", true, "REVOKE APPLICATION_PASSWORD_ADMIN, AUDIT_ADMIN ON *.* FROM `root`@`localhost`"},
+		{"revoke backup_admin, select (c) on *.* from u", true, "REVOKE backup_admin, SELECT (`c`) ON *.* FROM `u`@`%`"},
 		{"revoke all privileges, grant option from u1", true, "REVOKE ALL, GRANT OPTION ON *.* FROM `u1`@`%`"},                             // special case syntax
 		{"revoke all privileges, grant option from u1, u2, u3", true, "REVOKE ALL, GRANT OPTION ON *.* FROM `u1`@`%`, `u2`@`%`, `u3`@`%`"}, // special case syntax
 	}
@@ -5602,6 +5874,32 @@ func TestPriority(t *testing.T) {
 		{`replace high_priority into t values (1)`, true, "REPLACE HIGH_PRIORITY INTO `t` VALUES (1)"},
 		{`replace LOW_PRIORITY into t values (1)`, true, "REPLACE LOW_PRIORITY INTO `t` VALUES (1)"},
 		{`replace delayed into t values (1)`, true, "REPLACE DELAYED INTO `t` VALUES (1)"},
+
+		// The modifiers are restored in their canonical order.
+		{`insert low_priority ignore into t values (1)`, true, "INSERT LOW_PRIORITY IGNORE INTO `t` VALUES (1)"},
+		{`insert high_priority ignore t values (1)`, true, "INSERT HIGH_PRIORITY IGNORE INTO `t` VALUES (1)"},
+		{`insert delayed ignore into t values (1) on duplicate key update a = 2`, true, "INSERT DELAYED IGNORE INTO `t` VALUES (1) ON DUPLICATE KEY UPDATE `a`=2"},
+		{`insert low_priority ignore into t set a = 1`, true, "INSERT LOW_PRIORITY IGNORE INTO `t` SET `a`=1"},
+		{`replace low_priority t select * from s`, true, "REPLACE LOW_PRIORITY INTO `t` SELECT * FROM `s`"},
+		{`update low_priority ignore t set a = 2 limit 1`, true, "UPDATE LOW_PRIORITY IGNORE `t` SET `a`=2 LIMIT 1"},
+		{`update low_priority ignore t, s set t.a = s.a`, true, "UPDATE LOW_PRIORITY IGNORE (`t`) JOIN `s` SET `t`.`a`=`s`.`a`"},
+		{`delete low_priority quick ignore from t where a = 2`, true, "DELETE LOW_PRIORITY QUICK IGNORE FROM `t` WHERE `a`=2"},
+		{`delete quick ignore from t`, true, "DELETE QUICK IGNORE FROM `t`"},
+		{`delete low_priority quick t from t, s`, true, "DELETE LOW_PRIORITY QUICK `t` FROM (`t`) JOIN `s`"},
+		{`delete low_priority quick ignore from t using t, s`, true, "DELETE LOW_PRIORITY QUICK IGNORE FROM `t` USING (`t`) JOIN `s`"},
+
+		// A statement has only one priority.
+		{`insert low_priority high_priority into t values (1)`, false, ""},
+		{`insert delayed low_priority into t values (1)`, false, ""},
+		{`insert low_priority low_priority into t values (1)`, false, ""},
+		{`replace low_priority delayed into t values (1)`, false, ""},
+		{`update low_priority high_priority t set a = 2`, false, ""},
+		{`delete high_priority low_priority from t`, false, ""},
+		{`delete quick low_priority from t`, false, ""},
+
+		// QUICK is only a modifier of DELETE, elsewhere it's a table name.
+		{`insert quick into t values (1)`, false, ""},
+		{`update quick t set a = 2`, true, "UPDATE `quick` AS `t` SET `a`=2"},
 	}
 	RunTest(t, table, false)
 
@@ -5610,6 +5908,48 @@ func TestPriority(t *testing.T) {
 	require.NoError(t, err)
 	sel := stmt[0].(*ast.SelectStmt)
 	require.Equal(t, mysql.HighPriority, sel.SelectStmtOpts.Priority)
+
+	stmt, warns, err := p.Parse("insert low_priority ignore into t values (1)", "", "")
+	require.NoError(t, err)
+	require.Empty(t, warns)
+	ins := stmt[0].(*ast.InsertStmt)
+	require.Equal(t, mysql.LowPriority, ins.Priority)
+	require.True(t, ins.IgnoreErr)
+	require.Equal(t, ast.DMLModifierLowPriority|ast.DMLModifierIgnore, ins.Modifiers())
+
+	stmt, _, err = p.Parse("delete low_priority quick ignore from t", "", "")
+	require.NoError(t, err)
+	del := stmt[0].(*ast.DeleteStmt)
+	require.Equal(t, mysql.LowPriority, del.Priority)
+	require.True(t, del.Quick)
+	require.True(t, del.IgnoreErr)
+	require.True(t, del.Modifiers().Has(ast.DMLModifierQuick|ast.DMLModifierIgnore))
+	require.Equal(t, "LOW_PRIORITY QUICK IGNORE", del.Modifiers().String())
+
+	stmt, _, err = p.Parse("update high_priority ignore t set a = 1", "", "")
+	require.NoError(t, err)
+	require.Equal(t, ast.DMLModifierHighPriority|ast.DMLModifierIgnore, stmt[0].(*ast.UpdateStmt).Modifiers())
+
+	// DELAYED is removed from MySQL 8.0 but still found in old dumps.
+	for _, sql := range []string{
+		"insert delayed into t values (1)",
+		"replace delayed into t values (1)",
+	} {
+		stmt, warns, err = p.Parse(sql, "", "")
+		require.NoError(t, err, sql)
+		require.Equal(t, mysql.DelayedPriority, stmt[0].(*ast.InsertStmt).Priority, sql)
+		require.Equal(t, ast.DMLModifierDelayed, stmt[0].(*ast.InsertStmt).Modifiers(), sql)
+		require.Len(t, warns, 1, sql)
+		require.True(t, terror.ErrorEqual(warns[0], parser.ErrWarnDeprecatedSyntaxNoReplacement), warns[0])
+		require.EqualError(t, warns[0], "[parser:1681]'DELAYED' is deprecated and will be removed in a future release.")
+	}
+
+	// The error is at the start of the second priority.
+	_, _, err = p.Parse("insert low_priority high_priority into t values (1)", "", "")
+	require.True(t, terror.ErrorEqual(err, parser.ErrParse), "%v", err)
+	require.EqualError(t, err, `[parser:1064]LOW_PRIORITY and HIGH_PRIORITY can't be used together line 1 column 20 near "high_priority into t values (1)"`)
+	_, _, err = p.Parse("update low_priority\n  low_priority t set a = 1", "", "")
+	require.EqualError(t, err, `[parser:1064]Duplicate LOW_PRIORITY line 2 column 2 near "low_priority t set a = 1"`)
 }
 
 func TestSQLResult(t *testing.T) {
@@ -5669,9 +6009,9 @@ func TestExplain(t *testing.T) {
 		{"DESC SCHE.TABL COLUM", true, "DESC `SCHE`.`TABL` `COLUM`"},
 		{"DESCRIBE SCHE.TABL COLUM", true, "DESC `SCHE`.`TABL` `COLUM`"},
 		{"EXPLAIN ANALYZE SELECT 1", true, "EXPLAIN ANALYZE SELECT 1"},
-		{"EXPLAIN ANALYZE format=VERBOSE SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'VERBOSE' SELECT 1"},
+		{"EXPLAIN ANALYZE format=VERBOSE SELECT 1", true, "EXPLAIN ANALYZE FORMAT = VERBOSE SELECT 1"},
 		{"EXPLAIN ANALYZE format=TRUE_CARD_COST SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'TRUE_CARD_COST' SELECT 1"},
-		{"EXPLAIN ANALYZE format='VERBOSE' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'VERBOSE' SELECT 1"},
+		{"EXPLAIN ANALYZE format='VERBOSE' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = VERBOSE SELECT 1"},
 		{"EXPLAIN ANALYZE format='TRUE_CARD_COST' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'TRUE_CARD_COST' SELECT 1"},
 		{"EXPLAIN FORMAT = 'dot' SELECT 1", true, "EXPLAIN FORMAT = 'dot' SELECT 1"},
 		{"EXPLAIN FORMAT = DOT SELECT 1", true, "EXPLAIN FORMAT = 'DOT' SELECT 1"},
@@ -5679,9 +6019,9 @@ func TestExplain(t *testing.T) {
 		{"EXPLAIN FORMAT = 'ROW' SELECT 1", true, "EXPLAIN FORMAT = 'ROW' SELECT 1"},
 		{"EXPLAIN FORMAT = 'BRIEF' SELECT 1", true, "EXPLAIN FORMAT = 'BRIEF' SELECT 1"},
 		{"EXPLAIN FORMAT = BRIEF SELECT 1", true, "EXPLAIN FORMAT = 'BRIEF' SELECT 1"},
-		{"EXPLAIN FORMAT = 'verbose' SELECT 1", true, "EXPLAIN FORMAT = 'verbose' SELECT 1"},
-		{"EXPLAIN FORMAT = 'VERBOSE' SELECT 1", true, "EXPLAIN FORMAT = 'VERBOSE' SELECT 1"},
-		{"EXPLAIN FORMAT = VERBOSE SELECT 1", true, "EXPLAIN FORMAT = 'VERBOSE' SELECT 1"},
+		{"EXPLAIN FORMAT = 'verbose' SELECT 1", true, "EXPLAIN FORMAT = VERBOSE SELECT 1"},
+		{"EXPLAIN FORMAT = 'VERBOSE' SELECT 1", true, "EXPLAIN FORMAT = VERBOSE SELECT 1"},
+		{"EXPLAIN FORMAT = VERBOSE SELECT 1", true, "EXPLAIN FORMAT = VERBOSE SELECT 1"},
 		{"EXPLAIN SELECT 1", true, "EXPLAIN FORMAT = 'row' SELECT 1"},
 		{"EXPLAIN FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'row' FOR CONNECTION 1"},
 		{"EXPLAIN FOR connection 42", true, "EXPLAIN FORMAT = 'row' FOR CONNECTION 42"},
@@ -5689,18 +6029,20 @@ func TestExplain(t *testing.T) {
 		{"EXPLAIN FORMAT = DOT FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'DOT' FOR CONNECTION 1"},
 		{"EXPLAIN FORMAT = 'row' FOR connection 1", true, "EXPLAIN FORMAT = 'row' FOR CONNECTION 1"},
 		{"EXPLAIN FORMAT = ROW FOR connection 1", true, "EXPLAIN FORMAT = 'ROW' FOR CONNECTION 1"},
-		{"EXPLAIN FORMAT = TRADITIONAL FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'TRADITIONAL' FOR CONNECTION 1"},
-		{"EXPLAIN FORMAT = TRADITIONAL SELECT 1", true, "EXPLAIN FORMAT = 'TRADITIONAL' SELECT 1"},
+		{"EXPLAIN FORMAT = TRADITIONAL FOR CONNECTION 1", true, "EXPLAIN FORMAT = TRADITIONAL FOR CONNECTION 1"},
+		{"EXPLAIN FORMAT = 'json' FOR CONNECTION 1", true, "EXPLAIN FORMAT = JSON FOR CONNECTION 1"},
+		{"EXPLAIN FORMAT = 'Tree' FOR CONNECTION 1", true, "EXPLAIN FORMAT = TREE FOR CONNECTION 1"},
+		{"EXPLAIN FORMAT = TRADITIONAL SELECT 1", true, "EXPLAIN FORMAT = TRADITIONAL SELECT 1"},
 		{"EXPLAIN FORMAT = BRIEF SELECT 1", true, "EXPLAIN FORMAT = 'BRIEF' SELECT 1"},
 		{"EXPLAIN FORMAT = 'brief' SELECT 1", true, "EXPLAIN FORMAT = 'brief' SELECT 1"},
 		{"EXPLAIN FORMAT = DOT SELECT 1", true, "EXPLAIN FORMAT = 'DOT' SELECT 1"},
 		{"EXPLAIN FORMAT = 'dot' SELECT 1", true, "EXPLAIN FORMAT = 'dot' SELECT 1"},
-		{"EXPLAIN FORMAT = VERBOSE SELECT 1", true, "EXPLAIN FORMAT = 'VERBOSE' SELECT 1"},
-		{"EXPLAIN FORMAT = 'verbose' SELECT 1", true, "EXPLAIN FORMAT = 'verbose' SELECT 1"},
-		{"EXPLAIN FORMAT = JSON FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'JSON' FOR CONNECTION 1"},
-		{"EXPLAIN FORMAT = JSON SELECT 1", true, "EXPLAIN FORMAT = 'JSON' SELECT 1"},
+		{"EXPLAIN FORMAT = VERBOSE SELECT 1", true, "EXPLAIN FORMAT = VERBOSE SELECT 1"},
+		{"EXPLAIN FORMAT = 'verbose' SELECT 1", true, "EXPLAIN FORMAT = VERBOSE SELECT 1"},
+		{"EXPLAIN FORMAT = JSON FOR CONNECTION 1", true, "EXPLAIN FORMAT = JSON FOR CONNECTION 1"},
+		{"EXPLAIN FORMAT = JSON SELECT 1", true, "EXPLAIN FORMAT = JSON SELECT 1"},
 		{"EXPLAIN FORMAT = 'hint' SELECT 1", true, "EXPLAIN FORMAT = 'hint' SELECT 1"},
-		{"EXPLAIN ANALYZE FORMAT = 'verbose' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'verbose' SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT = 'verbose' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = VERBOSE SELECT 1"},
 		{"EXPLAIN ANALYZE FORMAT = 'binary' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'binary' SELECT 1"},
 		{"EXPLAIN ALTER TABLE t1 ADD INDEX (a)", true, "EXPLAIN FORMAT = 'row' ALTER TABLE `t1` ADD INDEX(`a`)"},
 		{"EXPLAIN ALTER TABLE t1 ADD a varchar(255)", true, "EXPLAIN FORMAT = 'row' ALTER TABLE `t1` ADD COLUMN `a` VARCHAR(255)"},
@@ -5709,12 +6051,59 @@ func TestExplain(t *testing.T) {
 		{"EXPLAIN ANALYZE FORMAT = tidb_json SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'tidb_json' SELECT 1"},
 		{"EXPLAIN 'sqldigest'", true, "EXPLAIN FORMAT = 'row' 'sqldigest'"},
 		{"EXPLAIN ANALYZE 'sqldigest'", true, "EXPLAIN ANALYZE 'sqldigest'"},
-		{"EXPLAIN format='json' 'sqldigest'", true, "EXPLAIN FORMAT = 'json' 'sqldigest'"},
-		{"EXPLAIN ANALYZE format='json' 'sqldigest'", true, "EXPLAIN ANALYZE FORMAT = 'json' 'sqldigest'"},
+		{"EXPLAIN format='json' 'sqldigest'", true, "EXPLAIN FORMAT = JSON 'sqldigest'"},
+		{"EXPLAIN ANALYZE format='json' 'sqldigest'", true, "EXPLAIN ANALYZE FORMAT = JSON 'sqldigest'"},
+		{"EXPLAIN FORMAT = TREE SELECT 1", true, "EXPLAIN FORMAT = TREE SELECT 1"},
+		{"EXPLAIN FORMAT = 'tree' SELECT 1", true, "EXPLAIN FORMAT = TREE SELECT 1"},
+		{"EXPLAIN ANALYZE SELECT 1", true, "EXPLAIN ANALYZE SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT = TRADITIONAL SELECT 1", true, "EXPLAIN ANALYZE FORMAT = TRADITIONAL SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT = json SELECT 1", true, "EXPLAIN ANALYZE FORMAT = JSON SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT=TREE SELECT 1", true, "EXPLAIN ANALYZE FORMAT = TREE SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT = 'Tree' SELECT 1", true, "EXPLAIN ANALYZE FORMAT = TREE SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT = VERBOSE SELECT 1", true, "EXPLAIN ANALYZE FORMAT = VERBOSE SELECT 1"},
+		{"select tree from t", true, "SELECT `tree` FROM `t`"},
 	}
 	RunTest(t, table, false)
 }
 
+func TestExplainFormatType(t *testing.T) {
+	p := parser.New()
+	for _, tc := range []struct {
+		sql        string
+		format     string
+		formatType ast.ExplainFormatType
+	}{
+		{"EXPLAIN SELECT 1", "row", ast.ExplainFormatNone},
+		{"EXPLAIN FORMAT = 'brief' SELECT 1", "brief", ast.ExplainFormatNone},
+		{"EXPLAIN FORMAT = traditional SELECT 1", "traditional", ast.ExplainFormatTraditional},
+		{"EXPLAIN FORMAT = 'json' SELECT 1", "json", ast.ExplainFormatJSON},
+		{"EXPLAIN FORMAT = TREE SELECT 1", "TREE", ast.ExplainFormatTree},
+		{"EXPLAIN FORMAT = VERBOSE SELECT 1", "VERBOSE", ast.ExplainFormatVerbose},
+		{"EXPLAIN ANALYZE SELECT 1", "row", ast.ExplainFormatNone},
+		{"EXPLAIN ANALYZE FORMAT = TRADITIONAL SELECT 1", "TRADITIONAL", ast.ExplainFormatTraditional},
+		{"EXPLAIN ANALYZE FORMAT = JSON SELECT 1", "JSON", ast.ExplainFormatJSON},
+		{"EXPLAIN ANALYZE FORMAT = 'tree' SELECT 1", "tree", ast.ExplainFormatTree},
+		{"EXPLAIN ANALYZE FORMAT = verbose SELECT 1", "verbose", ast.ExplainFormatVerbose},
+		{"EXPLAIN FOR CONNECTION 1", "row", ast.ExplainFormatNone},
+		{"EXPLAIN FORMAT = 'dot' FOR CONNECTION 1", "dot", ast.ExplainFormatNone},
+		{"EXPLAIN FORMAT = 'json' FOR CONNECTION 1", "json", ast.ExplainFormatJSON},
+		{"EXPLAIN FORMAT = TRADITIONAL FOR CONNECTION 1", "TRADITIONAL", ast.ExplainFormatTraditional},
+	} {
+		stmt, err := p.ParseOneStmt(tc.sql, "", "")
+		require.NoError(t, err, tc.sql)
+		switch explain := stmt.(type) {
+		case *ast.ExplainStmt:
+			require.Equal(t, tc.format, explain.Format, tc.sql)
+			require.Equal(t, tc.formatType, explain.FormatType, tc.sql)
+		case *ast.ExplainForStmt:
+			require.Equal(t, tc.format, explain.Format, tc.sql)
+			require.Equal(t, tc.formatType, explain.FormatType, tc.sql)
+		default:
+			require.Failf(t, "unexpected statement", "%s: %T", tc.sql, stmt)
+		}
+	}
+}
+
 func TestPrepare(t *testing.T) {
 	table := []testCase{
 		{"PREPARE pname FROM 'SELECT ?'", true, "PREPARE `pname` FROM 'SELECT ?'"},
@@ -5972,6 +6361,187 @@ CREATE VIEW v2 AS SELECT 123123123123123;
 	require.Equal(t, nodes[1].(*ast.CreateViewStmt).Select.Text(), "SELECT 123123123123123")
 }
 
+func TestTrigger(t *testing.T) {
+	table := []testCase{
+		{"create trigger trg before insert on t for each row set new.a = 1", true, "CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW set new.a = 1"},
+		{"CREATE DEFINER = 'root'@'localhost' TRIGGER IF NOT EXISTS db.trg AFTER UPDATE ON db.t FOR EACH ROW FOLLOWS other INSERT INTO log VALUES (OLD.id, NEW.id)", true, "CREATE DEFINER = `root`@`localhost` TRIGGER IF NOT EXISTS `db`.`trg` AFTER UPDATE ON `db`.`t` FOR EACH ROW FOLLOWS `other` INSERT INTO log VALUES (OLD.id, NEW.id)"},
+		{"create definer = current_user trigger trg after delete on t for each row precedes other begin delete from t2 where id = old.id; insert into log values (old.id); end", true, "CREATE TRIGGER `trg` AFTER DELETE ON `t` FOR EACH ROW PRECEDES `other` begin delete from t2 where id = old.id; insert into log values (old.id); end"},
+		{"create trigger before before update on each for each row set @each = 1", true, "CREATE TRIGGER `before` BEFORE UPDATE ON `each` FOR EACH ROW set @each = 1"},
+		{"create trigger trg before insert on t for each row", false, ""},
+		{"create trigger trg before select on t for each row set new.a = 1", false, ""},
+		{"create trigger trg before insert on t set new.a = 1", false, ""},
+		{"create or replace trigger trg before insert on t for each row set new.a = 1", false, ""},
+		{"create algorithm = merge trigger trg before insert on t for each row set new.a = 1", false, ""},
+		{"drop trigger trg", true, "DROP TRIGGER `trg`"},
+		{"drop trigger if exists db.trg", true, "DROP TRIGGER IF EXISTS `db`.`trg`"},
+		{"drop trigger", false, ""},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("CREATE DEFINER = 'u'@'%' TRIGGER db.trg BEFORE INSERT ON t FOR EACH ROW FOLLOWS other SET NEW.a = 1", "", "")
+	require.NoError(t, err)
+	trigger, ok := stmt.(*ast.CreateTriggerStmt)
+	require.True(t, ok)
+	require.Equal(t, "u", trigger.Definer.Username)
+	require.Equal(t, "db", trigger.TriggerName.Schema.O)
+	require.Equal(t, "trg", trigger.TriggerName.Name.O)
+	require.Equal(t, ast.TriggerBefore, trigger.Timing)
+	require.Equal(t, ast.TriggerInsert, trigger.Event)
+	require.Equal(t, "t", trigger.Table.Name.O)
+	require.Equal(t, ast.TriggerFollows, trigger.Order)
+	require.Equal(t, "other", trigger.OtherTrigger.O)
+	require.Equal(t, "SET NEW.a = 1", trigger.Body)
+}
+
+func TestTriggerBody(t *testing.T) {
+	p := parser.New()
+
+	// The ';' of a compound statement don't end the body, as if the statement
+	// was sent with a custom DELIMITER.
+	body := "BEGIN IF NEW.a < 0 THEN SET NEW.a = 0; ELSEIF NEW.a > 10 THEN SET NEW.a = CASE WHEN NEW.b THEN 10 ELSE 9 END; END IF; " +
+		"lbl: LOOP LEAVE lbl; END LOOP lbl; CASE NEW.c WHEN 1 THEN SET NEW.d = IF(NEW.e, 1, 2); END CASE; END"
+	sql := "CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW " + body
+	stmt, err := p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	require.Equal(t, body, stmt.(*ast.CreateTriggerStmt).Body)
+	require.Equal(t, sql, stmt.Text())
+
+	for _, tc := range []struct {
+		sql  string
+		body string
+	}{
+		{sql + "; SELECT 1", body},
+		{"CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW IF NEW.a < 0 THEN SET NEW.a = 0; END IF ; SELECT 1", "IF NEW.a < 0 THEN SET NEW.a = 0; END IF"},
+		{"CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET NEW.a = 'END;' /* ; */; SELECT 1", "SET NEW.a = 'END;'"},
+		{"CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW WHILE NEW.a > 0 DO SET NEW.a = NEW.a - 1; END WHILE; SELECT 1", "WHILE NEW.a > 0 DO SET NEW.a = NEW.a - 1; END WHILE"},
+		// The statement of mysqldump.
+		{"/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER trg BEFORE INSERT ON t FOR EACH ROW SET NEW.a = 1 */; SELECT 1", "SET NEW.a = 1"},
+	} {
+		stmts, _, err := p.Parse(tc.sql, "", "")
+		require.NoError(t, err, tc.sql)
+		require.Len(t, stmts, 2, tc.sql)
+		require.Equal(t, tc.body, stmts[0].(*ast.CreateTriggerStmt).Body, tc.sql)
+		require.IsType(t, &ast.SelectStmt{}, stmts[1], tc.sql)
+	}
+}
+
+func TestEvent(t *testing.T) {
+	table := []testCase{
+		{"create event e on schedule every 1 day starts '2024-01-01 00:00:00' do delete from logs where ts < now() - interval 30 day", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 DAY STARTS '2024-01-01 00:00:00' DO DELETE FROM `logs` WHERE `ts`<DATE_SUB(NOW(), INTERVAL 30 DAY)"},
+		{"CREATE DEFINER = 'root'@'localhost' EVENT IF NOT EXISTS db.e ON SCHEDULE AT CURRENT_TIMESTAMP + INTERVAL 1 HOUR ON COMPLETION PRESERVE DISABLE COMMENT 'once' DO INSERT INTO t VALUES (1)", true, "CREATE DEFINER = `root`@`localhost` EVENT IF NOT EXISTS `db`.`e` ON SCHEDULE AT DATE_ADD(CURRENT_TIMESTAMP(), INTERVAL 1 HOUR) ON COMPLETION PRESERVE DISABLE COMMENT 'once' DO INSERT INTO `t` VALUES (1)"},
+		{"create event e on schedule every '1:30' hour_minute starts now() ends now() + interval 1 month on completion not preserve enable do update t set a = a + 1", true, "CREATE EVENT `e` ON SCHEDULE EVERY '1:30' HOUR_MINUTE STARTS NOW() ENDS DATE_ADD(NOW(), INTERVAL 1 MONTH) ON COMPLETION NOT PRESERVE ENABLE DO UPDATE `t` SET `a`=`a`+1"},
+		{"create event e on schedule every 1 week disable on slave comment '' do set @a = 1", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 WEEK DISABLE ON SLAVE COMMENT '' DO SET @`a`=1"},
+		{"create event e on schedule every 1 week disable on replica do set @a = 1", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 WEEK DISABLE ON SLAVE DO SET @`a`=1"},
+		{"create definer = current_user event e on schedule every 1 hour do begin delete from t1; delete from t2; end", true, "CREATE EVENT `e` ON SCHEDULE EVERY 1 HOUR DO begin delete from t1; delete from t2; end"},
+		{"create event e on schedule every 1 day", false, ""},
+		{"create event e on schedule every 1 do delete from t", false, ""},
+		{"create event e on schedule at now() every 1 day do delete from t", false, ""},
+		{"create event e do delete from t", false, ""},
+		{"create or replace event e on schedule every 1 day do delete from t", false, ""},
+		{"alter event e on schedule every 2 day", true, "ALTER EVENT `e` ON SCHEDULE EVERY 2 DAY"},
+		{"alter definer = 'u'@'%' event db.e on completion preserve rename to db.e2 enable comment 'x' do delete from t", true, "ALTER DEFINER = `u`@`%` EVENT `db`.`e` ON COMPLETION PRESERVE RENAME TO `db`.`e2` ENABLE COMMENT 'x' DO DELETE FROM `t`"},
+		{"alter event e on schedule at '2030-01-01' on completion not preserve disable", true, "ALTER EVENT `e` ON SCHEDULE AT '2030-01-01' ON COMPLETION NOT PRESERVE DISABLE"},
+		{"alter event e do begin end", true, "ALTER EVENT `e` DO begin end"},
+		{"alter event e", false, ""},
+		{"alter event e rename to", false, ""},
+		{"drop event e", true, "DROP EVENT `e`"},
+		{"drop event if exists db.e", true, "DROP EVENT IF EXISTS `db`.`e`"},
+		{"drop event", false, ""},
+		// The keywords of events are not reserved.
+		{"select at, every, starts, ends, completion from t", true, "SELECT `at`,`every`,`starts`,`ends`,`completion` FROM `t`"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("CREATE EVENT db.e ON SCHEDULE EVERY 1 DAY STARTS '2024-01-01' DO DELETE FROM logs WHERE ts < NOW() - INTERVAL 30 DAY", "", "")
+	require.NoError(t, err)
+	event, ok := stmt.(*ast.CreateEventStmt)
+	require.True(t, ok)
+	require.True(t, event.Definer.CurrentUser)
+	require.Equal(t, "db", event.EventName.Schema.O)
+	require.Equal(t, "e", event.EventName.Name.O)
+	require.Nil(t, event.Schedule.At)
+	require.Equal(t, ast.TimeUnitDay, event.Schedule.Unit)
+	require.NotNil(t, event.Schedule.Starts)
+	require.Nil(t, event.Schedule.Ends)
+	require.Equal(t, ast.EventCompletionUnspecified, event.Completion)
+	require.Equal(t, ast.EventStatusUnspecified, event.Status)
+	require.Nil(t, event.Comment)
+	require.IsType(t, &ast.DeleteStmt{}, event.Body.Stmt)
+	require.Equal(t, "DELETE FROM logs WHERE ts < NOW() - INTERVAL 30 DAY", event.Body.Stmt.Text())
+
+	// The intervals of the schedule and of the body are visited.
+	event.Accept(&eventIntervalRewriter{})
+	var sb strings.Builder
+	require.NoError(t, event.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+	require.Equal(t, "CREATE EVENT `db`.`e` ON SCHEDULE EVERY 7 DAY STARTS '2024-01-01' DO DELETE FROM `logs` WHERE `ts`<DATE_SUB(NOW(), INTERVAL 7 DAY)", sb.String())
+}
+
+// eventIntervalRewriter sets the intervals of the schedule of an event and of
+// the DATE_SUB in its body to 7.
+type eventIntervalRewriter struct{}
+
+func (*eventIntervalRewriter) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.EventSchedule:
+		x.Every.(ast.ValueExpr).SetValue(int64(7))
+	case *ast.FuncCallExpr:
+		if x.FnName.L == ast.DateSub {
+			x.Args[1].(ast.ValueExpr).SetValue(int64(7))
+		}
+	}
+	return in, false
+}
+
+func (*eventIntervalRewriter) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func TestEventBody(t *testing.T) {
+	p := parser.New()
+
+	// A compound body is kept as text, whose ';' don't end the statement.
+	body := "BEGIN IF (SELECT COUNT(*) FROM logs) > 100 THEN DELETE FROM logs LIMIT 10; END IF; INSERT INTO audit VALUES (NOW()); END"
+	for _, sql := range []string{
+		"CREATE EVENT e ON SCHEDULE EVERY 1 DAY DO " + body,
+		"CREATE DEFINER = `root`@`localhost` EVENT e ON SCHEDULE EVERY 1 DAY COMMENT 'x' DO " + body,
+		"ALTER EVENT e DO " + body,
+	} {
+		stmts, _, err := p.Parse(sql+"; SELECT 1", "", "")
+		require.NoError(t, err, sql)
+		require.Len(t, stmts, 2, sql)
+		switch x := stmts[0].(type) {
+		case *ast.CreateEventStmt:
+			require.Nil(t, x.Body.Stmt, sql)
+			require.Equal(t, body, x.Body.Text, sql)
+		case *ast.AlterEventStmt:
+			require.Nil(t, x.Body.Stmt, sql)
+			require.Equal(t, body, x.Body.Text, sql)
+		default:
+			require.Failf(t, "unexpected statement", "%T", x)
+		}
+		require.IsType(t, &ast.SelectStmt{}, stmts[1], sql)
+	}
+
+	for _, tc := range []struct {
+		sql  string
+		body string
+	}{
+		{"CREATE EVENT e ON SCHEDULE EVERY 1 DAY DO lbl: LOOP LEAVE lbl; END LOOP lbl; SELECT 1", "lbl: LOOP LEAVE lbl; END LOOP lbl"},
+		{"CREATE EVENT e ON SCHEDULE EVERY 1 DAY DO WHILE @a > 0 DO SET @a = @a - 1; END WHILE; SELECT 1", "WHILE @a > 0 DO SET @a = @a - 1; END WHILE"},
+	} {
+		stmts, _, err := p.Parse(tc.sql, "", "")
+		require.NoError(t, err, tc.sql)
+		require.Len(t, stmts, 2, tc.sql)
+		require.Equal(t, tc.body, stmts[0].(*ast.CreateEventStmt).Body.Text, tc.sql)
+	}
+
+	// The DO of a compound statement in a procedure isn't the DO of an event.
+	_, _, err := p.Parse("CREATE PROCEDURE p() BEGIN SELECT event FROM t; WHILE @a > 0 DO IF @a > 1 THEN SET @a = @a - 1; END IF; END WHILE; END", "", "")
+	require.NoError(t, err)
+}
+
 func TestTimestampDiffUnit(t *testing.T) {
 	// Test case for timestampdiff unit.
 	// TimeUnit should be unified to upper case.
@@ -7002,6 +7572,9 @@ func cleanPartition(n ast.Node) {
 func (checker *nodeTextCleaner) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 	in.SetText(nil, "")
 	in.SetOriginTextPosition(0)
+	if stmt, ok := in.(ast.StmtNode); ok {
+		stmt.SetEndPosition(0)
+	}
 	if v, ok := in.(ast.ValueExpr); ok && v != nil {
 		tpFlag := v.GetType().GetFlag()
 		if tpFlag&mysql.UnderScoreCharsetFlag != 0 {
@@ -7050,6 +7623,15 @@ func (checker *nodeTextCleaner) Enter(in ast.Node) (out ast.Node, skipChildren b
 		node.SetOriginTextPosition(0)
 	case *ast.AggregateFuncExpr:
 		node.F = strings.ToLower(node.F)
+	case *ast.ExplainStmt:
+		if node.FormatType != ast.ExplainFormatNone {
+			// The format is restored in its MySQL spelling.
+			node.Format = strings.ToUpper(node.Format)
+		}
+	case *ast.ExplainForStmt:
+		if node.FormatType != ast.ExplainFormatNone {
+			node.Format = strings.ToUpper(node.Format)
+		}
 	case *ast.SelectField:
 		node.Offset = 0
 	case *test_driver.ValueExpr:
@@ -7234,6 +7816,12 @@ func TestCTE(t *testing.T) {
 		{"WITH RECURSIVE cte (n) AS (  SELECT 1  UNION ALL  SELECT n + 1 FROM cte WHERE n < 5)SELECT * FROM cte;", true, "WITH RECURSIVE `cte` (`n`) AS (SELECT 1 UNION ALL SELECT `n`+1 FROM `cte` WHERE `n`<5) SELECT * FROM `cte`"},
 		{"with cte(a) as (select 1) update t, cte set t.a=1  where t.a=cte.a;", true, "WITH `cte` (`a`) AS (SELECT 1) UPDATE (`t`) JOIN `cte` SET `t`.`a`=1 WHERE `t`.`a`=`cte`.`a`"},
 		{"with cte(a) as (select 1) delete t from t, cte where t.a=cte.a;", true, "WITH `cte` (`a`) AS (SELECT 1) DELETE `t` FROM (`t`) JOIN `cte` WHERE `t`.`a`=`cte`.`a`"},
+		{"with cte as (select id from t where a > 1) delete from t where id in (select id from cte)", true, "WITH `cte` AS (SELECT `id` FROM `t` WHERE `a`>1) DELETE FROM `t` WHERE `id` IN (SELECT `id` FROM `cte`)"},
+		{"with c1 as (select 1 as id), c2 as (select id from c1) delete from t where id in (select id from c2)", true, "WITH `c1` AS (SELECT 1 AS `id`), `c2` AS (SELECT `id` FROM `c1`) DELETE FROM `t` WHERE `id` IN (SELECT `id` FROM `c2`)"},
+		{"with cte as (select id from t) update t set a = 1 where id in (select id from cte)", true, "WITH `cte` AS (SELECT `id` FROM `t`) UPDATE `t` SET `a`=1 WHERE `id` IN (SELECT `id` FROM `cte`)"},
+		{"with c1 as (select 1 as id), c2 as (select 2 as id) update t set a = (select id from c2) where id in (select id from c1)", true, "WITH `c1` AS (SELECT 1 AS `id`), `c2` AS (SELECT 2 AS `id`) UPDATE `t` SET `a`=(SELECT `id` FROM `c2`) WHERE `id` IN (SELECT `id` FROM `c1`)"},
+		{"with recursive cte (n) as (select 1 union all select n + 1 from cte where n < 5) delete from t where a in (select n from cte)", true, "WITH RECURSIVE `cte` (`n`) AS (SELECT 1 UNION ALL SELECT `n`+1 FROM `cte` WHERE `n`<5) DELETE FROM `t` WHERE `a` IN (SELECT `n` FROM `cte`)"},
+		{"with recursive cte (n) as (select 1 union all select n + 1 from cte where n < 5) update t, cte set t.a = cte.n where t.id = cte.n", true, "WITH RECURSIVE `cte` (`n`) AS (SELECT 1 UNION ALL SELECT `n`+1 FROM `cte` WHERE `n`<5) UPDATE (`t`) JOIN `cte` SET `t`.`a`=`cte`.`n` WHERE `t`.`id`=`cte`.`n`"},
 		{"WITH cte1 AS (SELECT 1) SELECT * FROM (WITH cte2 AS (SELECT 2) SELECT * FROM cte2 JOIN cte1) AS dt;", true, "WITH `cte1` AS (SELECT 1) SELECT * FROM (WITH `cte2` AS (SELECT 2) SELECT * FROM `cte2` JOIN `cte1`) AS `dt`"},
 		{"WITH cte AS (SELECT 1) SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM cte;", true, "WITH `cte` AS (SELECT 1) SELECT /*+ MAX_EXECUTION_TIME(1000)*/ * FROM `cte`"},
 		{"with cte as (table t) table cte;", true, "WITH `cte` AS (TABLE `t`) TABLE `cte`"},
@@ -7558,12 +8146,17 @@ func TestGBKEncoding(t *testing.T) {
 	sql, err := encoder.String("create table 测试表 (测试列 varchar(255) default 'GBK测试用例');")
 	require.NoError(t, err)
 
+	// The identifiers aren't valid utf8mb4, they're replaced in lenient mode.
+	_, _, err = p.ParseSQL(sql)
+	require.True(t, terror.ErrorEqual(parser.ErrInvalidIdentifierEncoding, err), "%v", err)
+	p.SetLenientIdentifierEncoding(true)
 	stmt, _, err := p.ParseSQL(sql)
 	require.NoError(t, err)
 	checker := &gbkEncodingChecker{}
 	_, _ = stmt[0].Accept(checker)
 	require.NotEqual(t, "测试表", checker.tblName)
 	require.NotEqual(t, "测试列", checker.colName)
+	p.SetLenientIdentifierEncoding(false)
 
 	gbkOpt := parser.CharsetClient("gbk")
 	stmt, _, err = p.ParseSQL(sql, gbkOpt)
@@ -7606,12 +8199,17 @@ func TestGB18030Encoding(t *testing.T) {
 	sql, err := encoder.String("create table 测试表 (测试列 varchar(255) default 'GB18030测试用例');")
 	require.NoError(t, err)
 
+	// The identifiers aren't valid utf8mb4, they're replaced in lenient mode.
+	_, _, err = p.ParseSQL(sql)
+	require.True(t, terror.ErrorEqual(parser.ErrInvalidIdentifierEncoding, err), "%v", err)
+	p.SetLenientIdentifierEncoding(true)
 	stmt, _, err := p.ParseSQL(sql)
 	require.NoError(t, err)
 	checker := &gbkEncodingChecker{}
 	_, _ = stmt[0].Accept(checker)
 	require.NotEqual(t, "测试表", checker.tblName)
 	require.NotEqual(t, "测试列", checker.colName)
+	p.SetLenientIdentifierEncoding(false)
 
 	gb18030Opt := parser.CharsetClient("gb18030")
 	stmt, _, err = p.ParseSQL(sql, gb18030Opt)
@@ -7844,6 +8442,35 @@ func TestMultiStmt(t *testing.T) {
 	require.Equal(t, "1", stmt4.Fields.Fields[0].Text())
 }
 
+func TestStmtTextOffsets(t *testing.T) {
+	cases := []struct {
+		sql   string
+		stmts []string
+	}{
+		{"SELECT 1;SELECT 2", []string{"SELECT 1;", "SELECT 2"}},
+		{"SELECT 1 ; /* c; */ SELECT 2 /* d */;\n-- e;\n# f;\nSELECT 3  -- g", []string{"SELECT 1 ;", "SELECT 2 /* d */;", "SELECT 3"}},
+		{"SELECT 1;\r\nINSERT INTO t VALUES (';');\r\n\r\nSELECT 2;\r\n", []string{"SELECT 1;", "INSERT INTO t VALUES (';');", "SELECT 2;"}},
+		{"/* 日本語 */ SELECT '中文';SELECT 'é' ;", []string{"SELECT '中文';", "SELECT 'é' ;"}},
+		{";; SELECT 1;;\n;SELECT 2;;", []string{"SELECT 1;", "SELECT 2;"}},
+	}
+	p := parser.New()
+	for _, c := range cases {
+		stmts, _, err := p.Parse(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Len(t, stmts, len(c.stmts), c.sql)
+		for i, stmt := range stmts {
+			require.Equal(t, c.stmts[i], c.sql[stmt.OriginTextPosition():stmt.EndPosition()], c.sql)
+		}
+	}
+
+	// The positions are not recorded when SkipPositionRecording is set.
+	p.SetParserConfig(parser.ParserConfig{EnableWindowFunction: true, SkipPositionRecording: true})
+	stmts, _, err := p.Parse("SELECT 1; SELECT 2", "", "")
+	require.NoError(t, err)
+	require.Equal(t, 0, stmts[1].OriginTextPosition())
+	require.Equal(t, 0, stmts[1].EndPosition())
+}
+
 // https://dev.mysql.com/doc/refman/8.1/en/other-vendor-data-types.html
 func TestCompatTypes(t *testing.T) {
 	table := []testCase{
@@ -8037,3 +8664,101 @@ func TestSecondaryEngineAttribute(t *testing.T) {
 
 	RunTest(t, table, false)
 }
+
+type opcodeCollector struct {
+	ops []opcode.Op
+}
+
+// Enter implements ast.Visitor interface.
+func (oc *opcodeCollector) Enter(inNode ast.Node) (outNode ast.Node, skipChildren bool) {
+	if expr, ok := inNode.(*ast.BinaryOperationExpr); ok {
+		oc.ops = append(oc.ops, expr.Op)
+	}
+	return inNode, false
+}
+
+// Leave implements ast.Visitor interface.
+func (oc *opcodeCollector) Leave(inNode ast.Node) (node ast.Node, ok bool) {
+	return inNode, true
+}
+
+func TestBinaryOperator(t *testing.T) {
+	table := []testCase{
+		{"select * from t where binary a = b", true, "SELECT * FROM `t` WHERE BINARY `a`=`b`"},
+		{"select * from t where binary (a = b)", true, "SELECT * FROM `t` WHERE BINARY (`a`=`b`)"},
+		{"select * from t where a = binary b", true, "SELECT * FROM `t` WHERE `a`=BINARY `b`"},
+		{"select * from t where not binary a like b", true, "SELECT * FROM `t` WHERE NOT BINARY `a` LIKE `b`"},
+		{"select * from t where binary -a < b", true, "SELECT * FROM `t` WHERE BINARY -`a`<`b`"},
+		{"select binary a collate utf8mb4_bin from t", true, "SELECT BINARY `a` COLLATE utf8mb4_bin FROM `t`"},
+		{"select binary binary a from t", true, "SELECT BINARY BINARY `a` FROM `t`"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	where := func(sql string) ast.ExprNode {
+		stmt, warns, err := p.Parse(sql, "", "")
+		require.NoError(t, err)
+		require.Len(t, warns, 1)
+		require.True(t, terror.ErrorEqual(warns[0], parser.ErrWarnDeprecatedSyntax), warns[0])
+		require.EqualError(t, warns[0], "[parser:1287]'BINARY expr' is deprecated and will be removed in a future release. Please use CAST(expr AS BINARY) instead")
+		return stmt[0].(*ast.SelectStmt).Where
+	}
+
+	// BINARY binds tighter than the comparison.
+	cmp := where("select * from t where binary a = b").(*ast.BinaryOperationExpr)
+	require.Equal(t, opcode.EQ, cmp.Op)
+	cast := cmp.L.(*ast.FuncCastExpr)
+	require.Equal(t, ast.CastBinaryOperator, cast.FunctionType)
+	require.IsType(t, &ast.ColumnNameExpr{}, cast.Expr)
+
+	cast = where("select * from t where binary (a = b)").(*ast.FuncCastExpr)
+	require.Equal(t, ast.CastBinaryOperator, cast.FunctionType)
+	require.IsType(t, &ast.ParenthesesExpr{}, cast.Expr)
+
+	not := where("select * from t where not binary a like b").(*ast.UnaryOperationExpr)
+	require.Equal(t, opcode.Not, not.Op)
+	like := not.V.(*ast.PatternLikeOrIlikeExpr)
+	require.Equal(t, ast.CastBinaryOperator, like.Expr.(*ast.FuncCastExpr).FunctionType)
+
+	// BINARY binds looser than the unary minus.
+	cast = where("select * from t where binary -a").(*ast.FuncCastExpr)
+	require.IsType(t, &ast.UnaryOperationExpr{}, cast.Expr)
+
+	// A compound operand is restored with parentheses, so that BINARY still applies
+	// to the whole of it.
+	cast.Expr = cmp
+	cmp.L = &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: ast.NewCIStr("a")}}
+	var sb strings.Builder
+	require.NoError(t, cast.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+	require.Equal(t, "BINARY (`a`=`b`)", sb.String())
+
+	_, warns, err := p.Parse("select cast(a as binary), convert(a, binary) from t", "", "")
+	require.NoError(t, err)
+	require.Empty(t, warns)
+}
+
+func TestNullSafeEqual(t *testing.T) {
+	table := []testCase{
+		{"select * from t where a <=> b", true, "SELECT * FROM `t` WHERE `a`<=>`b`"},
+		{"select * from t where not (a <=> null)", true, "SELECT * FROM `t` WHERE NOT (`a`<=>NULL)"},
+		{"select * from t1 join t2 on t1.a <=> t2.a", true, "SELECT * FROM `t1` JOIN `t2` ON `t1`.`a`<=>`t2`.`a`"},
+		{"select * from t1 left join t2 on t1.a <=> t2.a and t1.b = t2.b", true, "SELECT * FROM `t1` LEFT JOIN `t2` ON `t1`.`a`<=>`t2`.`a` AND `t1`.`b`=`t2`.`b`"},
+		{"select * from t where a is distinct from b", false, ""},
+		{"select * from t where a is not distinct from b", false, ""},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	stmt, err := p.ParseOneStmt("select * from t1 join t2 on t1.a <=> t2.a where t1.b = 1", "", "")
+	require.NoError(t, err)
+	var collector opcodeCollector
+	stmt.Accept(&collector)
+	require.Equal(t, []opcode.Op{opcode.NullEQ, opcode.EQ}, collector.ops)
+
+	_, err = p.ParseOneStmt("select * from t where a is distinct from b", "", "")
+	require.True(t, terror.ErrorEqual(err, parser.ErrParse), err)
+	require.ErrorContains(t, err, `IS DISTINCT FROM is not supported, use NOT (a <=> b) instead line 1 column 24 near "is distinct from b"`)
+	_, err = p.ParseOneStmt("select * from t\nwhere a IS NOT DISTINCT FROM b", "", "")
+	require.True(t, terror.ErrorEqual(err, parser.ErrParse), err)
+	require.ErrorContains(t, err, `IS NOT DISTINCT FROM is not supported, use a <=> b instead line 2 column 8 near "IS NOT DISTINCT FROM b"`)
+}
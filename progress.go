@@ -0,0 +1,36 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+// ProgressHook is called by the parser as the statements of its input are parsed.
+// stmtIndex is the index of the statement in the result, byteOffset is the offset
+// in the input of the end of the statement.
+type ProgressHook func(stmtIndex int, byteOffset int64)
+
+// SetProgressHook sets the hook called after each statement is parsed, e.g. to
+// report the progress of a huge input. Once the whole input is parsed without
+// error, the hook is called a last time with the number of statements and the
+// length of the input. The hook is called synchronously from the goroutine
+// calling Parse, the parser doesn't parse concurrently. A nil hook, the
+// default, disables the calls.
+func (parser *Parser) SetProgressHook(hook ProgressHook) {
+	parser.progressHook = hook
+}
+
+// stmtParsed reports the last statement of the result to the progress hook.
+func (parser *Parser) stmtParsed() {
+	if parser.progressHook != nil {
+		parser.progressHook(len(parser.result)-1, int64(parser.lexer.stmtStartPos))
+	}
+}
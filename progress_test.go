@@ -0,0 +1,67 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressHook(t *testing.T) {
+	var sb strings.Builder
+	for i := range 1000 {
+		fmt.Fprintf(&sb, "INSERT INTO t VALUES (%d, 'row %d');\n", i, i)
+	}
+	sql := sb.String()
+
+	p := parser.New()
+	var indexes []int
+	var offsets []int64
+	p.SetProgressHook(func(stmtIndex int, byteOffset int64) {
+		indexes = append(indexes, stmtIndex)
+		offsets = append(offsets, byteOffset)
+	})
+	stmts, _, err := p.Parse(sql, "", "")
+	require.NoError(t, err)
+	require.Len(t, stmts, 1000)
+
+	// One call per statement, then the totals.
+	require.Len(t, indexes, 1001)
+	for i := range 1000 {
+		require.Equal(t, i, indexes[i])
+		if i > 0 {
+			require.Greater(t, offsets[i], offsets[i-1])
+		}
+		require.LessOrEqual(t, offsets[i], int64(len(sql)))
+	}
+	require.Equal(t, 1000, indexes[1000])
+	require.Equal(t, int64(len(sql)), offsets[1000])
+
+	// No totals are reported for an input with an error.
+	indexes, offsets = nil, nil
+	_, _, err = p.Parse("SELECT 1; SELECT 2; SELEC 3", "", "")
+	require.Error(t, err)
+	require.Equal(t, []int{0, 1}, indexes)
+
+	// Reset removes the hook.
+	indexes = nil
+	p.Reset()
+	_, _, err = p.Parse("SELECT 1", "", "")
+	require.NoError(t, err)
+	require.Nil(t, indexes)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConcurrentRegistration is meant to be run with -race: the parsers read
+// the registration state while another goroutine changes it.
+func TestConcurrentRegistration(t *testing.T) {
+	// The first parse freezes the driver.
+	_, err := parser.New().ParseOneStmt("select 1", "", "")
+	require.NoError(t, err)
+
+	const parsers, rounds = 4, 50
+	var wg sync.WaitGroup
+	errs := make(chan error, parsers*rounds)
+	for range parsers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p := parser.New()
+			for range rounds {
+				_, _, err := p.Parse("select _latin1 'a' collate latin1_bin, x'01', 1.5, ? from t "+
+					"where c = _utf8mb4 'b' collate utf8mb4_general_ci", "utf8mb4", "utf8mb4_bin")
+				errs <- err
+			}
+		}()
+	}
+
+	panics := make(chan any, rounds)
+	valid := true
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range rounds {
+			name := fmt.Sprintf("custom%d", i)
+			charset.AddCharset(&charset.Charset{Name: name, DefaultCollation: name + "_bin", Collations: make(map[string]*charset.Collation), Desc: "Custom", Maxlen: 4})
+			charset.AddCollation(&charset.Collation{ID: 90000 + i, CharsetName: name, Name: name + "_bin", IsDefault: true, Sortlen: 1, PadAttribute: charset.PadNone})
+			valid = valid && charset.ValidCharsetAndCollation(name, name+"_bin")
+			charset.RemoveCharset(name)
+			func() {
+				defer func() { panics <- recover() }()
+				ast.RegisterDriver(ast.DriverHooks{})
+			}()
+		}
+	}()
+
+	wg.Wait()
+	close(errs)
+	close(panics)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	for p := range panics {
+		require.Equal(t, ast.ErrDriverFrozen, p)
+	}
+	require.True(t, valid)
+	_, err = charset.GetCharsetInfo("custom0")
+	require.Error(t, err)
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+)
+
+// ErrSequenceDisabled returns for the sequence syntax when it's disabled by
+// EnableSequence.
+var ErrSequenceDisabled = terror.ClassParser.NewStdErr(mysql.ErrNotSupportedYet, mysql.Message("%s is not supported when the sequence syntax is disabled, at %s", nil))
+
+// EnableSequence controls whether the parser accepts the sequence syntax of
+// TiDB and MariaDB, which MySQL lacks: CREATE, ALTER and DROP SEQUENCE, SHOW
+// CREATE SEQUENCE, NEXT VALUE FOR or NEXTVAL(), LASTVAL() and SETVAL() of a
+// sequence. It's enabled by default.
+func (parser *Parser) EnableSequence(val bool) {
+	parser.sequenceDisabled = !val
+}
+
+// checkSequences reports the first use of the sequence syntax when it's disabled.
+func (parser *Parser) checkSequences(stmts []ast.StmtNode) {
+	if !parser.sequenceDisabled {
+		return
+	}
+	for _, stmt := range stmts {
+		construct := ""
+		switch x := stmt.(type) {
+		case *ast.CreateSequenceStmt:
+			construct = "CREATE SEQUENCE"
+		case *ast.AlterSequenceStmt:
+			construct = "ALTER SEQUENCE"
+		case *ast.DropSequenceStmt:
+			construct = "DROP SEQUENCE"
+		case *ast.ShowStmt:
+			if x.Tp == ast.ShowCreateSequence {
+				construct = "SHOW CREATE SEQUENCE"
+			}
+		}
+		if construct != "" {
+			parser.lexer.AppendError(ErrSequenceDisabled.GenWithStackByArgs(construct, parser.positionAt(stmt.OriginTextPosition())))
+			return
+		}
+		checker := &sequenceChecker{}
+		stmt.Accept(checker)
+		if checker.found != nil {
+			construct := "NEXT VALUE FOR"
+			switch checker.found.FnName.L {
+			case ast.LastVal:
+				construct = "LASTVAL()"
+			case ast.SetVal:
+				construct = "SETVAL()"
+			}
+			parser.lexer.AppendError(ErrSequenceDisabled.GenWithStackByArgs(construct, parser.positionAt(checker.found.OriginTextPosition())))
+			return
+		}
+	}
+}
+
+// sequenceChecker finds the first sequence function: NEXT VALUE FOR, which is
+// parsed into the NEXTVAL function, LASTVAL and SETVAL of a sequence name.
+type sequenceChecker struct {
+	found *ast.FuncCallExpr
+}
+
+func (c *sequenceChecker) Enter(n ast.Node) (ast.Node, bool) {
+	if c.found != nil {
+		return n, true
+	}
+	if f, ok := n.(*ast.FuncCallExpr); ok && isSequenceFunc(f.FnName.L) && len(f.Args) > 0 {
+		if _, ok := f.Args[0].(*ast.TableNameExpr); ok {
+			c.found = f
+			return n, true
+		}
+	}
+	return n, false
+}
+
+func (*sequenceChecker) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+func isSequenceFunc(name string) bool {
+	return name == ast.NextVal || name == ast.LastVal || name == ast.SetVal
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	myformat "github.com/abbychau/mysql-parser/format"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableSequence(t *testing.T) {
+	cases := []struct {
+		sql      string
+		restored string
+		label    string
+		// construct is the construct reported when the sequences are disabled.
+		construct string
+	}{
+		{"create sequence s start with 1 increment by 2 minvalue 1 maxvalue 100 cache 100 cycle",
+			"CREATE SEQUENCE `s` START WITH 1 INCREMENT BY 2 MINVALUE 1 MAXVALUE 100 CACHE 100 CYCLE", "CreateSequence", "CREATE SEQUENCE"},
+		{"create sequence if not exists s no minvalue no maxvalue nocache nocycle",
+			"CREATE SEQUENCE IF NOT EXISTS `s` NO MINVALUE NO MAXVALUE NOCACHE NOCYCLE", "CreateSequence", "CREATE SEQUENCE"},
+		{"alter sequence s restart with 10 increment by 5 nocycle",
+			"ALTER SEQUENCE `s` RESTART WITH 10 INCREMENT BY 5 NOCYCLE", "AlterSequence", "ALTER SEQUENCE"},
+		{"drop sequence if exists s, s2", "DROP SEQUENCE IF EXISTS `s`, `s2`", "DropSequence", "DROP SEQUENCE"},
+		{"show create sequence s", "SHOW CREATE SEQUENCE `s`", "Show", "SHOW CREATE SEQUENCE"},
+		{"select next value for s", "SELECT NEXTVAL(`s`)", "Select", "NEXT VALUE FOR"},
+		{"select nextval(s)", "SELECT NEXTVAL(`s`)", "Select", "NEXT VALUE FOR"},
+		{"create table t (a int default next value for s)", "CREATE TABLE `t` (`a` INT DEFAULT (NEXTVAL(`s`)))", "CreateTable", "NEXT VALUE FOR"},
+		{"select lastval(s)", "SELECT LASTVAL(`s`)", "Select", "LASTVAL()"},
+		{"select setval(s, 10)", "SELECT SETVAL(`s`, 10)", "Select", "SETVAL()"},
+	}
+
+	p := parser.New()
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(myformat.NewRestoreCtx(myformat.DefaultRestoreFlags, &sb)), c.sql)
+		require.Equal(t, c.restored, sb.String(), c.sql)
+		require.Equal(t, c.label, ast.GetStmtLabel(stmt), c.sql)
+	}
+
+	p.EnableSequence(false)
+	for _, c := range cases {
+		_, err := p.ParseOneStmt(c.sql, "", "")
+		require.True(t, parser.ErrSequenceDisabled.Equal(err), "%s: %v", c.sql, err)
+		require.ErrorContains(t, err, c.construct+" is not supported when the sequence syntax is disabled", c.sql)
+	}
+	_, _, err := p.Parse("select 1; select a from t where b = next value for s", "", "")
+	require.ErrorContains(t, err, "at line 1 column 36")
+	// The position is the one of the statement, not of the same text before it.
+	_, _, err = p.Parse("select 'drop sequence s'; drop sequence s", "", "")
+	require.ErrorContains(t, err, "at line 1 column 26")
+
+	// The rest of the syntax is accepted.
+	for _, sql := range []string{"select 1", "create table s (a int)"} {
+		_, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+	}
+
+	p.Reset()
+	_, err = p.ParseOneStmt("drop sequence s", "", "")
+	require.NoError(t, err)
+}
@@ -0,0 +1,436 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/abbychau/mysql-parser/ast"
+)
+
+// StmtLabel is the label of a statement, one of the labels of ast.GetStmtLabel.
+type StmtLabel string
+
+// SniffStatementType returns the label ast.GetStmtLabel gives to the statement
+// sql, without parsing it when its leading tokens are enough to tell it.
+//
+// The tokens are read by the Scanner, so the comments, the optimizer hints and
+// the `/*! ... */` comments are handled as by the parser. When the leading
+// tokens are ambiguous, e.g. a WITH clause or a SELECT which may be followed by
+// a UNION, the tokens are read further; when they can't tell the statement
+// apart, sql is parsed with ParseOneStmt. So the label is always the one of the
+// full parse, but the rest of a statement classified by its tokens isn't
+// checked, and a statement with a syntax error may get a label instead of the
+// error.
+func SniffStatementType(sql string) (StmtLabel, error) {
+	sn := stmtSniffer{s: NewScanner(sql)}
+	if label, ok := sn.sniff(); ok {
+		return label, nil
+	}
+	stmt, err := New().ParseOneStmt(sql, "", "")
+	if err != nil {
+		return "", err
+	}
+	return StmtLabel(ast.GetStmtLabel(stmt)), nil
+}
+
+// stmtSniffer classifies a statement by its leading tokens. Its methods return
+// false when the tokens can't tell the label of the statement.
+type stmtSniffer struct {
+	s    *Scanner
+	lval yySymType
+}
+
+func (sn *stmtSniffer) next() int {
+	return sn.s.Lex(&sn.lval)
+}
+
+func isStmtEnd(tok int) bool {
+	return tok == 0 || tok == ';'
+}
+
+func (sn *stmtSniffer) sniff() (StmtLabel, bool) {
+	switch sn.next() {
+	case selectKwd:
+		return sn.selectRest()
+	case with:
+		return sn.with()
+	case '(':
+		return sn.parenthesized()
+	case insert:
+		return "Insert", true
+	case replace:
+		return "Replace", true
+	case update:
+		return "Update", true
+	case deleteKwd:
+		return "Delete", true
+	case explain, describe, desc:
+		return sn.explain()
+	case create:
+		return sn.create()
+	case drop:
+		return sn.drop()
+	case alter:
+		return sn.alter()
+	case analyze:
+		switch sn.next() {
+		case tableKwd, incremental, noWriteToBinLog, local:
+			return "AnalyzeTable", true
+		}
+	case truncate:
+		return "TruncateTable", true
+	case optimize:
+		return "Optimize", true
+	case begin:
+		// BEGIN followed by anything else starts a compound statement.
+		switch tok := sn.next(); {
+		case isStmtEnd(tok), tok == pessimistic, tok == optimistic:
+			return "Begin", true
+		}
+	case start:
+		if sn.next() == transaction {
+			return "Begin", true
+		}
+	case commit:
+		return "Commit", true
+	case rollback:
+		return "Rollback", true
+	case savepoint:
+		return "Savepoint", true
+	case release:
+		if sn.next() == savepoint {
+			return "ReleaseSavepoint", true
+		}
+	case set:
+		// SET ROLE, SET CONFIG, SET BINDING... aren't SetStmt.
+		switch tok := sn.next(); {
+		case isStmtEnd(tok), tok == role, tok == defaultKwd, tok == config,
+			tok == sessionStates, tok == resource, tok == binding, tok == invalid:
+		default:
+			return "Set", true
+		}
+	case show:
+		// SHOW BACKUP, SHOW BR and SHOW TRAFFIC aren't ShowStmt.
+		switch tok := sn.next(); {
+		case isStmtEnd(tok), tok == backup, tok == br, tok == traffic, tok == invalid:
+		default:
+			return "Show", true
+		}
+	case use:
+		return "Use", true
+	case grant:
+		return sn.privileges("Grant", to)
+	case revoke:
+		return sn.privileges("Revoke", from)
+	case load:
+		if sn.next() == data {
+			return "LoadData", true
+		}
+	case importKwd:
+		if sn.next() == into {
+			return "ImportInto", true
+		}
+	case prepare:
+		return "Prepare", true
+	case execute:
+		return "Execute", true
+	case deallocate:
+		return "Deallocate", true
+	case trace:
+		return "Trace", true
+	case shutdown:
+		return "Shutdown", true
+	}
+	return "", false
+}
+
+// selectRest reads the rest of a statement starting with SELECT, which is a
+// SetOprStmt instead of a SelectStmt if it has a top-level UNION, EXCEPT or
+// INTERSECT.
+func (sn *stmtSniffer) selectRest() (StmtLabel, bool) {
+	depth := 0
+	for {
+		switch tok := sn.next(); tok {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case union, except, intersect:
+			if depth == 0 {
+				return "", false
+			}
+		case invalid:
+			return "", false
+		default:
+			if isStmtEnd(tok) {
+				return "Select", true
+			}
+		}
+	}
+}
+
+// with reads the common table expressions up to the statement they precede.
+func (sn *stmtSniffer) with() (StmtLabel, bool) {
+	depth := 0
+	for {
+		tok := sn.next()
+		switch {
+		case tok == '(':
+			depth++
+		case tok == ')':
+			depth--
+		case isStmtEnd(tok) || tok == invalid:
+			return "", false
+		case depth > 0:
+		case tok == selectKwd:
+			return sn.selectRest()
+		case tok == update:
+			return "Update", true
+		case tok == deleteKwd:
+			return "Delete", true
+		case tok != identifier && tok != recursive && tok != as && tok != ',':
+			// A parenthesized statement, or a CTE named by a keyword.
+			return "", false
+		}
+	}
+}
+
+// parenthesized reads a statement starting with '(', which is a SelectStmt if
+// it's a single parenthesized SELECT without set operator.
+func (sn *stmtSniffer) parenthesized() (StmtLabel, bool) {
+	if sn.next() != selectKwd {
+		return "", false
+	}
+	depth := 1
+	for {
+		switch tok := sn.next(); tok {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				if isStmtEnd(sn.next()) {
+					return "Select", true
+				}
+				return "", false
+			}
+		case union, except, intersect, invalid:
+			return "", false
+		default:
+			if isStmtEnd(tok) {
+				return "", false
+			}
+		}
+	}
+}
+
+// explain reads the statement after EXPLAIN, DESCRIBE or DESC.
+func (sn *stmtSniffer) explain() (StmtLabel, bool) {
+	switch sn.next() {
+	case analyze:
+		// ANALYZE is reserved, so it can't be a table name.
+		return "ExplainAnalyzeSQL", true
+	case selectKwd, with, '(', insert, replace, update, deleteKwd, stringLit:
+		return "ExplainSQL", true
+	case identifier:
+		return "DescTable", true
+	}
+	return "", false
+}
+
+func (sn *stmtSniffer) create() (StmtLabel, bool) {
+	switch sn.next() {
+	case tableKwd:
+		return "CreateTable", true
+	case temporary:
+		if sn.next() == tableKwd {
+			return "CreateTable", true
+		}
+	case global:
+		switch sn.next() {
+		case temporary:
+			if sn.next() == tableKwd {
+				return "CreateTable", true
+			}
+		case binding:
+			return "CreateBinding", true
+		}
+	case session:
+		if sn.next() == binding {
+			return "CreateBinding", true
+		}
+	case binding:
+		return "CreateBinding", true
+	case database:
+		return "CreateDatabase", true
+	case index, unique, fulltext, spatial:
+		return "CreateIndex", true
+	case view:
+		return "CreateView", true
+	case or:
+		if sn.next() == replace && sn.next() == view {
+			return "CreateView", true
+		}
+	case user:
+		return "CreateUser", true
+	case sequence:
+		return "CreateSequence", true
+	}
+	return "", false
+}
+
+func (sn *stmtSniffer) drop() (StmtLabel, bool) {
+	switch sn.next() {
+	case tableKwd, tables:
+		return "DropTable", true
+	case temporary:
+		switch sn.next() {
+		case tableKwd, tables:
+			return "DropTable", true
+		}
+	case global:
+		switch sn.next() {
+		case temporary:
+			switch sn.next() {
+			case tableKwd, tables:
+				return "DropTable", true
+			}
+		case binding:
+			return "DropBinding", true
+		}
+	case session:
+		if sn.next() == binding {
+			return "DropBinding", true
+		}
+	case binding:
+		return "DropBinding", true
+	case view:
+		return "DropView", true
+	case database:
+		return "DropDatabase", true
+	case index:
+		return "DropIndex", true
+	case sequence:
+		return "DropSequence", true
+	case prepare:
+		return "Deallocate", true
+	}
+	return "", false
+}
+
+func (sn *stmtSniffer) alter() (StmtLabel, bool) {
+	switch sn.next() {
+	case tableKwd:
+		// ALTER TABLE ... COMPACT is a CompactTableStmt.
+		for {
+			switch tok := sn.next(); {
+			case tok == compact || tok == invalid:
+				return "", false
+			case isStmtEnd(tok):
+				return "AlterTable", true
+			}
+		}
+	case sequence:
+		return "AlterSequence", true
+	}
+	return "", false
+}
+
+// privileges reads a GRANT or REVOKE, which is a GrantStmt or a RevokeStmt if
+// it has an ON clause before the TO or FROM of the users, and a statement on
+// roles or proxies otherwise.
+func (sn *stmtSniffer) privileges(label StmtLabel, users int) (StmtLabel, bool) {
+	for {
+		switch tok := sn.next(); {
+		case tok == on:
+			return label, true
+		case tok == users || tok == proxy || tok == invalid || isStmtEnd(tok):
+			return "", false
+		}
+	}
+}
+
+// StatementPiece is a statement of the text split by SplitAndClassify.
+type StatementPiece struct {
+	// Text is the text of the statement, sql[StartOffset:EndOffset], with its
+	// terminating ';' and the comments and the spaces before it.
+	Text        string
+	StartOffset int
+	EndOffset   int
+	// Label is the label SniffStatementType gives to Text, it's empty when
+	// Empty is true.
+	Label StmtLabel
+	// Empty is true when Text has only spaces, comments and ';', e.g. the text
+	// after the last ';', which the parser skips.
+	Empty bool
+}
+
+// SplitAndClassify splits the statements of sql and labels them without
+// building their ASTs, unless their leading tokens can't tell the label, see
+// SniffStatementType.
+//
+// The statements are split as by the parser: the ';' in string literals,
+// quoted identifiers, comments, BEGIN ... END and CASE ... END CASE compound
+// statements don't end a statement, the ones in `/*! ... */` comments do. The
+// pieces cover the whole of sql in order. An error is returned when a token of
+// sql is invalid, e.g. an unterminated string literal, as the statements can't
+// be split then.
+func SplitAndClassify(sql string) ([]StatementPiece, error) {
+	var (
+		pieces []StatementPiece
+		// inBangComment tells whether the pieces start inside a `/*! ... */`
+		// comment.
+		inBangComment []bool
+		parser        *Parser
+	)
+	sn := stmtSniffer{s: NewScanner("")}
+	start, bang := 0, false
+	err := scanStatementEnds(sql, 0, true, func(end int, nextInBangComment bool) bool {
+		pieces = append(pieces, StatementPiece{Text: sql[start:end], StartOffset: start, EndOffset: end})
+		inBangComment = append(inBangComment, bang)
+		start, bang = end, nextInBangComment
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range pieces {
+		piece := &pieces[i]
+		sn.s.reset(piece.Text)
+		sn.s.inBangComment = inBangComment[i]
+		if isStmtEnd(sn.next()) {
+			piece.Empty = true
+			continue
+		}
+		sn.s.reset(piece.Text)
+		sn.s.inBangComment = inBangComment[i]
+		if label, ok := sn.sniff(); ok {
+			piece.Label = label
+			continue
+		}
+		if parser == nil {
+			parser = New()
+		}
+		text := piece.Text
+		if inBangComment[i] {
+			// Reopen the comment, so that its end is read as by the parser.
+			text = "/*! " + text
+		}
+		stmt, err := parser.ParseOneStmt(text, "", "")
+		if err != nil {
+			return nil, err
+		}
+		piece.Label = StmtLabel(ast.GetStmtLabel(stmt))
+	}
+	return pieces, nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/internal/corpus"
+	"github.com/stretchr/testify/require"
+)
+
+// sniffCorpus are statements of every label, with the leading tokens which are
+// ambiguous or which aren't enough to tell the label.
+var sniffCorpus = []string{
+	"select 1",
+	"SELECT pad FROM sbtest1 WHERE id=1;",
+	"select a from t where b in (select b from t1 union select b from t2)",
+	"select 1 union select 2",
+	"select 1 except select 2",
+	"select 1 intersect select 2",
+	"select * from t for update",
+	"/* comment */ select 1",
+	"-- comment\nselect 1",
+	"select /*+ use_index(t, idx) */ a from t",
+	"(select 1)",
+	"(select 1) union (select 2)",
+	"(select a from t) order by a",
+	"((select 1))",
+	"with c as (select 1) select * from c",
+	"with recursive c(n) as (select 1 union all select n + 1 from c where n < 10) select * from c",
+	"with c as (select 1) select * from c union select 2",
+	"with c1 as (select 1), c2 as (select 2) select * from c1, c2",
+	"with c as (select 1 as a) update t, c set t.a = c.a",
+	"with c as (select 1 as a) delete t from t, c where t.a = c.a",
+	"insert into t values (1)",
+	"insert into t select * from t1 union select * from t2",
+	"replace into t values (1)",
+	"update t set a = 1",
+	"delete from t",
+	"explain select 1",
+	"explain analyze select 1",
+	"explain format = 'brief' select 1",
+	"explain for connection 1",
+	"explain insert into t values (1)",
+	"explain (select 1) union (select 2)",
+	"desc t",
+	"describe t a",
+	"desc select 1",
+	"create table t (a int)",
+	"create temporary table t (a int)",
+	"create global temporary table t (a int) on commit delete rows",
+	"create table t like t1",
+	"create database d",
+	"create schema d",
+	"create index i on t (a)",
+	"create unique index i on t (a)",
+	"create view v as select 1",
+	"create or replace view v as select 1",
+	"create algorithm = merge view v as select 1",
+	"create user u",
+	"create sequence s",
+	"create binding for select 1 using select 1",
+	"create global binding for select 1 using select 1",
+	"create session binding for select 1 using select 1",
+	"drop table t",
+	"drop table t1, t2 cascade",
+	"drop temporary table t",
+	"drop view v",
+	"drop database d",
+	"drop index i on t",
+	"drop sequence s",
+	"drop user u",
+	"drop binding for select 1",
+	"drop global binding for select 1",
+	"drop prepare s",
+	"alter table t add column a int",
+	"alter table t compact",
+	"alter table t compact tiflash replica",
+	"alter sequence s increment by 2",
+	"alter user u identified by 'p'",
+	"alter instance reload tls",
+	"analyze table t",
+	"analyze no_write_to_binlog table t",
+	"truncate t",
+	"truncate table t",
+	"optimize table t",
+	"begin",
+	"begin pessimistic",
+	"start transaction",
+	"start transaction read only",
+	"commit",
+	"rollback",
+	"rollback to savepoint s",
+	"savepoint s",
+	"release savepoint s",
+	"set @a = 1",
+	"set @@global.autocommit = 1",
+	"set names utf8mb4",
+	"set global transaction isolation level read committed",
+	"set password = 'p'",
+	"set role all",
+	"set default role all to u",
+	"set config tikv `log.level` = 'info'",
+	"set resource group rg1",
+	"/*!40101 SET NAMES utf8 */",
+	"show tables",
+	"show create table t",
+	"show databases",
+	"use d",
+	"grant select on t to u",
+	"grant all privileges on *.* to u with grant option",
+	"grant r1 to u",
+	"revoke select on t from u",
+	"revoke r1 from u",
+	"load data infile '/tmp/t.csv' into table t",
+	"load stats '/tmp/stats.json'",
+	"load index into cache t1 ignore leaves",
+	"cache index t1 index (i1) in kc",
+	"checksum table t1, t2 quick",
+	"binlog 'AAAA'",
+	"import into t from '/tmp/t.csv'",
+	"prepare s from 'select 1'",
+	"execute s",
+	"execute s using @a",
+	"deallocate prepare s",
+	"trace select 1",
+	"shutdown",
+	"kill 1",
+	"do 1",
+	"flush tables",
+}
+
+func TestSniffStatementType(t *testing.T) {
+	for _, sql := range sniffCorpus {
+		stmt, err := parser.New().ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+		label, err := parser.SniffStatementType(sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, ast.GetStmtLabel(stmt), string(label), sql)
+	}
+}
+
+func TestSniffStatementTypeLabels(t *testing.T) {
+	for _, c := range []struct {
+		sql   string
+		label parser.StmtLabel
+	}{
+		{"/* comment */ /*+ hint */ SELECT 1", "Select"},
+		{"select 1 union select 2", "other"},
+		{"with c as (select 1) update t set a = 1", "Update"},
+		{"with c as (select 1) delete from t", "Delete"},
+		{"explain analyze select 1", "ExplainAnalyzeSQL"},
+		{"desc t", "DescTable"},
+		{"/*!40101 SET NAMES utf8 */", "Set"},
+		{"drop prepare s", "Deallocate"},
+		{"alter table t compact", "CompactTable"},
+	} {
+		label, err := parser.SniffStatementType(c.sql)
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.label, label, c.sql)
+	}
+
+	_, err := parser.SniffStatementType("not a statement")
+	require.Error(t, err)
+}
+
+func TestSplitAndClassify(t *testing.T) {
+	sql := "select ';', `;` from t; /* ; */ insert into t values (1);\n" +
+		"-- only a comment;\n;" +
+		"create procedure p() begin select 1; select 2; end;" +
+		"/*!40101 SET NAMES utf8; */ select 1 union select 2"
+	pieces, err := parser.SplitAndClassify(sql)
+	require.NoError(t, err)
+	expected := []parser.StatementPiece{
+		{Text: "select ';', `;` from t;", Label: "Select"},
+		{Text: " /* ; */ insert into t values (1);", Label: "Insert"},
+		{Text: "\n-- only a comment;\n;", Empty: true},
+		{Text: "create procedure p() begin select 1; select 2; end;", Label: "other"},
+		// A ';' in a `/*! ... */` comment ends the statement, as for the parser.
+		{Text: "/*!40101 SET NAMES utf8;", Label: "Set"},
+		{Text: " */ select 1 union select 2", Label: "other"},
+	}
+	start := 0
+	for i := range expected {
+		expected[i].StartOffset = start
+		start += len(expected[i].Text)
+		expected[i].EndOffset = start
+	}
+	require.Equal(t, expected, pieces)
+
+	pieces, err = parser.SplitAndClassify(" ")
+	require.NoError(t, err)
+	require.Equal(t, []parser.StatementPiece{{Text: " ", EndOffset: 1, Empty: true}}, pieces)
+	pieces, err = parser.SplitAndClassify("")
+	require.NoError(t, err)
+	require.Empty(t, pieces)
+
+	// The ParseSQL of sql gives a statement for each piece which isn't empty.
+	nodes, _, err := parser.New().ParseSQL(sql)
+	require.NoError(t, err)
+	require.Len(t, nodes, 5)
+
+	for _, sql := range []string{"select 1; select 'a", "select 1; select `a", "select 1 /* a"} {
+		_, err = parser.SplitAndClassify(sql)
+		require.Error(t, err, sql)
+		_, _, err = parser.New().ParseSQL(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+// TestSplitAndClassifyCorpus checks that SplitAndClassify splits and labels
+// the statements of the corpus as ParseSQL does.
+func TestSplitAndClassifyCorpus(t *testing.T) {
+	stmts, err := corpus.Load(corpusDir)
+	require.NoError(t, err)
+	var sb strings.Builder
+	for i, stmt := range stmts {
+		switch i % 5 {
+		case 0:
+			sb.WriteString("/* ; */\n")
+		case 1:
+			sb.WriteString("/*!40101 SET NAMES utf8; */\n")
+		}
+		// The statements of the corpus end with their ';'.
+		sb.WriteString(stmt.SQL)
+		sb.WriteString("\n")
+	}
+	sql := sb.String()
+
+	nodes, _, err := parser.New().ParseSQL(sql)
+	require.NoError(t, err)
+	pieces, err := parser.SplitAndClassify(sql)
+	require.NoError(t, err)
+	var nonEmpty []parser.StatementPiece
+	for _, piece := range pieces {
+		require.Equal(t, sql[piece.StartOffset:piece.EndOffset], piece.Text)
+		if !piece.Empty {
+			nonEmpty = append(nonEmpty, piece)
+		}
+	}
+	require.Len(t, nonEmpty, len(nodes))
+	for i, node := range nodes {
+		require.Equal(t, ast.GetStmtLabel(node), string(nonEmpty[i].Label), nonEmpty[i].Text)
+		text := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(node.Text()), ";"))
+		require.Contains(t, nonEmpty[i].Text, text)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/pingcap/errors"
+)
+
+// StatementHandler parses a statement the grammar doesn't know, see
+// Parser.RegisterStatementHandler. text is the statement from its first keyword
+// to its last token, without its ';', and offset is the offset of text in the
+// SQL text.
+type StatementHandler func(text string, offset int) (ast.StmtNode, error)
+
+// RegisterStatementHandler registers handler for the statements whose first
+// token is the identifier prefixKeyword, compared case-insensitively, e.g. the
+// `ROUTE TO 'shard-3'` of a proxy. The comments before it are skipped, but a
+// quoted identifier doesn't match. The statement is given to handler instead of
+// the grammar, and the node it returns, e.g. an ast.GenericStmt, takes its place
+// among the statements of ParseSQL with its text and its offsets set. An error
+// of handler fails the parse.
+//
+// The keywords of the parser, reserved or not, can't be registered, so that the
+// statements of the grammar are never taken over. A nil handler unregisters
+// prefixKeyword.
+func (parser *Parser) RegisterStatementHandler(prefixKeyword string, handler StatementHandler) error {
+	s := NewScanner(prefixKeyword)
+	if tok, _, lit := s.scan(); tok != identifier || lit != prefixKeyword {
+		return errors.Errorf("%q is not an identifier", prefixKeyword)
+	}
+	word := strings.ToUpper(prefixKeyword)
+	_, isKeyword := tokenMap[word]
+	_, isWindowFuncKeyword := windowFuncTokenMap[word]
+	if isKeyword || isWindowFuncKeyword {
+		return errors.Errorf("%s is a keyword of the parser", prefixKeyword)
+	}
+	if handler == nil {
+		delete(parser.stmtHandlers, word)
+		return nil
+	}
+	if parser.stmtHandlers == nil {
+		parser.stmtHandlers = make(map[string]StatementHandler)
+	}
+	parser.stmtHandlers[word] = handler
+	return nil
+}
+
+// handledStmt is a statement of the SQL text given to a StatementHandler.
+type handledStmt struct {
+	// start and end are the offsets of the statement as split by the parser,
+	// from the end of the previous statement to the end of its ';'.
+	start, end int
+	// textStart and textEnd are the offsets of the text given to handler.
+	textStart, textEnd int
+	// stmtEnd is the end offset of the statement, see ast.StmtNode.EndPosition.
+	stmtEnd int
+	handler StatementHandler
+}
+
+// handledStmts returns the statements of sql taken by the registered handlers.
+// It returns none when the statements can't be split, so that the parser
+// reports the error.
+func (parser *Parser) handledStmts(sql string) []handledStmt {
+	var stmts []handledStmt
+	start, bang := 0, false
+	err := scanStatementEnds(sql, 0, true, func(end int, nextInBangComment bool) bool {
+		s := NewScanner(sql[start:end])
+		s.inBangComment = bang
+		tok, pos, lit := s.scan()
+		if handler, ok := parser.stmtHandlers[strings.ToUpper(lit)]; ok && tok == identifier {
+			h := handledStmt{start: start, end: end, textStart: start + pos.Offset, handler: handler}
+			h.textEnd = start + s.r.pos().Offset
+			h.stmtEnd = h.textEnd
+			for {
+				tok, pos, _ = s.scan()
+				if tok == ';' {
+					h.stmtEnd = start + pos.Offset + 1
+				}
+				if tok == ';' || tok == 0 || tok == invalid {
+					break
+				}
+				h.textEnd = start + s.r.pos().Offset
+				h.stmtEnd = h.textEnd
+			}
+			stmts = append(stmts, h)
+		}
+		start, bang = end, nextInBangComment
+		return true
+	})
+	if err != nil {
+		return nil
+	}
+	return stmts
+}
+
+// parse parses sql into parser.result, giving the statements taken by the
+// registered handlers to them and the others to the grammar.
+func (parser *Parser) parse(sql string) {
+	var handled []handledStmt
+	if len(parser.stmtHandlers) > 0 {
+		handled = parser.handledStmts(sql)
+	}
+	if len(handled) == 0 {
+		yyParse(&parser.lexer, parser)
+		return
+	}
+	start := 0
+	for _, h := range handled {
+		if h.start > start {
+			parser.parseRange(start, h.start)
+			if _, errs := parser.lexer.Errors(); len(errs) > 0 {
+				return
+			}
+		}
+		if parser.lexer.oneStmt && len(parser.result) > 0 {
+			// ParseOneStmt stops at the second statement.
+			if parser.lexer.nextStmtOffset < 0 {
+				parser.lexer.nextStmtOffset = h.textStart
+			}
+			return
+		}
+		stmt, err := h.handler(sql[h.textStart:h.textEnd], h.textStart)
+		if err != nil {
+			parser.lexer.AppendError(err)
+			return
+		}
+		if stmt != nil {
+			if !parser.lexer.skipPositionRecording {
+				stmt.SetOriginTextPosition(h.textStart)
+				stmt.SetEndPosition(h.stmtEnd)
+			}
+			// The text is trimmed as by Scanner.stmtText.
+			text := strings.TrimSuffix(strings.TrimPrefix(sql[h.start:h.end], "\n"), "\n")
+			stmt.SetText(parser.lexer.client, text)
+			parser.result = append(parser.result, stmt)
+			parser.lexer.stmtStartPos = h.end
+			parser.stmtParsed()
+		}
+		start = h.end
+	}
+	if start < len(sql) {
+		parser.parseRange(start, len(sql))
+	}
+}
+
+// parseRange parses the statements of sql[start:end] with the grammar. The text
+// is read in place, so that the offsets are the ones in the whole text.
+func (parser *Parser) parseRange(start, end int) {
+	s := &parser.lexer
+	line := strings.Count(s.r.s[:start], "\n") + 1
+	// The column is counted like by reader.inc.
+	col := start
+	if nl := strings.LastIndexByte(s.r.s[:start], '\n'); nl >= 0 {
+		col = start - nl
+	}
+	s.r.p = Pos{Line: line, Col: col, Offset: start}
+	s.r.l = end
+	s.stmtStartPos = start
+	s.stmtTokenStart = -1
+	s.prevTokenEnd = start
+	s.parsedStmt = len(parser.result) > 0
+	yyParse(s, parser)
+	s.r.l = len(s.r.s)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/format"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementHandler(t *testing.T) {
+	type call struct {
+		text   string
+		offset int
+	}
+	var calls []call
+	p := parser.New()
+	require.NoError(t, p.RegisterStatementHandler("Route", func(text string, offset int) (ast.StmtNode, error) {
+		calls = append(calls, call{text, offset})
+		if !strings.Contains(text, "'") {
+			return nil, errors.New("no shard")
+		}
+		return &ast.GenericStmt{Keyword: "ROUTE", SQL: text}, nil
+	}))
+
+	sql := "SELECT 1;\n/* to the proxy */ ROUTE TO 'shard-3' /* c */ ;\nINSERT INTO t VALUES ('x;y');route  to 'shard;4'"
+	stmts, _, err := p.ParseSQL(sql)
+	require.NoError(t, err)
+	require.Equal(t, []call{
+		{"ROUTE TO 'shard-3'", strings.Index(sql, "ROUTE")},
+		{"route  to 'shard;4'", strings.Index(sql, "route")},
+	}, calls)
+	require.Len(t, stmts, 4)
+	require.IsType(t, &ast.SelectStmt{}, stmts[0])
+	require.IsType(t, &ast.GenericStmt{}, stmts[1])
+	require.IsType(t, &ast.InsertStmt{}, stmts[2])
+	require.IsType(t, &ast.GenericStmt{}, stmts[3])
+
+	// The statements around the handled ones keep their offsets and texts.
+	texts := []string{
+		"SELECT 1;",
+		"/* to the proxy */ ROUTE TO 'shard-3' /* c */ ;",
+		"INSERT INTO t VALUES ('x;y');",
+		"route  to 'shard;4'",
+	}
+	ranges := []string{
+		"SELECT 1;",
+		"ROUTE TO 'shard-3' /* c */ ;",
+		"INSERT INTO t VALUES ('x;y');",
+		"route  to 'shard;4'",
+	}
+	for i, stmt := range stmts {
+		require.Equal(t, texts[i], stmt.Text())
+		require.Equal(t, ranges[i], sql[stmt.OriginTextPosition():stmt.EndPosition()])
+	}
+
+	// A GenericStmt restores the original text.
+	var sb strings.Builder
+	require.NoError(t, stmts[1].Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "ROUTE TO 'shard-3'", sb.String())
+
+	// The syntax errors after a handled statement are located in the whole text.
+	_, _, err = p.ParseSQL("ROUTE TO 'a';\nSELECT FROM t")
+	var syntaxErr *parser.SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 2, syntaxErr.Line)
+	require.Equal(t, len("ROUTE TO 'a';\nSELECT "), syntaxErr.Offset)
+
+	// An error of the handler fails the parse.
+	_, _, err = p.ParseSQL("SELECT 1; ROUTE TO shard")
+	require.ErrorContains(t, err, "no shard")
+
+	// The identifier is the first token only, a quoted one doesn't match.
+	calls = nil
+	_, _, err = p.ParseSQL("SELECT route FROM route; INSERT INTO route VALUES (1)")
+	require.NoError(t, err)
+	_, _, err = p.ParseSQL("`ROUTE` TO 'a'")
+	require.Error(t, err)
+	require.Empty(t, calls)
+
+	// The keywords can't be registered.
+	handler := func(text string, _ int) (ast.StmtNode, error) {
+		return &ast.GenericStmt{SQL: text}, nil
+	}
+	for _, word := range []string{"SELECT", "begin", "Flush", "OVER", "`x`", "a b", ""} {
+		require.Error(t, p.RegisterStatementHandler(word, handler), word)
+	}
+	stmts, _, err = p.ParseSQL("BEGIN; SELECT 1")
+	require.NoError(t, err)
+	require.IsType(t, &ast.BeginStmt{}, stmts[0])
+
+	// A nil handler unregisters, and so does Reset.
+	require.NoError(t, p.RegisterStatementHandler("ROUTE", nil))
+	_, _, err = p.ParseSQL("ROUTE TO 'a'")
+	require.Error(t, err)
+	require.NoError(t, p.RegisterStatementHandler("ROUTE", handler))
+	p.Reset()
+	_, _, err = p.ParseSQL("ROUTE TO 'a'")
+	require.Error(t, err)
+}
@@ -0,0 +1,24 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+// SetStorageOptionCheck enables or disables the check of the values of the
+// physical storage options: a COMPRESSION table option other than 'zlib', 'lz4'
+// or 'none', and a KEY_BLOCK_SIZE table or index option other than 0, 1, 2, 4,
+// 8 or 16 are reported as errors with their position, as InnoDB rejects them.
+// ROW_FORMAT and COLUMN_FORMAT are always checked by the grammar. It's disabled
+// by default for compatibility, as other storage engines accept other values.
+func (parser *Parser) SetStorageOptionCheck(val bool) {
+	parser.storageOptionCheck = val
+}
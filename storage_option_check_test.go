@@ -0,0 +1,105 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	myformat "github.com/abbychau/mysql-parser/format"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageOptions(t *testing.T) {
+	cases := []struct {
+		sql      string
+		restored string
+	}{
+		// Table.
+		{"create table t (a int) row_format=compressed", "CREATE TABLE `t` (`a` INT) ROW_FORMAT = COMPRESSED"},
+		{"create table t (a int) row_format dynamic", "CREATE TABLE `t` (`a` INT) ROW_FORMAT = DYNAMIC"},
+		{"create table t (a int) key_block_size=8", "CREATE TABLE `t` (`a` INT) KEY_BLOCK_SIZE = 8"},
+		{"create table t (a int) compression='zlib'", "CREATE TABLE `t` (`a` INT) COMPRESSION = 'zlib'"},
+		{"create table t (a int) compression 'LZ4'", "CREATE TABLE `t` (`a` INT) COMPRESSION = 'LZ4'"},
+		{"alter table t row_format=compact, key_block_size=4, compression='none'",
+			"ALTER TABLE `t` ROW_FORMAT = COMPACT, KEY_BLOCK_SIZE = 4, COMPRESSION = 'none'"},
+		// Index.
+		{"create table t (a int, index i (a) key_block_size=2)", "CREATE TABLE `t` (`a` INT,INDEX `i`(`a`) KEY_BLOCK_SIZE=2)"},
+		{"create index i on t (a) key_block_size 16", "CREATE INDEX `i` ON `t` (`a`) KEY_BLOCK_SIZE=16"},
+		{"alter table t add unique key i (a) key_block_size=1", "ALTER TABLE `t` ADD UNIQUE `i`(`a`) KEY_BLOCK_SIZE=1"},
+		// Column.
+		{"create table t (a int column_format fixed, b int column_format dynamic, c int column_format default)",
+			"CREATE TABLE `t` (`a` INT COLUMN_FORMAT FIXED,`b` INT COLUMN_FORMAT DYNAMIC,`c` INT COLUMN_FORMAT DEFAULT)"},
+		// All of them.
+		{"create table t (a int column_format fixed, b varchar(10) column_format dynamic, index i (b) key_block_size=4) " +
+			"row_format=compressed key_block_size=8 compression='zlib'",
+			"CREATE TABLE `t` (`a` INT COLUMN_FORMAT FIXED,`b` VARCHAR(10) COLUMN_FORMAT DYNAMIC,INDEX `i`(`b`) KEY_BLOCK_SIZE=4) " +
+				"ROW_FORMAT = COMPRESSED KEY_BLOCK_SIZE = 8 COMPRESSION = 'zlib'"},
+	}
+
+	p := parser.New()
+	p.SetStorageOptionCheck(true)
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(myformat.NewRestoreCtx(myformat.DefaultRestoreFlags, &sb)), c.sql)
+		require.Equal(t, c.restored, sb.String(), c.sql)
+		_, err = p.ParseOneStmt(sb.String(), "", "")
+		require.NoError(t, err, c.sql)
+	}
+
+	stmt, err := p.ParseOneStmt(cases[len(cases)-1].sql, "", "")
+	require.NoError(t, err)
+	create := stmt.(*ast.CreateTableStmt)
+	require.Equal(t, ast.ColumnFormatFixed, create.Cols[0].Options[0].StrValue)
+	require.Equal(t, ast.ColumnFormatDynamic, create.Cols[1].Options[0].StrValue)
+	require.Equal(t, uint64(4), create.Constraints[0].Option.KeyBlockSize)
+	require.Equal(t, ast.RowFormatCompressed, create.Options[0].UintValue)
+	require.Equal(t, uint64(8), create.Options[1].UintValue)
+	require.True(t, ast.IsValidCompression(create.Options[2].StrValue))
+
+	invalid := []struct {
+		sql string
+		msg string
+	}{
+		{"create table t (a int) compression='zstd'", "Invalid compression algorithm 'zstd'"},
+		{"alter table t compression='zlib2'", "Invalid compression algorithm 'zlib2'"},
+		{"create table t (a int) key_block_size=3", "Invalid KEY_BLOCK_SIZE = 3"},
+		{"create table t (a int, key i (a) key_block_size=32)", "Invalid KEY_BLOCK_SIZE = 32"},
+		{"create index i on t (a) key_block_size=1024", "Invalid KEY_BLOCK_SIZE = 1024"},
+	}
+	for _, c := range invalid {
+		_, err := p.ParseOneStmt(c.sql, "", "")
+		require.ErrorContains(t, err, c.msg, c.sql)
+		require.ErrorContains(t, err, "line 1 column", c.sql)
+	}
+	// The enumerated formats are checked by the grammar.
+	for _, sql := range []string{
+		"create table t (a int) row_format=tiny",
+		"create table t (a int column_format compressed)",
+	} {
+		_, err := p.ParseOneStmt(sql, "", "")
+		require.Error(t, err, sql)
+	}
+
+	// The check is disabled by default.
+	p.Reset()
+	for _, c := range invalid {
+		_, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+	}
+}
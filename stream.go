@@ -0,0 +1,147 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	goio "io"
+	"slices"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/pingcap/errors"
+)
+
+// streamReadSize is the least number of bytes a StreamParser reads at once.
+const streamReadSize = 64 * 1024
+
+// StreamParser parses the statements read from an io.Reader one at a time, e.g.
+// of a large dump, without reading the whole text first. The text is read as
+// needed to hold the next statement, so the memory used is bounded by the size
+// of the largest statement.
+//
+// The statements are split at the ';' as by the parser: the ones in string
+// literals, quoted identifiers, comments, `/*! ... */` comments and BEGIN ... END
+// compound statements don't end a statement. The DELIMITER command of the mysql
+// client isn't supported. The offsets recorded in the AST nodes are relative to
+// the text of their own statement, see Offset.
+type StreamParser struct {
+	parser *Parser
+	r      goio.Reader
+	// buf[start:] is the text read but not parsed yet, buf[0] is at the offset
+	// bufOffset of the stream.
+	buf       []byte
+	start     int
+	bufOffset int64
+	// stmts are the statements parsed from the text at the offset stmtOffset of
+	// the stream, and not returned yet.
+	stmts      []ast.StmtNode
+	stmtOffset int64
+	// readErr is io.EOF at the end of the stream, or the error of r.
+	readErr error
+}
+
+// NewStreamParser creates a StreamParser of the statements read from r.
+func NewStreamParser(r goio.Reader) *StreamParser {
+	return &StreamParser{parser: New(), r: r}
+}
+
+// Parser returns the parser of the statements, so that it can be set up, e.g.
+// with SetSQLMode, before the first call of Next.
+func (sp *StreamParser) Parser() *Parser {
+	return sp.parser
+}
+
+// Next returns the next statement of the stream, or io.EOF after the last one.
+// A statement with an error returns the error, and the next call goes on with
+// the next statement. An error of the reader other than io.EOF is returned by
+// every call after it.
+func (sp *StreamParser) Next() (ast.StmtNode, error) {
+	for len(sp.stmts) == 0 {
+		text, err := sp.nextText()
+		if err != nil {
+			return nil, err
+		}
+		stmts, _, err := sp.parser.ParseSQL(text)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		sp.stmts = stmts
+	}
+	stmt := sp.stmts[0]
+	sp.stmts = sp.stmts[1:]
+	return stmt, nil
+}
+
+// Offset returns the offset in the stream of the text of the statement returned
+// by the last call of Next. The text starts right after the previous statement,
+// so it includes the spaces and the comments before the statement.
+func (sp *StreamParser) Offset() int64 {
+	return sp.stmtOffset
+}
+
+// nextText returns the text of the next statement, up to its ';' or the end of
+// the stream, reading from r as needed.
+func (sp *StreamParser) nextText() (string, error) {
+	for {
+		end := 0
+		if sp.start < len(sp.buf) {
+			// The text isn't kept by the Scanner, it doesn't need a copy. A ';'
+			// at the end of the text may be in a comment continued by the next
+			// read, so a statement ending the text waits for it.
+			sql := charset.HackString(sp.buf[sp.start:])
+			_ = scanStatementEnds(sql, 0, false, func(e int, _ bool) bool {
+				if e < len(sql) {
+					end = e
+				}
+				return false
+			})
+		}
+		if end == 0 && sp.readErr != nil {
+			if sp.readErr != goio.EOF || sp.start == len(sp.buf) {
+				return "", sp.readErr
+			}
+			end = len(sp.buf) - sp.start
+		}
+		if end > 0 {
+			text := string(sp.buf[sp.start : sp.start+end])
+			sp.stmtOffset = sp.bufOffset + int64(sp.start)
+			sp.start += end
+			return text, nil
+		}
+		sp.read()
+	}
+}
+
+// read appends the next bytes of r to buf, at least as many as the unparsed text
+// so that a long statement is scanned a few times only.
+func (sp *StreamParser) read() {
+	if sp.start > 0 {
+		// Drop the parsed text.
+		n := copy(sp.buf, sp.buf[sp.start:])
+		sp.buf = sp.buf[:n]
+		sp.bufOffset += int64(sp.start)
+		sp.start = 0
+	}
+	size := streamReadSize
+	if len(sp.buf) > size {
+		size = len(sp.buf)
+	}
+	sp.buf = slices.Grow(sp.buf, size)
+	n, err := goio.ReadFull(sp.r, sp.buf[len(sp.buf):len(sp.buf)+size])
+	sp.buf = sp.buf[:len(sp.buf)+n]
+	if err == goio.ErrUnexpectedEOF {
+		err = goio.EOF
+	}
+	sp.readErr = err
+}
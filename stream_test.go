@@ -0,0 +1,109 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+// streamAll returns the statements of sp with their offsets in the stream.
+func streamAll(t *testing.T, sp *parser.StreamParser) ([]ast.StmtNode, []int64) {
+	var (
+		stmts   []ast.StmtNode
+		offsets []int64
+	)
+	for {
+		stmt, err := sp.Next()
+		if err == io.EOF {
+			return stmts, offsets
+		}
+		require.NoError(t, err)
+		stmts = append(stmts, stmt)
+		offsets = append(offsets, sp.Offset())
+	}
+}
+
+func TestStreamParser(t *testing.T) {
+	sql := "SELECT 'a;b', `c;d` FROM t; -- e;\n" +
+		"/* f; */ INSERT INTO t VALUES (1);\n" +
+		"/*!40101 SET @a = 1; SET @b = 2 */;\n" +
+		"# g;\n" +
+		"SELECT 2 ;\n" +
+		"UPDATE t SET a = '\\';' -- h"
+	want, _, err := parser.New().ParseSQL(sql)
+	require.NoError(t, err)
+
+	for _, r := range []io.Reader{strings.NewReader(sql), iotest.OneByteReader(strings.NewReader(sql)), iotest.HalfReader(strings.NewReader(sql))} {
+		sp := parser.NewStreamParser(r)
+		stmts, offsets := streamAll(t, sp)
+		require.Len(t, stmts, len(want))
+		for i, stmt := range stmts {
+			require.Equal(t, want[i].Text(), stmt.Text())
+			require.IsType(t, want[i], stmt)
+			// The two statements of the `/*! ... */` comment are parsed from
+			// the same text.
+			require.Contains(t, sql[offsets[i]:], stmt.Text())
+		}
+		require.Equal(t, []int64{0, 27, 68, 68, 104, 120}, offsets)
+		// The end is sticky.
+		_, err = sp.Next()
+		require.Equal(t, io.EOF, err)
+	}
+
+	// A statement larger than a read.
+	long := "INSERT INTO t VALUES ('" + strings.Repeat("x;", 100000) + "')"
+	sp := parser.NewStreamParser(strings.NewReader("SELECT 1;" + long + ";\nSELECT 2"))
+	stmts, offsets := streamAll(t, sp)
+	require.Len(t, stmts, 3)
+	require.Equal(t, long+";", stmts[1].Text())
+	require.Equal(t, []int64{0, 9, int64(9 + len(long) + 1)}, offsets)
+
+	// A statement with an error doesn't stop the stream.
+	sp = parser.NewStreamParser(strings.NewReader("SELECT 1; SELECT FROM; SELECT 3; /* empty */"))
+	stmt, err := sp.Next()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 1;", stmt.Text())
+	_, err = sp.Next()
+	require.Error(t, err)
+	stmt, err = sp.Next()
+	require.NoError(t, err)
+	require.Equal(t, " SELECT 3;", stmt.Text())
+	_, err = sp.Next()
+	require.Equal(t, io.EOF, err)
+
+	// The errors of the reader are returned.
+	sp = parser.NewStreamParser(io.MultiReader(strings.NewReader("SELECT 1; SELECT"), iotest.ErrReader(iotest.ErrTimeout)))
+	stmt, err = sp.Next()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 1;", stmt.Text())
+	for range 2 {
+		_, err = sp.Next()
+		require.Equal(t, iotest.ErrTimeout, err)
+	}
+
+	// The parser can be set up.
+	sp = parser.NewStreamParser(strings.NewReader(`SELECT "a" FROM t`))
+	sp.Parser().SetSQLMode(mysql.ModeANSIQuotes)
+	stmt, err = sp.Next()
+	require.NoError(t, err)
+	require.Equal(t, "a", stmt.(*ast.SelectStmt).Fields.Fields[0].Expr.(*ast.ColumnNameExpr).Name.Name.O)
+}
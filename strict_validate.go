@@ -0,0 +1,328 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+)
+
+// ValidationRule identifies a rule checked by StrictValidate.
+type ValidationRule string
+
+// The rules checked by StrictValidate, see ValidationRules for their description.
+const (
+	RuleDuplicatePrimaryKey   ValidationRule = "duplicate-primary-key"
+	RuleDuplicateColumn       ValidationRule = "duplicate-column"
+	RuleMultipleAutoIncrement ValidationRule = "multiple-auto-increment"
+	RuleBlobDefault           ValidationRule = "blob-default"
+	RuleForeignKeyColumnCount ValidationRule = "foreign-key-column-count"
+	RuleDefaultNumeric        ValidationRule = "default-numeric"
+	RuleDefaultTooLong        ValidationRule = "default-too-long"
+	RuleDefaultTemporal       ValidationRule = "default-temporal"
+	RuleDefaultElement        ValidationRule = "default-element"
+	RuleWindowFuncArgs        ValidationRule = "window-function-arguments"
+)
+
+// ValidationRuleInfo describes a rule checked by StrictValidate. Code is the
+// error code of the MySQL server for a statement breaking the rule.
+type ValidationRuleInfo struct {
+	Rule        ValidationRule
+	Code        uint16
+	Description string
+}
+
+// ValidationRules lists the rules checked by StrictValidate, so that a tool can
+// tell which rules it ignores.
+var ValidationRules = []ValidationRuleInfo{
+	{RuleDuplicatePrimaryKey, mysql.ErrMultiplePriKey,
+		"A table has at most one primary key, whether defined by a column or by a table constraint."},
+	{RuleDuplicateColumn, mysql.ErrDupFieldName,
+		"The column names of a table are unique, compared case-insensitively."},
+	{RuleMultipleAutoIncrement, mysql.ErrWrongAutoKey,
+		"A table has at most one AUTO_INCREMENT column."},
+	{RuleBlobDefault, mysql.ErrBlobCantHaveDefault,
+		"A BLOB, TEXT, GEOMETRY or JSON column has no literal default value other than NULL, its default must be written in the expression form DEFAULT (expr)."},
+	{RuleForeignKeyColumnCount, mysql.ErrWrongFkDef,
+		"A foreign key references as many columns as it has."},
+	{RuleDefaultNumeric, mysql.ErrInvalidDefault,
+		"The literal default value of a numeric or BIT column is a number in the range of its type."},
+	{RuleDefaultTooLong, mysql.ErrInvalidDefault,
+		"The literal default value of a CHAR, VARCHAR, BINARY or VARBINARY column fits in its length."},
+	{RuleDefaultTemporal, mysql.ErrInvalidDefault,
+		"The literal default value of a DATE, DATETIME, TIMESTAMP, TIME or YEAR column is a valid value of its type."},
+	{RuleDefaultElement, mysql.ErrInvalidDefault,
+		"The literal default value of an ENUM or SET column is made of its elements."},
+	{RuleWindowFuncArgs, mysql.ErrWrongArguments,
+		"A window function has as many arguments as it takes, the N of NTILE and NTH_VALUE is a positive integer and the offset of LAG and LEAD a non-negative one, or a parameter marker."},
+}
+
+// ValidationIssue is a rule broken by a statement, see StrictValidate.
+type ValidationIssue struct {
+	Rule ValidationRule
+	// Table is the name of the table breaking the rule.
+	Table string
+	// Column is the name of the column breaking the rule, it's empty for the
+	// table constraints.
+	Column string
+	// Clause is the clause of the column definition or the table constraint
+	// breaking the rule, e.g. DEFAULT, or empty if the whole definition does. It's
+	// the name of the function for a window function, e.g. NTILE.
+	Clause string
+	// Offset is the offset in the statement text of the column definition or the
+	// table constraint breaking the rule, or of the value of the clause breaking
+	// it if it's a DEFAULT, or -1 if it's unknown. For a window function, it's the
+	// offset of the function in the SQL text the statement is parsed from, which
+	// has the other statements too.
+	Offset  int
+	Message string
+}
+
+// Error implements the error interface.
+func (i ValidationIssue) Error() string {
+	return fmt.Sprintf("%s: %s at offset %d", i.Rule, i.Message, i.Offset)
+}
+
+// StrictValidate checks stmt against the rules of ValidationRules, which the
+// MySQL server enforces but the grammar doesn't, and returns the broken ones, the
+// ones of the column definitions first. The rules of the tables are checked for
+// CREATE TABLE statements only, then the window functions of any statement.
+func StrictValidate(stmt ast.StmtNode) []ValidationIssue {
+	var issues []ValidationIssue
+	if create, ok := stmt.(*ast.CreateTableStmt); ok {
+		v := &strictValidator{stmt: create}
+		v.locateElements()
+		v.validate()
+		issues = v.issues
+	}
+	w := &windowFuncValidator{}
+	stmt.Accept(w)
+	return append(issues, w.issues...)
+}
+
+// strictValidator validates a CreateTableStmt.
+type strictValidator struct {
+	stmt   *ast.CreateTableStmt
+	issues []ValidationIssue
+	// cols and constraints are the table elements in the text of the statement,
+	// they are nil if the text doesn't match the AST.
+	cols        []tableElementText
+	constraints []tableElementText
+}
+
+// tableElementText is the text of a column definition or a table constraint.
+type tableElementText struct {
+	offset  int
+	tokens  []int
+	offsets []int
+}
+
+// defaultIsExpr tells if the DEFAULT of the column is written as DEFAULT (expr).
+func (e tableElementText) defaultIsExpr() bool {
+	for i, tok := range e.tokens {
+		if tok == defaultKwd {
+			return i+1 < len(e.tokens) && e.tokens[i+1] == '('
+		}
+	}
+	return false
+}
+
+// defaultOffset returns the offset of the value of the DEFAULT of the column,
+// inside the parentheses of DEFAULT (expr), or -1 if there is no DEFAULT.
+func (e tableElementText) defaultOffset() int {
+	for i, tok := range e.tokens {
+		if tok != defaultKwd {
+			continue
+		}
+		for i++; i < len(e.tokens) && e.tokens[i] == '('; i++ {
+		}
+		if i < len(e.tokens) {
+			return e.offsets[i]
+		}
+	}
+	return -1
+}
+
+// locateElements splits the table elements of the statement text.
+func (v *strictValidator) locateElements() {
+	text := v.stmt.Text()
+	if text == "" {
+		return
+	}
+	var elems []tableElementText
+	var cur *tableElementText
+	depth := 0
+	l := NewScanner(text)
+	var lval yySymType
+scan:
+	for tok := l.Lex(&lval); tok != 0 && tok != invalid; tok = l.Lex(&lval) {
+		switch {
+		case tok == '(':
+			depth++
+			if depth == 1 && elems == nil {
+				elems = []tableElementText{}
+				continue
+			}
+		case tok == ')':
+			depth--
+			if depth == 0 && elems != nil {
+				break scan
+			}
+		case tok == ',' && depth == 1 && elems != nil:
+			cur = nil
+			continue
+		}
+		if elems == nil || depth < 1 {
+			continue
+		}
+		if cur == nil {
+			elems = append(elems, tableElementText{offset: lval.offset})
+			cur = &elems[len(elems)-1]
+		}
+		cur.tokens = append(cur.tokens, tok)
+		cur.offsets = append(cur.offsets, lval.offset)
+	}
+	var cols, constraints []tableElementText
+	for _, e := range elems {
+		switch e.tokens[0] {
+		case primary, unique, key, index, fulltext, spatial, constraint, foreign, check:
+			constraints = append(constraints, e)
+		default:
+			cols = append(cols, e)
+		}
+	}
+	if len(cols) != len(v.stmt.Cols) || len(constraints) != len(v.stmt.Constraints) {
+		return
+	}
+	v.cols, v.constraints = cols, constraints
+}
+
+func (v *strictValidator) colOffset(i int) int {
+	if v.cols == nil {
+		return -1
+	}
+	return v.cols[i].offset
+}
+
+func (v *strictValidator) constraintOffset(i int) int {
+	if v.constraints == nil {
+		return -1
+	}
+	return v.constraints[i].offset
+}
+
+func (v *strictValidator) defaultOffset(i int) int {
+	if v.cols == nil {
+		return -1
+	}
+	return v.cols[i].defaultOffset()
+}
+
+func (v *strictValidator) report(rule ValidationRule, column, clause string, offset int, format string, args ...interface{}) {
+	v.issues = append(v.issues, ValidationIssue{
+		Rule:    rule,
+		Table:   v.stmt.Table.Name.O,
+		Column:  column,
+		Clause:  clause,
+		Offset:  offset,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *strictValidator) validate() {
+	primaryKeys, autoIncrements := 0, 0
+	names := make(map[string]struct{}, len(v.stmt.Cols))
+	for i, col := range v.stmt.Cols {
+		offset := v.colOffset(i)
+		name := col.Name.Name.L
+		if _, ok := names[name]; ok {
+			v.report(RuleDuplicateColumn, col.Name.Name.O, "", offset, "Duplicate column name '%s'", col.Name.Name.O)
+		}
+		names[name] = struct{}{}
+		for _, opt := range col.Options {
+			switch opt.Tp {
+			case ast.ColumnOptionPrimaryKey:
+				primaryKeys++
+				if primaryKeys > 1 {
+					v.report(RuleDuplicatePrimaryKey, col.Name.Name.O, "PRIMARY KEY", offset, "Multiple primary key defined")
+				}
+			case ast.ColumnOptionAutoIncrement:
+				autoIncrements++
+				if autoIncrements > 1 {
+					v.report(RuleMultipleAutoIncrement, col.Name.Name.O, "AUTO_INCREMENT", offset, "Column '%s' is another AUTO_INCREMENT column", col.Name.Name.O)
+				}
+			case ast.ColumnOptionDefaultValue:
+				if isBlobType(col) && v.isLiteralDefault(i, opt.Expr) {
+					v.report(RuleBlobDefault, col.Name.Name.O, "DEFAULT", offset, "BLOB, TEXT, GEOMETRY or JSON column '%s' can't have a default value", col.Name.Name.O)
+				} else if rule, msg := checkDefaultValue(col.Tp, opt.Expr); msg != "" {
+					v.report(rule, col.Name.Name.O, "DEFAULT", v.defaultOffset(i), "Invalid default value for '%s': %s", col.Name.Name.O, msg)
+				}
+			case ast.ColumnOptionReference:
+				if opt.Refer != nil && len(opt.Refer.IndexPartSpecifications) > 0 && len(opt.Refer.IndexPartSpecifications) != 1 {
+					v.report(RuleForeignKeyColumnCount, col.Name.Name.O, "REFERENCES", offset, "Incorrect foreign key definition for '%s': Key reference and table reference don't match", col.Name.Name.O)
+				}
+			}
+		}
+	}
+	for i, c := range v.stmt.Constraints {
+		offset := v.constraintOffset(i)
+		switch c.Tp {
+		case ast.ConstraintPrimaryKey:
+			primaryKeys++
+			if primaryKeys > 1 {
+				v.report(RuleDuplicatePrimaryKey, "", "PRIMARY KEY", offset, "Multiple primary key defined")
+			}
+		case ast.ConstraintForeignKey:
+			if c.Refer != nil && len(c.Refer.IndexPartSpecifications) != len(c.Keys) {
+				name := c.Name
+				if name == "" {
+					name = "foreign key without name"
+				}
+				v.report(RuleForeignKeyColumnCount, "", "FOREIGN KEY", offset, "Incorrect foreign key definition for '%s': Key reference and table reference don't match", name)
+			}
+		}
+	}
+}
+
+// isBlobType tells if the type of col has no literal default value.
+func isBlobType(col *ast.ColumnDef) bool {
+	if col.Tp == nil {
+		return false
+	}
+	switch col.Tp.GetType() {
+	case mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob, mysql.TypeGeometry, mysql.TypeJSON:
+		return true
+	}
+	return false
+}
+
+// isLiteralDefault tells if the default value expr of the i-th column isn't NULL
+// and isn't written in the expression form. The grammar drops the parentheses of
+// DEFAULT (literal), so the form is read from the statement text when it's known,
+// otherwise only the literals are reported.
+func (v *strictValidator) isLiteralDefault(i int, expr ast.ExprNode) bool {
+	if u, ok := expr.(*ast.UnaryOperationExpr); ok {
+		expr = u.V
+	}
+	if value, ok := expr.(ast.ValueExpr); ok && value.GetValue() == nil {
+		return false
+	}
+	if v.cols != nil {
+		return !v.cols[i].defaultIsExpr()
+	}
+	_, ok := expr.(ast.ValueExpr)
+	return ok
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictValidate(t *testing.T) {
+	p := parser.New()
+
+	cases := []struct {
+		rule parser.ValidationRule
+		sql  string
+		// at is the text the issue is located at, empty if sql passes.
+		at string
+	}{
+		{parser.RuleDuplicatePrimaryKey, "CREATE TABLE t (a INT PRIMARY KEY, b INT, UNIQUE (b))", ""},
+		{parser.RuleDuplicatePrimaryKey, "CREATE TABLE t (a INT PRIMARY KEY, b INT, PRIMARY KEY (b))", "PRIMARY KEY (b)"},
+		{parser.RuleDuplicatePrimaryKey, "CREATE TABLE t (a INT PRIMARY KEY, b INT PRIMARY KEY)", "b INT PRIMARY KEY"},
+		{parser.RuleDuplicateColumn, "CREATE TABLE t (a INT, b INT, c INT)", ""},
+		{parser.RuleDuplicateColumn, "CREATE TABLE t (a INT, b INT, A VARCHAR(10))", "A VARCHAR(10)"},
+		{parser.RuleMultipleAutoIncrement, "CREATE TABLE t (a INT AUTO_INCREMENT, b INT, KEY (a)) AUTO_INCREMENT = 10", ""},
+		{parser.RuleMultipleAutoIncrement, "CREATE TABLE t (a INT AUTO_INCREMENT, b INT AUTO_INCREMENT, KEY (a), KEY (b))", "b INT AUTO_INCREMENT"},
+		{parser.RuleBlobDefault, "CREATE TABLE t (a TEXT DEFAULT ('x'), b BLOB DEFAULT NULL, c JSON DEFAULT (JSON_ARRAY()), d VARCHAR(10) DEFAULT 'x')", ""},
+		{parser.RuleBlobDefault, "CREATE TABLE t (a INT, b TEXT DEFAULT 'x')", "b TEXT DEFAULT 'x'"},
+		{parser.RuleBlobDefault, "CREATE TABLE t (a INT, b JSON DEFAULT '[]')", "b JSON DEFAULT '[]'"},
+		{parser.RuleForeignKeyColumnCount, "CREATE TABLE t (a INT, b INT, FOREIGN KEY (a, b) REFERENCES p (x, y), c INT REFERENCES p (z))", ""},
+		{parser.RuleForeignKeyColumnCount, "CREATE TABLE t (a INT, b INT, CONSTRAINT fk FOREIGN KEY (a, b) REFERENCES p (x))", "CONSTRAINT fk FOREIGN KEY (a, b) REFERENCES p (x)"},
+		{parser.RuleForeignKeyColumnCount, "CREATE TABLE t (a INT REFERENCES p (x, y))", "a INT REFERENCES p (x, y)"},
+	}
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		issues := parser.StrictValidate(stmt)
+		if c.at == "" {
+			require.Empty(t, issues, c.sql)
+			continue
+		}
+		require.Len(t, issues, 1, c.sql)
+		require.Equal(t, c.rule, issues[0].Rule, c.sql)
+		require.Equal(t, strings.Index(c.sql, c.at), issues[0].Offset, c.sql)
+		require.NotEmpty(t, issues[0].Message, c.sql)
+	}
+
+	// Every rule is documented.
+	documented := make(map[parser.ValidationRule]bool)
+	for _, info := range parser.ValidationRules {
+		require.NotZero(t, info.Code)
+		require.NotEmpty(t, info.Description)
+		documented[info.Rule] = true
+	}
+	for _, c := range cases {
+		require.True(t, documented[c.rule], c.rule)
+	}
+
+	// The issues are reported without position when the text is unknown.
+	stmt, err := p.ParseOneStmt("CREATE TABLE t (a INT, a INT)", "", "")
+	require.NoError(t, err)
+	stmt.SetText(nil, "")
+	issues := parser.StrictValidate(stmt)
+	require.Len(t, issues, 1)
+	require.Equal(t, parser.RuleDuplicateColumn, issues[0].Rule)
+	require.Equal(t, -1, issues[0].Offset)
+
+	// The tables of the other statements aren't checked.
+	stmt, err = p.ParseOneStmt("SELECT 1", "", "")
+	require.NoError(t, err)
+	require.Empty(t, parser.StrictValidate(stmt))
+}
+
+func TestStrictValidateDefaults(t *testing.T) {
+	p := parser.New()
+
+	sql := "CREATE TABLE t (" +
+		"a DECIMAL(5,2) DEFAULT 123456.789, " +
+		"b VARCHAR(3) DEFAULT 'abcd', " +
+		"c DATETIME DEFAULT '2024-02-30 10:00:00', " +
+		"d ENUM('x', 'y') DEFAULT 'z', " +
+		"e TEXT DEFAULT 'text', " +
+		"f INT DEFAULT 1)"
+	stmt, err := p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	issues := parser.StrictValidate(stmt)
+	require.Len(t, issues, 5)
+	expected := []struct {
+		rule   parser.ValidationRule
+		column string
+		at     string
+	}{
+		{parser.RuleDefaultNumeric, "a", "123456.789"},
+		{parser.RuleDefaultTooLong, "b", "'abcd'"},
+		{parser.RuleDefaultTemporal, "c", "'2024-02-30 10:00:00'"},
+		{parser.RuleDefaultElement, "d", "'z'"},
+		{parser.RuleBlobDefault, "e", "e TEXT"},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.rule, issues[i].Rule, e.column)
+		require.Equal(t, "t", issues[i].Table, e.column)
+		require.Equal(t, e.column, issues[i].Column, e.column)
+		require.Equal(t, "DEFAULT", issues[i].Clause, e.column)
+		require.Equal(t, strings.Index(sql, e.at), issues[i].Offset, e.column)
+		require.NotEmpty(t, issues[i].Message, e.column)
+	}
+
+	stmt, err = p.ParseOneStmt("CREATE TABLE t ("+
+		"a DECIMAL(5,2) DEFAULT -999.99, b DECIMAL(5,2) UNSIGNED DEFAULT '1.234', c TINYINT DEFAULT -128, d TINYINT UNSIGNED DEFAULT 255, "+
+		"e BIGINT UNSIGNED DEFAULT 18446744073709551615, f FLOAT DEFAULT 1e38, g DOUBLE(4,1) DEFAULT (999.9), h BIT(4) DEFAULT b'1111', "+
+		"i CHAR(2) DEFAULT 'éé', j BINARY(2) DEFAULT x'0102', k VARCHAR(3) DEFAULT NULL, "+
+		"l DATE DEFAULT '2024-02-29', m DATETIME(3) DEFAULT '2024-01-01 23:59:59.999', n TIMESTAMP DEFAULT CURRENT_TIMESTAMP, "+
+		"o TIMESTAMP DEFAULT '2038-01-19 03:14:07', p TIME DEFAULT '-838:59:59', q TIME DEFAULT 235959, r YEAR DEFAULT 2155, "+
+		"s DATE DEFAULT 20240101, t DATETIME DEFAULT '0000-00-00 00:00:00', "+
+		"t1 DATETIME DEFAULT '2020-01-01 10:00', t2 DATETIME DEFAULT '2020-01-01 10:00:00+08:00', t3 DATE DEFAULT '2020-1-1', "+
+		"t4 TIME DEFAULT '1 10:00:00', t5 YEAR DEFAULT 0, t6 YEAR DEFAULT '99', t7 DECIMAL(5,2) DEFAULT '  1.5 ', t8 BIGINT DEFAULT -9223372036854775808, "+
+		"t9 BIT(64) DEFAULT x'FFFFFFFFFFFFFFFF', t10 DECIMAL(5,2) UNSIGNED DEFAULT -0.001, "+
+		"u ENUM('x', 'y') DEFAULT 'Y', v ENUM('x', 'y') DEFAULT 2, w SET('x', 'y') DEFAULT 'y,x', x SET('x', 'y') DEFAULT '', "+
+		"y DOUBLE DEFAULT (RAND()))", "", "")
+	require.NoError(t, err)
+	require.Empty(t, parser.StrictValidate(stmt))
+
+	cases := []struct {
+		rule parser.ValidationRule
+		col  string
+	}{
+		{parser.RuleDefaultNumeric, "DECIMAL(5,2) DEFAULT 999.995"},
+		{parser.RuleDefaultNumeric, "DECIMAL(5,2) UNSIGNED DEFAULT -1"},
+		{parser.RuleDefaultNumeric, "TINYINT DEFAULT 128"},
+		{parser.RuleDefaultNumeric, "INT UNSIGNED DEFAULT -1"},
+		{parser.RuleDefaultNumeric, "INT DEFAULT 'abc'"},
+		{parser.RuleDefaultNumeric, "FLOAT DEFAULT 1e39"},
+		{parser.RuleDefaultNumeric, "BIT(4) DEFAULT 16"},
+		{parser.RuleDefaultNumeric, "BIGINT DEFAULT 9223372036854775808"},
+		{parser.RuleDefaultNumeric, "BIGINT UNSIGNED DEFAULT 18446744073709551616"},
+		{parser.RuleDefaultNumeric, "INT DEFAULT '12abc'"},
+		{parser.RuleDefaultTooLong, "CHAR DEFAULT 'ab'"},
+		{parser.RuleDefaultTooLong, "BINARY(2) DEFAULT 'éé'"},
+		{parser.RuleDefaultTemporal, "DATE DEFAULT '2023-02-29'"},
+		{parser.RuleDefaultTemporal, "DATETIME DEFAULT '2024-01-01 24:00:00'"},
+		{parser.RuleDefaultTemporal, "TIMESTAMP DEFAULT '1969-12-31 23:59:59'"},
+		{parser.RuleDefaultTemporal, "TIME DEFAULT '839:00:00'"},
+		{parser.RuleDefaultTemporal, "YEAR DEFAULT 1900"},
+		{parser.RuleDefaultTemporal, "DATE DEFAULT 'tomorrow'"},
+		{parser.RuleDefaultTemporal, "TIMESTAMP DEFAULT '2020-01-01 10:00:00 x'"},
+		{parser.RuleDefaultTemporal, "TIME DEFAULT 'abc'"},
+		{parser.RuleDefaultTemporal, "YEAR DEFAULT '2156'"},
+		{parser.RuleDefaultElement, "ENUM('x', 'y') DEFAULT 3"},
+		{parser.RuleDefaultElement, "SET('x', 'y') DEFAULT 'x,z'"},
+	}
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt("CREATE TABLE t (a "+c.col+")", "", "")
+		require.NoError(t, err, c.col)
+		issues := parser.StrictValidate(stmt)
+		require.Len(t, issues, 1, c.col)
+		require.Equal(t, c.rule, issues[0].Rule, c.col)
+	}
+}
+
+func TestStrictValidateWindowFuncs(t *testing.T) {
+	p := parser.New()
+
+	stmt, err := p.ParseOneStmt("SELECT ROW_NUMBER() OVER w, NTILE(4) OVER w, NTILE(?) OVER w, LAG(x) OVER w, LAG(x, 1, 0) OVER (w ORDER BY y), "+
+		"LEAD(x, 0) OVER w, LEAD(x, ?, 1) OVER w, NTH_VALUE(x, 2) OVER w, SUM(x) OVER w FROM t WINDOW w AS (PARTITION BY a)", "", "")
+	require.NoError(t, err)
+	require.Empty(t, parser.StrictValidate(stmt))
+
+	for _, c := range []struct {
+		sql    string
+		clause string
+	}{
+		{"SELECT a, NTILE(0) OVER w FROM t", "NTILE"},
+		{"SELECT a, NTILE(-1) OVER w FROM t", "NTILE"},
+		{"SELECT a, NTILE(a) OVER w FROM t", "NTILE"},
+		{"SELECT a, NTILE(1.5) OVER w FROM t", "NTILE"},
+		{"SELECT a, LAG(x, 1.5) OVER w FROM t", "LAG"},
+		{"SELECT a, LEAD(x, 2.0, 0) OVER w FROM t", "LEAD"},
+		{"SELECT a, NTH_VALUE(x, 0) OVER w FROM t", "NTH_VALUE"},
+	} {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		issues := parser.StrictValidate(stmt)
+		require.Len(t, issues, 1, c.sql)
+		require.Equal(t, parser.RuleWindowFuncArgs, issues[0].Rule, c.sql)
+		require.Equal(t, c.clause, issues[0].Clause, c.sql)
+		require.Equal(t, strings.Index(c.sql, c.clause), issues[0].Offset, c.sql)
+	}
+
+	// The grammar only accepts the right numbers of arguments, the ones of a
+	// rewritten AST are checked.
+	sql := "SELECT ROW_NUMBER() OVER w, LAG(x) OVER w, LEAD(x, 1, 0) OVER w, NTILE(4) OVER w FROM t"
+	stmt, err = p.ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	fields := stmt.(*ast.SelectStmt).Fields.Fields
+	rowNumber := fields[0].Expr.(*ast.WindowFuncExpr)
+	rowNumber.Args = fields[1].Expr.(*ast.WindowFuncExpr).Args
+	lag := fields[1].Expr.(*ast.WindowFuncExpr)
+	lag.Args = nil
+	lead := fields[2].Expr.(*ast.WindowFuncExpr)
+	lead.Args = append(lead.Args, lead.Args[1])
+	ntile := fields[3].Expr.(*ast.WindowFuncExpr)
+	ntile.Args = append(ntile.Args, ntile.Args[0])
+	issues := parser.StrictValidate(stmt)
+	require.Len(t, issues, 4)
+	for i, name := range []string{"ROW_NUMBER", "LAG", "LEAD", "NTILE"} {
+		require.Equal(t, parser.RuleWindowFuncArgs, issues[i].Rule, name)
+		require.Equal(t, name, issues[i].Clause)
+		require.Equal(t, strings.Index(sql, name), issues[i].Offset, name)
+		require.Contains(t, issues[i].Message, "Incorrect parameter count in the call to "+name, name)
+	}
+	require.Contains(t, issues[0].Message, "0 expected but 1 given")
+	require.Contains(t, issues[2].Message, "1 to 3 expected but 4 given")
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pingcap/errors"
+)
+
+// maxNearLength is the max length of SyntaxError.Near.
+const maxNearLength = 2048
+
+// SyntaxError is an error located in the SQL text, e.g. a syntax error. The
+// errors of ParseSQL, Parse and ParseOneStmt wrap it, use errors.As to get it.
+// The positions are absolute in the whole SQL text, whatever the statement
+// having the error.
+type SyntaxError struct {
+	// Line is the line of the position the scanner stopped at, from 1.
+	Line int
+	// Col is the column of the position the scanner stopped at, in bytes, from 0
+	// at the start of the line. It's right after the token the error is found at.
+	Col int
+	// Offset is the offset of Near in the SQL text, in bytes.
+	Offset int
+	// Near is the SQL text from the token the error is found at, at most
+	// maxNearLength bytes.
+	Near string
+	// Msg describes the error, it's empty for a syntax error.
+	Msg string
+	// nearLength is the length of the text from Near, if it has been truncated.
+	nearLength int
+}
+
+// Error implements the error interface.
+func (e *SyntaxError) Error() string {
+	var lenStr string
+	if e.nearLength > len(e.Near) {
+		lenStr = "(total length " + strconv.Itoa(e.nearLength) + ")"
+	}
+	return fmt.Sprintf("line %d column %d near \"%s\"%s %s", e.Line, e.Col, e.Near, e.Msg, lenStr)
+}
+
+// ErrMultipleStatements is the error of a query of more than one statement given
+// to ParseOneStmt, it's wrapped by MultipleStatementsError.
+var ErrMultipleStatements = errors.New("the query has more than one statement")
+
+// MultipleStatementsError is returned by ParseOneStmt for a query of more than
+// one statement, use errors.As to get it, or errors.Is with
+// ErrMultipleStatements.
+type MultipleStatementsError struct {
+	// Offset is the offset of the second statement in the SQL text, in bytes.
+	Offset int
+}
+
+// Error implements the error interface.
+func (e *MultipleStatementsError) Error() string {
+	return fmt.Sprintf("%s, the second one is at offset %d", ErrMultipleStatements, e.Offset)
+}
+
+// Unwrap returns ErrMultipleStatements.
+func (e *MultipleStatementsError) Unwrap() error {
+	return ErrMultipleStatements
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntaxError(t *testing.T) {
+	p := parser.New()
+
+	// The position of an error in the second statement is absolute, and the
+	// offset is in bytes.
+	sql := "select '日本';\nselect 1 from1 dual;\nselect 3;"
+	_, _, err := p.Parse(sql, "", "")
+	require.EqualError(t, err, "line 2 column 19 near \"dual;\nselect 3;\" ")
+	var syntaxErr *parser.SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 2, syntaxErr.Line)
+	require.Equal(t, 19, syntaxErr.Col)
+	require.Equal(t, strings.Index(sql, "dual"), syntaxErr.Offset)
+	require.Equal(t, sql[syntaxErr.Offset:], syntaxErr.Near)
+	require.Empty(t, syntaxErr.Msg)
+
+	_, err = p.ParseOneStmt("select * from t1 join t2 one t1.a = t2.a", "", "")
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 1, syntaxErr.Line)
+	require.Equal(t, 31, syntaxErr.Col)
+	require.Equal(t, "t1.a = t2.a", syntaxErr.Near)
+
+	// The positioned errors of the grammar actions are SyntaxError too.
+	_, _, err = p.ParseSQL("select 1;\ncreate table t (a int) compression='zstd'")
+	require.NoError(t, err)
+	p.SetStorageOptionCheck(true)
+	_, _, err = p.ParseSQL("select 1;\ncreate table t (a int) compression='zstd'")
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 2, syntaxErr.Line)
+	require.Contains(t, syntaxErr.Msg, "Invalid compression algorithm 'zstd'")
+
+	// The text near the error is truncated.
+	long := "select 1 from1 dual " + strings.Repeat("a", 3000)
+	_, _, err = p.Parse(long, "", "")
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Len(t, syntaxErr.Near, 2048)
+	require.True(t, strings.HasSuffix(err.Error(), "(total length 3005)"))
+
+	// The errors positioned at a construct count the columns from 0 as well, at
+	// the start of the construct instead of right after the token.
+	sql = "select 1;\ncreate table t (a blob compressed, b int) x y"
+	line := sql[strings.IndexByte(sql, '\n')+1:]
+	_, _, err = p.ParseSQL(sql)
+	require.True(t, parser.ErrDialectSyntax.Equal(err), "%v", err)
+	require.ErrorContains(t, err, fmt.Sprintf("at line 2 column %d near \"compressed", strings.Index(line, "compressed")))
+	p.SetDialect(parser.DialectMariaDB)
+	_, _, err = p.ParseSQL(sql)
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 2, syntaxErr.Line)
+	require.Equal(t, strings.Index(line, "x")+len("x"), syntaxErr.Col)
+	require.Equal(t, "x y", syntaxErr.Near)
+}
+
+func TestMultipleStatementsError(t *testing.T) {
+	p := parser.New()
+
+	// A trailing ';', empty statements and comments aren't statements.
+	for _, sql := range []string{
+		"select 1",
+		"select 1;",
+		"select 1; -- x",
+		"select 1; -- x; select 2",
+		"select 1 /* ; */ ;\n;  # select 2;\n",
+		"create trigger tr before insert on t for each row begin set @a = 1; set @b = 2; end;",
+	} {
+		_, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err, sql)
+	}
+
+	// The second statement is located, and not parsed.
+	for _, c := range []struct {
+		sql    string
+		second string
+	}{
+		{"select 1;select 2", "select 2"},
+		{"select 1; drop table t", "drop"},
+		{"select 1;; ;\n/* c */ select 2", "select 2"},
+		{"select 1; drop table t where (", "drop"},
+		{"select 1; /*!40101 set @a = 1 */", "set"},
+	} {
+		_, err := p.ParseOneStmt(c.sql, "", "")
+		var multiErr *parser.MultipleStatementsError
+		require.True(t, errors.As(err, &multiErr), "%s: %v", c.sql, err)
+		require.True(t, errors.Is(err, parser.ErrMultipleStatements), c.sql)
+		require.Equal(t, strings.LastIndex(c.sql, c.second), multiErr.Offset, c.sql)
+	}
+	_, err := p.ParseOneStmt("select 1;select 2", "", "")
+	require.EqualError(t, err, "the query has more than one statement, the second one is at offset 9")
+
+	// The first statement is parsed before.
+	_, err = p.ParseOneStmt("select from t; select 2", "", "")
+	var syntaxErr *parser.SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+
+	// The parser is reusable, and ParseSQL is unchanged.
+	stmts, _, err := p.ParseSQL("select 1;select 2")
+	require.NoError(t, err)
+	require.Len(t, stmts, 2)
+	_, err = p.ParseOneStmt("", "", "")
+	require.True(t, terror.ErrorEqual(parser.ErrSyntax, err))
+
+	// A second statement taken by a statement handler is located too.
+	require.NoError(t, p.RegisterStatementHandler("Route", func(text string, offset int) (ast.StmtNode, error) {
+		return &ast.GenericStmt{Keyword: "ROUTE", SQL: text}, nil
+	}))
+	_, err = p.ParseOneStmt("ROUTE TO 'a';", "", "")
+	require.NoError(t, err)
+	for _, c := range []struct {
+		sql    string
+		second string
+	}{
+		{"select 1; ROUTE TO 'a'", "ROUTE"},
+		{"ROUTE TO 'a'; select 1", "select"},
+		{"ROUTE TO 'a'; ROUTE TO 'b'", "ROUTE TO 'b'"},
+	} {
+		_, err = p.ParseOneStmt(c.sql, "", "")
+		var multiErr *parser.MultipleStatementsError
+		require.True(t, errors.As(err, &multiErr), "%s: %v", c.sql, err)
+		require.Equal(t, strings.LastIndex(c.sql, c.second), multiErr.Offset, c.sql)
+	}
+}
@@ -126,6 +126,7 @@ func frozen() bool {
 }
 
 // RegisterErrorClass registers new error class for terror.
+// It isn't safe for concurrent use, call it from a package initializer.
 func RegisterErrorClass(classCode int, desc string) ErrClass {
 	errClass := ErrClass(classCode)
 	if _, exists := errClass2Desc[errClass]; exists {
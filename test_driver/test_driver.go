@@ -17,6 +17,7 @@
 package test_driver
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strconv"
@@ -28,21 +29,23 @@ import (
 )
 
 func init() {
-	ast.NewValueExpr = newValueExpr
-	ast.NewParamMarkerExpr = newParamMarkerExpr
-	ast.NewDecimal = func(str string) (any, error) {
-		dec := new(MyDecimal)
-		err := dec.FromString([]byte(str))
-		return dec, err
-	}
-	ast.NewHexLiteral = func(str string) (any, error) {
-		h, err := NewHexLiteral(str)
-		return h, err
-	}
-	ast.NewBitLiteral = func(str string) (any, error) {
-		b, err := NewBitLiteral(str)
-		return b, err
-	}
+	ast.RegisterDriver(ast.DriverHooks{
+		NewValueExpr:       newValueExpr,
+		NewParamMarkerExpr: newParamMarkerExpr,
+		NewDecimal: func(str string) (any, error) {
+			dec := new(MyDecimal)
+			err := dec.FromString([]byte(str))
+			return dec, err
+		},
+		NewHexLiteral: func(str string) (any, error) {
+			h, err := NewHexLiteral(str)
+			return h, err
+		},
+		NewBitLiteral: func(str string) (any, error) {
+			b, err := NewBitLiteral(str)
+			return b, err
+		},
+	})
 }
 
 var (
@@ -70,22 +73,16 @@ func (n *ValueExpr) Restore(ctx *format.RestoreCtx) error {
 				ctx.WriteKeyWord("FALSE")
 			}
 		} else {
-			ctx.WritePlain(strconv.FormatInt(n.GetInt64(), 10))
+			ctx.WritePlainInt(n.GetInt64())
 		}
 	case KindUint64:
-		ctx.WritePlain(strconv.FormatUint(n.GetUint64(), 10))
+		ctx.WritePlainUint(n.GetUint64())
 	case KindFloat32:
-		ctx.WritePlain(strconv.FormatFloat(n.GetFloat64(), 'e', -1, 32))
+		ctx.WritePlainFloat(n.GetFloat64(), 'e', -1, 32)
 	case KindFloat64:
-		ctx.WritePlain(strconv.FormatFloat(n.GetFloat64(), 'e', -1, 64))
+		ctx.WritePlainFloat(n.GetFloat64(), 'e', -1, 64)
 	case KindString:
-		if n.Type.GetCharset() != "" &&
-			!ctx.Flags.HasStringWithoutCharset() &&
-			(!ctx.Flags.HasStringWithoutDefaultCharset() || n.Type.GetCharset() != mysql.DefaultCharset) {
-			ctx.WritePlain("_")
-			ctx.WriteKeyWord(n.Type.GetCharset())
-		}
-		ctx.WriteString(n.GetString())
+		return ast.RestoreStringLiteral(ctx, n.GetString(), n.Type.GetCharset(), n.OriginTextPosition())
 	case KindBytes:
 		ctx.WriteString(n.GetString())
 	case KindMysqlDecimal:
@@ -98,7 +95,9 @@ func (n *ValueExpr) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteKeyWord(n.Type.GetCharset() + " ")
 		}
 		if n.Type.GetFlag()&mysql.UnsignedFlag != 0 {
-			ctx.WritePlainf("x'%x'", n.GetBytes())
+			ctx.WritePlain("x'")
+			ctx.WritePlainAppend(func(b []byte) []byte { return hex.AppendEncode(b, n.GetBytes()) })
+			ctx.WritePlain("'")
 		} else {
 			ctx.WritePlain(n.GetBinaryLiteral().ToBitLiteralString(true))
 		}
@@ -169,6 +168,16 @@ func newValueExpr(value any, charset string, collate string) ast.ValueExpr {
 	return ve
 }
 
+// SetValue implements ValueExpr interface. Setting a value other than a bool
+// clears IsBooleanFlag, so that a value folded from a TRUE or FALSE literal is
+// restored as a number rather than as the keyword.
+func (n *ValueExpr) SetValue(res any) {
+	if _, ok := res.(bool); !ok {
+		n.Type.DelFlag(mysql.IsBooleanFlag)
+	}
+	n.Datum.SetValue(res)
+}
+
 // SetProjectionOffset sets ValueExpr.projectionOffset for logical plan builder.
 func (n *ValueExpr) SetProjectionOffset(offset int) {
 	n.projectionOffset = offset
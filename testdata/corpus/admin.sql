@@ -0,0 +1,355 @@
+-- Code generated by gencorpus with seed 1. DO NOT EDIT.
+
+-- AnalyzeTableStmt
+ANALYZE TABLE t;
+
+-- AnalyzeTableStmt
+ANALYZE TABLE t2;
+
+-- DropStatsStmt
+DROP STATS users;
+
+-- DropStatsStmt
+DROP STATS t2;
+
+-- LoadStatsStmt
+LOAD STATS '/tmp/stats.json';
+
+-- LockStatsStmt
+LOCK STATS orders;
+
+-- LockStatsStmt
+LOCK STATS t2;
+
+-- UnlockStatsStmt
+UNLOCK STATS t2;
+
+-- UnlockStatsStmt
+UNLOCK STATS users;
+
+-- RefreshStatsStmt
+REFRESH STATS p1.t1;
+
+-- RefreshStatsStmt
+REFRESH STATS v1.users;
+
+-- TraceStmt
+TRACE SELECT b FROM users;
+
+-- TraceStmt
+TRACE SELECT name FROM orders;
+
+-- ExplainStmt
+EXPLAIN SELECT * FROM t2 WHERE name = 21;
+
+-- ExplainStmt
+EXPLAIN SELECT * FROM t WHERE name = 51;
+
+-- ExplainStmt
+EXPLAIN FORMAT = 'brief' DELETE FROM t;
+
+-- ExplainStmt
+EXPLAIN FORMAT = 'brief' DELETE FROM users;
+
+-- ExplainForStmt
+EXPLAIN FOR CONNECTION 13;
+
+-- ExplainForStmt
+EXPLAIN FOR CONNECTION 6;
+
+-- PlanReplayerStmt
+PLAN REPLAYER DUMP EXPLAIN SELECT id FROM t1;
+
+-- PlanReplayerStmt
+PLAN REPLAYER DUMP EXPLAIN SELECT id FROM t2;
+
+-- TrafficStmt
+TRAFFIC CAPTURE TO '/tmp' DURATION = '1s';
+
+-- RecommendIndexStmt
+RECOMMEND INDEX RUN;
+
+-- PrepareStmt
+PREPARE x FROM 'SELECT ?';
+
+-- PrepareStmt
+PREPARE s1 FROM 'SELECT ?';
+
+-- ExecuteStmt
+EXECUTE y USING @db1;
+
+-- ExecuteStmt
+EXECUTE x USING @v1;
+
+-- DeallocateStmt
+DEALLOCATE PREPARE s1;
+
+-- BeginStmt
+BEGIN;
+
+-- BeginStmt
+START TRANSACTION READ ONLY;
+
+-- CommitStmt
+COMMIT;
+
+-- RollbackStmt
+ROLLBACK;
+
+-- RollbackStmt
+ROLLBACK TO SAVEPOINT x;
+
+-- RollbackStmt
+ROLLBACK TO SAVEPOINT db1;
+
+-- SavepointStmt
+SAVEPOINT p1;
+
+-- SavepointStmt
+SAVEPOINT y;
+
+-- ReleaseSavepointStmt
+RELEASE SAVEPOINT v1;
+
+-- ReleaseSavepointStmt
+RELEASE SAVEPOINT x;
+
+-- BinlogStmt
+BINLOG 'BxSFVw8JAAAA8QAAAPUAAAAAAAQANS41LjQ0';
+
+-- ChecksumTableStmt
+CHECKSUM TABLE t, t1;
+
+-- ChecksumTableStmt
+CHECKSUM TABLE t2, orders;
+
+-- ChecksumTableStmt
+CHECKSUM TABLE t QUICK;
+
+-- ChecksumTableStmt
+CHECKSUM TABLE users QUICK;
+
+-- CacheIndexStmt
+CACHE INDEX t INDEX (x) IN p1;
+
+-- CacheIndexStmt
+CACHE INDEX t INDEX (v1) IN p1;
+
+-- CacheIndexStmt
+CACHE INDEX users PARTITION (ALL), t IN x;
+
+-- CacheIndexStmt
+CACHE INDEX orders PARTITION (ALL), t1 IN p1;
+
+-- LoadIndexIntoCacheStmt
+LOAD INDEX INTO CACHE t1 PARTITION (db1) IGNORE LEAVES;
+
+-- LoadIndexIntoCacheStmt
+LOAD INDEX INTO CACHE orders PARTITION (p1) IGNORE LEAVES;
+
+-- LoadIndexIntoCacheStmt
+LOAD INDEX INTO CACHE orders INDEX (y, y), orders;
+
+-- LoadIndexIntoCacheStmt
+LOAD INDEX INTO CACHE users INDEX (y, x), t2;
+
+-- UseStmt
+USE s1;
+
+-- UseStmt
+USE v1;
+
+-- FlushStmt
+FLUSH TABLES t WITH READ LOCK;
+
+-- FlushStmt
+FLUSH TABLES orders WITH READ LOCK;
+
+-- FlushStmt
+FLUSH PRIVILEGES;
+
+-- KillStmt
+KILL 31;
+
+-- KillStmt
+KILL 87;
+
+-- KillStmt
+KILL QUERY 81;
+
+-- KillStmt
+KILL QUERY 51;
+
+-- SetStmt
+SET @s1 = a - b;
+
+-- SetStmt
+SET @s1 = 8;
+
+-- SetStmt
+SET NAMES utf8mb4;
+
+-- SetStmt
+SET SESSION sql_mode = '%a_';
+
+-- SetStmt
+SET PERSIST v1 = 21;
+
+-- SetStmt
+SET PERSIST v1 = 95;
+
+-- SetStmt
+SET @@PERSIST_ONLY.p1 = 90;
+
+-- SetStmt
+SET @@PERSIST_ONLY.x = 70;
+
+-- SetConfigStmt
+SET CONFIG TIKV LOG.LEVEL = 'info';
+
+-- SetSessionStatesStmt
+SET SESSION_STATES 'it''s';
+
+-- SetSessionStatesStmt
+SET SESSION_STATES 'abc';
+
+-- SetPwdStmt
+SET PASSWORD FOR 'u1'@'localhost' = 'it''s';
+
+-- SetPwdStmt
+SET PASSWORD FOR 'u1'@'localhost' = 'abc';
+
+-- SetRoleStmt
+SET ROLE DEFAULT;
+
+-- SetRoleStmt
+SET ROLE ALL;
+
+-- SetDefaultRoleStmt
+SET DEFAULT ROLE r1 TO 'u1'@'localhost';
+
+-- SetResourceGroupStmt
+SET RESOURCE GROUP s1;
+
+-- SetBindingStmt
+SET BINDING DISABLED FOR SELECT * FROM t;
+
+-- SetBindingStmt
+SET BINDING DISABLED FOR SELECT * FROM t1;
+
+-- CreateBindingStmt
+CREATE GLOBAL BINDING FOR SELECT * FROM t USING SELECT * FROM t USE INDEX (idx_a);
+
+-- DropBindingStmt
+DROP SESSION BINDING FOR SELECT * FROM users;
+
+-- DropBindingStmt
+DROP SESSION BINDING FOR SELECT * FROM t;
+
+-- CreateUserStmt
+CREATE USER IF NOT EXISTS 'u1'@'localhost' IDENTIFIED BY '%a_';
+
+-- CreateUserStmt
+CREATE USER IF NOT EXISTS 'u1'@'localhost' IDENTIFIED BY 'abc';
+
+-- AlterUserStmt
+ALTER USER 'u1'@'localhost' IDENTIFIED BY 'foo';
+
+-- DropUserStmt
+DROP USER IF EXISTS 'u1'@'localhost';
+
+-- RenameUserStmt
+RENAME USER 'u1'@'%' TO 'u2'@'%';
+
+-- GrantStmt
+GRANT SELECT, INSERT ON v1.* TO 'u1'@'localhost';
+
+-- GrantStmt
+GRANT SELECT, INSERT ON s1.* TO 'u1'@'localhost';
+
+-- GrantProxyStmt
+GRANT PROXY ON 'u1'@'%' TO 'u2'@'%';
+
+-- GrantRoleStmt
+GRANT r1, r2 TO 'u1'@'localhost';
+
+-- RevokeStmt
+REVOKE SELECT ON p1.users FROM 'u1'@'localhost';
+
+-- RevokeStmt
+REVOKE SELECT ON db1.t2 FROM 'u1'@'localhost';
+
+-- RevokeRoleStmt
+REVOKE r1 FROM 'u1'@'localhost';
+
+-- AlterInstanceStmt
+ALTER INSTANCE RELOAD TLS;
+
+-- DoStmt
+DO c - id;
+
+-- DoStmt
+DO id + 38;
+
+-- AdminStmt
+ADMIN CHECK TABLE t1;
+
+-- AdminStmt
+ADMIN SHOW DDL JOBS;
+
+-- ShutdownStmt
+SHUTDOWN;
+
+-- RestartStmt
+RESTART;
+
+-- ResetPersistStmt
+RESET PERSIST;
+
+-- ResetPersistStmt
+RESET PERSIST IF EXISTS p1;
+
+-- ResetPersistStmt
+RESET PERSIST IF EXISTS db1;
+
+-- HelpStmt
+HELP 'select';
+
+-- BRIEStmt
+BACKUP DATABASE v1 TO 'local:///tmp/backup/';
+
+-- BRIEStmt
+BACKUP DATABASE s1 TO 'local:///tmp/backup/';
+
+-- ImportIntoActionStmt
+CANCEL IMPORT JOB 44;
+
+-- ImportIntoActionStmt
+CANCEL IMPORT JOB 83;
+
+-- CancelDistributionJobStmt
+CANCEL DISTRIBUTION JOB 64;
+
+-- CancelDistributionJobStmt
+CANCEL DISTRIBUTION JOB 14;
+
+-- CalibrateResourceStmt
+CALIBRATE RESOURCE;
+
+-- DynamicCalibrateResourceOption
+CALIBRATE RESOURCE START_TIME '2021-04-15 00:00:00';
+
+-- AddQueryWatchStmt
+QUERY WATCH ADD RESOURCE GROUP db1 SQL TEXT EXACT TO 'select 1';
+
+-- AddQueryWatchStmt
+QUERY WATCH ADD RESOURCE GROUP p1 SQL TEXT EXACT TO 'select 1';
+
+-- QueryWatchOption
+QUERY WATCH ADD SQL DIGEST b13858789fce00208f9a262c99621b7045f4869807cd4e6568008ae7ca19a377;
+
+-- DropQueryWatchStmt
+QUERY WATCH REMOVE 55;
+
+-- DropQueryWatchStmt
+QUERY WATCH REMOVE 16;
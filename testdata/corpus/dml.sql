@@ -0,0 +1,103 @@
+-- Code generated by gencorpus with seed 1. DO NOT EDIT.
+
+-- SelectStmt
+SELECT b, 'bar baz' FROM users WHERE b > 16 ORDER BY a LIMIT 18;
+
+-- SelectStmt
+SELECT b, 60 FROM t1 WHERE c > 12 ORDER BY id LIMIT 18;
+
+-- SelectStmt
+SELECT DISTINCT x.b FROM t AS x JOIN t2 AS y ON x.b = y.a GROUP BY x.b;
+
+-- SelectStmt
+SELECT DISTINCT x.id FROM orders AS x JOIN t2 AS y ON x.c = y.c GROUP BY x.id;
+
+-- SetOprStmt
+SELECT a FROM t UNION ALL SELECT b FROM orders;
+
+-- SetOprStmt
+SELECT c FROM t1 UNION ALL SELECT name FROM t1;
+
+-- SetOprStmt
+SELECT c FROM t1 EXCEPT SELECT a FROM t1;
+
+-- SetOprStmt
+SELECT id FROM t EXCEPT SELECT name FROM orders;
+
+-- InsertStmt
+INSERT INTO orders (a, b) VALUES (17, '%a_'), (83, 'it''s');
+
+-- InsertStmt
+INSERT INTO t2 (a, b) VALUES (name, '%a_'), (41, 'abc');
+
+-- InsertStmt
+INSERT INTO orders SELECT * FROM orders WHERE id < 24;
+
+-- InsertStmt
+INSERT INTO t1 SELECT * FROM t WHERE a < 58;
+
+-- InsertStmt
+REPLACE INTO t1 SET a = 45, b = 'foo';
+
+-- InsertStmt
+REPLACE INTO t2 SET a = 78, b = 'foo';
+
+-- UpdateStmt
+UPDATE orders SET b = 60 WHERE c = 1;
+
+-- UpdateStmt
+UPDATE t2 SET id = a - id WHERE id = 89;
+
+-- DeleteStmt
+DELETE FROM orders WHERE b < 26 ORDER BY id LIMIT 42;
+
+-- DeleteStmt
+DELETE FROM t1 WHERE c < 48 ORDER BY b LIMIT 43;
+
+-- LoadDataStmt
+LOAD DATA INFILE '/tmp/t.csv' INTO TABLE t2 FIELDS TERMINATED BY ',' IGNORE 37 LINES;
+
+-- LoadDataStmt
+LOAD DATA INFILE '/tmp/t.csv' INTO TABLE orders FIELDS TERMINATED BY ',' IGNORE 4 LINES;
+
+-- ImportIntoStmt
+IMPORT INTO orders FROM '/tmp/t.csv';
+
+-- ImportIntoStmt
+IMPORT INTO t FROM '/tmp/t.csv';
+
+-- CallStmt
+CALL p1(40, 'foo');
+
+-- CallStmt
+CALL v1(27, 'bar baz');
+
+-- ShowStmt
+SHOW CREATE TABLE t;
+
+-- ShowStmt
+SHOW COLUMNS FROM t;
+
+-- NonTransactionalDMLStmt
+BATCH ON c LIMIT 43 DELETE FROM orders WHERE b = 81;
+
+-- NonTransactionalDMLStmt
+BATCH ON name LIMIT 68 DELETE FROM t2 WHERE c = 40;
+
+-- DistributeTableStmt
+DISTRIBUTE TABLE users RULE = 'leader-scatter' ENGINE = 'tikv';
+
+-- DistributeTableStmt
+DISTRIBUTE TABLE t1 RULE = 'leader-scatter' ENGINE = 'tikv';
+
+-- SplitRegionStmt
+SPLIT TABLE t2 INDEX idx_a BY ('foo'), ('%a_');
+
+-- SplitRegionStmt
+SPLIT TABLE t1 INDEX idx_a BY ('abc'), ('it''s');
+
+-- SplitOption
+SPLIT TABLE t1 BETWEEN (0) AND (1000000) REGIONS 68;
+
+-- SplitOption
+SPLIT TABLE t1 BETWEEN (0) AND (1000000) REGIONS 19;
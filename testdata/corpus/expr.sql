@@ -0,0 +1,208 @@
+-- Code generated by gencorpus with seed 1. DO NOT EDIT.
+
+-- BetweenExpr
+SELECT a BETWEEN 98 AND 9, c NOT BETWEEN 62 AND 18 FROM orders;
+
+-- BetweenExpr
+SELECT name BETWEEN 43 AND 25, c NOT BETWEEN 59 AND 35 FROM t1;
+
+-- BinaryOperationExpr
+SELECT name + 93 * b, b DIV 30 FROM t WHERE b > 71 AND name <> 'abc';
+
+-- BinaryOperationExpr
+SELECT id + 4 * a, c DIV 3 FROM t2 WHERE b > 95 AND name <> 'bar baz';
+
+-- CaseExpr
+SELECT CASE WHEN id > 43 THEN 'it''s' ELSE 'it''s' END FROM orders;
+
+-- CaseExpr
+SELECT CASE WHEN b > 82 THEN 'abc' ELSE 'abc' END FROM users;
+
+-- CaseExpr
+SELECT CASE c WHEN 77 THEN 'abc' WHEN 58 THEN '%a_' END FROM t;
+
+-- CaseExpr
+SELECT CASE id WHEN 62 THEN '%a_' WHEN 60 THEN 'it''s' END FROM t;
+
+-- SubqueryExpr
+SELECT (SELECT MAX(name) FROM t2) FROM t;
+
+-- SubqueryExpr
+SELECT (SELECT MAX(a) FROM t2) FROM users;
+
+-- CompareSubqueryExpr
+SELECT * FROM t WHERE id > ANY (SELECT b FROM t);
+
+-- CompareSubqueryExpr
+SELECT * FROM t1 WHERE a > ANY (SELECT b FROM users);
+
+-- CompareSubqueryExpr
+SELECT * FROM t2 WHERE b = ALL (SELECT c FROM t1);
+
+-- CompareSubqueryExpr
+SELECT * FROM t WHERE name = ALL (SELECT c FROM t2);
+
+-- TableNameExpr
+SELECT NEXTVAL(x);
+
+-- TableNameExpr
+SELECT NEXTVAL(s1);
+
+-- ColumnNameExpr
+SELECT b, orders.id FROM orders;
+
+-- ColumnNameExpr
+SELECT b, users.c FROM t2;
+
+-- DefaultExpr
+INSERT INTO t2 (a, b) VALUES (31, DEFAULT);
+
+-- DefaultExpr
+INSERT INTO t1 (a, b) VALUES (33, DEFAULT);
+
+-- DefaultExpr
+SELECT DEFAULT(a) FROM t2;
+
+-- DefaultExpr
+SELECT DEFAULT(id) FROM t;
+
+-- ExistsSubqueryExpr
+SELECT * FROM t1 WHERE NOT EXISTS (SELECT 1 FROM t1 WHERE c = 2);
+
+-- ExistsSubqueryExpr
+SELECT * FROM t WHERE NOT EXISTS (SELECT 1 FROM t2 WHERE name = 93);
+
+-- PatternInExpr
+SELECT * FROM t WHERE a IN (84, 22, '%a_');
+
+-- PatternInExpr
+SELECT * FROM t WHERE b IN (70, 24, 'foo');
+
+-- PatternInExpr
+SELECT * FROM t WHERE name NOT IN (SELECT c FROM orders);
+
+-- PatternInExpr
+SELECT * FROM t1 WHERE name NOT IN (SELECT a FROM users);
+
+-- IsNullExpr
+SELECT * FROM t WHERE name IS NULL OR name IS NOT NULL;
+
+-- IsNullExpr
+SELECT * FROM t WHERE c IS NULL OR a IS NOT NULL;
+
+-- IsTruthExpr
+SELECT a IS TRUE, name IS NOT FALSE FROM orders;
+
+-- IsTruthExpr
+SELECT a IS TRUE, id IS NOT FALSE FROM t1;
+
+-- PatternLikeOrIlikeExpr
+SELECT * FROM t WHERE name LIKE '%a_' OR a NOT ILIKE 'foo';
+
+-- PatternLikeOrIlikeExpr
+SELECT * FROM orders WHERE c LIKE '%a_' OR a NOT ILIKE '%a_';
+
+-- ParenthesesExpr
+SELECT (c + 70) * 22 FROM t1;
+
+-- ParenthesesExpr
+SELECT (id + 85) * 8 FROM t2;
+
+-- PositionExpr
+SELECT b, c FROM t1 ORDER BY 2, 1 DESC;
+
+-- PositionExpr
+SELECT a, c FROM users ORDER BY 2, 1 DESC;
+
+-- PatternRegexpExpr
+SELECT * FROM t2 WHERE name REGEXP 'foo';
+
+-- PatternRegexpExpr
+SELECT * FROM t1 WHERE a REGEXP 'foo';
+
+-- RowExpr
+SELECT ROW(id, 90) = ROW(85, name) FROM t2;
+
+-- RowExpr
+SELECT ROW(id, 76) = ROW(29, id) FROM users;
+
+-- UnaryOperationExpr
+SELECT -name, ~a, NOT name FROM t1;
+
+-- UnaryOperationExpr
+SELECT -c, ~b, NOT c FROM users;
+
+-- ValuesExpr
+INSERT INTO orders (a, b) VALUES (19, 'foo') ON DUPLICATE KEY UPDATE b = VALUES(b);
+
+-- ValuesExpr
+INSERT INTO orders (a, b) VALUES (7, 'bar baz') ON DUPLICATE KEY UPDATE b = VALUES(b);
+
+-- VariableExpr
+SELECT @y, @@sql_mode, @@SESSION.autocommit;
+
+-- VariableExpr
+SELECT @s1, @@sql_mode, @@SESSION.autocommit;
+
+-- MaxValueExpr
+CREATE TABLE users (a INT) PARTITION BY RANGE (a) (PARTITION p0 VALUES LESS THAN (8), PARTITION p1 VALUES LESS THAN MAXVALUE);
+
+-- MaxValueExpr
+CREATE TABLE t1 (a INT) PARTITION BY RANGE (a) (PARTITION p0 VALUES LESS THAN (65), PARTITION p1 VALUES LESS THAN MAXVALUE);
+
+-- MatchAgainst
+SELECT * FROM orders WHERE MATCH (b) AGAINST ('foo' IN BOOLEAN MODE);
+
+-- MatchAgainst
+SELECT * FROM t2 WHERE MATCH (c) AGAINST ('foo' IN BOOLEAN MODE);
+
+-- SetCollationExpr
+SELECT b COLLATE utf8mb4_bin FROM t;
+
+-- SetCollationExpr
+SELECT c COLLATE utf8mb4_bin FROM users;
+
+-- TrimDirectionExpr
+SELECT TRIM(LEADING 'it''s' FROM c) FROM t;
+
+-- TrimDirectionExpr
+SELECT TRIM(LEADING '%a_' FROM id) FROM users;
+
+-- TimeUnitExpr
+SELECT DATE_ADD(b, INTERVAL 86 DAY) FROM orders;
+
+-- TimeUnitExpr
+SELECT DATE_ADD(a, INTERVAL 57 DAY) FROM orders;
+
+-- GetFormatSelectorExpr
+SELECT GET_FORMAT(DATE, 'USA');
+
+-- FuncCallExpr
+SELECT CONCAT(a, 'abc'), IFNULL(b, 88) FROM t1;
+
+-- FuncCallExpr
+SELECT CONCAT(name, 'foo'), IFNULL(b, 70) FROM t;
+
+-- FuncCastExpr
+SELECT CAST(name AS CHAR), CONVERT(c, SIGNED) FROM t2;
+
+-- FuncCastExpr
+SELECT CAST(id AS CHAR), CONVERT(a, SIGNED) FROM orders;
+
+-- AggregateFuncExpr
+SELECT COUNT(*), SUM(a), MAX(DISTINCT id) FROM t1 GROUP BY a HAVING COUNT(*) > 28;
+
+-- AggregateFuncExpr
+SELECT COUNT(*), SUM(name), MAX(DISTINCT c) FROM orders GROUP BY c HAVING COUNT(*) > 58;
+
+-- WindowFuncExpr
+SELECT ROW_NUMBER() OVER (PARTITION BY c ORDER BY id) FROM orders;
+
+-- WindowFuncExpr
+SELECT ROW_NUMBER() OVER (PARTITION BY c ORDER BY b) FROM orders;
+
+-- JSONSumCrc32Expr
+SELECT JSON_SUM_CRC32(id AS UNSIGNED ARRAY) FROM t1;
+
+-- JSONSumCrc32Expr
+SELECT JSON_SUM_CRC32(name AS UNSIGNED ARRAY) FROM t1;
@@ -0,0 +1,133 @@
+-- Code generated by gencorpus with seed 1. DO NOT EDIT.
+
+-- ProcedureInfo
+CREATE PROCEDURE IF NOT EXISTS x(IN id INT, OUT total BIGINT) SELECT a FROM users WHERE c = id;
+
+-- DropProcedureStmt
+DROP PROCEDURE IF EXISTS db1;
+
+-- ProcedureBlock
+CREATE PROCEDURE v1() BEGIN DECLARE v INT DEFAULT 71; SELECT v; END;
+
+-- ProcedureBlock
+CREATE PROCEDURE db1() BEGIN DECLARE v INT DEFAULT 13; SELECT v; END;
+
+-- ProcedureIfInfo
+CREATE PROCEDURE y(i INT) BEGIN IF i > 41 THEN SELECT 29; END IF; END;
+
+-- ProcedureIfInfo
+CREATE PROCEDURE x(i INT) BEGIN IF i > 72 THEN SELECT 81; END IF; END;
+
+-- ProcedureIfBlock
+CREATE PROCEDURE y(i INT) BEGIN IF i > 38 THEN SELECT 1; ELSE SELECT 2; END IF; END;
+
+-- ProcedureIfBlock
+CREATE PROCEDURE s1(i INT) BEGIN IF i > 11 THEN SELECT 1; ELSE SELECT 2; END IF; END;
+
+-- ProcedureElseIfBlock
+CREATE PROCEDURE db1(i INT) BEGIN IF i > 22 THEN SELECT 1; ELSEIF i = 52 THEN SELECT 2; END IF; END;
+
+-- ProcedureElseIfBlock
+CREATE PROCEDURE s1(i INT) BEGIN IF i > 2 THEN SELECT 1; ELSEIF i = 83 THEN SELECT 2; END IF; END;
+
+-- ProcedureElseBlock
+CREATE PROCEDURE s1(i INT) BEGIN IF i > 77 THEN SELECT 1; ELSEIF i = 27 THEN SELECT 2; ELSE SELECT 3; END IF; END;
+
+-- ProcedureElseBlock
+CREATE PROCEDURE db1(i INT) BEGIN IF i > 75 THEN SELECT 1; ELSEIF i = 86 THEN SELECT 2; ELSE SELECT 3; END IF; END;
+
+-- SimpleCaseStmt
+CREATE PROCEDURE p1(i INT) CASE i WHEN 3 THEN SELECT 1; ELSE SELECT 2; END CASE;
+
+-- SimpleCaseStmt
+CREATE PROCEDURE x(i INT) CASE i WHEN 45 THEN SELECT 1; ELSE SELECT 2; END CASE;
+
+-- SimpleWhenThenStmt
+CREATE PROCEDURE p1(i INT) CASE i WHEN 23 THEN SELECT 1; WHEN 75 THEN SELECT 2; END CASE;
+
+-- SimpleWhenThenStmt
+CREATE PROCEDURE v1(i INT) CASE i WHEN 65 THEN SELECT 1; WHEN 27 THEN SELECT 2; END CASE;
+
+-- SearchCaseStmt
+CREATE PROCEDURE s1(i INT) CASE WHEN i = 15 THEN SELECT 1; ELSE SELECT 2; END CASE;
+
+-- SearchCaseStmt
+CREATE PROCEDURE y(i INT) CASE WHEN i = 71 THEN SELECT 1; ELSE SELECT 2; END CASE;
+
+-- SearchWhenThenStmt
+CREATE PROCEDURE db1(i INT) CASE WHEN i = 4 THEN SELECT 1; WHEN i > 27 THEN SELECT 2; END CASE;
+
+-- SearchWhenThenStmt
+CREATE PROCEDURE v1(i INT) CASE WHEN i = 61 THEN SELECT 1; WHEN i > 1 THEN SELECT 2; END CASE;
+
+-- ProcedureWhileStmt
+CREATE PROCEDURE y(i INT) BEGIN WHILE i < 63 DO SET i = i + 1; END WHILE; END;
+
+-- ProcedureWhileStmt
+CREATE PROCEDURE db1(i INT) BEGIN WHILE i < 84 DO SET i = i + 1; END WHILE; END;
+
+-- ProcedureRepeatStmt
+CREATE PROCEDURE s1(i INT) BEGIN REPEAT SET i = i + 1; UNTIL i > 86 END REPEAT; END;
+
+-- ProcedureRepeatStmt
+CREATE PROCEDURE v1(i INT) BEGIN REPEAT SET i = i + 1; UNTIL i > 77 END REPEAT; END;
+
+-- ProcedureOpenCur
+CREATE PROCEDURE x() BEGIN DECLARE cur CURSOR FOR SELECT c FROM users; OPEN cur; END;
+
+-- ProcedureOpenCur
+CREATE PROCEDURE s1() BEGIN DECLARE cur CURSOR FOR SELECT c FROM t; OPEN cur; END;
+
+-- ProcedureFetchInto
+CREATE PROCEDURE p1() BEGIN DECLARE v INT; DECLARE cur CURSOR FOR SELECT id FROM t; OPEN cur; FETCH cur INTO v; END;
+
+-- ProcedureFetchInto
+CREATE PROCEDURE v1() BEGIN DECLARE v INT; DECLARE cur CURSOR FOR SELECT name FROM orders; OPEN cur; FETCH cur INTO v; END;
+
+-- ProcedureCloseCur
+CREATE PROCEDURE p1() BEGIN DECLARE cur CURSOR FOR SELECT c FROM orders; OPEN cur; CLOSE cur; END;
+
+-- ProcedureCloseCur
+CREATE PROCEDURE s1() BEGIN DECLARE cur CURSOR FOR SELECT c FROM t1; OPEN cur; CLOSE cur; END;
+
+-- ProcedureErrorCondition
+CREATE PROCEDURE y() BEGIN DECLARE CONTINUE HANDLER FOR SQLEXCEPTION SELECT 99; SELECT 1; END;
+
+-- ProcedureErrorCondition
+CREATE PROCEDURE s1() BEGIN DECLARE CONTINUE HANDLER FOR SQLEXCEPTION SELECT 78; SELECT 1; END;
+
+-- ProcedureErrorCon
+CREATE PROCEDURE s1() BEGIN DECLARE EXIT HANDLER FOR SQLWARNING, NOT FOUND SELECT 80; SELECT 1; END;
+
+-- ProcedureErrorCon
+CREATE PROCEDURE y() BEGIN DECLARE EXIT HANDLER FOR SQLWARNING, NOT FOUND SELECT 86; SELECT 1; END;
+
+-- ProcedureErrorVal
+CREATE PROCEDURE x() BEGIN DECLARE EXIT HANDLER FOR 1062 SELECT 63; SELECT 1; END;
+
+-- ProcedureErrorVal
+CREATE PROCEDURE s1() BEGIN DECLARE EXIT HANDLER FOR 1062 SELECT 58; SELECT 1; END;
+
+-- ProcedureErrorState
+CREATE PROCEDURE p1() BEGIN DECLARE CONTINUE HANDLER FOR SQLSTATE '23000' SELECT 76; SELECT 1; END;
+
+-- ProcedureErrorState
+CREATE PROCEDURE v1() BEGIN DECLARE CONTINUE HANDLER FOR SQLSTATE '23000' SELECT 86; SELECT 1; END;
+
+-- ProcedureLabelBlock
+CREATE PROCEDURE db1() blk: BEGIN SELECT 98; END blk;
+
+-- ProcedureLabelBlock
+CREATE PROCEDURE y() blk: BEGIN SELECT 10; END blk;
+
+-- ProcedureLabelLoop
+CREATE PROCEDURE y(i INT) BEGIN lp: WHILE i < 33 DO SET i = i + 1; END WHILE lp; END;
+
+-- ProcedureLabelLoop
+CREATE PROCEDURE y(i INT) BEGIN lp: WHILE i < 44 DO SET i = i + 1; END WHILE lp; END;
+
+-- ProcedureJump
+CREATE PROCEDURE p1(i INT) BEGIN lp: REPEAT SET i = i + 1; IF i = 16 THEN ITERATE lp; END IF; IF i > 70 THEN LEAVE lp; END IF; UNTIL i > 100 END REPEAT lp; END;
+
+-- ProcedureJump
+CREATE PROCEDURE x(i INT) BEGIN lp: REPEAT SET i = i + 1; IF i = 14 THEN ITERATE lp; END IF; IF i > 40 THEN LEAVE lp; END IF; UNTIL i > 100 END REPEAT lp; END;
@@ -0,0 +1,3043 @@
+// Code generated by gentokenmatch. DO NOT EDIT.
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+// WARNING: This file is generated by 'gentokenmatch'
+
+// matchToken returns the token of lit in tokenMap, lit is matched with its ASCII letters
+// upper cased.
+func matchToken(lit string) (int, bool) {
+	switch len(lit) {
+	case 2:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "AS") {
+				return as, true
+			}
+			if equalFoldUpper(lit, "AT") {
+				return at, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BR") {
+				return br, true
+			}
+			if equalFoldUpper(lit, "BY") {
+				return by, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DO") {
+				return do, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IF") {
+				return ifKwd, true
+			}
+			if equalFoldUpper(lit, "IN") {
+				return in, true
+			}
+			if equalFoldUpper(lit, "IO") {
+				return io, true
+			}
+			if equalFoldUpper(lit, "IS") {
+				return is, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MB") {
+				return mb, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NO") {
+				return no, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OF") {
+				return of, true
+			}
+			if equalFoldUpper(lit, "ON") {
+				return on, true
+			}
+			if equalFoldUpper(lit, "OR") {
+				return or, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "RU") {
+				return ru, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "S3") {
+				return s3, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TO") {
+				return to, true
+			}
+		}
+	case 3:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ADD") {
+				return add, true
+			}
+			if equalFoldUpper(lit, "AGO") {
+				return ago, true
+			}
+			if equalFoldUpper(lit, "ALL") {
+				return all, true
+			}
+			if equalFoldUpper(lit, "AND") {
+				return and, true
+			}
+			if equalFoldUpper(lit, "ANY") {
+				return any, true
+			}
+			if equalFoldUpper(lit, "ASC") {
+				return asc, true
+			}
+			if equalFoldUpper(lit, "AVG") {
+				return avg, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BDR") {
+				return bdr, true
+			}
+			if equalFoldUpper(lit, "BIT") {
+				return bitType, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CPU") {
+				return cpu, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DAY") {
+				return day, true
+			}
+			if equalFoldUpper(lit, "DDL") {
+				return ddl, true
+			}
+			if equalFoldUpper(lit, "DEC") {
+				return decimalType, true
+			}
+			if equalFoldUpper(lit, "DIV") {
+				return div, true
+			}
+			if equalFoldUpper(lit, "DOT") {
+				return dotType, true
+			}
+			if equalFoldUpper(lit, "DRY") {
+				return dry, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "END") {
+				return end, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FOR") {
+				return forKwd, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "INT") {
+				return intType, true
+			}
+			if equalFoldUpper(lit, "IPC") {
+				return ipc, true
+			}
+		case 'J':
+			if equalFoldUpper(lit, "JOB") {
+				return job, true
+			}
+		case 'K':
+			if equalFoldUpper(lit, "KEY") {
+				return key, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LOG") {
+				return log, true
+			}
+			if equalFoldUpper(lit, "LOW") {
+				return low, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MAX") {
+				return max, true
+			}
+			if equalFoldUpper(lit, "MIN") {
+				return min, true
+			}
+			if equalFoldUpper(lit, "MOD") {
+				return mod, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NOT") {
+				return not, true
+			}
+			if equalFoldUpper(lit, "NOW") {
+				return now, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OFF") {
+				return off, true
+			}
+			if equalFoldUpper(lit, "OUT") {
+				return out, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "ROW") {
+				return row, true
+			}
+			if equalFoldUpper(lit, "RUN") {
+				return run, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SAN") {
+				return san, true
+			}
+			if equalFoldUpper(lit, "SET") {
+				return set, true
+			}
+			if equalFoldUpper(lit, "SQL") {
+				return sql, true
+			}
+			if equalFoldUpper(lit, "SSL") {
+				return ssl, true
+			}
+			if equalFoldUpper(lit, "STD") {
+				return stddevPop, true
+			}
+			if equalFoldUpper(lit, "SUM") {
+				return sum, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TLS") {
+				return tls, true
+			}
+			if equalFoldUpper(lit, "TOP") {
+				return top, true
+			}
+			if equalFoldUpper(lit, "TSO") {
+				return tsoType, true
+			}
+			if equalFoldUpper(lit, "TTL") {
+				return ttl, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "USE") {
+				return use, true
+			}
+		case 'X':
+			if equalFoldUpper(lit, "XOR") {
+				return xor, true
+			}
+		}
+	case 4:
+		switch lit[0] &^ 0x20 {
+		case 'B':
+			if equalFoldUpper(lit, "BLOB") {
+				return blobType, true
+			}
+			if equalFoldUpper(lit, "BOOL") {
+				return boolType, true
+			}
+			if equalFoldUpper(lit, "BOTH") {
+				return both, true
+			}
+			if equalFoldUpper(lit, "BYTE") {
+				return byteType, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CALL") {
+				return call, true
+			}
+			if equalFoldUpper(lit, "CASE") {
+				return caseKwd, true
+			}
+			if equalFoldUpper(lit, "CAST") {
+				return cast, true
+			}
+			if equalFoldUpper(lit, "CHAR") {
+				return charType, true
+			}
+			if equalFoldUpper(lit, "COPY") {
+				return copyKwd, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DATA") {
+				return data, true
+			}
+			if equalFoldUpper(lit, "DATE") {
+				return dateType, true
+			}
+			if equalFoldUpper(lit, "DESC") {
+				return desc, true
+			}
+			if equalFoldUpper(lit, "DISK") {
+				return disk, true
+			}
+			if equalFoldUpper(lit, "DROP") {
+				return drop, true
+			}
+			if equalFoldUpper(lit, "DUAL") {
+				return dual, true
+			}
+			if equalFoldUpper(lit, "DUMP") {
+				return dump, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "EACH") {
+				return each, true
+			}
+			if equalFoldUpper(lit, "ELSE") {
+				return elseKwd, true
+			}
+			if equalFoldUpper(lit, "ENDS") {
+				return ends, true
+			}
+			if equalFoldUpper(lit, "ENUM") {
+				return enum, true
+			}
+			if equalFoldUpper(lit, "EXIT") {
+				return exit, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FILE") {
+				return file, true
+			}
+			if equalFoldUpper(lit, "FROM") {
+				return from, true
+			}
+			if equalFoldUpper(lit, "FULL") {
+				return full, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HASH") {
+				return hash, true
+			}
+			if equalFoldUpper(lit, "HELP") {
+				return help, true
+			}
+			if equalFoldUpper(lit, "HIGH") {
+				return high, true
+			}
+			if equalFoldUpper(lit, "HNSW") {
+				return hnsw, true
+			}
+			if equalFoldUpper(lit, "HOUR") {
+				return hour, true
+			}
+			if equalFoldUpper(lit, "HYPO") {
+				return hypo, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "INT1") {
+				return int1Type, true
+			}
+			if equalFoldUpper(lit, "INT2") {
+				return int2Type, true
+			}
+			if equalFoldUpper(lit, "INT3") {
+				return int3Type, true
+			}
+			if equalFoldUpper(lit, "INT4") {
+				return int4Type, true
+			}
+			if equalFoldUpper(lit, "INT8") {
+				return int8Type, true
+			}
+			if equalFoldUpper(lit, "INTO") {
+				return into, true
+			}
+		case 'J':
+			if equalFoldUpper(lit, "JOBS") {
+				return jobs, true
+			}
+			if equalFoldUpper(lit, "JOIN") {
+				return join, true
+			}
+			if equalFoldUpper(lit, "JSON") {
+				return jsonType, true
+			}
+		case 'K':
+			if equalFoldUpper(lit, "KEYS") {
+				return keys, true
+			}
+			if equalFoldUpper(lit, "KILL") {
+				return kill, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LAST") {
+				return last, true
+			}
+			if equalFoldUpper(lit, "LEFT") {
+				return left, true
+			}
+			if equalFoldUpper(lit, "LESS") {
+				return less, true
+			}
+			if equalFoldUpper(lit, "LIKE") {
+				return like, true
+			}
+			if equalFoldUpper(lit, "LIST") {
+				return list, true
+			}
+			if equalFoldUpper(lit, "LOAD") {
+				return load, true
+			}
+			if equalFoldUpper(lit, "LOCK") {
+				return lock, true
+			}
+			if equalFoldUpper(lit, "LOGS") {
+				return logs, true
+			}
+			if equalFoldUpper(lit, "LONG") {
+				return long, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MODE") {
+				return mode, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NEXT") {
+				return next, true
+			}
+			if equalFoldUpper(lit, "NONE") {
+				return none, true
+			}
+			if equalFoldUpper(lit, "NULL") {
+				return null, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "ONLY") {
+				return only, true
+			}
+			if equalFoldUpper(lit, "OPEN") {
+				return open, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PAGE") {
+				return pageSym, true
+			}
+			if equalFoldUpper(lit, "PLAN") {
+				return plan, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "READ") {
+				return read, true
+			}
+			if equalFoldUpper(lit, "REAL") {
+				return realType, true
+			}
+			if equalFoldUpper(lit, "ROLE") {
+				return role, true
+			}
+			if equalFoldUpper(lit, "ROWS") {
+				return rows, true
+			}
+			if equalFoldUpper(lit, "RULE") {
+				return rule, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SHOW") {
+				return show, true
+			}
+			if equalFoldUpper(lit, "SKIP") {
+				return skip, true
+			}
+			if equalFoldUpper(lit, "SLOW") {
+				return slow, true
+			}
+			if equalFoldUpper(lit, "SOME") {
+				return some, true
+			}
+			if equalFoldUpper(lit, "SRID") {
+				return srid, true
+			}
+			if equalFoldUpper(lit, "STOP") {
+				return stop, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TEXT") {
+				return textType, true
+			}
+			if equalFoldUpper(lit, "THAN") {
+				return than, true
+			}
+			if equalFoldUpper(lit, "THEN") {
+				return then, true
+			}
+			if equalFoldUpper(lit, "TIDB") {
+				return tidb, true
+			}
+			if equalFoldUpper(lit, "TIME") {
+				return timeType, true
+			}
+			if equalFoldUpper(lit, "TOPN") {
+				return topn, true
+			}
+			if equalFoldUpper(lit, "TPCC") {
+				return tpcc, true
+			}
+			if equalFoldUpper(lit, "TREE") {
+				return tree, true
+			}
+			if equalFoldUpper(lit, "TRIM") {
+				return trim, true
+			}
+			if equalFoldUpper(lit, "TRUE") {
+				return trueKwd, true
+			}
+			if equalFoldUpper(lit, "TYPE") {
+				return tp, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "USER") {
+				return user, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VIEW") {
+				return view, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WAIT") {
+				return wait, true
+			}
+			if equalFoldUpper(lit, "WEEK") {
+				return week, true
+			}
+			if equalFoldUpper(lit, "WHEN") {
+				return when, true
+			}
+			if equalFoldUpper(lit, "WITH") {
+				return with, true
+			}
+		case 'X':
+			if equalFoldUpper(lit, "X509") {
+				return x509, true
+			}
+		case 'Y':
+			if equalFoldUpper(lit, "YEAR") {
+				return yearType, true
+			}
+		}
+	case 5:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ADMIN") {
+				return admin, true
+			}
+			if equalFoldUpper(lit, "AFTER") {
+				return after, true
+			}
+			if equalFoldUpper(lit, "ALTER") {
+				return alter, true
+			}
+			if equalFoldUpper(lit, "APPLY") {
+				return apply, true
+			}
+			if equalFoldUpper(lit, "ARRAY") {
+				return array, true
+			}
+			if equalFoldUpper(lit, "ASCII") {
+				return ascii, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BATCH") {
+				return batch, true
+			}
+			if equalFoldUpper(lit, "BEGIN") {
+				return begin, true
+			}
+			if equalFoldUpper(lit, "BLOCK") {
+				return block, true
+			}
+			if equalFoldUpper(lit, "BOUND") {
+				return bound, true
+			}
+			if equalFoldUpper(lit, "BRIEF") {
+				return briefType, true
+			}
+			if equalFoldUpper(lit, "BTREE") {
+				return btree, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CACHE") {
+				return cache, true
+			}
+			if equalFoldUpper(lit, "CHAIN") {
+				return chain, true
+			}
+			if equalFoldUpper(lit, "CHECK") {
+				return check, true
+			}
+			if equalFoldUpper(lit, "CLOSE") {
+				return close, true
+			}
+			if equalFoldUpper(lit, "CROSS") {
+				return cross, true
+			}
+			if equalFoldUpper(lit, "CYCLE") {
+				return cycle, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DEPTH") {
+				return depth, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ERROR") {
+				return errorKwd, true
+			}
+			if equalFoldUpper(lit, "EVENT") {
+				return event, true
+			}
+			if equalFoldUpper(lit, "EVERY") {
+				return every, true
+			}
+			if equalFoldUpper(lit, "EXACT") {
+				return exact, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FALSE") {
+				return falseKwd, true
+			}
+			if equalFoldUpper(lit, "FETCH") {
+				return fetch, true
+			}
+			if equalFoldUpper(lit, "FIRST") {
+				return first, true
+			}
+			if equalFoldUpper(lit, "FIXED") {
+				return fixed, true
+			}
+			if equalFoldUpper(lit, "FLOAT") {
+				return floatType, true
+			}
+			if equalFoldUpper(lit, "FLUSH") {
+				return flush, true
+			}
+			if equalFoldUpper(lit, "FORCE") {
+				return force, true
+			}
+			if equalFoldUpper(lit, "FOUND") {
+				return found, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GRANT") {
+				return grant, true
+			}
+			if equalFoldUpper(lit, "GROUP") {
+				return group, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HOSTS") {
+				return hosts, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "ILIKE") {
+				return ilike, true
+			}
+			if equalFoldUpper(lit, "INDEX") {
+				return index, true
+			}
+			if equalFoldUpper(lit, "INNER") {
+				return inner, true
+			}
+			if equalFoldUpper(lit, "INOUT") {
+				return inout, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LEAVE") {
+				return leave, true
+			}
+			if equalFoldUpper(lit, "LEVEL") {
+				return level, true
+			}
+			if equalFoldUpper(lit, "LIMIT") {
+				return limit, true
+			}
+			if equalFoldUpper(lit, "LINES") {
+				return lines, true
+			}
+			if equalFoldUpper(lit, "LOCAL") {
+				return local, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MATCH") {
+				return match, true
+			}
+			if equalFoldUpper(lit, "MERGE") {
+				return merge, true
+			}
+			if equalFoldUpper(lit, "MONTH") {
+				return month, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NAMES") {
+				return names, true
+			}
+			if equalFoldUpper(lit, "NCHAR") {
+				return ncharType, true
+			}
+			if equalFoldUpper(lit, "NEVER") {
+				return never, true
+			}
+			if equalFoldUpper(lit, "NULLS") {
+				return nulls, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "ORDER") {
+				return order, true
+			}
+			if equalFoldUpper(lit, "OUTER") {
+				return outer, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PAUSE") {
+				return pause, true
+			}
+			if equalFoldUpper(lit, "POINT") {
+				return point, true
+			}
+			if equalFoldUpper(lit, "PROXY") {
+				return proxy, true
+			}
+			if equalFoldUpper(lit, "PURGE") {
+				return purge, true
+			}
+		case 'Q':
+			if equalFoldUpper(lit, "QUERY") {
+				return query, true
+			}
+			if equalFoldUpper(lit, "QUICK") {
+				return quick, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "RANGE") {
+				return rangeKwd, true
+			}
+			if equalFoldUpper(lit, "RESET") {
+				return reset, true
+			}
+			if equalFoldUpper(lit, "REUSE") {
+				return reuse, true
+			}
+			if equalFoldUpper(lit, "RIGHT") {
+				return right, true
+			}
+			if equalFoldUpper(lit, "RLIKE") {
+				return rlike, true
+			}
+			if equalFoldUpper(lit, "RTREE") {
+				return rtree, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SHARE") {
+				return share, true
+			}
+			if equalFoldUpper(lit, "SLAVE") {
+				return slave, true
+			}
+			if equalFoldUpper(lit, "SPEED") {
+				return speed, true
+			}
+			if equalFoldUpper(lit, "SPLIT") {
+				return split, true
+			}
+			if equalFoldUpper(lit, "START") {
+				return start, true
+			}
+			if equalFoldUpper(lit, "STATS") {
+				return stats, true
+			}
+			if equalFoldUpper(lit, "SUPER") {
+				return super, true
+			}
+			if equalFoldUpper(lit, "SWAPS") {
+				return swaps, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TABLE") {
+				return tableKwd, true
+			}
+			if equalFoldUpper(lit, "TRACE") {
+				return trace, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UNION") {
+				return union, true
+			}
+			if equalFoldUpper(lit, "UNSET") {
+				return unset, true
+			}
+			if equalFoldUpper(lit, "UNTIL") {
+				return until, true
+			}
+			if equalFoldUpper(lit, "USAGE") {
+				return usage, true
+			}
+			if equalFoldUpper(lit, "USING") {
+				return using, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VALUE") {
+				return value, true
+			}
+			if equalFoldUpper(lit, "VOTER") {
+				return voter, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WATCH") {
+				return watch, true
+			}
+			if equalFoldUpper(lit, "WHERE") {
+				return where, true
+			}
+			if equalFoldUpper(lit, "WHILE") {
+				return while, true
+			}
+			if equalFoldUpper(lit, "WIDTH") {
+				return width, true
+			}
+			if equalFoldUpper(lit, "WRITE") {
+				return write, true
+			}
+		}
+	case 6:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ACTION") {
+				return action, true
+			}
+			if equalFoldUpper(lit, "ADVISE") {
+				return advise, true
+			}
+			if equalFoldUpper(lit, "ALWAYS") {
+				return always, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BACKUP") {
+				return backup, true
+			}
+			if equalFoldUpper(lit, "BEFORE") {
+				return before, true
+			}
+			if equalFoldUpper(lit, "BIGINT") {
+				return bigIntType, true
+			}
+			if equalFoldUpper(lit, "BINARY") {
+				return binaryType, true
+			}
+			if equalFoldUpper(lit, "BINLOG") {
+				return binlog, true
+			}
+			if equalFoldUpper(lit, "BIT_OR") {
+				return bitOr, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CANCEL") {
+				return cancel, true
+			}
+			if equalFoldUpper(lit, "CAUSAL") {
+				return causal, true
+			}
+			if equalFoldUpper(lit, "CHANGE") {
+				return change, true
+			}
+			if equalFoldUpper(lit, "CIPHER") {
+				return cipher, true
+			}
+			if equalFoldUpper(lit, "CLIENT") {
+				return client, true
+			}
+			if equalFoldUpper(lit, "COLUMN") {
+				return column, true
+			}
+			if equalFoldUpper(lit, "COMMIT") {
+				return commit, true
+			}
+			if equalFoldUpper(lit, "CONFIG") {
+				return config, true
+			}
+			if equalFoldUpper(lit, "CREATE") {
+				return create, true
+			}
+			if equalFoldUpper(lit, "CURSOR") {
+				return cursor, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DELETE") {
+				return deleteKwd, true
+			}
+			if equalFoldUpper(lit, "DIGEST") {
+				return digest, true
+			}
+			if equalFoldUpper(lit, "DOUBLE") {
+				return doubleType, true
+			}
+			if equalFoldUpper(lit, "DRYRUN") {
+				return dryRun, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ELSEIF") {
+				return elseIfKwd, true
+			}
+			if equalFoldUpper(lit, "ENABLE") {
+				return enable, true
+			}
+			if equalFoldUpper(lit, "ENGINE") {
+				return engine, true
+			}
+			if equalFoldUpper(lit, "ERRORS") {
+				return identSQLErrors, true
+			}
+			if equalFoldUpper(lit, "ESCAPE") {
+				return escape, true
+			}
+			if equalFoldUpper(lit, "EVENTS") {
+				return events, true
+			}
+			if equalFoldUpper(lit, "EVOLVE") {
+				return evolve, true
+			}
+			if equalFoldUpper(lit, "EXCEPT") {
+				return except, true
+			}
+			if equalFoldUpper(lit, "EXISTS") {
+				return exists, true
+			}
+			if equalFoldUpper(lit, "EXPIRE") {
+				return expire, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FAULTS") {
+				return faultsSym, true
+			}
+			if equalFoldUpper(lit, "FIELDS") {
+				return fields, true
+			}
+			if equalFoldUpper(lit, "FLOAT4") {
+				return float4Type, true
+			}
+			if equalFoldUpper(lit, "FLOAT8") {
+				return float8Type, true
+			}
+			if equalFoldUpper(lit, "FORMAT") {
+				return format, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GC_TTL") {
+				return gcTTL, true
+			}
+			if equalFoldUpper(lit, "GLOBAL") {
+				return global, true
+			}
+			if equalFoldUpper(lit, "GRANTS") {
+				return grants, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HAVING") {
+				return having, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IGNORE") {
+				return ignore, true
+			}
+			if equalFoldUpper(lit, "IMPORT") {
+				return importKwd, true
+			}
+			if equalFoldUpper(lit, "INFILE") {
+				return infile, true
+			}
+			if equalFoldUpper(lit, "INSERT") {
+				return insert, true
+			}
+			if equalFoldUpper(lit, "ISSUER") {
+				return issuer, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LABELS") {
+				return labels, true
+			}
+			if equalFoldUpper(lit, "LEADER") {
+				return leader, true
+			}
+			if equalFoldUpper(lit, "LEAVES") {
+				return leaves, true
+			}
+			if equalFoldUpper(lit, "LINEAR") {
+				return linear, true
+			}
+			if equalFoldUpper(lit, "LOCKED") {
+				return locked, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MASTER") {
+				return master, true
+			}
+			if equalFoldUpper(lit, "MEDIUM") {
+				return medium, true
+			}
+			if equalFoldUpper(lit, "MEMBER") {
+				return member, true
+			}
+			if equalFoldUpper(lit, "MEMORY") {
+				return memory, true
+			}
+			if equalFoldUpper(lit, "MINUTE") {
+				return minute, true
+			}
+			if equalFoldUpper(lit, "MODIFY") {
+				return modify, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NOWAIT") {
+				return nowait, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OFFSET") {
+				return offset, true
+			}
+			if equalFoldUpper(lit, "ONLINE") {
+				return online, true
+			}
+			if equalFoldUpper(lit, "OPTION") {
+				return option, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PARSER") {
+				return parser, true
+			}
+			if equalFoldUpper(lit, "PER_DB") {
+				return per_db, true
+			}
+			if equalFoldUpper(lit, "POLICY") {
+				return policy, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "RECENT") {
+				return recent, true
+			}
+			if equalFoldUpper(lit, "REGEXP") {
+				return regexpKwd, true
+			}
+			if equalFoldUpper(lit, "REGION") {
+				return region, true
+			}
+			if equalFoldUpper(lit, "RELOAD") {
+				return reload, true
+			}
+			if equalFoldUpper(lit, "REMOVE") {
+				return remove, true
+			}
+			if equalFoldUpper(lit, "RENAME") {
+				return rename, true
+			}
+			if equalFoldUpper(lit, "REPAIR") {
+				return repair, true
+			}
+			if equalFoldUpper(lit, "REPEAT") {
+				return repeat, true
+			}
+			if equalFoldUpper(lit, "REPLAY") {
+				return replay, true
+			}
+			if equalFoldUpper(lit, "RESUME") {
+				return resume, true
+			}
+			if equalFoldUpper(lit, "REVOKE") {
+				return revoke, true
+			}
+			if equalFoldUpper(lit, "ROLLUP") {
+				return rollup, true
+			}
+			if equalFoldUpper(lit, "ROTATE") {
+				return rotate, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SCHEMA") {
+				return database, true
+			}
+			if equalFoldUpper(lit, "SECOND") {
+				return second, true
+			}
+			if equalFoldUpper(lit, "SELECT") {
+				return selectKwd, true
+			}
+			if equalFoldUpper(lit, "SERIAL") {
+				return serial, true
+			}
+			if equalFoldUpper(lit, "SETVAL") {
+				return setval, true
+			}
+			if equalFoldUpper(lit, "SHARED") {
+				return shared, true
+			}
+			if equalFoldUpper(lit, "SIGNED") {
+				return signed, true
+			}
+			if equalFoldUpper(lit, "SIMPLE") {
+				return simple, true
+			}
+			if equalFoldUpper(lit, "SOURCE") {
+				return source, true
+			}
+			if equalFoldUpper(lit, "STARTS") {
+				return starts, true
+			}
+			if equalFoldUpper(lit, "STATUS") {
+				return status, true
+			}
+			if equalFoldUpper(lit, "STDDEV") {
+				return stddevPop, true
+			}
+			if equalFoldUpper(lit, "STORED") {
+				return stored, true
+			}
+			if equalFoldUpper(lit, "STRICT") {
+				return strict, true
+			}
+			if equalFoldUpper(lit, "STRONG") {
+				return strong, true
+			}
+			if equalFoldUpper(lit, "SUBSTR") {
+				return substring, true
+			}
+			if equalFoldUpper(lit, "SYSTEM") {
+				return system, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TABLES") {
+				return tables, true
+			}
+			if equalFoldUpper(lit, "TARGET") {
+				return target, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UNIQUE") {
+				return unique, true
+			}
+			if equalFoldUpper(lit, "UNLOCK") {
+				return unlock, true
+			}
+			if equalFoldUpper(lit, "UPDATE") {
+				return update, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VALUES") {
+				return values, true
+			}
+			if equalFoldUpper(lit, "VECTOR") {
+				return vectorType, true
+			}
+			if equalFoldUpper(lit, "VOTERS") {
+				return voters, true
+			}
+		}
+	case 7:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ACCOUNT") {
+				return account, true
+			}
+			if equalFoldUpper(lit, "ADDDATE") {
+				return addDate, true
+			}
+			if equalFoldUpper(lit, "AGAINST") {
+				return against, true
+			}
+			if equalFoldUpper(lit, "ANALYZE") {
+				return analyze, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BACKEND") {
+				return backend, true
+			}
+			if equalFoldUpper(lit, "BACKUPS") {
+				return backups, true
+			}
+			if equalFoldUpper(lit, "BETWEEN") {
+				return between, true
+			}
+			if equalFoldUpper(lit, "BINDING") {
+				return binding, true
+			}
+			if equalFoldUpper(lit, "BIT_AND") {
+				return bitAnd, true
+			}
+			if equalFoldUpper(lit, "BIT_XOR") {
+				return bitXor, true
+			}
+			if equalFoldUpper(lit, "BOOLEAN") {
+				return booleanType, true
+			}
+			if equalFoldUpper(lit, "BUCKETS") {
+				return buckets, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CAPTURE") {
+				return capture, true
+			}
+			if equalFoldUpper(lit, "CASCADE") {
+				return cascade, true
+			}
+			if equalFoldUpper(lit, "CHARSET") {
+				return charsetKwd, true
+			}
+			if equalFoldUpper(lit, "CLEANUP") {
+				return cleanup, true
+			}
+			if equalFoldUpper(lit, "CLUSTER") {
+				return cluster, true
+			}
+			if equalFoldUpper(lit, "COLLATE") {
+				return collate, true
+			}
+			if equalFoldUpper(lit, "COLUMNS") {
+				return columns, true
+			}
+			if equalFoldUpper(lit, "COMMENT") {
+				return comment, true
+			}
+			if equalFoldUpper(lit, "COMPACT") {
+				return compact, true
+			}
+			if equalFoldUpper(lit, "CONTEXT") {
+				return context, true
+			}
+			if equalFoldUpper(lit, "CONVERT") {
+				return convert, true
+			}
+			if equalFoldUpper(lit, "CURDATE") {
+				return curDate, true
+			}
+			if equalFoldUpper(lit, "CURRENT") {
+				return current, true
+			}
+			if equalFoldUpper(lit, "CURTIME") {
+				return curTime, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DECIMAL") {
+				return decimalType, true
+			}
+			if equalFoldUpper(lit, "DECLARE") {
+				return declare, true
+			}
+			if equalFoldUpper(lit, "DEFAULT") {
+				return defaultKwd, true
+			}
+			if equalFoldUpper(lit, "DEFINED") {
+				return defined, true
+			}
+			if equalFoldUpper(lit, "DEFINER") {
+				return definer, true
+			}
+			if equalFoldUpper(lit, "DELAYED") {
+				return delayed, true
+			}
+			if equalFoldUpper(lit, "DISABLE") {
+				return disable, true
+			}
+			if equalFoldUpper(lit, "DISCARD") {
+				return discard, true
+			}
+			if equalFoldUpper(lit, "DYNAMIC") {
+				return dynamic, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ENABLED") {
+				return enabled, true
+			}
+			if equalFoldUpper(lit, "ENGINES") {
+				return engines, true
+			}
+			if equalFoldUpper(lit, "ESCAPED") {
+				return escaped, true
+			}
+			if equalFoldUpper(lit, "EXECUTE") {
+				return execute, true
+			}
+			if equalFoldUpper(lit, "EXPLAIN") {
+				return explain, true
+			}
+			if equalFoldUpper(lit, "EXPLORE") {
+				return explore, true
+			}
+			if equalFoldUpper(lit, "EXTRACT") {
+				return extract, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FOLLOWS") {
+				return follows, true
+			}
+			if equalFoldUpper(lit, "FOREIGN") {
+				return foreign, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GENERAL") {
+				return general, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HANDLER") {
+				return handler, true
+			}
+			if equalFoldUpper(lit, "HISTORY") {
+				return history, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IMPORTS") {
+				return imports, true
+			}
+			if equalFoldUpper(lit, "INDEXES") {
+				return indexes, true
+			}
+			if equalFoldUpper(lit, "INPLACE") {
+				return inplace, true
+			}
+			if equalFoldUpper(lit, "INSTANT") {
+				return instant, true
+			}
+			if equalFoldUpper(lit, "INTEGER") {
+				return integerType, true
+			}
+			if equalFoldUpper(lit, "INVOKER") {
+				return invoker, true
+			}
+			if equalFoldUpper(lit, "ITERATE") {
+				return iterate, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LASTVAL") {
+				return lastval, true
+			}
+			if equalFoldUpper(lit, "LEADING") {
+				return leading, true
+			}
+			if equalFoldUpper(lit, "LEARNER") {
+				return learner, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NATURAL") {
+				return natural, true
+			}
+			if equalFoldUpper(lit, "NEXTVAL") {
+				return nextval, true
+			}
+			if equalFoldUpper(lit, "NOCACHE") {
+				return nocache, true
+			}
+			if equalFoldUpper(lit, "NOCYCLE") {
+				return nocycle, true
+			}
+			if equalFoldUpper(lit, "NODE_ID") {
+				return nodeID, true
+			}
+			if equalFoldUpper(lit, "NUMERIC") {
+				return numericType, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OUTFILE") {
+				return outfile, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PARTIAL") {
+				return partial, true
+			}
+			if equalFoldUpper(lit, "PERCENT") {
+				return percent, true
+			}
+			if equalFoldUpper(lit, "PERSIST") {
+				return persist, true
+			}
+			if equalFoldUpper(lit, "PLUGINS") {
+				return plugins, true
+			}
+			if equalFoldUpper(lit, "POLYGON") {
+				return polygonType, true
+			}
+			if equalFoldUpper(lit, "PREPARE") {
+				return prepare, true
+			}
+			if equalFoldUpper(lit, "PRIMARY") {
+				return primary, true
+			}
+			if equalFoldUpper(lit, "PROCESS") {
+				return process, true
+			}
+			if equalFoldUpper(lit, "PROFILE") {
+				return profile, true
+			}
+		case 'Q':
+			if equalFoldUpper(lit, "QUARTER") {
+				return quarter, true
+			}
+			if equalFoldUpper(lit, "QUERIES") {
+				return queries, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "REBUILD") {
+				return rebuild, true
+			}
+			if equalFoldUpper(lit, "RECOVER") {
+				return recover, true
+			}
+			if equalFoldUpper(lit, "REFRESH") {
+				return refresh, true
+			}
+			if equalFoldUpper(lit, "REGIONS") {
+				return regions, true
+			}
+			if equalFoldUpper(lit, "RELEASE") {
+				return release, true
+			}
+			if equalFoldUpper(lit, "REPLACE") {
+				return replace, true
+			}
+			if equalFoldUpper(lit, "REPLICA") {
+				return replica, true
+			}
+			if equalFoldUpper(lit, "REQUIRE") {
+				return require, true
+			}
+			if equalFoldUpper(lit, "RESPECT") {
+				return respect, true
+			}
+			if equalFoldUpper(lit, "RESTART") {
+				return restart, true
+			}
+			if equalFoldUpper(lit, "RESTORE") {
+				return restore, true
+			}
+			if equalFoldUpper(lit, "REVERSE") {
+				return reverse, true
+			}
+			if equalFoldUpper(lit, "ROUTINE") {
+				return routine, true
+			}
+			if equalFoldUpper(lit, "RUNNING") {
+				return running, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SAMPLES") {
+				return samples, true
+			}
+			if equalFoldUpper(lit, "SCHEMAS") {
+				return databases, true
+			}
+			if equalFoldUpper(lit, "SESSION") {
+				return session, true
+			}
+			if equalFoldUpper(lit, "SIMILAR") {
+				return similar, true
+			}
+			if equalFoldUpper(lit, "SPATIAL") {
+				return spatial, true
+			}
+			if equalFoldUpper(lit, "STORAGE") {
+				return storage, true
+			}
+			if equalFoldUpper(lit, "SUBDATE") {
+				return subDate, true
+			}
+			if equalFoldUpper(lit, "SUBJECT") {
+				return subject, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TIFLASH") {
+				return tiFlash, true
+			}
+			if equalFoldUpper(lit, "TIMEOUT") {
+				return timeout, true
+			}
+			if equalFoldUpper(lit, "TINYINT") {
+				return tinyIntType, true
+			}
+			if equalFoldUpper(lit, "TPCH_10") {
+				return tpch10, true
+			}
+			if equalFoldUpper(lit, "TRAFFIC") {
+				return traffic, true
+			}
+			if equalFoldUpper(lit, "TRIGGER") {
+				return trigger, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UNICODE") {
+				return unicodeSym, true
+			}
+			if equalFoldUpper(lit, "UNKNOWN") {
+				return unknown, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VARCHAR") {
+				return varcharType, true
+			}
+			if equalFoldUpper(lit, "VARYING") {
+				return varying, true
+			}
+			if equalFoldUpper(lit, "VAR_POP") {
+				return varPop, true
+			}
+			if equalFoldUpper(lit, "VERBOSE") {
+				return verboseType, true
+			}
+			if equalFoldUpper(lit, "VIRTUAL") {
+				return virtual, true
+			}
+			if equalFoldUpper(lit, "VISIBLE") {
+				return visible, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WITHOUT") {
+				return without, true
+			}
+		}
+	case 8:
+		switch lit[0] &^ 0x20 {
+		case 'B':
+			if equalFoldUpper(lit, "BINDINGS") {
+				return bindings, true
+			}
+			if equalFoldUpper(lit, "BUILTINS") {
+				return builtins, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CASCADED") {
+				return cascaded, true
+			}
+			if equalFoldUpper(lit, "CHECKSUM") {
+				return checksum, true
+			}
+			if equalFoldUpper(lit, "CMSKETCH") {
+				return cmSketch, true
+			}
+			if equalFoldUpper(lit, "COALESCE") {
+				return coalesce, true
+			}
+			if equalFoldUpper(lit, "COLUMNAR") {
+				return columnar, true
+			}
+			if equalFoldUpper(lit, "COMPRESS") {
+				return compress, true
+			}
+			if equalFoldUpper(lit, "CONTINUE") {
+				return continueKwd, true
+			}
+			if equalFoldUpper(lit, "COOLDOWN") {
+				return cooldown, true
+			}
+			if equalFoldUpper(lit, "CSV_NULL") {
+				return csvNull, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DATABASE") {
+				return database, true
+			}
+			if equalFoldUpper(lit, "DATETIME") {
+				return datetimeType, true
+			}
+			if equalFoldUpper(lit, "DATE_ADD") {
+				return dateAdd, true
+			}
+			if equalFoldUpper(lit, "DATE_SUB") {
+				return dateSub, true
+			}
+			if equalFoldUpper(lit, "DAY_HOUR") {
+				return dayHour, true
+			}
+			if equalFoldUpper(lit, "DESCRIBE") {
+				return describe, true
+			}
+			if equalFoldUpper(lit, "DISABLED") {
+				return disabled, true
+			}
+			if equalFoldUpper(lit, "DISTINCT") {
+				return distinct, true
+			}
+			if equalFoldUpper(lit, "DUMPFILE") {
+				return dumpfile, true
+			}
+			if equalFoldUpper(lit, "DURATION") {
+				return timeDuration, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ENCLOSED") {
+				return enclosed, true
+			}
+			if equalFoldUpper(lit, "END_TIME") {
+				return endTime, true
+			}
+			if equalFoldUpper(lit, "ENFORCED") {
+				return enforced, true
+			}
+			if equalFoldUpper(lit, "EXCHANGE") {
+				return exchange, true
+			}
+			if equalFoldUpper(lit, "EXTENDED") {
+				return extended, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FOLLOWER") {
+				return follower, true
+			}
+			if equalFoldUpper(lit, "FULLTEXT") {
+				return fulltext, true
+			}
+			if equalFoldUpper(lit, "FUNCTION") {
+				return function, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GEOMETRY") {
+				return geometryType, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "INSTANCE") {
+				return instance, true
+			}
+			if equalFoldUpper(lit, "INTERNAL") {
+				return internal, true
+			}
+			if equalFoldUpper(lit, "INTERVAL") {
+				return interval, true
+			}
+			if equalFoldUpper(lit, "INVERTED") {
+				return inverted, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LANGUAGE") {
+				return language, true
+			}
+			if equalFoldUpper(lit, "LEARNERS") {
+				return learners, true
+			}
+			if equalFoldUpper(lit, "LOCATION") {
+				return location, true
+			}
+			if equalFoldUpper(lit, "LONGBLOB") {
+				return longblobType, true
+			}
+			if equalFoldUpper(lit, "LONGTEXT") {
+				return longtextType, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MAXVALUE") {
+				return maxValue, true
+			}
+			if equalFoldUpper(lit, "MAX_ROWS") {
+				return maxRows, true
+			}
+			if equalFoldUpper(lit, "METADATA") {
+				return metadata, true
+			}
+			if equalFoldUpper(lit, "MINVALUE") {
+				return minValue, true
+			}
+			if equalFoldUpper(lit, "MIN_ROWS") {
+				return minRows, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NATIONAL") {
+				return national, true
+			}
+			if equalFoldUpper(lit, "NVARCHAR") {
+				return nvarcharType, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OPTIMIZE") {
+				return optimize, true
+			}
+			if equalFoldUpper(lit, "OPTIONAL") {
+				return optional, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PASSWORD") {
+				return password, true
+			}
+			if equalFoldUpper(lit, "POSITION") {
+				return position, true
+			}
+			if equalFoldUpper(lit, "PRECEDES") {
+				return precedes, true
+			}
+			if equalFoldUpper(lit, "PRESERVE") {
+				return preserve, true
+			}
+			if equalFoldUpper(lit, "PRIORITY") {
+				return priority, true
+			}
+			if equalFoldUpper(lit, "PROFILES") {
+				return profiles, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "REPLAYER") {
+				return replayer, true
+			}
+			if equalFoldUpper(lit, "REPLICAS") {
+				return replicas, true
+			}
+			if equalFoldUpper(lit, "REQUIRED") {
+				return required, true
+			}
+			if equalFoldUpper(lit, "RESOURCE") {
+				return resource, true
+			}
+			if equalFoldUpper(lit, "RESTORES") {
+				return restores, true
+			}
+			if equalFoldUpper(lit, "RESTRICT") {
+				return restrict, true
+			}
+			if equalFoldUpper(lit, "ROLLBACK") {
+				return rollback, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SCHEDULE") {
+				return schedule, true
+			}
+			if equalFoldUpper(lit, "SECURITY") {
+				return security, true
+			}
+			if equalFoldUpper(lit, "SEQUENCE") {
+				return sequence, true
+			}
+			if equalFoldUpper(lit, "SHUTDOWN") {
+				return shutdown, true
+			}
+			if equalFoldUpper(lit, "SMALLINT") {
+				return smallIntType, true
+			}
+			if equalFoldUpper(lit, "SNAPSHOT") {
+				return snapshot, true
+			}
+			if equalFoldUpper(lit, "SQLSTATE") {
+				return sqlstate, true
+			}
+			if equalFoldUpper(lit, "STARTING") {
+				return starting, true
+			}
+			if equalFoldUpper(lit, "START_TS") {
+				return startTS, true
+			}
+			if equalFoldUpper(lit, "SWITCHES") {
+				return switchesSym, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TINYBLOB") {
+				return tinyblobType, true
+			}
+			if equalFoldUpper(lit, "TINYTEXT") {
+				return tinytextType, true
+			}
+			if equalFoldUpper(lit, "TRAILING") {
+				return trailing, true
+			}
+			if equalFoldUpper(lit, "TRIGGERS") {
+				return triggers, true
+			}
+			if equalFoldUpper(lit, "TRUNCATE") {
+				return truncate, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UNSIGNED") {
+				return unsigned, true
+			}
+			if equalFoldUpper(lit, "UNTIL_TS") {
+				return untilTS, true
+			}
+			if equalFoldUpper(lit, "UTC_DATE") {
+				return utcDate, true
+			}
+			if equalFoldUpper(lit, "UTC_TIME") {
+				return utcTime, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VARIANCE") {
+				return varPop, true
+			}
+			if equalFoldUpper(lit, "VAR_SAMP") {
+				return varSamp, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WARNINGS") {
+				return warnings, true
+			}
+			if equalFoldUpper(lit, "WORKLOAD") {
+				return workload, true
+			}
+		case 'Z':
+			if equalFoldUpper(lit, "ZEROFILL") {
+				return zerofill, true
+			}
+		}
+	case 9:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ALGORITHM") {
+				return algorithm, true
+			}
+			if equalFoldUpper(lit, "ATTRIBUTE") {
+				return attribute, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BERNOULLI") {
+				return bernoulli, true
+			}
+			if equalFoldUpper(lit, "BURSTABLE") {
+				return burstable, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CALIBRATE") {
+				return calibrate, true
+			}
+			if equalFoldUpper(lit, "CHARACTER") {
+				return character, true
+			}
+			if equalFoldUpper(lit, "CLUSTERED") {
+				return clustered, true
+			}
+			if equalFoldUpper(lit, "COLLATION") {
+				return collation, true
+			}
+			if equalFoldUpper(lit, "COMMITTED") {
+				return committed, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DATABASES") {
+				return databases, true
+			}
+			if equalFoldUpper(lit, "DIRECTORY") {
+				return directory, true
+			}
+			if equalFoldUpper(lit, "DUPLICATE") {
+				return duplicate, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "EXCLUSIVE") {
+				return exclusive, true
+			}
+			if equalFoldUpper(lit, "EXPANSION") {
+				return expansion, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FLASHBACK") {
+				return flashback, true
+			}
+			if equalFoldUpper(lit, "FOLLOWERS") {
+				return followers, true
+			}
+			if equalFoldUpper(lit, "FOLLOWING") {
+				return following, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GENERATED") {
+				return generated, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HISTOGRAM") {
+				return histogram, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "INCREMENT") {
+				return increment, true
+			}
+			if equalFoldUpper(lit, "INTERSECT") {
+				return intersect, true
+			}
+			if equalFoldUpper(lit, "INVISIBLE") {
+				return invisible, true
+			}
+			if equalFoldUpper(lit, "ISOLATION") {
+				return isolation, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LOCALTIME") {
+				return localTime, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MEDIUMINT") {
+				return mediumIntType, true
+			}
+			if equalFoldUpper(lit, "MIDDLEINT") {
+				return middleIntType, true
+			}
+			if equalFoldUpper(lit, "MODERATED") {
+				return moderated, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NODEGROUP") {
+				return nodegroup, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PACK_KEYS") {
+				return packKeys, true
+			}
+			if equalFoldUpper(lit, "PARTITION") {
+				return partition, true
+			}
+			if equalFoldUpper(lit, "PER_TABLE") {
+				return per_table, true
+			}
+			if equalFoldUpper(lit, "PLACEMENT") {
+				return placement, true
+			}
+			if equalFoldUpper(lit, "PRECEDING") {
+				return preceding, true
+			}
+			if equalFoldUpper(lit, "PRECISION") {
+				return precisionType, true
+			}
+			if equalFoldUpper(lit, "PREDICATE") {
+				return predicate, true
+			}
+			if equalFoldUpper(lit, "PROCEDURE") {
+				return procedure, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "READ_ONLY") {
+				return readOnly, true
+			}
+			if equalFoldUpper(lit, "RECOMMEND") {
+				return recommend, true
+			}
+			if equalFoldUpper(lit, "RECURSIVE") {
+				return recursive, true
+			}
+			if equalFoldUpper(lit, "REDUNDANT") {
+				return redundant, true
+			}
+			if equalFoldUpper(lit, "ROW_COUNT") {
+				return rowCount, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SAVEPOINT") {
+				return savepoint, true
+			}
+			if equalFoldUpper(lit, "SECONDARY") {
+				return secondary, true
+			}
+			if equalFoldUpper(lit, "SEPARATOR") {
+				return separator, true
+			}
+			if equalFoldUpper(lit, "SQL_CACHE") {
+				return sqlCache, true
+			}
+			if equalFoldUpper(lit, "STALENESS") {
+				return staleness, true
+			}
+			if equalFoldUpper(lit, "SUBSTRING") {
+				return substring, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TEMPORARY") {
+				return temporary, true
+			}
+			if equalFoldUpper(lit, "TEMPTABLE") {
+				return temptable, true
+			}
+			if equalFoldUpper(lit, "TIDB_JSON") {
+				return tidbJson, true
+			}
+			if equalFoldUpper(lit, "TIMESTAMP") {
+				return timestampType, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UNBOUNDED") {
+				return unbounded, true
+			}
+			if equalFoldUpper(lit, "UNDEFINED") {
+				return undefined, true
+			}
+			if equalFoldUpper(lit, "UNLIMITED") {
+				return unlimited, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VARBINARY") {
+				return varbinaryType, true
+			}
+			if equalFoldUpper(lit, "VARIABLES") {
+				return variables, true
+			}
+		}
+	case 10:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ATTRIBUTES") {
+				return attributes, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BACKGROUND") {
+				return background, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CHECKPOINT") {
+				return checkpoint, true
+			}
+			if equalFoldUpper(lit, "COMPLETION") {
+				return completion, true
+			}
+			if equalFoldUpper(lit, "COMPRESSED") {
+				return compressed, true
+			}
+			if equalFoldUpper(lit, "CONNECTION") {
+				return connection, true
+			}
+			if equalFoldUpper(lit, "CONSISTENT") {
+				return consistent, true
+			}
+			if equalFoldUpper(lit, "CONSTRAINT") {
+				return constraint, true
+			}
+			if equalFoldUpper(lit, "CSV_HEADER") {
+				return csvHeader, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DAY_MINUTE") {
+				return dayMinute, true
+			}
+			if equalFoldUpper(lit, "DAY_SECOND") {
+				return daySecond, true
+			}
+			if equalFoldUpper(lit, "DEALLOCATE") {
+				return deallocate, true
+			}
+			if equalFoldUpper(lit, "DEPENDENCY") {
+				return dependency, true
+			}
+			if equalFoldUpper(lit, "DISTRIBUTE") {
+				return distribute, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ENCRYPTION") {
+				return encryption, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GET_FORMAT") {
+				return getFormat, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IDENTIFIED") {
+				return identified, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LINESTRING") {
+				return lineStringType, true
+			}
+			if equalFoldUpper(lit, "LOAD_STATS") {
+				return loadStats, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MAX_IDXNUM") {
+				return max_idxnum, true
+			}
+			if equalFoldUpper(lit, "MEDIUMBLOB") {
+				return mediumblobType, true
+			}
+			if equalFoldUpper(lit, "MEDIUMTEXT") {
+				return mediumtextType, true
+			}
+			if equalFoldUpper(lit, "MULTIPOINT") {
+				return multiPointType, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NODE_STATE") {
+				return nodeState, true
+			}
+			if equalFoldUpper(lit, "NOMAXVALUE") {
+				return nomaxvalue, true
+			}
+			if equalFoldUpper(lit, "NOMINVALUE") {
+				return nominvalue, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OPTIMISTIC") {
+				return optimistic, true
+			}
+			if equalFoldUpper(lit, "OPTIONALLY") {
+				return optionally, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PARTITIONS") {
+				return partitions, true
+			}
+			if equalFoldUpper(lit, "PLAN_CACHE") {
+				return planCache, true
+			}
+			if equalFoldUpper(lit, "PRIVILEGES") {
+				return privileges, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "RATE_LIMIT") {
+				return rateLimit, true
+			}
+			if equalFoldUpper(lit, "REFERENCES") {
+				return references, true
+			}
+			if equalFoldUpper(lit, "REORGANIZE") {
+				return reorganize, true
+			}
+			if equalFoldUpper(lit, "REPEATABLE") {
+				return repeatable, true
+			}
+			if equalFoldUpper(lit, "ROW_FORMAT") {
+				return rowFormat, true
+			}
+			if equalFoldUpper(lit, "RU_PER_SEC") {
+				return ruRate, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SAMPLERATE") {
+				return sampleRate, true
+			}
+			if equalFoldUpper(lit, "SQLWARNING") {
+				return sqlwarning, true
+			}
+			if equalFoldUpper(lit, "START_TIME") {
+				return startTime, true
+			}
+			if equalFoldUpper(lit, "STATISTICS") {
+				return statistics, true
+			}
+			if equalFoldUpper(lit, "STATS_META") {
+				return statsMeta, true
+			}
+			if equalFoldUpper(lit, "STATS_TOPN") {
+				return statsTopN, true
+			}
+			if equalFoldUpper(lit, "STDDEV_POP") {
+				return stddevPop, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TABLESPACE") {
+				return tablespace, true
+			}
+			if equalFoldUpper(lit, "TASK_TYPES") {
+				return taskTypes, true
+			}
+			if equalFoldUpper(lit, "TERMINATED") {
+				return terminated, true
+			}
+			if equalFoldUpper(lit, "TTL_ENABLE") {
+				return ttlEnable, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VALIDATION") {
+				return validation, true
+			}
+		case 'Y':
+			if equalFoldUpper(lit, "YEAR_MONTH") {
+				return yearMonth, true
+			}
+		}
+	case 11:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "AUTO_RANDOM") {
+				return autoRandom, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CARDINALITY") {
+				return cardinality, true
+			}
+			if equalFoldUpper(lit, "COMPRESSION") {
+				return compression, true
+			}
+			if equalFoldUpper(lit, "CONCURRENCY") {
+				return concurrency, true
+			}
+			if equalFoldUpper(lit, "CONSISTENCY") {
+				return consistency, true
+			}
+			if equalFoldUpper(lit, "CONSTRAINTS") {
+				return constraints, true
+			}
+			if equalFoldUpper(lit, "CORRELATION") {
+				return correlation, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DISTINCTROW") {
+				return distinct, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HOUR_MINUTE") {
+				return hourMinute, true
+			}
+			if equalFoldUpper(lit, "HOUR_SECOND") {
+				return hourSecond, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "INCREMENTAL") {
+				return incremental, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LAST_BACKUP") {
+				return lastBackup, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MAX_MINUTES") {
+				return max_minutes, true
+			}
+			if equalFoldUpper(lit, "MICROSECOND") {
+				return microsecond, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NEXT_ROW_ID") {
+				return next_row_id, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PESSIMISTIC") {
+				return pessimistic, true
+			}
+			if equalFoldUpper(lit, "PROCESSLIST") {
+				return processlist, true
+			}
+		case 'Q':
+			if equalFoldUpper(lit, "QUERY_LIMIT") {
+				return queryLimit, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "REPLICATION") {
+				return replication, true
+			}
+			if equalFoldUpper(lit, "RESTORED_TS") {
+				return restoredTS, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SQL_TSI_DAY") {
+				return sqlTsiDay, true
+			}
+			if equalFoldUpper(lit, "STDDEV_SAMP") {
+				return stddevSamp, true
+			}
+			if equalFoldUpper(lit, "SYSTEM_TIME") {
+				return systemTime, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TABLESAMPLE") {
+				return tableSample, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_FAST") {
+				return tokudbFast, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_LZMA") {
+				return tokudbLzma, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_ZLIB") {
+				return tokudbZlib, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_ZSTD") {
+				return tokudbZstd, true
+			}
+			if equalFoldUpper(lit, "TRADITIONAL") {
+				return traditional, true
+			}
+			if equalFoldUpper(lit, "TRANSACTION") {
+				return transaction, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UNCOMMITTED") {
+				return uncommitted, true
+			}
+		}
+	case 12:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "CSV_NOT_NULL") {
+				return csvNotNull, true
+			}
+			if equalFoldUpper(lit, "CURRENT_DATE") {
+				return currentDate, true
+			}
+			if equalFoldUpper(lit, "CURRENT_ROLE") {
+				return currentRole, true
+			}
+			if equalFoldUpper(lit, "CURRENT_TIME") {
+				return currentTime, true
+			}
+			if equalFoldUpper(lit, "CURRENT_USER") {
+				return currentUser, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DISTRIBUTION") {
+				return distribution, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "EXEC_ELAPSED") {
+				return execElapsed, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GROUP_CONCAT") {
+				return groupConcat, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IGNORE_STATS") {
+				return ignoreStats, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LOW_PRIORITY") {
+				return lowPriority, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MULTIPOLYGON") {
+				return multiPolygonType, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NONCLUSTERED") {
+				return nonclustered, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "ON_DUPLICATE") {
+				return onDuplicate, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PARTITIONING") {
+				return partitioning, true
+			}
+			if equalFoldUpper(lit, "PERSIST_ONLY") {
+				return persistOnly, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SERIALIZABLE") {
+				return serializable, true
+			}
+			if equalFoldUpper(lit, "SQLEXCEPTION") {
+				return sqlexception, true
+			}
+			if equalFoldUpper(lit, "SQL_NO_CACHE") {
+				return sqlNoCache, true
+			}
+			if equalFoldUpper(lit, "SQL_TSI_HOUR") {
+				return sqlTsiHour, true
+			}
+			if equalFoldUpper(lit, "SQL_TSI_WEEK") {
+				return sqlTsiWeek, true
+			}
+			if equalFoldUpper(lit, "SQL_TSI_YEAR") {
+				return sqlTsiYear, true
+			}
+			if equalFoldUpper(lit, "STATS_LOCKED") {
+				return statsLocked, true
+			}
+			if equalFoldUpper(lit, "SUBPARTITION") {
+				return subpartition, true
+			}
+			if equalFoldUpper(lit, "SWITCH_GROUP") {
+				return switchGroup, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TIMESTAMPADD") {
+				return timestampAdd, true
+			}
+			if equalFoldUpper(lit, "TOKEN_ISSUER") {
+				return tokenIssuer, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_SMALL") {
+				return tokudbSmall, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VARCHARACTER") {
+				return varcharacter, true
+			}
+		}
+	case 13:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "AUTO_ID_CACHE") {
+				return autoIdCache, true
+			}
+		case 'B':
+			if equalFoldUpper(lit, "BINDING_CACHE") {
+				return bindingCache, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "COLUMN_FORMAT") {
+				return columnFormat, true
+			}
+			if equalFoldUpper(lit, "CSV_DELIMITER") {
+				return csvDelimiter, true
+			}
+			if equalFoldUpper(lit, "CSV_SEPARATOR") {
+				return csvSeparator, true
+			}
+		case 'D':
+			if equalFoldUpper(lit, "DISTRIBUTIONS") {
+				return distributions, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HIGH_PRIORITY") {
+				return highPriority, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "INSERT_METHOD") {
+				return insertMethod, true
+			}
+		case 'J':
+			if equalFoldUpper(lit, "JSON_ARRAYAGG") {
+				return jsonArrayagg, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MINUTE_SECOND") {
+				return minuteSecond, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SQL_TSI_MONTH") {
+				return sqlTsiMonth, true
+			}
+			if equalFoldUpper(lit, "STATS_BUCKETS") {
+				return statsBuckets, true
+			}
+			if equalFoldUpper(lit, "STATS_HEALTHY") {
+				return statsHealthy, true
+			}
+			if equalFoldUpper(lit, "STATS_OPTIONS") {
+				return statsOptions, true
+			}
+			if equalFoldUpper(lit, "STRAIGHT_JOIN") {
+				return straightJoin, true
+			}
+			if equalFoldUpper(lit, "STRICT_FORMAT") {
+				return strictFormat, true
+			}
+			if equalFoldUpper(lit, "SUBPARTITIONS") {
+				return subpartitions, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TIKV_IMPORTER") {
+				return tikvImporter, true
+			}
+			if equalFoldUpper(lit, "TIMESTAMPDIFF") {
+				return timestampDiff, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_SNAPPY") {
+				return tokudbSnappy, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UTC_TIMESTAMP") {
+				return utcTimestamp, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WEIGHT_STRING") {
+				return weightString, true
+			}
+		}
+	case 14:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "AUTO_INCREMENT") {
+				return autoIncrement, true
+			}
+			if equalFoldUpper(lit, "AVG_ROW_LENGTH") {
+				return avgRowLength, true
+			}
+		case 'J':
+			if equalFoldUpper(lit, "JSON_OBJECTAGG") {
+				return jsonObjectAgg, true
+			}
+			if equalFoldUpper(lit, "JSON_SUM_CRC32") {
+				return jsonSumCrc32, true
+			}
+		case 'K':
+			if equalFoldUpper(lit, "KEY_BLOCK_SIZE") {
+				return keyBlockSize, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LOCALTIMESTAMP") {
+				return localTs, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OLTP_READ_ONLY") {
+				return oltpReadOnly, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PRIMARY_REGION") {
+				return primaryRegion, true
+			}
+			if equalFoldUpper(lit, "PROCESSED_KEYS") {
+				return processedKeys, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SECONDARY_LOAD") {
+				return secondaryLoad, true
+			}
+			if equalFoldUpper(lit, "SESSION_STATES") {
+				return sessionStates, true
+			}
+			if equalFoldUpper(lit, "SQL_BIG_RESULT") {
+				return sqlBigResult, true
+			}
+			if equalFoldUpper(lit, "SQL_TSI_MINUTE") {
+				return sqlTsiMinute, true
+			}
+			if equalFoldUpper(lit, "SQL_TSI_SECOND") {
+				return sqlTsiSecond, true
+			}
+			if equalFoldUpper(lit, "STATS_COL_LIST") {
+				return statsColList, true
+			}
+			if equalFoldUpper(lit, "STATS_EXTENDED") {
+				return statsExtended, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TABLE_CHECKSUM") {
+				return tableChecksum, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_DEFAULT") {
+				return tokudbDefault, true
+			}
+			if equalFoldUpper(lit, "TOKUDB_QUICKLZ") {
+				return tokudbQuickLZ, true
+			}
+			if equalFoldUpper(lit, "TRUE_CARD_COST") {
+				return trueCardCost, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WITH_SYS_TABLE") {
+				return withSysTable, true
+			}
+		}
+	case 15:
+		switch lit[0] &^ 0x20 {
+		case 'D':
+			if equalFoldUpper(lit, "DAY_MICROSECOND") {
+				return dayMicrosecond, true
+			}
+			if equalFoldUpper(lit, "DELAY_KEY_WRITE") {
+				return delayKeyWrite, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MULTILINESTRING") {
+				return multiLineStringType, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OLTP_READ_WRITE") {
+				return oltpReadWrite, true
+			}
+			if equalFoldUpper(lit, "OLTP_WRITE_ONLY") {
+				return oltpWriteOnly, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SQL_TSI_QUARTER") {
+				return sqlTsiQuarter, true
+			}
+		}
+	case 16:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "AUTO_RANDOM_BASE") {
+				return autoRandomBase, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "COMPRESSION_TYPE") {
+				return compressionType, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ENGINE_ATTRIBUTE") {
+				return engine_attribute, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HOUR_MICROSECOND") {
+				return hourMicrosecond, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SECONDARY_ENGINE") {
+				return secondaryEngine, true
+			}
+			if equalFoldUpper(lit, "SECONDARY_UNLOAD") {
+				return secondaryUnload, true
+			}
+			if equalFoldUpper(lit, "SQL_SMALL_RESULT") {
+				return sqlSmallResult, true
+			}
+			if equalFoldUpper(lit, "STATS_COL_CHOICE") {
+				return statsColChoice, true
+			}
+			if equalFoldUpper(lit, "STATS_HISTOGRAMS") {
+				return statsHistograms, true
+			}
+			if equalFoldUpper(lit, "STATS_PERSISTENT") {
+				return statsPersistent, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TIDB_CURRENT_TSO") {
+				return tidbCurrentTSO, true
+			}
+			if equalFoldUpper(lit, "TTL_JOB_INTERVAL") {
+				return ttlJobInterval, true
+			}
+		}
+	case 17:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "APPROX_PERCENTILE") {
+				return approxPercentile, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "COMPRESSION_LEVEL") {
+				return compressionLevel, true
+			}
+			if equalFoldUpper(lit, "CURRENT_TIMESTAMP") {
+				return currentTs, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ENCRYPTION_METHOD") {
+				return encryptionMethod, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IO_READ_BANDWIDTH") {
+				return ioReadBandwidth, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PRE_SPLIT_REGIONS") {
+				return preSplitRegions, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SHARD_ROW_ID_BITS") {
+				return shardRowIDBits, true
+			}
+			if equalFoldUpper(lit, "SKIP_SCHEMA_FILES") {
+				return skipSchemaFiles, true
+			}
+			if equalFoldUpper(lit, "SQL_BUFFER_RESULT") {
+				return sqlBufferResult, true
+			}
+			if equalFoldUpper(lit, "STATS_AUTO_RECALC") {
+				return statsAutoRecalc, true
+			}
+			if equalFoldUpper(lit, "STATS_SAMPLE_RATE") {
+				return statsSampleRate, true
+			}
+		case 'U':
+			if equalFoldUpper(lit, "UTILIZATION_LIMIT") {
+				return utilizationLimit, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VOTER_CONSTRAINTS") {
+				return voterConstraints, true
+			}
+		}
+	case 18:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "COLUMN_STATS_USAGE") {
+				return columnStatsUsage, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "ENCRYPTION_KEYFILE") {
+				return encryptionKeyFile, true
+			}
+		case 'G':
+			if equalFoldUpper(lit, "GEOMETRYCOLLECTION") {
+				return geometryCollectionType, true
+			}
+		case 'I':
+			if equalFoldUpper(lit, "IO_WRITE_BANDWIDTH") {
+				return ioWriteBandwidth, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LEADER_CONSTRAINTS") {
+				return leaderConstraints, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MINUTE_MICROSECOND") {
+				return minuteMicrosecond, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NO_WRITE_TO_BINLOG") {
+				return noWriteToBinLog, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OPT_RULE_BLACKLIST") {
+				return optRuleBlacklist, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "PASSWORD_LOCK_TIME") {
+				return passwordLockTime, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SECOND_MICROSECOND") {
+				return secondMicrosecond, true
+			}
+			if equalFoldUpper(lit, "STATS_SAMPLE_PAGES") {
+				return statsSamplePages, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WAIT_TIFLASH_READY") {
+				return waitTiflashReady, true
+			}
+		}
+	case 19:
+		switch lit[0] &^ 0x20 {
+		case 'F':
+			if equalFoldUpper(lit, "FULL_BACKUP_STORAGE") {
+				return fullBackupStorage, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LEARNER_CONSTRAINTS") {
+				return learnerConstraints, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SQL_CALC_FOUND_ROWS") {
+				return sqlCalcFoundRows, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TOKUDB_UNCOMPRESSED") {
+				return tokudbUncompressed, true
+			}
+		}
+	case 20:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "CHECKSUM_CONCURRENCY") {
+				return checksumConcurrency, true
+			}
+			if equalFoldUpper(lit, "CSV_BACKSLASH_ESCAPE") {
+				return csvBackslashEscape, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FOLLOWER_CONSTRAINTS") {
+				return followerConstraints, true
+			}
+		case 'H':
+			if equalFoldUpper(lit, "HISTOGRAMS_IN_FLIGHT") {
+				return histogramsInFlight, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MAX_QUERIES_PER_HOUR") {
+				return maxQueriesPerHour, true
+			}
+			if equalFoldUpper(lit, "MAX_UPDATES_PER_HOUR") {
+				return maxUpdatesPerHour, true
+			}
+			if equalFoldUpper(lit, "MAX_USER_CONNECTIONS") {
+				return maxUserConnections, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SURVIVAL_PREFERENCES") {
+				return survivalPreferences, true
+			}
+		}
+	case 21:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "APPROX_COUNT_DISTINCT") {
+				return approxCountDistinct, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CLIENT_ERRORS_SUMMARY") {
+				return clientErrorsSummary, true
+			}
+		case 'F':
+			if equalFoldUpper(lit, "FAILED_LOGIN_ATTEMPTS") {
+				return failedLoginAttempts, true
+			}
+		}
+	case 23:
+		switch lit[0] &^ 0x20 {
+		case 'E':
+			if equalFoldUpper(lit, "EXPR_PUSHDOWN_BLACKLIST") {
+				return exprPushdownBlacklist, true
+			}
+		}
+	case 24:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "CSV_TRIM_LAST_SEPARATORS") {
+				return csvTrimLastSeparators, true
+			}
+		case 'M':
+			if equalFoldUpper(lit, "MAX_CONNECTIONS_PER_HOUR") {
+				return maxConnectionsPerHour, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SEND_CREDENTIALS_TO_TIKV") {
+				return sendCredentialsToTiKV, true
+			}
+		}
+	case 26:
+		switch lit[0] &^ 0x20 {
+		case 'S':
+			if equalFoldUpper(lit, "SECONDARY_ENGINE_ATTRIBUTE") {
+				return secondaryEngineAttribute, true
+			}
+		}
+	case 30:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "ADD_COLUMNAR_REPLICA_ON_DEMAND") {
+				return addColumnarReplicaOnDemand, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// matchBtFuncToken returns the token of lit in btFuncTokenMap, lit is matched with its ASCII letters
+// upper cased.
+func matchBtFuncToken(lit string) (int, bool) {
+	switch len(lit) {
+	case 3:
+		switch lit[0] &^ 0x20 {
+		case 'M':
+			if equalFoldUpper(lit, "MAX") {
+				return builtinMax, true
+			}
+			if equalFoldUpper(lit, "MID") {
+				return builtinSubstring, true
+			}
+			if equalFoldUpper(lit, "MIN") {
+				return builtinMin, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NOW") {
+				return builtinNow, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "STD") {
+				return builtinStddevPop, true
+			}
+			if equalFoldUpper(lit, "SUM") {
+				return builtinSum, true
+			}
+		}
+	case 4:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "CAST") {
+				return builtinCast, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TRIM") {
+				return builtinTrim, true
+			}
+		}
+	case 5:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "COUNT") {
+				return builtinCount, true
+			}
+		}
+	case 6:
+		switch lit[0] &^ 0x20 {
+		case 'B':
+			if equalFoldUpper(lit, "BIT_OR") {
+				return builtinBitOr, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "STDDEV") {
+				return builtinStddevPop, true
+			}
+			if equalFoldUpper(lit, "SUBSTR") {
+				return builtinSubstring, true
+			}
+		}
+	case 7:
+		switch lit[0] &^ 0x20 {
+		case 'B':
+			if equalFoldUpper(lit, "BIT_AND") {
+				return builtinBitAnd, true
+			}
+			if equalFoldUpper(lit, "BIT_XOR") {
+				return builtinBitXor, true
+			}
+		case 'C':
+			if equalFoldUpper(lit, "CURDATE") {
+				return builtinCurDate, true
+			}
+			if equalFoldUpper(lit, "CURTIME") {
+				return builtinCurTime, true
+			}
+		case 'E':
+			if equalFoldUpper(lit, "EXTRACT") {
+				return builtinExtract, true
+			}
+		case 'S':
+			if equalFoldUpper(lit, "SYSDATE") {
+				return builtinSysDate, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VAR_POP") {
+				return builtinVarPop, true
+			}
+		}
+	case 8:
+		switch lit[0] &^ 0x20 {
+		case 'D':
+			if equalFoldUpper(lit, "DATE_ADD") {
+				return builtinDateAdd, true
+			}
+			if equalFoldUpper(lit, "DATE_SUB") {
+				return builtinDateSub, true
+			}
+		case 'P':
+			if equalFoldUpper(lit, "POSITION") {
+				return builtinPosition, true
+			}
+		case 'V':
+			if equalFoldUpper(lit, "VARIANCE") {
+				return builtinVarPop, true
+			}
+			if equalFoldUpper(lit, "VAR_SAMP") {
+				return builtinVarSamp, true
+			}
+		}
+	case 9:
+		switch lit[0] &^ 0x20 {
+		case 'S':
+			if equalFoldUpper(lit, "SUBSTRING") {
+				return builtinSubstring, true
+			}
+		case 'T':
+			if equalFoldUpper(lit, "TRANSLATE") {
+				return builtinTranslate, true
+			}
+		}
+	case 10:
+		switch lit[0] &^ 0x20 {
+		case 'S':
+			if equalFoldUpper(lit, "STDDEV_POP") {
+				return builtinStddevPop, true
+			}
+		}
+	case 11:
+		switch lit[0] &^ 0x20 {
+		case 'S':
+			if equalFoldUpper(lit, "STDDEV_SAMP") {
+				return builtinStddevSamp, true
+			}
+		}
+	case 12:
+		switch lit[0] &^ 0x20 {
+		case 'G':
+			if equalFoldUpper(lit, "GROUP_CONCAT") {
+				return builtinGroupConcat, true
+			}
+		}
+	case 17:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "APPROX_PERCENTILE") {
+				return builtinApproxPercentile, true
+			}
+		}
+	case 21:
+		switch lit[0] &^ 0x20 {
+		case 'A':
+			if equalFoldUpper(lit, "APPROX_COUNT_DISTINCT") {
+				return builtinApproxCountDistinct, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// matchWindowFuncToken returns the token of lit in windowFuncTokenMap, lit is matched with its ASCII letters
+// upper cased.
+func matchWindowFuncToken(lit string) (int, bool) {
+	switch len(lit) {
+	case 3:
+		switch lit[0] &^ 0x20 {
+		case 'L':
+			if equalFoldUpper(lit, "LAG") {
+				return lag, true
+			}
+		}
+	case 4:
+		switch lit[0] &^ 0x20 {
+		case 'L':
+			if equalFoldUpper(lit, "LEAD") {
+				return lead, true
+			}
+		case 'O':
+			if equalFoldUpper(lit, "OVER") {
+				return over, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "RANK") {
+				return rank, true
+			}
+		}
+	case 5:
+		switch lit[0] &^ 0x20 {
+		case 'N':
+			if equalFoldUpper(lit, "NTILE") {
+				return ntile, true
+			}
+		}
+	case 6:
+		switch lit[0] &^ 0x20 {
+		case 'G':
+			if equalFoldUpper(lit, "GROUPS") {
+				return groups, true
+			}
+		case 'W':
+			if equalFoldUpper(lit, "WINDOW") {
+				return window, true
+			}
+		}
+	case 9:
+		switch lit[0] &^ 0x20 {
+		case 'C':
+			if equalFoldUpper(lit, "CUME_DIST") {
+				return cumeDist, true
+			}
+		case 'N':
+			if equalFoldUpper(lit, "NTH_VALUE") {
+				return nthValue, true
+			}
+		}
+	case 10:
+		switch lit[0] &^ 0x20 {
+		case 'D':
+			if equalFoldUpper(lit, "DENSE_RANK") {
+				return denseRank, true
+			}
+		case 'L':
+			if equalFoldUpper(lit, "LAST_VALUE") {
+				return lastValue, true
+			}
+		case 'R':
+			if equalFoldUpper(lit, "ROW_NUMBER") {
+				return rowNumber, true
+			}
+		}
+	case 11:
+		switch lit[0] &^ 0x20 {
+		case 'F':
+			if equalFoldUpper(lit, "FIRST_VALUE") {
+				return firstValue, true
+			}
+		}
+	case 12:
+		switch lit[0] &^ 0x20 {
+		case 'P':
+			if equalFoldUpper(lit, "PERCENT_RANK") {
+				return percentRank, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// matchMariaDBToken returns the token of lit in mariaDBTokenMap, lit is matched with its ASCII letters
+// upper cased.
+func matchMariaDBToken(lit string) (int, bool) {
+	switch len(lit) {
+	case 9:
+		switch lit[0] &^ 0x20 {
+		case 'R':
+			if equalFoldUpper(lit, "RETURNING") {
+				return returning, true
+			}
+		}
+	}
+	return 0, false
+}
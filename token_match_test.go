@@ -0,0 +1,87 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	requires "github.com/stretchr/testify/require"
+)
+
+func TestTokenMatchers(t *testing.T) {
+	// lookup is how the lexer looked the identifiers up before the matchers.
+	lookup := func(m map[string]int, lit string) (int, bool) {
+		data := []byte(lit)
+		for i, c := range data {
+			if c >= 'a' && c <= 'z' {
+				data[i] = c + 'A' - 'a'
+			}
+		}
+		tok, ok := m[string(data)]
+		return tok, ok
+	}
+	matchers := []struct {
+		name  string
+		m     map[string]int
+		match func(string) (int, bool)
+	}{
+		{"tokenMap", tokenMap, matchToken},
+		{"btFuncTokenMap", btFuncTokenMap, matchBtFuncToken},
+		{"windowFuncTokenMap", windowFuncTokenMap, matchWindowFuncToken},
+		{"mariaDBTokenMap", mariaDBTokenMap, matchMariaDBToken},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	mutate := func(word string) string {
+		b := []byte(word)
+		i := r.Intn(len(b))
+		switch r.Intn(4) {
+		case 0:
+			b[i] ^= 0x20
+		case 1:
+			b[i]++
+		case 2:
+			b = append(b[:i:i], b[i+1:]...)
+		default:
+			b = append(b[:i:i], append([]byte{b[i], b[i]}, b[i+1:]...)...)
+		}
+		return string(b)
+	}
+
+	for _, m := range matchers {
+		check := func(lit string) {
+			tok, ok := m.match(lit)
+			expectedTok, expectedOK := lookup(m.m, lit)
+			requires.Equal(t, expectedOK, ok, "%s: %q", m.name, lit)
+			requires.Equal(t, expectedTok, tok, "%s: %q", m.name, lit)
+		}
+		for word := range m.m {
+			check(word)
+			check(strings.ToLower(word))
+			check(strings.ToLower(word[:1]) + word[1:])
+			check(word + "T")
+			check(word + "_")
+			check(word[:len(word)-1])
+			check("_" + word)
+			for range 8 {
+				check(mutate(word))
+			}
+		}
+		for _, lit := range []string{"", "selectt", "Sélect", "SELECT\x00", "sİlect", "ſelect", "\x7fS", "@@", "`select`", "select`"} {
+			check(lit)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"unicode"
+
+	tidbfeature "github.com/abbychau/mysql-parser/tidb"
+)
+
+// TokenKind is the kind of a Token.
+type TokenKind int
+
+// TokenKind types.
+const (
+	TokenWhitespace TokenKind = iota
+	// TokenComment is a comment, or the start or the end of a versioned
+	// comment, e.g. /*!50700 and */ of /*!50700 STRAIGHT_JOIN */, whose
+	// content is tokenized as it's executed.
+	TokenComment
+	// TokenKeyword is a word the scanner takes for a keyword, e.g. SELECT, or
+	// COUNT before (.
+	TokenKeyword
+	// TokenIdentifier is an identifier, quoted with backquotes or not.
+	TokenIdentifier
+	// TokenVariable is a user or a system variable, e.g. @a or @@sql_mode.
+	TokenVariable
+	// TokenString is a string literal, or the introducer of its character
+	// set, e.g. N of N'a' or _utf8mb4 of _utf8mb4'a'.
+	TokenString
+	// TokenNumber is a numeric literal, a hexadecimal literal, e.g. 0x1F or
+	// X'1F', or a bit-value literal, e.g. b'101'.
+	TokenNumber
+	// TokenOperator is an operator or a punctuation, e.g. <=, ( or ;.
+	TokenOperator
+)
+
+var tokenKindNames = [...]string{
+	TokenWhitespace: "whitespace",
+	TokenComment:    "comment",
+	TokenKeyword:    "keyword",
+	TokenIdentifier: "identifier",
+	TokenVariable:   "variable",
+	TokenString:     "string",
+	TokenNumber:     "number",
+	TokenOperator:   "operator",
+}
+
+// String implements fmt.Stringer interface.
+func (k TokenKind) String() string {
+	if k >= 0 && int(k) < len(tokenKindNames) {
+		return tokenKindNames[k]
+	}
+	return "unknown"
+}
+
+// Token is a token of the SQL text, see Tokenize.
+type Token struct {
+	Kind TokenKind
+	// Text is the token as written, e.g. 'it''s' or `a b`.
+	Text string
+	// Start and End are the offsets of the token in the SQL text.
+	Start int
+	End   int
+	// Reserved is set for a reserved keyword, see IsReservedKeyword.
+	Reserved bool
+	// Unterminated is set for a string, a quoted identifier, a variable, a
+	// hexadecimal or bit-value literal or a comment the text ends in.
+	Unterminated bool
+}
+
+// reservedKeywords is the set of the reserved words of Keywords.
+var reservedKeywords = func() map[string]struct{} {
+	words := make(map[string]struct{})
+	for _, kw := range Keywords {
+		if kw.Reserved {
+			words[kw.Word] = struct{}{}
+		}
+	}
+	return words
+}()
+
+// IsReservedKeyword returns whether word is a reserved keyword, which must be
+// quoted to be an identifier, see Keywords.
+func IsReservedKeyword(word string) bool {
+	_, ok := reservedKeywords[strings.ToUpper(word)]
+	return ok
+}
+
+// Tokenize returns the tokens of sql, read by the Scanner in the default SQL
+// mode, the whitespaces and the comments included, so that their texts make up
+// sql. It doesn't need a complete statement: a string, a quoted identifier or a
+// comment the text ends in is returned as an Unterminated token.
+//
+// It returns an error for the first malformed token, e.g. X'1G', along with all
+// the tokens, the malformed ones being tokenized the best the Scanner can.
+func Tokenize(sql string) ([]Token, error) {
+	t := &tokenizer{s: NewScanner(sql), c: NewScanner(sql), sql: sql}
+	for {
+		tok, pos, lit := t.s.scan()
+		if tok == 0 {
+			t.splitGap(len(t.sql))
+			return t.tokens, t.err
+		}
+		t.splitGap(pos.Offset)
+		end := t.s.r.pos().Offset
+		if end <= pos.Offset {
+			// A byte the Scanner has no rule for.
+			t.s.r.inc()
+			end = t.s.r.pos().Offset
+		}
+		t.add(t.classify(tok, pos, lit, end))
+	}
+}
+
+type tokenizer struct {
+	// s scans the tokens, c the versioned comments.
+	s      *Scanner
+	c      *Scanner
+	sql    string
+	tokens []Token
+	err    error
+}
+
+func (t *tokenizer) add(tok Token) {
+	tok.Text = t.sql[tok.Start:tok.End]
+	t.tokens = append(t.tokens, tok)
+}
+
+func (t *tokenizer) classify(tok int, pos Pos, lit string, end int) Token {
+	token := Token{Start: pos.Offset, End: end}
+	switch tok {
+	case identifier:
+		switch {
+		case t.s.isTokenIdentifier(lit, pos.Offset) != 0:
+			token.Kind = TokenKeyword
+			token.Reserved = IsReservedKeyword(lit)
+		case t.s.handleIdent(&yySymType{ident: lit}) == underscoreCS:
+			token.Kind = TokenString
+		default:
+			token.Kind = TokenIdentifier
+		}
+	case quotedIdentifier:
+		token.Kind = TokenIdentifier
+	case singleAtIdentifier, doubleAtIdentifier:
+		token.Kind = TokenVariable
+	case stringLit, underscoreCS:
+		token.Kind = TokenString
+	case intLit, decLit, floatLit, hexLit, bitLit:
+		token.Kind = TokenNumber
+	case invalid:
+		token.Kind = invalidTokenKind(t.sql[pos.Offset:end])
+		if end == len(t.sql) && token.Kind != TokenOperator {
+			token.Unterminated = true
+		} else if t.err == nil {
+			t.err = ParseErrorWith(t.sql[pos.Offset:], pos.Line)
+		}
+	default:
+		token.Kind = TokenOperator
+	}
+	return token
+}
+
+// invalidTokenKind returns the kind of the token the Scanner failed to scan,
+// text, by its first bytes.
+func invalidTokenKind(text string) TokenKind {
+	switch text[0] {
+	case '\'', '"':
+		return TokenString
+	case '`':
+		return TokenIdentifier
+	case '@':
+		return TokenVariable
+	case 'x', 'X', 'b', 'B':
+		if len(text) > 1 && text[1] == '\'' {
+			return TokenNumber
+		}
+	}
+	if isDigit(text[0]) {
+		return TokenNumber
+	}
+	return TokenOperator
+}
+
+// splitGap adds the whitespaces and the comments the Scanner skipped before the
+// token at end.
+func (t *tokenizer) splitGap(end int) {
+	start := t.prevEnd()
+	for i := start; i < end; {
+		tok := Token{Start: i, Kind: TokenComment}
+		rest := t.sql[i:end]
+		switch {
+		case unicode.IsSpace(rune(rest[0])):
+			j := i + 1
+			for j < end && unicode.IsSpace(rune(t.sql[j])) {
+				j++
+			}
+			tok.Kind, tok.End = TokenWhitespace, j
+		case rest[0] == '#' || strings.HasPrefix(rest, "--"):
+			if j := strings.IndexByte(rest, '\n'); j >= 0 {
+				tok.End = i + j
+			} else {
+				tok.End = end
+			}
+		case strings.HasPrefix(rest, "*/"):
+			// The end of a versioned comment.
+			tok.End = i + 2
+		case strings.HasPrefix(rest, "/*"):
+			if j := t.versionedCommentStart(i); j > 0 {
+				tok.End = j
+			} else if j := strings.Index(rest[2:], "*/"); j >= 0 {
+				tok.End = i + 2 + j + 2
+			} else {
+				tok.End, tok.Unterminated = end, true
+			}
+		default:
+			// The Scanner skips only whitespaces and comments.
+			tok.Kind, tok.End = TokenOperator, i+1
+		}
+		t.add(tok)
+		i = tok.End
+	}
+}
+
+// prevEnd returns the end of the last token added.
+func (t *tokenizer) prevEnd() int {
+	if len(t.tokens) == 0 {
+		return 0
+	}
+	return t.tokens[len(t.tokens)-1].End
+}
+
+// versionedCommentStart returns the end of the start of the versioned comment
+// at offset, e.g. /*!50700 or /*T![clustered_index], 0 if there is none. It's
+// read as the Scanner does.
+func (t *tokenizer) versionedCommentStart(offset int) int {
+	c := t.c
+	c.r.updatePos(Pos{Offset: offset + 2})
+	switch c.r.readByte() {
+	case '!':
+		c.scanVersionDigits(5, 5)
+		return c.r.pos().Offset
+	case 'T':
+		if c.r.peek() != '!' {
+			return 0
+		}
+		c.r.inc()
+		if features := c.scanFeatureIDs(); tidbfeature.CanParseFeature(features...) {
+			return c.r.pos().Offset
+		}
+	}
+	return 0
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	myformat "github.com/abbychau/mysql-parser/format"
+	requires "github.com/stretchr/testify/require"
+)
+
+// tokenKinds returns the text and the kind of every token of tokens, with the
+// flags set.
+func tokenKinds(tokens []Token) []string {
+	kinds := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		kind := tok.Text + " " + tok.Kind.String()
+		if tok.Reserved {
+			kind += " reserved"
+		}
+		if tok.Unterminated {
+			kind += " unterminated"
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+func TestTokenize(t *testing.T) {
+	for _, tt := range []struct {
+		sql   string
+		kinds []string
+	}{
+		{"SELECT `a b`, count(*) FROM t1 WHERE c >= 1.5", []string{
+			"SELECT keyword reserved", "  whitespace", "`a b` identifier", ", operator", "  whitespace",
+			"count keyword", "( operator", "* operator", ") operator", "  whitespace",
+			"FROM keyword reserved", "  whitespace", "t1 identifier", "  whitespace",
+			"WHERE keyword reserved", "  whitespace", "c identifier", "  whitespace", ">= operator",
+			"  whitespace", "1.5 number",
+		}},
+		{"SELECT x'1F', 0x1F, b'101', 0b11, 2e3, 'it''s', _utf8mb4'x', N'y', @a, @@sql_mode, ?", []string{
+			"SELECT keyword reserved", "  whitespace",
+			"x'1F' number", ", operator", "  whitespace", "0x1F number", ", operator", "  whitespace",
+			"b'101' number", ", operator", "  whitespace", "0b11 number", ", operator", "  whitespace",
+			"2e3 number", ", operator", "  whitespace", "'it''s' string", ", operator", "  whitespace",
+			"_utf8mb4 string", "'x' string", ", operator", "  whitespace", "N string", "'y' string", ", operator", "  whitespace",
+			"@a variable", ", operator", "  whitespace", "@@sql_mode variable", ", operator", "  whitespace", "? operator",
+		}},
+		{"SELECT /*!50700 STRAIGHT_JOIN */ a -- note\nFROM t # end", []string{
+			"SELECT keyword reserved", "  whitespace", "/*!50700 comment", "  whitespace",
+			"STRAIGHT_JOIN keyword reserved", "  whitespace", "*/ comment", "  whitespace", "a identifier", "  whitespace",
+			"-- note comment", "\n whitespace", "FROM keyword reserved", "  whitespace", "t identifier", "  whitespace",
+			"# end comment",
+		}},
+		{"/* c */SELECT/*+ SET_VAR(a=1) */ t.status", []string{
+			"/* c */ comment", "SELECT keyword reserved", "/*+ SET_VAR(a=1) */ comment", "  whitespace",
+			"t identifier", ". operator", "status identifier",
+		}},
+		{"CREATE TABLE t (a INT) /*T![clustered_index] CLUSTERED */", []string{
+			"CREATE keyword reserved", "  whitespace", "TABLE keyword reserved", "  whitespace", "t identifier", "  whitespace",
+			"( operator", "a identifier", "  whitespace", "INT keyword reserved", ") operator", "  whitespace",
+			"/*T![clustered_index] comment", "  whitespace", "CLUSTERED keyword", "  whitespace", "*/ comment",
+		}},
+		// The incomplete input being typed.
+		{"SELECT 'abc", []string{"SELECT keyword reserved", "  whitespace", "'abc string unterminated"}},
+		{"SELECT * FROM `my tab", []string{
+			"SELECT keyword reserved", "  whitespace", "* operator", "  whitespace", "FROM keyword reserved", "  whitespace",
+			"`my tab identifier unterminated",
+		}},
+		{"SELECT a /* unfinished", []string{
+			"SELECT keyword reserved", "  whitespace", "a identifier", "  whitespace", "/* unfinished comment unterminated",
+		}},
+		{"SELECT x'1F", []string{"SELECT keyword reserved", "  whitespace", "x'1F number unterminated"}},
+		{"", []string{}},
+	} {
+		tokens, err := Tokenize(tt.sql)
+		requires.NoError(t, err, tt.sql)
+		requires.Equal(t, tt.kinds, tokenKinds(tokens), tt.sql)
+		var sb strings.Builder
+		for _, tok := range tokens {
+			requires.Equal(t, tok.Text, tt.sql[tok.Start:tok.End], tt.sql)
+			sb.WriteString(tok.Text)
+		}
+		requires.Equal(t, tt.sql, sb.String())
+	}
+
+	// A malformed token is an error, the tokens still make up the text.
+	sql := "SELECT X'1G' FROM t"
+	tokens, err := Tokenize(sql)
+	requires.ErrorContains(t, err, "near 'X'1G' FROM t'")
+	var sb strings.Builder
+	for _, tok := range tokens {
+		sb.WriteString(tok.Text)
+	}
+	requires.Equal(t, sql, sb.String())
+
+	requires.True(t, IsReservedKeyword("select"))
+	requires.False(t, IsReservedKeyword("STATUS"))
+	requires.False(t, IsReservedKeyword("a"))
+	// The restore quotes the same keywords.
+	for _, kw := range Keywords {
+		requires.Equal(t, IsReservedKeyword(kw.Word), myformat.IsReservedKeyword(strings.ToLower(kw.Word)), kw.Word)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"unicode/utf8"
+
+	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/mysql"
+)
+
+// The flen of a FieldType means different things for different types: the
+// characters of CHAR and VARCHAR, the bytes of BINARY and VARBINARY, the
+// digits and the sign of the integers, the digits of DECIMAL, the bits of BIT
+// and the characters of the text of the temporal types. The methods below
+// tell the lengths apart, so that e.g. VARCHAR(255) isn't taken for 255 bytes.
+
+// DefaultFlenDecimalForType returns the flen and decimal of tp when none is
+// written in a column definition, e.g. 20 and 0 for BIGINT. It returns -1 and
+// -1 if tp has no default.
+func DefaultFlenDecimalForType(tp byte) (flen, dec int) {
+	return mysql.GetDefaultFieldLengthAndDecimal(tp)
+}
+
+// flenOrDefault returns the flen of ft, or the default flen of its type if
+// it's unspecified.
+func (ft *FieldType) flenOrDefault() int {
+	if ft.flen != UnspecifiedLength {
+		return ft.flen
+	}
+	flen, _ := DefaultFlenDecimalForType(ft.tp)
+	return flen
+}
+
+// fsp returns the fractional seconds precision of a temporal type.
+func (ft *FieldType) fsp() int {
+	return max(ft.decimal, 0)
+}
+
+func (ft *FieldType) isBinaryString() bool {
+	return ft.charset == charset.CharsetBin
+}
+
+// CharacterMaxLength returns the maximum length of a value of a string type in
+// characters, which is the CHARACTER_MAXIMUM_LENGTH of information_schema. The
+// characters of the binary strings are bytes. ok is false for the other types.
+func (ft *FieldType) CharacterMaxLength() (length int, ok bool) {
+	switch ft.tp {
+	case mysql.TypeString, mysql.TypeVarchar, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		// The TEXT and BLOB types are limited in bytes, whatever their flen.
+		if IsTypeBlob(ft.tp) {
+			flen, _ := DefaultFlenDecimalForType(ft.tp)
+			return flen, true
+		}
+		return ft.flenOrDefault(), true
+	case mysql.TypeEnum:
+		for _, e := range ft.elems {
+			length = max(length, utf8.RuneCountInString(e))
+		}
+		return length, true
+	case mysql.TypeSet:
+		for i, e := range ft.elems {
+			if i > 0 {
+				length++
+			}
+			length += utf8.RuneCountInString(e)
+		}
+		return length, true
+	}
+	return 0, false
+}
+
+// decimalBytes returns the bytes the digits of one side of the point of a
+// DECIMAL take.
+func decimalBytes(digits int) int {
+	return digits/digitsPerWord*wordSize + dig2bytes[digits%digitsPerWord]
+}
+
+// StorageBytesUpperBound returns the maximum bytes a value of ft takes, as
+// documented by the storage requirements of the MySQL manual, without the
+// length prefix of the variable-length types. Unlike StorageLength, it isn't
+// about the encoding of TiDB. The strings take charsetMaxlen
+// bytes per character, or the maximum bytes of a character of the charset of
+// ft if charsetMaxlen isn't positive. ok is false for the types whose size
+// isn't bounded by the type, e.g. GEOMETRY.
+func (ft *FieldType) StorageBytesUpperBound(charsetMaxlen int) (length int, ok bool) {
+	switch ft.tp {
+	case mysql.TypeTiny, mysql.TypeYear:
+		return 1, true
+	case mysql.TypeShort:
+		return 2, true
+	case mysql.TypeInt24, mysql.TypeDate:
+		return 3, true
+	case mysql.TypeLong, mysql.TypeFloat:
+		return 4, true
+	case mysql.TypeLonglong, mysql.TypeDouble:
+		return 8, true
+	case mysql.TypeDuration:
+		return 3 + (ft.fsp()+1)/2, true
+	case mysql.TypeTimestamp:
+		return 4 + (ft.fsp()+1)/2, true
+	case mysql.TypeDatetime:
+		return 5 + (ft.fsp()+1)/2, true
+	case mysql.TypeNewDecimal:
+		flen, dec := ft.flenOrDefault(), max(ft.decimal, 0)
+		return decimalBytes(flen-dec) + decimalBytes(dec), true
+	case mysql.TypeBit:
+		return (ft.flenOrDefault() + 7) / 8, true
+	case mysql.TypeEnum:
+		if len(ft.elems) > 255 {
+			return 2, true
+		}
+		return 1, true
+	case mysql.TypeSet:
+		switch n := (len(ft.elems) + 7) / 8; {
+		case n <= 4:
+			return max(n, 1), true
+		default:
+			return 8, true
+		}
+	case mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeJSON:
+		flen, _ := DefaultFlenDecimalForType(ft.tp)
+		return flen, true
+	case mysql.TypeString, mysql.TypeVarchar, mysql.TypeVarString:
+		if ft.isBinaryString() {
+			return ft.flenOrDefault(), true
+		}
+		if charsetMaxlen <= 0 {
+			cs := ft.charset
+			if cs == "" {
+				cs = mysql.DefaultCharset
+			}
+			info, err := charset.GetCharsetInfo(cs)
+			if err != nil {
+				return 0, false
+			}
+			charsetMaxlen = info.Maxlen
+		}
+		return ft.flenOrDefault() * charsetMaxlen, true
+	}
+	return 0, false
+}
+
+// DisplayWidth returns the characters a value of a numeric, BIT or temporal
+// type is displayed with, e.g. 20 for BIGINT, which counts the sign, and 23
+// for DATETIME(3). ok is false for the other types.
+func (ft *FieldType) DisplayWidth() (width int, ok bool) {
+	switch ft.tp {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong:
+		width = ft.flenOrDefault()
+		// The default widths count the sign, which the unsigned integers don't
+		// have.
+		if ft.flen == UnspecifiedLength && mysql.HasUnsignedFlag(ft.flag) {
+			width--
+		}
+		return width, true
+	case mysql.TypeLonglong, mysql.TypeFloat, mysql.TypeDouble, mysql.TypeBit, mysql.TypeYear, mysql.TypeDate:
+		return ft.flenOrDefault(), true
+	case mysql.TypeNewDecimal:
+		// The digits, the point and the sign.
+		width = ft.flenOrDefault()
+		if ft.decimal > 0 {
+			width++
+		}
+		if !mysql.HasUnsignedFlag(ft.flag) {
+			width++
+		}
+		return width, true
+	case mysql.TypeDuration, mysql.TypeDatetime, mysql.TypeTimestamp:
+		if ft.flen != UnspecifiedLength {
+			return ft.flen, true
+		}
+		width = mysql.MaxDatetimeWidthNoFsp
+		if ft.tp == mysql.TypeDuration {
+			width = mysql.MaxDurationWidthNoFsp
+		}
+		if fsp := ft.fsp(); fsp > 0 {
+			width += 1 + fsp
+		}
+		return width, true
+	}
+	return 0, false
+}
@@ -72,6 +72,8 @@ type FieldType struct {
 	elems            []string
 	elemsIsBinaryLit []bool
 	array            bool
+	// geometryType is the subtype of mysql.TypeGeometry.
+	geometryType GeometryType
 	// Please keep in mind that jsonFieldType should be updated if you add a new field here.
 }
 
@@ -92,6 +94,7 @@ func (ft *FieldType) Hash64(h IHasher) {
 		h.HashBool(elem)
 	}
 	h.HashBool(ft.array)
+	h.HashByte(byte(ft.geometryType))
 }
 
 // Equals implements the cascades/base.Hasher.<1th> interface.
@@ -112,7 +115,8 @@ func (ft *FieldType) Equals(other any) bool {
 		ft.decimal == ft2.decimal &&
 		ft.charset == ft2.charset &&
 		ft.collate == ft2.collate &&
-		ft.array == ft2.array
+		ft.array == ft2.array &&
+		ft.geometryType == ft2.geometryType
 	if !ok {
 		return false
 	}
@@ -308,6 +312,16 @@ func (ft *FieldType) IsArray() bool {
 	return ft.array
 }
 
+// GetGeometryType returns the subtype of a mysql.TypeGeometry field.
+func (ft *FieldType) GetGeometryType() GeometryType {
+	return ft.geometryType
+}
+
+// SetGeometryType sets the subtype of a mysql.TypeGeometry field.
+func (ft *FieldType) SetGeometryType(tp GeometryType) {
+	ft.geometryType = tp
+}
+
 // ArrayType return the type of the array.
 func (ft *FieldType) ArrayType() *FieldType {
 	if !ft.array {
@@ -371,7 +385,8 @@ func (ft *FieldType) Equal(other *FieldType) bool {
 		ft.charset == other.charset &&
 		ft.collate == other.collate &&
 		flenEqual &&
-		mysql.HasUnsignedFlag(ft.flag) == mysql.HasUnsignedFlag(other.flag)
+		mysql.HasUnsignedFlag(ft.flag) == mysql.HasUnsignedFlag(other.flag) &&
+		ft.geometryType == other.geometryType
 	if !partialEqual {
 		return false
 	}
@@ -445,7 +460,7 @@ func (ft *FieldType) Init(tp byte) {
 // CompactStr only considers tp/CharsetBin/flen/Deimal.
 // This is used for showing column type in infoschema.
 func (ft *FieldType) CompactStr() string {
-	ts := TypeToStr(ft.GetType(), ft.charset)
+	ts := ft.typeStr()
 	suffix := ""
 
 	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.GetType())
@@ -513,6 +528,14 @@ func (ft *FieldType) CompactStr() string {
 	return ts + suffix
 }
 
+// typeStr returns the type keyword, which is the subtype for mysql.TypeGeometry.
+func (ft *FieldType) typeStr() string {
+	if ft.GetType() == mysql.TypeGeometry {
+		return ft.geometryType.String()
+	}
+	return TypeToStr(ft.GetType(), ft.charset)
+}
+
 // InfoSchemaStr joins the CompactStr with unsigned flag and
 // returns a string.
 func (ft *FieldType) InfoSchemaStr() string {
@@ -553,7 +576,7 @@ func (ft *FieldType) String() string {
 
 // Restore implements Node interface.
 func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord(TypeToStr(ft.GetType(), ft.charset))
+	ctx.WriteKeyWord(ft.typeStr())
 
 	precision := UnspecifiedLength
 	scale := UnspecifiedLength
@@ -591,7 +614,9 @@ func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
 	if mysql.HasZerofillFlag(ft.flag) {
 		ctx.WriteKeyWord(" ZEROFILL")
 	}
-	if mysql.HasBinaryFlag(ft.flag) && ft.charset != charset.CharsetBin {
+	// The BINARY attribute of a string is written as the _bin collation of its
+	// charset once the collation is resolved, see ast.ColumnDef.ResolveBinaryCollation.
+	if mysql.HasBinaryFlag(ft.flag) && ft.charset != charset.CharsetBin && ft.collate == "" {
 		ctx.WriteKeyWord(" BINARY")
 	}
 
@@ -726,6 +751,7 @@ type jsonFieldType struct {
 	Elems            []string
 	ElemsIsBinaryLit []bool
 	Array            bool
+	GeometryType     GeometryType
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -742,6 +768,7 @@ func (ft *FieldType) UnmarshalJSON(data []byte) error {
 		ft.elems = r.Elems
 		ft.elemsIsBinaryLit = r.ElemsIsBinaryLit
 		ft.array = r.Array
+		ft.geometryType = r.GeometryType
 	}
 	return err
 }
@@ -758,6 +785,7 @@ func (ft *FieldType) MarshalJSON() ([]byte, error) {
 	r.Elems = ft.elems
 	r.ElemsIsBinaryLit = ft.elemsIsBinaryLit
 	r.Array = ft.array
+	r.GeometryType = ft.geometryType
 	return json.Marshal(r)
 }
 
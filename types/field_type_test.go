@@ -15,11 +15,13 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/abbychau/mysql-parser"
 	"github.com/abbychau/mysql-parser/ast"
 	"github.com/abbychau/mysql-parser/charset"
+	"github.com/abbychau/mysql-parser/format"
 	"github.com/abbychau/mysql-parser/mysql"
 	// import parser_driver
 	_ "github.com/abbychau/mysql-parser/test_driver"
@@ -329,3 +331,188 @@ func TestCompactStr(t *testing.T) {
 		require.Equal(t, cc.e2, ft.CompactStr())
 	}
 }
+
+func TestGeometryType(t *testing.T) {
+	ft := NewFieldType(mysql.TypeGeometry)
+	require.Equal(t, GeometryTypeGeometry, ft.GetGeometryType())
+	require.Equal(t, "geometry", ft.CompactStr())
+
+	ft.SetGeometryType(GeometryTypeMultiPolygon)
+	require.Equal(t, "multipolygon", ft.CompactStr())
+	require.Equal(t, "multipolygon", ft.InfoSchemaStr())
+	var sb strings.Builder
+	require.NoError(t, ft.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "MULTIPOLYGON", sb.String())
+
+	ft2 := ft.Clone()
+	require.True(t, ft.Equal(ft2))
+	require.True(t, ft.Equals(ft2))
+	ft2.SetGeometryType(GeometryTypePoint)
+	require.False(t, ft.Equal(ft2))
+	require.False(t, ft.Equals(ft2))
+
+	data, err := ft.MarshalJSON()
+	require.NoError(t, err)
+	ft3 := &FieldType{}
+	require.NoError(t, ft3.UnmarshalJSON(data))
+	require.Equal(t, GeometryTypeMultiPolygon, ft3.GetGeometryType())
+}
+
+func TestDefaultFlenDecimalForType(t *testing.T) {
+	cases := []struct {
+		tp   byte
+		flen int
+		dec  int
+	}{
+		{mysql.TypeUnspecified, -1, -1},
+		{mysql.TypeTiny, 4, 0},
+		{mysql.TypeShort, 6, 0},
+		{mysql.TypeLong, 11, 0},
+		{mysql.TypeFloat, 12, -1},
+		{mysql.TypeDouble, 22, -1},
+		{mysql.TypeNull, 0, 0},
+		{mysql.TypeTimestamp, 19, 0},
+		{mysql.TypeLonglong, 20, 0},
+		{mysql.TypeInt24, 9, 0},
+		{mysql.TypeDate, 10, 0},
+		{mysql.TypeDuration, 10, 0},
+		{mysql.TypeDatetime, 19, 0},
+		{mysql.TypeYear, 4, 0},
+		{mysql.TypeNewDate, -1, -1},
+		{mysql.TypeVarchar, 5, 0},
+		{mysql.TypeBit, 1, 0},
+		{mysql.TypeJSON, 4294967295, 0},
+		{mysql.TypeNewDecimal, 10, 0},
+		{mysql.TypeEnum, -1, 0},
+		{mysql.TypeSet, -1, 0},
+		{mysql.TypeTinyBlob, 255, 0},
+		{mysql.TypeMediumBlob, 16777215, 0},
+		{mysql.TypeLongBlob, 4294967295, 0},
+		{mysql.TypeBlob, 65535, 0},
+		{mysql.TypeVarString, 5, 0},
+		{mysql.TypeString, 1, 0},
+		{mysql.TypeGeometry, -1, -1},
+		{mysql.TypeTiDBVectorFloat32, -1, -1},
+	}
+	for _, ca := range cases {
+		flen, dec := DefaultFlenDecimalForType(ca.tp)
+		require.Equal(t, ca.flen, flen, TypeToStr(ca.tp, ""))
+		require.Equal(t, ca.dec, dec, TypeToStr(ca.tp, ""))
+	}
+}
+
+func TestFieldTypeLengths(t *testing.T) {
+	const na = -1
+	p := parser.New()
+	cases := []struct {
+		tp         string
+		charLen    int
+		bytes      int
+		utf8Bytes  int
+		displayLen int
+	}{
+		{"tinyint", na, 1, 1, 4},
+		{"tinyint(1)", na, 1, 1, 1},
+		{"smallint", na, 2, 2, 6},
+		{"mediumint", na, 3, 3, 9},
+		{"int", na, 4, 4, 11},
+		{"int unsigned", na, 4, 4, 10},
+		{"int(5) unsigned", na, 4, 4, 5},
+		{"tinyint unsigned", na, 1, 1, 3},
+		{"bigint", na, 8, 8, 20},
+		{"bigint unsigned", na, 8, 8, 20},
+		{"float", na, 4, 4, 12},
+		{"double", na, 8, 8, 22},
+		{"decimal", na, 5, 5, 11},
+		{"decimal(10,2)", na, 5, 5, 12},
+		{"decimal(10,2) unsigned", na, 5, 5, 11},
+		{"decimal(20,6)", na, 10, 10, 22},
+		{"decimal(65,30)", na, 30, 30, 67},
+		{"bit", na, 1, 1, 1},
+		{"bit(9)", na, 2, 2, 9},
+		{"bit(64)", na, 8, 8, 64},
+		{"year", na, 1, 1, 4},
+		{"date", na, 3, 3, 10},
+		{"time", na, 3, 3, 10},
+		{"time(3)", na, 5, 5, 14},
+		{"datetime", na, 5, 5, 19},
+		{"datetime(3)", na, 7, 7, 23},
+		{"datetime(6)", na, 8, 8, 26},
+		{"timestamp", na, 4, 4, 19},
+		{"timestamp(1)", na, 5, 5, 21},
+		{"char", 1, 4, 3, na},
+		{"char(10)", 10, 40, 30, na},
+		{"char(10) charset latin1", 10, 10, 30, na},
+		{"binary(10)", 10, 10, 10, na},
+		{"varchar(255)", 255, 1020, 765, na},
+		{"varchar(255) charset ascii", 255, 255, 765, na},
+		{"varbinary(255)", 255, 255, 255, na},
+		{"tinytext", 255, 255, 255, na},
+		{"text", 65535, 65535, 65535, na},
+		{"text(10)", 65535, 65535, 65535, na},
+		{"mediumtext", 16777215, 16777215, 16777215, na},
+		{"longtext", 4294967295, 4294967295, 4294967295, na},
+		{"blob", 65535, 65535, 65535, na},
+		{"longblob", 4294967295, 4294967295, 4294967295, na},
+		{"json", na, 4294967295, 4294967295, na},
+		{"enum('a', 'bb', 'ccc')", 3, 1, 1, na},
+		{"enum('é', 'ab')", 2, 1, 1, na},
+		{"set('a', 'bb', 'ccc')", 8, 1, 1, na},
+		{"set('a','b','c','d','e','f','g','h','i')", 17, 2, 2, na},
+		{"geometry", na, na, na, na},
+		{"point", na, na, na, na},
+	}
+	for _, ca := range cases {
+		stmt, err := p.ParseOneStmt(fmt.Sprintf("create table t (c %s)", ca.tp), "", "")
+		require.NoError(t, err, ca.tp)
+		ft := stmt.(*ast.CreateTableStmt).Cols[0].Tp
+
+		length, ok := ft.CharacterMaxLength()
+		require.Equal(t, ca.charLen != na, ok, ca.tp)
+		if ok {
+			require.Equal(t, ca.charLen, length, ca.tp)
+		}
+		length, ok = ft.StorageBytesUpperBound(0)
+		require.Equal(t, ca.bytes != na, ok, ca.tp)
+		if ok {
+			require.Equal(t, ca.bytes, length, ca.tp)
+		}
+		length, ok = ft.StorageBytesUpperBound(3)
+		require.Equal(t, ca.utf8Bytes != na, ok, ca.tp)
+		if ok {
+			require.Equal(t, ca.utf8Bytes, length, ca.tp)
+		}
+		length, ok = ft.DisplayWidth()
+		require.Equal(t, ca.displayLen != na, ok, ca.tp)
+		if ok {
+			require.Equal(t, ca.displayLen, length, ca.tp)
+		}
+	}
+
+	// The types built without a column definition fall back to the defaults.
+	ft := NewFieldType(mysql.TypeLonglong)
+	width, ok := ft.DisplayWidth()
+	require.True(t, ok)
+	require.Equal(t, 20, width)
+	ft = NewFieldType(mysql.TypeDatetime)
+	ft.SetDecimal(3)
+	width, ok = ft.DisplayWidth()
+	require.True(t, ok)
+	require.Equal(t, 23, width)
+	bytes, ok := ft.StorageBytesUpperBound(0)
+	require.True(t, ok)
+	require.Equal(t, 7, bytes)
+	ft = NewFieldType(mysql.TypeVarchar)
+	length, ok := ft.CharacterMaxLength()
+	require.True(t, ok)
+	require.Equal(t, 5, length)
+	for _, tp := range []byte{mysql.TypeUnspecified, mysql.TypeNull, mysql.TypeNewDate, mysql.TypeGeometry, mysql.TypeTiDBVectorFloat32} {
+		ft = NewFieldType(tp)
+		_, ok = ft.CharacterMaxLength()
+		require.False(t, ok)
+		_, ok = ft.StorageBytesUpperBound(0)
+		require.False(t, ok)
+		_, ok = ft.DisplayWidth()
+		require.False(t, ok)
+	}
+}
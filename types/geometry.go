@@ -0,0 +1,50 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// GeometryType is the subtype of a spatial column. All spatial columns are
+// mysql.TypeGeometry, the subtype keeps the type keyword they are declared with.
+// The values are the same as the ones of MySQL.
+type GeometryType byte
+
+// Geometry types.
+const (
+	GeometryTypeGeometry GeometryType = iota
+	GeometryTypePoint
+	GeometryTypeLineString
+	GeometryTypePolygon
+	GeometryTypeMultiPoint
+	GeometryTypeMultiLineString
+	GeometryTypeMultiPolygon
+	GeometryTypeGeometryCollection
+)
+
+var geometryType2Str = [...]string{
+	GeometryTypeGeometry:           "geometry",
+	GeometryTypePoint:              "point",
+	GeometryTypeLineString:         "linestring",
+	GeometryTypePolygon:            "polygon",
+	GeometryTypeMultiPoint:         "multipoint",
+	GeometryTypeMultiLineString:    "multilinestring",
+	GeometryTypeMultiPolygon:       "multipolygon",
+	GeometryTypeGeometryCollection: "geometrycollection",
+}
+
+// String implements fmt.Stringer interface.
+func (t GeometryType) String() string {
+	if int(t) < len(geometryType2Str) {
+		return geometryType2Str[t]
+	}
+	return geometryType2Str[GeometryTypeGeometry]
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+// Version is the semantic version of the parser. The minor version is bumped
+// when a Feature is added, the major version on an incompatible change of the
+// API or of the AST.
+const Version = "3.4.0"
+
+// Feature is an optional capability of the parser, which the code depending on
+// it can check with Features instead of comparing versions.
+type Feature string
+
+// The features of the parser.
+const (
+	// FeatureWindowFunctions parses the window functions and the OVER clause.
+	FeatureWindowFunctions Feature = "window-functions"
+	// FeatureIntersectExcept parses INTERSECT and EXCEPT besides UNION.
+	FeatureIntersectExcept Feature = "intersect-except"
+	// FeatureSequences parses CREATE, ALTER and DROP SEQUENCE and NEXT VALUE FOR,
+	// see Parser.EnableSequence.
+	FeatureSequences Feature = "sequences"
+	// FeatureMariaDBDialect accepts the MariaDB constructs with warnings, see
+	// Parser.SetDialect.
+	FeatureMariaDBDialect Feature = "mariadb-dialect"
+	// FeatureStrictValidation reports the constructs MySQL rejects, see
+	// StrictValidate.
+	FeatureStrictValidation Feature = "strict-validation"
+	// FeatureIncremental re-parses the edited statements only, see
+	// NewIncremental.
+	FeatureIncremental Feature = "incremental"
+	// FeatureStatementSplitting splits and labels statements without parsing
+	// them, see SplitAndClassify and SniffStatementType.
+	FeatureStatementSplitting Feature = "statement-splitting"
+	// FeatureStatementOffsets records the start and end offsets of the parsed
+	// statements, see ast.StmtNode.EndPosition.
+	FeatureStatementOffsets Feature = "statement-offsets"
+	// FeatureSyntaxErrorPosition locates the syntax errors, see SyntaxError.
+	FeatureSyntaxErrorPosition Feature = "syntax-error-position"
+	// FeatureProgressHook reports the progress of a parse, see
+	// Parser.SetProgressHook.
+	FeatureProgressHook Feature = "progress-hook"
+	// FeatureASTJSON encodes a tree into JSON, see ast.ToJSON.
+	FeatureASTJSON Feature = "ast-json"
+	// FeatureStatementHandlers gives the statements the grammar doesn't know to
+	// handlers, see Parser.RegisterStatementHandler.
+	FeatureStatementHandlers Feature = "statement-handlers"
+	// FeatureStreaming parses the statements read from an io.Reader one at a
+	// time, see NewStreamParser.
+	FeatureStreaming Feature = "streaming"
+	// FeatureASTBinary encodes trees into a compact binary form and decodes
+	// them, see ast.EncodeBinary.
+	FeatureASTBinary Feature = "ast-binary"
+)
+
+// features are the features of this build.
+var features = []Feature{
+	FeatureWindowFunctions,
+	FeatureIntersectExcept,
+	FeatureSequences,
+	FeatureMariaDBDialect,
+	FeatureStrictValidation,
+	FeatureIncremental,
+	FeatureStatementSplitting,
+	FeatureStatementOffsets,
+	FeatureSyntaxErrorPosition,
+	FeatureProgressHook,
+	FeatureASTJSON,
+	FeatureStatementHandlers,
+	FeatureStreaming,
+	FeatureASTBinary,
+}
+
+// Features returns the features of the parser, a feature it doesn't have is
+// missing in the map. The map is a copy, callers may modify it.
+func Features() map[Feature]bool {
+	m := make(map[Feature]bool, len(features))
+	for _, f := range features {
+		m[f] = true
+	}
+	return m
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion(t *testing.T) {
+	require.Regexp(t, regexp.MustCompile(`^\d+\.\d+\.\d+$`), parser.Version)
+}
+
+// TestFeatures checks that every feature has a canary exercising it, so that
+// Features can't claim a capability that is gone.
+func TestFeatures(t *testing.T) {
+	canaries := map[parser.Feature]func(t *testing.T){
+		parser.FeatureWindowFunctions: func(t *testing.T) {
+			_, err := parser.New().ParseOneStmt("SELECT ROW_NUMBER() OVER (ORDER BY a) FROM t", "", "")
+			require.NoError(t, err)
+		},
+		parser.FeatureIntersectExcept: func(t *testing.T) {
+			_, err := parser.New().ParseOneStmt("SELECT 1 INTERSECT SELECT 2 EXCEPT SELECT 3", "", "")
+			require.NoError(t, err)
+		},
+		parser.FeatureSequences: func(t *testing.T) {
+			p := parser.New()
+			p.EnableSequence(true)
+			stmt, err := p.ParseOneStmt("CREATE SEQUENCE s START WITH 1", "", "")
+			require.NoError(t, err)
+			require.IsType(t, &ast.CreateSequenceStmt{}, stmt)
+		},
+		parser.FeatureMariaDBDialect: func(t *testing.T) {
+			p := parser.New()
+			p.SetDialect(parser.DialectMariaDB)
+			_, warns, err := p.ParseSQL("DELETE FROM t RETURNING a")
+			require.NoError(t, err)
+			require.Len(t, warns, 1)
+			require.IsType(t, &parser.DialectWarning{}, warns[0])
+		},
+		parser.FeatureStrictValidation: func(t *testing.T) {
+			stmt, err := parser.New().ParseOneStmt("CREATE TABLE t (a INT, a INT)", "", "")
+			require.NoError(t, err)
+			require.NotEmpty(t, parser.StrictValidate(stmt))
+		},
+		parser.FeatureIncremental: func(t *testing.T) {
+			s := parser.NewIncremental("SELECT 1; SELECT 2")
+			require.NoError(t, s.Edit(len("SELECT 1; SELECT "), 1, "3"))
+			stmts, _, errs := s.Statements()
+			require.Empty(t, errs)
+			require.Len(t, stmts, 2)
+		},
+		parser.FeatureStatementSplitting: func(t *testing.T) {
+			pieces, err := parser.SplitAndClassify("SELECT 1; INSERT INTO t VALUES (1)")
+			require.NoError(t, err)
+			require.Len(t, pieces, 2)
+			label, err := parser.SniffStatementType("INSERT INTO t VALUES (1)")
+			require.NoError(t, err)
+			require.Equal(t, parser.StmtLabel("Insert"), label)
+		},
+		parser.FeatureStatementOffsets: func(t *testing.T) {
+			stmts, _, err := parser.New().ParseSQL("SELECT 1; SELECT 2;")
+			require.NoError(t, err)
+			require.Equal(t, 10, stmts[1].OriginTextPosition())
+			require.Equal(t, 19, stmts[1].EndPosition())
+		},
+		parser.FeatureSyntaxErrorPosition: func(t *testing.T) {
+			_, _, err := parser.New().ParseSQL("SELECT 1;\nSELECT FROM")
+			var syntaxErr *parser.SyntaxError
+			require.True(t, errors.As(err, &syntaxErr))
+			require.Equal(t, 2, syntaxErr.Line)
+		},
+		parser.FeatureProgressHook: func(t *testing.T) {
+			p := parser.New()
+			calls := 0
+			p.SetProgressHook(func(int, int64) { calls++ })
+			_, _, err := p.ParseSQL("SELECT 1; SELECT 2")
+			require.NoError(t, err)
+			require.Positive(t, calls)
+		},
+		parser.FeatureASTJSON: func(t *testing.T) {
+			stmt, err := parser.New().ParseOneStmt("SELECT 1", "", "")
+			require.NoError(t, err)
+			data, err := ast.ToJSON(stmt)
+			require.NoError(t, err)
+			require.Contains(t, string(data), `"type":"SelectStmt"`)
+		},
+		parser.FeatureStatementHandlers: func(t *testing.T) {
+			p := parser.New()
+			require.NoError(t, p.RegisterStatementHandler("ROUTE", func(text string, _ int) (ast.StmtNode, error) {
+				return &ast.GenericStmt{Keyword: "ROUTE", SQL: text}, nil
+			}))
+			stmts, _, err := p.ParseSQL("ROUTE TO 'a'; SELECT 1")
+			require.NoError(t, err)
+			require.IsType(t, &ast.GenericStmt{}, stmts[0])
+		},
+		parser.FeatureStreaming: func(t *testing.T) {
+			sp := parser.NewStreamParser(strings.NewReader("SELECT 1; SELECT 2"))
+			for range 2 {
+				_, err := sp.Next()
+				require.NoError(t, err)
+			}
+			_, err := sp.Next()
+			require.Equal(t, io.EOF, err)
+		},
+		parser.FeatureASTBinary: func(t *testing.T) {
+			stmt, err := parser.New().ParseOneStmt("SELECT 1", "", "")
+			require.NoError(t, err)
+			blob, err := ast.EncodeBinary([]ast.StmtNode{stmt})
+			require.NoError(t, err)
+			decoded, err := ast.DecodeBinary(blob)
+			require.NoError(t, err)
+			require.True(t, ast.Equal(stmt, decoded[0]))
+		},
+	}
+
+	features := parser.Features()
+	for f := range features {
+		require.Contains(t, canaries, f, "feature %s has no canary", f)
+	}
+	for f, canary := range canaries {
+		require.True(t, features[f], "feature %s is missing", f)
+		t.Run(string(f), canary)
+	}
+}
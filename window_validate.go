@@ -0,0 +1,115 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abbychau/mysql-parser/ast"
+)
+
+// windowFuncArgCounts are the min and max numbers of arguments of the window
+// functions which aren't aggregate functions.
+var windowFuncArgCounts = map[string][2]int{
+	ast.WindowFuncRowNumber:   {0, 0},
+	ast.WindowFuncRank:        {0, 0},
+	ast.WindowFuncDenseRank:   {0, 0},
+	ast.WindowFuncCumeDist:    {0, 0},
+	ast.WindowFuncPercentRank: {0, 0},
+	ast.WindowFuncNtile:       {1, 1},
+	ast.WindowFuncLead:        {1, 3},
+	ast.WindowFuncLag:         {1, 3},
+	ast.WindowFuncFirstValue:  {1, 1},
+	ast.WindowFuncLastValue:   {1, 1},
+	ast.WindowFuncNthValue:    {2, 2},
+}
+
+// windowFuncValidator reports the window functions breaking RuleWindowFuncArgs.
+// The grammar only accepts the right numbers of arguments, but an AST may be
+// built or rewritten by a tool.
+type windowFuncValidator struct {
+	issues []ValidationIssue
+}
+
+// Enter implements ast.Visitor interface.
+func (v *windowFuncValidator) Enter(in ast.Node) (ast.Node, bool) {
+	if fn, ok := in.(*ast.WindowFuncExpr); ok {
+		v.check(fn)
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (*windowFuncValidator) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func (v *windowFuncValidator) check(fn *ast.WindowFuncExpr) {
+	name := strings.ToLower(fn.Name)
+	counts, ok := windowFuncArgCounts[name]
+	if !ok {
+		return
+	}
+	if n := len(fn.Args); n < counts[0] || n > counts[1] {
+		expected := fmt.Sprint(counts[0])
+		if counts[1] > counts[0] {
+			expected = fmt.Sprintf("%d to %d", counts[0], counts[1])
+		}
+		v.report(fn, "Incorrect parameter count in the call to %s, %s expected but %d given", strings.ToUpper(name), expected, n)
+		return
+	}
+	switch name {
+	case ast.WindowFuncNtile:
+		if !isCountArg(fn.Args[0], 1) {
+			v.report(fn, "Incorrect arguments to NTILE, N must be a positive integer")
+		}
+	case ast.WindowFuncLead, ast.WindowFuncLag:
+		if len(fn.Args) > 1 && !isCountArg(fn.Args[1], 0) {
+			v.report(fn, "Incorrect arguments to %s, the offset must be a non-negative integer", strings.ToUpper(name))
+		}
+	case ast.WindowFuncNthValue:
+		if !isCountArg(fn.Args[1], 1) {
+			v.report(fn, "Incorrect arguments to NTH_VALUE, N must be a positive integer")
+		}
+	}
+}
+
+func (v *windowFuncValidator) report(fn *ast.WindowFuncExpr, format string, args ...interface{}) {
+	v.issues = append(v.issues, ValidationIssue{
+		Rule:    RuleWindowFuncArgs,
+		Clause:  strings.ToUpper(fn.Name),
+		Offset:  fn.OriginTextPosition(),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// isCountArg tells whether expr is an integer literal not less than low, or a
+// parameter marker, whose value is checked when the statement is executed.
+func isCountArg(expr ast.ExprNode, low uint64) bool {
+	if _, ok := expr.(ast.ParamMarkerExpr); ok {
+		return true
+	}
+	value, ok := expr.(ast.ValueExpr)
+	if !ok {
+		return false
+	}
+	switch v := value.GetValue().(type) {
+	case int64:
+		return v >= 0 && uint64(v) >= low
+	case uint64:
+		return v >= low
+	}
+	return false
+}
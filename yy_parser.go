@@ -95,6 +95,13 @@ type Parser struct {
 
 	explicitCharset       bool
 	strictDoubleFieldType bool
+	charsetCollationCheck CharsetCollationCheck
+	zeroDateMode          ZeroDateMode
+	progressHook          ProgressHook
+	sequenceDisabled      bool
+	defaultSchema         string
+	storageOptionCheck    bool
+	stmtHandlers          map[string]StatementHandler
 
 	// the following fields are used by yyParse to reduce allocation.
 	cache  []yySymType
@@ -113,6 +120,7 @@ func yyhintSetOffset(_ *yyhintSymType, _ int) {
 
 type stmtTexter interface {
 	stmtText() string
+	stmtOffsets() (start, end int)
 }
 
 // New returns a Parser object with default SQL mode.
@@ -140,6 +148,18 @@ func (parser *Parser) Reset() {
 func (parser *Parser) reset() {
 	parser.explicitCharset = false
 	parser.strictDoubleFieldType = false
+	parser.charsetCollationCheck = CharsetCollationCheckOff
+	parser.zeroDateMode = ZeroDateAccept
+	parser.progressHook = nil
+	parser.sequenceDisabled = false
+	parser.defaultSchema = ""
+	parser.storageOptionCheck = false
+	parser.lexer.lenientIdentEncoding = false
+	parser.lexer.digitSeparators = false
+	parser.lexer.litCheck.strictCharset = false
+	parser.stmtHandlers = nil
+	parser.lexer.funcArgParsers = nil
+	parser.SetDialect(DialectMySQL)
 	parser.EnableWindowFunc(true)
 	parser.SetStrictDoubleTypeCheck(true)
 	mode, _ := mysql.GetSQLMode(mysql.DefaultSQLMode)
@@ -151,6 +171,15 @@ func (parser *Parser) SetStrictDoubleTypeCheck(val bool) {
 	parser.strictDoubleFieldType = val
 }
 
+// SetSupportUnderscoreInNumericLiterals sets whether the underscores between
+// digits are digit separators of the numeric literals, e.g. 1_000_000 and
+// 1_000.000_1e1_0, which MySQL doesn't accept. They are stripped before the
+// conversion, alike for the integer, decimal and float literals. By default,
+// 1_000 is an identifier as in MySQL.
+func (parser *Parser) SetSupportUnderscoreInNumericLiterals(val bool) {
+	parser.lexer.digitSeparators = val
+}
+
 // SetParserConfig sets the parser config.
 func (parser *Parser) SetParserConfig(config ParserConfig) {
 	parser.EnableWindowFunc(config.EnableWindowFunction)
@@ -160,6 +189,7 @@ func (parser *Parser) SetParserConfig(config ParserConfig) {
 
 // ParseSQL parses a query string to raw ast.StmtNode.
 func (parser *Parser) ParseSQL(sql string, params ...ParseParam) (stmt []ast.StmtNode, warns []error, err error) {
+	ast.FreezeDriver()
 	resetParams(parser)
 	parser.lexer.reset(sql)
 	for _, p := range params {
@@ -171,7 +201,14 @@ func (parser *Parser) ParseSQL(sql string, params ...ParseParam) (stmt []ast.Stm
 	parser.result = parser.result[:0]
 
 	var l yyLexer = &parser.lexer
-	yyParse(l, parser)
+	parser.parse(sql)
+	if _, errs := l.Errors(); len(errs) == 0 {
+		parser.checkCharsetCollation(parser.result)
+		parser.checkIdentifiers(parser.result)
+		parser.checkZeroDates(parser.result)
+		parser.checkSequences(parser.result)
+		parser.checkDefaultValues(parser.result)
+	}
 
 	warns, errs := l.Errors()
 	if len(warns) > 0 {
@@ -180,11 +217,14 @@ func (parser *Parser) ParseSQL(sql string, params ...ParseParam) (stmt []ast.Stm
 		warns = nil
 	}
 	if len(errs) != 0 {
-		return nil, warns, errors.Trace(errs[0])
+		return nil, warns, errors.Trace(parser.dialectError(sql, params, errs[0]))
 	}
 	for _, stmt := range parser.result {
 		ast.SetFlag(stmt)
 	}
+	if parser.progressHook != nil {
+		parser.progressHook(len(parser.result), int64(len(sql)))
+	}
 	return parser.result, warns, nil
 }
 
@@ -199,12 +239,19 @@ func (parser *Parser) lastErrorAsWarn() {
 }
 
 // ParseOneStmt parses a query and returns an ast.StmtNode.
-// The query must have one statement, otherwise ErrSyntax is returned.
+// The query must have one statement, which may be followed by ';', comments and
+// empty statements. Another statement isn't parsed, a *MultipleStatementsError
+// locating it is returned. A query without statement returns ErrSyntax.
 func (parser *Parser) ParseOneStmt(sql, charset, collation string) (ast.StmtNode, error) {
+	parser.lexer.oneStmt = true
 	stmts, _, err := parser.ParseSQL(sql, CharsetConnection(charset), CollationConnection(collation))
+	parser.lexer.oneStmt = false
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if offset := parser.lexer.nextStmtOffset; offset >= 0 {
+		return nil, &MultipleStatementsError{Offset: offset}
+	}
 	if len(stmts) != 1 {
 		return nil, ErrSyntax
 	}
@@ -247,6 +294,13 @@ func (*Parser) startOffset(v *yySymType) int {
 	return v.offset
 }
 
+// positionAt describes the offset of the src string in the format of a SyntaxError,
+// "line %d column %d near ...", with the column counted from 0 as well. Unlike the
+// one of a SyntaxError, the position is the one of the offset itself.
+func (parser *Parser) positionAt(offset int) string {
+	return fmt.Sprintf("%s near \"%s\"", parser.lexer.lineColumnAt(offset), parser.src[offset:])
+}
+
 func (parser *Parser) endOffset(v *yySymType) int {
 	offset := v.offset
 	for offset > 0 && unicode.IsSpace(rune(parser.src[offset-1])) {
@@ -408,6 +462,30 @@ func convertToPriv(roleOrPrivList []*ast.RoleOrPriv) ([]*ast.PrivElem, error) {
 	return privileges, nil
 }
 
+// markDeprecatedValues sets ValuesExpr.Deprecated for the VALUES(col) of the
+// assignments of an ON DUPLICATE KEY UPDATE.
+func markDeprecatedValues(assignments []*ast.Assignment) {
+	var m deprecatedValuesMarker
+	for _, a := range assignments {
+		a.Expr.Accept(m)
+	}
+}
+
+type deprecatedValuesMarker struct{}
+
+// Enter implements ast.Visitor interface.
+func (deprecatedValuesMarker) Enter(in ast.Node) (ast.Node, bool) {
+	if v, ok := in.(*ast.ValuesExpr); ok {
+		v.Deprecated = true
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (deprecatedValuesMarker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
 var (
 	_ ParseParam = CharsetConnection("")
 	_ ParseParam = CollationConnection("")
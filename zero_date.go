@@ -0,0 +1,113 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"regexp"
+
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/abbychau/mysql-parser/mysql"
+	"github.com/abbychau/mysql-parser/terror"
+)
+
+// ZeroDateMode is how the parser treats the string literals of zero dates, e.g.
+// '0000-00-00', and of dates with a zero month or day, e.g. '2020-00-15'.
+type ZeroDateMode int
+
+const (
+	// ZeroDateAccept accepts the zero-date literals silently, this is the default.
+	ZeroDateAccept ZeroDateMode = iota
+	// ZeroDateRecord accepts the zero-date literals and annotates their ValueExpr
+	// with ast.AnnotationZeroDate or ast.AnnotationZeroInDate, see ast.FindZeroDateLiterals.
+	ZeroDateRecord
+	// ZeroDateStrict reports the first zero-date literal of the SQL as an error.
+	ZeroDateStrict
+)
+
+// ErrZeroDateLiteral returns for a zero-date literal under ZeroDateStrict.
+var ErrZeroDateLiteral = terror.ClassParser.NewStd(mysql.ErrZeroDateLiteral)
+
+// SetZeroDateMode sets how the parser treats the zero-date literals, wherever
+// they appear, e.g. in column defaults, inserted values and comparisons.
+func (parser *Parser) SetZeroDateMode(mode ZeroDateMode) {
+	parser.zeroDateMode = mode
+}
+
+// dateLiteralPattern matches the date and datetime strings with delimiters.
+var dateLiteralPattern = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})(?:[ T]\d{1,2}:\d{1,2}:\d{1,2}(?:\.\d*)?)?$`)
+
+// zeroDateAnnotation returns the annotation of the string s, or 0 if s isn't a
+// zero date.
+func zeroDateAnnotation(s string) ast.Annotation {
+	m := dateLiteralPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0
+	}
+	zero := func(digits string) bool {
+		for _, c := range digits {
+			if c != '0' {
+				return false
+			}
+		}
+		return true
+	}
+	switch {
+	case zero(m[1]) && zero(m[2]) && zero(m[3]):
+		return ast.AnnotationZeroDate
+	case zero(m[2]) || zero(m[3]):
+		return ast.AnnotationZeroInDate
+	}
+	return 0
+}
+
+// checkZeroDates annotates or reports the zero-date literals of stmts according
+// to the zero-date mode.
+func (parser *Parser) checkZeroDates(stmts []ast.StmtNode) {
+	if parser.zeroDateMode == ZeroDateAccept {
+		return
+	}
+	checker := &zeroDateChecker{}
+	for _, stmt := range stmts {
+		stmt.Accept(checker)
+	}
+	if parser.zeroDateMode == ZeroDateStrict && len(checker.found) > 0 {
+		v := checker.found[0]
+		parser.lexer.AppendError(ErrZeroDateLiteral.GenWithStackByArgs(v.GetString(), parser.positionAt(v.OriginTextPosition())))
+	}
+}
+
+// zeroDateChecker annotates the zero-date literals.
+type zeroDateChecker struct {
+	found []ast.ValueExpr
+}
+
+func (c *zeroDateChecker) Enter(n ast.Node) (ast.Node, bool) {
+	v, ok := n.(ast.ValueExpr)
+	if !ok {
+		return n, false
+	}
+	if s, ok := v.GetValue().(string); ok {
+		if a := zeroDateAnnotation(s); a != 0 {
+			if annotated, ok := v.(ast.AnnotatedNode); ok {
+				annotated.Annotate(a)
+			}
+			c.found = append(c.found, v)
+		}
+	}
+	return n, true
+}
+
+func (*zeroDateChecker) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
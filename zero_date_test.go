@@ -0,0 +1,88 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/abbychau/mysql-parser"
+	"github.com/abbychau/mysql-parser/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZeroDateMode(t *testing.T) {
+	p := parser.New()
+
+	cases := []struct {
+		sql        string
+		zeroDates  []string
+		zeroInDate []string
+	}{
+		{
+			sql:       "CREATE TABLE t (a DATE NOT NULL DEFAULT '0000-00-00', b DATETIME DEFAULT '0000-00-00 00:00:00', c DATE DEFAULT '2020-01-01', d VARCHAR(10) DEFAULT 'x')",
+			zeroDates: []string{"0000-00-00", "0000-00-00 00:00:00"},
+		},
+		{
+			sql:        "INSERT INTO t VALUES ('2020-01-01', '0000-00-00'), ('2020-00-15', '1999-12-31 23:59:59'), (DATE '0000-00-00', '0')",
+			zeroDates:  []string{"0000-00-00", "0000-00-00"},
+			zeroInDate: []string{"2020-00-15"},
+		},
+		{
+			sql:        "SELECT * FROM t WHERE a = '0000-00-00' OR b > '2021-03-00 10:00:00' OR c < '2021-03-01'",
+			zeroDates:  []string{"0000-00-00"},
+			zeroInDate: []string{"2021-03-00 10:00:00"},
+		},
+	}
+
+	find := func(stmt ast.StmtNode) (zeroDates, zeroInDate []string) {
+		for _, v := range ast.FindZeroDateLiterals(stmt) {
+			a := v.(ast.AnnotatedNode)
+			if a.HasAnnotation(ast.AnnotationZeroDate) {
+				zeroDates = append(zeroDates, v.GetString())
+			}
+			if a.HasAnnotation(ast.AnnotationZeroInDate) {
+				zeroInDate = append(zeroInDate, v.GetString())
+			}
+		}
+		return
+	}
+
+	// The literals are accepted without annotations by default.
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		require.Empty(t, ast.FindZeroDateLiterals(stmt), c.sql)
+	}
+
+	p.SetZeroDateMode(parser.ZeroDateRecord)
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err, c.sql)
+		zeroDates, zeroInDate := find(stmt)
+		require.Equal(t, c.zeroDates, zeroDates, c.sql)
+		require.Equal(t, c.zeroInDate, zeroInDate, c.sql)
+	}
+
+	p.SetZeroDateMode(parser.ZeroDateStrict)
+	for _, c := range cases {
+		_, err := p.ParseOneStmt(c.sql, "", "")
+		require.True(t, parser.ErrZeroDateLiteral.Equal(err), c.sql)
+		require.False(t, parser.ErrWrongValue.Equal(err), c.sql)
+		require.False(t, parser.ErrEmptyIdentifier.Equal(err), c.sql)
+	}
+	_, err := p.ParseOneStmt("SELECT 1 FROM t\nWHERE a = '2020-01-01' OR a = '0000-00-00'", "", "")
+	require.EqualError(t, err, `[parser:8069]Zero date literal '0000-00-00' is not allowed at line 2 column 30 near "'0000-00-00'"`)
+	_, err = p.ParseOneStmt("SELECT '2020-01-01', '0000', '00:00:00'", "", "")
+	require.NoError(t, err)
+}